@@ -246,7 +246,8 @@ resource "n8n_workflow" "scheduled_processing" {
     "callerPolicy"         = "workflowsFromSameOwner"
   })
 
-  static_data = jsonencode({
+  manage_static_data = true
+  static_data        = jsonencode({
     "node:Schedule Trigger" = {
       "recurrenceRules" = []
     }
@@ -0,0 +1,100 @@
+package client
+
+import "time"
+
+// N8nAPI is the part of the client that the provider's resources and data sources use. They depend on it
+// rather than on *Client, so that their CRUD logic can be unit tested against the mock in the clientmock
+// package. Run "make generate-client" after changing it.
+//
+//go:generate go run ../../tools/mockgen -source api.go -interface N8nAPI -out clientmock/mock_generated.go
+type N8nAPI interface {
+	// DefaultProjectID returns the project new workflows and credentials are created in
+	DefaultProjectID() string
+	// WorkflowNamePattern returns the regular expression that workflow names must match
+	WorkflowNamePattern() string
+	GetInstanceInfo() (*InstanceInfo, error)
+	GetInstanceSettings() (*InstanceSettings, error)
+	DismissBanner(banner string) error
+	IsMFAEnforced() (bool, error)
+	EnforceMFA(enforce bool) error
+	IsOwnerSetUp() (bool, error)
+	SetupOwner(req *OwnerSetupRequest) (*User, error)
+	RunAudit(options *AuditOptions) (*Audit, error)
+
+	// Workflows
+	GetWorkflow(id string) (*Workflow, error)
+	ListWorkflows(options *WorkflowListOptions, maxItems int) (*WorkflowList, error)
+	CreateWorkflow(workflow *Workflow) (*Workflow, error)
+	UpdateWorkflow(id string, workflow *Workflow) (*Workflow, error)
+	PatchWorkflow(id string, fields map[string]interface{}) (*Workflow, error)
+	DeleteWorkflow(id string) error
+	ActivateWorkflow(id string) (*Workflow, error)
+	DeactivateWorkflow(id string) (*Workflow, error)
+	ArchiveWorkflow(id string) (*Workflow, error)
+	UnarchiveWorkflow(id string) (*Workflow, error)
+	TransferWorkflow(id, projectID string) error
+	MoveWorkflowToFolder(id, folderID string) error
+	GetWorkflowVersions(workflowID string) ([]WorkflowVersion, error)
+	UpdateWorkflowTags(workflowID string, tagIDs []string) ([]Tag, error)
+	GetAllTags() ([]Tag, error)
+	CreateTag(name string) (*Tag, error)
+	WorkflowWebhooks(workflow *Workflow) []Webhook
+	RunWorkflow(id string, payload map[string]interface{}) (string, error)
+	RunWorkflowWithPinData(id string, pinData map[string]interface{}) (string, error)
+	WaitForExecution(id string, timeout time.Duration) (*Execution, error)
+	DeleteExecutions(filter *ExecutionDeleteFilter) error
+	GetNodeTypes() ([]NodeType, error)
+	GetAllVariables() ([]Variable, error)
+
+	// Credentials
+	GetAllCredentials(options *CredentialListOptions) ([]Credential, error)
+	GetCredential(id string) (*Credential, error)
+	CreateCredential(credential *Credential) (*Credential, error)
+	PatchCredential(id string, fields map[string]interface{}) (*Credential, error)
+	DeleteCredential(id string) error
+	TransferCredential(id, projectID string) error
+	GetCredentialSharing(id string) ([]string, error)
+	ShareCredential(id string, projectIDs []string) error
+	TestCredential(credential *Credential) (*CredentialTestResult, error)
+	GetCredentialTypes() ([]CredentialType, error)
+
+	// Projects and folders
+	GetProject(id string) (*Project, error)
+	CreateProject(project *Project) (*Project, error)
+	UpdateProject(id string, project *Project) error
+	DeleteProject(id string) error
+	DeleteProjectWithContent(id, transferToProjectID string) error
+	GetPersonalProject() (*Project, error)
+	GetProjectUsers(projectID string) ([]ProjectUser, error)
+	AddUserToProject(projectUser *ProjectUser) (*ProjectUser, error)
+	UpdateProjectUser(projectID, userID string, projectUser *ProjectUser) (*ProjectUser, error)
+	RemoveUserFromProject(projectID, userID string) error
+	GetFolder(projectID, id string) (*Folder, error)
+	CreateFolder(projectID, name, parentFolderID string) (*Folder, error)
+	UpdateFolder(projectID, id, name, parentFolderID string) (*Folder, error)
+	DeleteFolder(projectID, id string) error
+
+	// Users and LDAP
+	GetUser(id string) (*User, error)
+	GetAllUsers(options *UserListOptions) ([]User, error)
+	CreateUser(userReq *CreateUserRequest) (*User, error)
+	CreateUsers(userReqs []*CreateUserRequest) ([]CreateUserResult, error)
+	UpdateUser(id string, user *User) (*User, error)
+	ChangeUserRole(id, role string) error
+	SetUserPassword(id, password string) error
+	DeleteUser(id string) error
+	DeleteUserWithTransfer(id, transferToProjectID string) error
+	GetGlobalRoles() ([]string, error)
+	GetLDAPConfig() (*LDAPConfig, error)
+	UpdateLDAPConfig(config *LDAPConfig) (*LDAPConfig, error)
+	SyncLDAP(runMode string) error
+	GetLastLDAPSyncRun() (*LDAPSyncRun, error)
+
+	// Log streaming
+	GetLogStreamingDestination(id string) (*LogStreamingDestination, error)
+	SaveLogStreamingDestination(destination *LogStreamingDestination) (*LogStreamingDestination, error)
+	DeleteLogStreamingDestination(id string) error
+}
+
+// Ensure the client satisfies the interface the provider depends on.
+var _ N8nAPI = &Client{}
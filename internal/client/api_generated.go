@@ -0,0 +1,363 @@
+// Code generated by openapigen from n8n-openapi.yml; DO NOT EDIT.
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Execution is a single run of a workflow
+type Execution struct {
+	ID         json.Number `json:"id"`
+	WorkflowID string      `json:"workflowId"`
+	Mode       string      `json:"mode"`
+	Status     string      `json:"status"`
+	Finished   bool        `json:"finished"`
+	StartedAt  *time.Time  `json:"startedAt,omitempty"`
+	StoppedAt  *time.Time  `json:"stoppedAt,omitempty"`
+	// ID of the execution this execution retries
+	RetryOf json.Number `json:"retryOf,omitempty"`
+	// ID of the retry of this execution that succeeded
+	RetrySuccessID json.Number `json:"retrySuccessId,omitempty"`
+	// Detailed data of the execution, only returned when requested with includeData
+	Data map[string]interface{} `json:"data,omitempty"`
+}
+
+// ExecutionList is a page of executions
+type ExecutionList struct {
+	Data []Execution `json:"data"`
+	// Paginate through executions by setting the cursor parameter to this value
+	NextCursor string `json:"nextCursor,omitempty"`
+}
+
+// Project is an n8n project (Enterprise feature)
+type Project struct {
+	ID          string                 `json:"id,omitempty"`
+	Name        string                 `json:"name"`
+	Description string                 `json:"description,omitempty"`
+	Settings    map[string]interface{} `json:"settings,omitempty"`
+	Icon        string                 `json:"icon,omitempty"`
+	Color       string                 `json:"color,omitempty"`
+	OwnerID     string                 `json:"ownerId,omitempty"`
+	MemberCount int                    `json:"memberCount,omitempty"`
+	CreatedAt   *time.Time             `json:"createdAt,omitempty"`
+	UpdatedAt   *time.Time             `json:"updatedAt,omitempty"`
+}
+
+// ProjectList is a page of projects
+type ProjectList struct {
+	Data []Project `json:"data"`
+	// Paginate through projects by setting the cursor parameter to this value
+	NextCursor string `json:"nextCursor,omitempty"`
+}
+
+// Variable is a variable that workflows can read as $vars
+type Variable struct {
+	ID    string `json:"id,omitempty"`
+	Key   string `json:"key"`
+	Value string `json:"value"`
+	Type  string `json:"type,omitempty"`
+	// Project the variable is scoped to, or empty for a global variable
+	ProjectID string `json:"projectId,omitempty"`
+}
+
+// VariableList is a page of variables
+type VariableList struct {
+	Data []Variable `json:"data"`
+	// Paginate through variables by setting the cursor parameter to this value
+	NextCursor string `json:"nextCursor,omitempty"`
+}
+
+// GetExecutionsParams holds the query parameters of GetExecutions. Zero values are left out of the request.
+type GetExecutionsParams struct {
+	// Whether or not to include the execution's detailed data.
+	IncludeData bool
+	// Status to filter the executions by.
+	Status string
+	// Workflow to filter the executions by.
+	WorkflowID string
+	// Project to filter the executions by.
+	ProjectID string
+	// The maximum number of items to return.
+	Limit int
+	// Paginate by setting the cursor parameter to the nextCursor attribute returned by the previous request.
+	Cursor string
+}
+
+// encode returns the query string of the parameters
+func (p *GetExecutionsParams) encode() string {
+	if p == nil {
+		return ""
+	}
+
+	params := url.Values{}
+	if p.IncludeData {
+		params.Set("includeData", "true")
+	}
+	if p.Status != "" {
+		params.Set("status", p.Status)
+	}
+	if p.WorkflowID != "" {
+		params.Set("workflowId", p.WorkflowID)
+	}
+	if p.ProjectID != "" {
+		params.Set("projectId", p.ProjectID)
+	}
+	if p.Limit != 0 {
+		params.Set("limit", strconv.Itoa(p.Limit))
+	}
+	if p.Cursor != "" {
+		params.Set("cursor", p.Cursor)
+	}
+	return params.Encode()
+}
+
+// GetExecutions calls GET /executions: retrieve all executions
+func (c *Client) GetExecutions(params *GetExecutionsParams) (*ExecutionList, error) {
+	path := "executions"
+	if query := params.encode(); query != "" {
+		path += "?" + query
+	}
+
+	var result ExecutionList
+	if err := c.request("GET", path, nil, &result); err != nil {
+		return nil, fmt.Errorf("failed to retrieve all executions: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GetExecutionParams holds the query parameters of GetExecution. Zero values are left out of the request.
+type GetExecutionParams struct {
+	// Whether or not to include the execution's detailed data.
+	IncludeData bool
+}
+
+// encode returns the query string of the parameters
+func (p *GetExecutionParams) encode() string {
+	if p == nil {
+		return ""
+	}
+
+	params := url.Values{}
+	if p.IncludeData {
+		params.Set("includeData", "true")
+	}
+	return params.Encode()
+}
+
+// GetExecution calls GET /executions/{id}: retrieve an execution
+func (c *Client) GetExecution(id string, params *GetExecutionParams) (*Execution, error) {
+	if id == "" {
+		return nil, fmt.Errorf("id is required")
+	}
+
+	path := fmt.Sprintf("executions/%s", url.PathEscape(id))
+	if query := params.encode(); query != "" {
+		path += "?" + query
+	}
+
+	var result Execution
+	if err := c.request("GET", path, nil, &result); err != nil {
+		return nil, fmt.Errorf("failed to retrieve an execution (id=%s): %w", id, err)
+	}
+
+	return &result, nil
+}
+
+// DeleteExecution calls DELETE /executions/{id}: delete an execution
+func (c *Client) DeleteExecution(id string) (*Execution, error) {
+	if id == "" {
+		return nil, fmt.Errorf("id is required")
+	}
+
+	path := fmt.Sprintf("executions/%s", url.PathEscape(id))
+
+	var result Execution
+	if err := c.request("DELETE", path, nil, &result); err != nil {
+		return nil, fmt.Errorf("failed to delete an execution (id=%s): %w", id, err)
+	}
+
+	return &result, nil
+}
+
+// GetProjectsParams holds the query parameters of GetProjects. Zero values are left out of the request.
+type GetProjectsParams struct {
+	// The maximum number of items to return.
+	Limit int
+	// Paginate by setting the cursor parameter to the nextCursor attribute returned by the previous request.
+	Cursor string
+}
+
+// encode returns the query string of the parameters
+func (p *GetProjectsParams) encode() string {
+	if p == nil {
+		return ""
+	}
+
+	params := url.Values{}
+	if p.Limit != 0 {
+		params.Set("limit", strconv.Itoa(p.Limit))
+	}
+	if p.Cursor != "" {
+		params.Set("cursor", p.Cursor)
+	}
+	return params.Encode()
+}
+
+// GetProjects calls GET /projects: retrieve projects
+func (c *Client) GetProjects(params *GetProjectsParams) (*ProjectList, error) {
+	path := "projects"
+	if query := params.encode(); query != "" {
+		path += "?" + query
+	}
+
+	var result ProjectList
+	if err := c.request("GET", path, nil, &result); err != nil {
+		return nil, fmt.Errorf("failed to retrieve projects: %w", err)
+	}
+
+	return &result, nil
+}
+
+// CreateProject calls POST /projects: create a project
+func (c *Client) CreateProject(project *Project) (*Project, error) {
+	if project == nil {
+		return nil, fmt.Errorf("project is required")
+	}
+
+	path := "projects"
+
+	var result Project
+	if err := c.request("POST", path, project, &result); err != nil {
+		return nil, fmt.Errorf("failed to create a project: %w", err)
+	}
+
+	return &result, nil
+}
+
+// UpdateProject calls PUT /projects/{projectId}: update a project
+func (c *Client) UpdateProject(projectID string, project *Project) error {
+	if projectID == "" {
+		return fmt.Errorf("projectId is required")
+	}
+
+	if project == nil {
+		return fmt.Errorf("project is required")
+	}
+
+	path := fmt.Sprintf("projects/%s", url.PathEscape(projectID))
+
+	if err := c.request("PUT", path, project, nil); err != nil {
+		return fmt.Errorf("failed to update a project (projectId=%s): %w", projectID, err)
+	}
+
+	return nil
+}
+
+// DeleteProject calls DELETE /projects/{projectId}: delete a project
+func (c *Client) DeleteProject(projectID string) error {
+	if projectID == "" {
+		return fmt.Errorf("projectId is required")
+	}
+
+	path := fmt.Sprintf("projects/%s", url.PathEscape(projectID))
+
+	if err := c.request("DELETE", path, nil, nil); err != nil {
+		return fmt.Errorf("failed to delete a project (projectId=%s): %w", projectID, err)
+	}
+
+	return nil
+}
+
+// GetVariablesParams holds the query parameters of GetVariables. Zero values are left out of the request.
+type GetVariablesParams struct {
+	// The maximum number of items to return.
+	Limit int
+	// Paginate by setting the cursor parameter to the nextCursor attribute returned by the previous request.
+	Cursor string
+}
+
+// encode returns the query string of the parameters
+func (p *GetVariablesParams) encode() string {
+	if p == nil {
+		return ""
+	}
+
+	params := url.Values{}
+	if p.Limit != 0 {
+		params.Set("limit", strconv.Itoa(p.Limit))
+	}
+	if p.Cursor != "" {
+		params.Set("cursor", p.Cursor)
+	}
+	return params.Encode()
+}
+
+// GetVariables calls GET /variables: retrieve variables
+func (c *Client) GetVariables(params *GetVariablesParams) (*VariableList, error) {
+	path := "variables"
+	if query := params.encode(); query != "" {
+		path += "?" + query
+	}
+
+	var result VariableList
+	if err := c.request("GET", path, nil, &result); err != nil {
+		return nil, fmt.Errorf("failed to retrieve variables: %w", err)
+	}
+
+	return &result, nil
+}
+
+// CreateVariable calls POST /variables: create a variable
+func (c *Client) CreateVariable(variable *Variable) error {
+	if variable == nil {
+		return fmt.Errorf("variable is required")
+	}
+
+	path := "variables"
+
+	if err := c.request("POST", path, variable, nil); err != nil {
+		return fmt.Errorf("failed to create a variable: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateVariable calls PUT /variables/{id}: update a variable
+func (c *Client) UpdateVariable(id string, variable *Variable) error {
+	if id == "" {
+		return fmt.Errorf("id is required")
+	}
+
+	if variable == nil {
+		return fmt.Errorf("variable is required")
+	}
+
+	path := fmt.Sprintf("variables/%s", url.PathEscape(id))
+
+	if err := c.request("PUT", path, variable, nil); err != nil {
+		return fmt.Errorf("failed to update a variable (id=%s): %w", id, err)
+	}
+
+	return nil
+}
+
+// DeleteVariable calls DELETE /variables/{id}: delete a variable
+func (c *Client) DeleteVariable(id string) error {
+	if id == "" {
+		return fmt.Errorf("id is required")
+	}
+
+	path := fmt.Sprintf("variables/%s", url.PathEscape(id))
+
+	if err := c.request("DELETE", path, nil, nil); err != nil {
+		return fmt.Errorf("failed to delete a variable (id=%s): %w", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestClient_GetExecutions(t *testing.T) {
+	expectedQuery := url.Values{"workflowId": {"wf-1"}, "status": {"error"}, "limit": {"10"}}
+	server := TestServer(ListTestHandler(t, expectedQuery, ExecutionList{
+		Data:       []Execution{{ID: "42", WorkflowID: "wf-1", Status: ExecutionStatusError}},
+		NextCursor: "next",
+	}))
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	executions, err := client.GetExecutions(&GetExecutionsParams{WorkflowID: "wf-1", Status: "error", Limit: 10})
+	if err != nil {
+		t.Fatalf("GetExecutions() error = %v", err)
+	}
+	if len(executions.Data) != 1 || executions.Data[0].ID.String() != "42" || executions.NextCursor != "next" {
+		t.Errorf("Unexpected executions: %+v", executions)
+	}
+}
+
+func TestClient_Variables(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		switch r.Method {
+		case "GET":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"data": [{"id": "var-1", "key": "REGION", "value": "eu", "type": "string"}]}`))
+		case "POST", "PUT":
+			var variable Variable
+			if err := json.NewDecoder(r.Body).Decode(&variable); err != nil {
+				t.Errorf("Failed to decode request body: %v", err)
+			}
+			if variable.Key != "REGION" || variable.Value != "us" {
+				t.Errorf("Unexpected variable: %+v", variable)
+			}
+			w.WriteHeader(http.StatusCreated)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	variables, err := client.GetVariables(nil)
+	if err != nil {
+		t.Fatalf("GetVariables() error = %v", err)
+	}
+	if len(variables.Data) != 1 || variables.Data[0].ID != "var-1" || variables.Data[0].Key != "REGION" {
+		t.Errorf("Unexpected variables: %+v", variables)
+	}
+
+	variable := &Variable{Key: "REGION", Value: "us"}
+	if err := client.CreateVariable(variable); err != nil {
+		t.Fatalf("CreateVariable() error = %v", err)
+	}
+	if err := client.UpdateVariable("var-1", variable); err != nil {
+		t.Fatalf("UpdateVariable() error = %v", err)
+	}
+	if err := client.DeleteVariable("var-1"); err != nil {
+		t.Fatalf("DeleteVariable() error = %v", err)
+	}
+
+	expected := []string{"GET /api/v1/variables", "POST /api/v1/variables", "PUT /api/v1/variables/var-1",
+		"DELETE /api/v1/variables/var-1"}
+	if len(requests) != len(expected) {
+		t.Fatalf("Expected requests %v, got %v", expected, requests)
+	}
+	for i := range expected {
+		if requests[i] != expected[i] {
+			t.Errorf("Expected request %s, got %s", expected[i], requests[i])
+		}
+	}
+
+	if err := client.UpdateVariable("", variable); err == nil {
+		t.Error("Expected error for missing variable ID")
+	}
+	if err := client.CreateVariable(nil); err == nil {
+		t.Error("Expected error for missing variable")
+	}
+}
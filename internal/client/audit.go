@@ -0,0 +1,128 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+)
+
+// AuditCategories lists the risk categories the security audit covers
+var AuditCategories = []string{"credentials", "database", "nodes", "filesystem", "instance"}
+
+// AuditOptions selects what the security audit reports on
+type AuditOptions struct {
+	// Categories limits the audit to these risk categories; all categories are audited if it is empty
+	Categories []string
+	// DaysAbandonedWorkflow is the number of days without executions after which a workflow counts as
+	// abandoned; n8n uses 90 days if it is zero
+	DaysAbandonedWorkflow int
+}
+
+// auditRequest is the body of an audit request
+type auditRequest struct {
+	AdditionalOptions *auditAdditionalOptions `json:"additionalOptions,omitempty"`
+}
+
+// auditAdditionalOptions are the options of an audit request
+type auditAdditionalOptions struct {
+	DaysAbandonedWorkflow int      `json:"daysAbandonedWorkflow,omitempty"`
+	Categories            []string `json:"categories,omitempty"`
+}
+
+// Audit is the result of a security audit of the n8n instance
+type Audit struct {
+	// Reports holds one report per risk category that has findings, sorted by title
+	Reports []AuditReport
+	// Raw is the audit as returned by n8n, including details that are not modeled in Reports
+	Raw json.RawMessage
+}
+
+// AuditReport lists the findings of a risk category
+type AuditReport struct {
+	Title    string         `json:"-"`
+	Risk     string         `json:"risk"`
+	Sections []AuditSection `json:"sections"`
+}
+
+// AuditSection is a finding of the security audit
+type AuditSection struct {
+	Title          string `json:"title"`
+	Description    string `json:"description"`
+	Recommendation string `json:"recommendation"`
+	// Location lists the workflows, nodes or credentials the finding applies to
+	Location []map[string]interface{} `json:"location,omitempty"`
+}
+
+// FindingCount returns the number of findings of a report: each location a section lists, or the section
+// itself if it lists none
+func (r *AuditReport) FindingCount() int {
+	count := 0
+	for _, section := range r.Sections {
+		if len(section.Location) > 0 {
+			count += len(section.Location)
+		} else {
+			count++
+		}
+	}
+	return count
+}
+
+// RunAudit runs a security audit of the n8n instance. Categories without findings are left out of the
+// result.
+func (c *Client) RunAudit(options *AuditOptions) (*Audit, error) {
+	body := &auditRequest{}
+	if options != nil {
+		for _, category := range options.Categories {
+			if !slices.Contains(AuditCategories, category) {
+				return nil, fmt.Errorf("invalid audit category %q", category)
+			}
+		}
+
+		if len(options.Categories) > 0 || options.DaysAbandonedWorkflow > 0 {
+			body.AdditionalOptions = &auditAdditionalOptions{
+				DaysAbandonedWorkflow: options.DaysAbandonedWorkflow,
+				Categories:            options.Categories,
+			}
+		}
+	}
+
+	var raw json.RawMessage
+	if err := c.Post("audit", body, &raw); err != nil {
+		return nil, fmt.Errorf("failed to run audit: %w", err)
+	}
+
+	audit, err := parseAudit(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to run audit: %w", err)
+	}
+
+	return audit, nil
+}
+
+// parseAudit decodes an audit, which n8n returns as an object keyed by report title, or as an empty
+// array if there are no findings
+func parseAudit(raw json.RawMessage) (*Audit, error) {
+	audit := &Audit{Reports: []AuditReport{}, Raw: raw}
+
+	var empty []interface{}
+	if len(raw) == 0 || json.Unmarshal(raw, &empty) == nil {
+		audit.Raw = json.RawMessage("{}")
+		return audit, nil
+	}
+
+	var reports map[string]AuditReport
+	if err := json.Unmarshal(raw, &reports); err != nil {
+		return nil, fmt.Errorf("failed to parse audit: %w", err)
+	}
+
+	for title, report := range reports {
+		report.Title = title
+		audit.Reports = append(audit.Reports, report)
+	}
+	sort.Slice(audit.Reports, func(i, j int) bool {
+		return audit.Reports[i].Title < audit.Reports[j].Title
+	})
+
+	return audit, nil
+}
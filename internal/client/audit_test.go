@@ -0,0 +1,73 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestClient_RunAudit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/api/v1/audit" {
+			t.Errorf("Expected POST /api/v1/audit, got %s %s", r.Method, r.URL.Path)
+		}
+
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Failed to decode request body: %v", err)
+		}
+		expected := map[string]interface{}{
+			"additionalOptions": map[string]interface{}{
+				"daysAbandonedWorkflow": float64(30),
+				"categories":            []interface{}{"nodes", "credentials"},
+			},
+		}
+		if !reflect.DeepEqual(body, expected) {
+			t.Errorf("Expected body %v, got %v", expected, body)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"Nodes Risk Report": {"risk": "nodes", "sections": [{"title": "Community nodes", "description": "d",
+				"recommendation": "r", "location": [{"kind": "node", "workflowId": "1", "nodeName": "Code"}]}]},
+			"Credentials Risk Report": {"risk": "credentials", "sections": []}
+		}`))
+	}))
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	audit, err := client.RunAudit(&AuditOptions{Categories: []string{"nodes", "credentials"}, DaysAbandonedWorkflow: 30})
+	if err != nil {
+		t.Fatalf("RunAudit() error = %v", err)
+	}
+
+	if len(audit.Reports) != 2 || audit.Reports[0].Title != "Credentials Risk Report" || audit.Reports[1].Risk != "nodes" {
+		t.Fatalf("Expected the reports sorted by title, got %+v", audit.Reports)
+	}
+	if location := audit.Reports[1].Sections[0].Location; len(location) != 1 || location[0]["nodeName"] != "Code" {
+		t.Errorf("Expected the location of the finding, got %v", location)
+	}
+	if audit.Reports[0].FindingCount() != 0 || audit.Reports[1].FindingCount() != 1 {
+		t.Errorf("Expected 0 and 1 findings, got %d and %d", audit.Reports[0].FindingCount(), audit.Reports[1].FindingCount())
+	}
+	if !json.Valid(audit.Raw) {
+		t.Errorf("Expected the raw audit, got %s", audit.Raw)
+	}
+
+	if _, err := client.RunAudit(&AuditOptions{Categories: []string{"network"}}); err == nil {
+		t.Error("Expected error for an invalid category")
+	}
+}
+
+func TestParseAudit_NoFindings(t *testing.T) {
+	audit, err := parseAudit(json.RawMessage(`[]`))
+	if err != nil {
+		t.Fatalf("parseAudit() error = %v", err)
+	}
+	if len(audit.Reports) != 0 || string(audit.Raw) != "{}" {
+		t.Errorf("Expected an empty audit, got %+v", audit)
+	}
+}
@@ -138,6 +138,22 @@ func TestBasicAuth_ApplyAuth(t *testing.T) {
 	}
 }
 
+func TestCompositeAuth_ApplyAuth(t *testing.T) {
+	auth := CompositeAuth{&BasicAuth{Email: "proxy", Password: "proxy-secret"}, &APIKeyAuth{APIKey: "test-key"}}
+	req, _ := http.NewRequest("GET", "http://example.com", nil)
+
+	if err := auth.ApplyAuth(req); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if username, _, ok := req.BasicAuth(); !ok || username != "proxy" {
+		t.Errorf("Expected the proxy credentials, got %q", username)
+	}
+	if got := req.Header.Get("X-N8N-API-KEY"); got != "test-key" {
+		t.Errorf("Expected the API key to be set as well, got %q", got)
+	}
+}
+
 func TestSessionAuth_WithCookieJar(t *testing.T) {
 	// Create a cookie jar with test cookies
 	jar, err := cookiejar.New(nil)
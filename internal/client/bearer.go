@@ -0,0 +1,112 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// tokenExpiryMargin is how long before it expires a token from the client credentials grant is renewed
+const tokenExpiryMargin = 30 * time.Second
+
+// BearerTokenAuth sends a bearer token, e.g. for a reverse proxy in front of n8n that authenticates with
+// OIDC. The token is either static or, when TokenURL is set, obtained with the OAuth 2.0 client credentials
+// grant from ClientID and ClientSecret and renewed shortly before it expires.
+type BearerTokenAuth struct {
+	Token        string
+	TokenURL     string
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+	HTTPClient   *http.Client // Client for token requests; NewClient uses the transport of n8n requests if unset
+
+	mu      sync.Mutex
+	expires time.Time
+}
+
+func (a *BearerTokenAuth) ApplyAuth(req *http.Request) error {
+	token, err := a.token()
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+// token returns the static token or a current token from the token endpoint
+func (a *BearerTokenAuth) token() (string, error) {
+	if a.TokenURL == "" {
+		return a.Token, nil
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.Token != "" && (a.expires.IsZero() || time.Now().Before(a.expires.Add(-tokenExpiryMargin))) {
+		return a.Token, nil
+	}
+
+	token, expiresIn, err := a.requestToken()
+	if err != nil {
+		return "", fmt.Errorf("unable to obtain a bearer token from %s: %w", a.TokenURL, err)
+	}
+	a.Token = token
+	a.expires = time.Time{}
+	if expiresIn > 0 {
+		a.expires = time.Now().Add(time.Duration(expiresIn) * time.Second)
+	}
+	return a.Token, nil
+}
+
+// requestToken obtains a token with the client credentials grant and returns it with its lifetime in seconds
+func (a *BearerTokenAuth) requestToken() (string, int64, error) {
+	form := url.Values{"grant_type": {"client_credentials"}}
+	if len(a.Scopes) > 0 {
+		form.Set("scope", strings.Join(a.Scopes, " "))
+	}
+
+	req, err := http.NewRequest(http.MethodPost, a.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", 0, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	// The client credentials are form encoded before they are sent as Basic authentication (RFC 6749 2.3.1)
+	req.SetBasicAuth(url.QueryEscape(a.ClientID), url.QueryEscape(a.ClientSecret))
+
+	httpClient := a.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 30 * time.Second}
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", 0, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, DefaultMaxResponseSize))
+	if err != nil {
+		return "", 0, err
+	}
+
+	var result struct {
+		AccessToken      string `json:"access_token"`
+		ExpiresIn        int64  `json:"expires_in"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return "", 0, fmt.Errorf("status %d with an invalid response: %w", resp.StatusCode, err)
+	}
+	if resp.StatusCode != http.StatusOK || result.Error != "" {
+		return "", 0, fmt.Errorf("status %d: %s %s", resp.StatusCode, result.Error, result.ErrorDescription)
+	}
+	if result.AccessToken == "" {
+		return "", 0, fmt.Errorf("the response has no access_token")
+	}
+	return result.AccessToken, result.ExpiresIn, nil
+}
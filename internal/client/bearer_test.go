@@ -0,0 +1,110 @@
+package client
+
+import (
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBearerTokenAuth_StaticToken(t *testing.T) {
+	auth := &BearerTokenAuth{Token: "static-token"}
+	req, _ := http.NewRequest("GET", "http://example.com", nil)
+
+	if err := auth.ApplyAuth(req); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got := req.Header.Get("Authorization"); got != "Bearer static-token" {
+		t.Errorf("Expected the bearer token, got %q", got)
+	}
+}
+
+func TestBearerTokenAuth_ClientCredentials(t *testing.T) {
+	requests := 0
+	expiresIn := 3600
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		clientID, secret, _ := r.BasicAuth()
+		if err := r.ParseForm(); err != nil || r.Form.Get("grant_type") != "client_credentials" ||
+			r.Form.Get("scope") != "n8n openid" || clientID != "terraform" || secret != "s3cret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error": "invalid_client", "error_description": "unknown client"}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"access_token": "token-%d", "token_type": "Bearer", "expires_in": %d}`, requests, expiresIn)
+	}))
+	defer server.Close()
+
+	auth := &BearerTokenAuth{TokenURL: server.URL, ClientID: "terraform", ClientSecret: "s3cret", Scopes: []string{"n8n", "openid"}}
+	apply := func() string {
+		t.Helper()
+		req, _ := http.NewRequest("GET", "http://example.com", nil)
+		if err := auth.ApplyAuth(req); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		return req.Header.Get("Authorization")
+	}
+
+	if got := apply(); got != "Bearer token-1" {
+		t.Errorf("Expected the token from the token endpoint, got %q", got)
+	}
+	if got := apply(); got != "Bearer token-1" || requests != 1 {
+		t.Errorf("Expected the token to be reused until it expires, got %q after %d requests", got, requests)
+	}
+
+	// A token that is about to expire is renewed
+	expiresIn = 10
+	auth.expires = auth.expires.Add(-time.Hour)
+	if got := apply(); got != "Bearer token-2" {
+		t.Errorf("Expected an expired token to be renewed, got %q", got)
+	}
+	if got := apply(); got != "Bearer token-3" {
+		t.Errorf("Expected a token within the expiry margin to be renewed, got %q", got)
+	}
+
+	auth = &BearerTokenAuth{TokenURL: server.URL, ClientID: "unknown"}
+	req, _ := http.NewRequest("GET", "http://example.com", nil)
+	if err := auth.ApplyAuth(req); err == nil || !strings.Contains(err.Error(), "invalid_client unknown client") {
+		t.Errorf("Expected the error of the token endpoint, got %v", err)
+	}
+}
+
+func TestClient_BearerTokenUsesTransport(t *testing.T) {
+	tokenServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token": "proxy-token", "token_type": "Bearer", "expires_in": 3600}`))
+	}))
+	defer tokenServer.Close()
+
+	var authorization string
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": []}`))
+	}))
+	defer server.Close()
+
+	// Both servers use the self-signed certificate of httptest, which only the CA bundle trusts
+	caPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+
+	client, err := NewClient(&Config{
+		BaseURL:     server.URL,
+		Auth:        &BearerTokenAuth{TokenURL: tokenServer.URL, ClientID: "terraform", ClientSecret: "s3cret"},
+		CACertPEM:   caPEM,
+		RetryConfig: RetryConfig{MaxRetries: 1, BaseDelay: time.Millisecond},
+	})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	if _, err := client.GetWorkflows(nil); err != nil {
+		t.Fatalf("GetWorkflows() error = %v", err)
+	}
+	if authorization != "Bearer proxy-token" {
+		t.Errorf("Expected the token obtained through the configured CA bundle, got %q", authorization)
+	}
+}
@@ -0,0 +1,203 @@
+package client
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// DefaultMaxResponseSize is the largest response body read when no maximum is configured
+const DefaultMaxResponseSize int64 = 64 << 20
+
+// streamDecodeThreshold is the size above which write responses are decoded as they are read instead of
+// being buffered first. Responses of unknown size are decoded as they are read as well.
+const streamDecodeThreshold int64 = 1 << 20
+
+// compressRequestThreshold is the size above which request bodies are compressed, if enabled. Smaller
+// bodies gain too little to be worth the compression.
+const compressRequestThreshold = 1 << 10
+
+// maxLoggedBodySize is the number of bytes of a request or response body that is logged or included in
+// error messages
+const maxLoggedBodySize = 4 << 10
+
+// redactedFields are the fields of request bodies that hold secrets, i.e. credential data and passwords, and
+// are never logged
+var redactedFields = []string{"data", "password", "currentPassword", "newPassword"}
+
+// ResponseTooLargeError is returned when a response body exceeds the maximum response size
+type ResponseTooLargeError struct {
+	Limit int64
+}
+
+func (e *ResponseTooLargeError) Error() string {
+	return fmt.Sprintf("response body exceeds the maximum response size of %d bytes", e.Limit)
+}
+
+// limitedBody reads a response body and fails once more than limit bytes have been read
+type limitedBody struct {
+	r     io.Reader
+	read  int64
+	limit int64
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	n, err := b.r.Read(p)
+	b.read += int64(n)
+	if b.read > b.limit {
+		return n, &ResponseTooLargeError{Limit: b.limit}
+	}
+	return n, err
+}
+
+// responseSizeLimit returns the largest response body in bytes that is read
+func (c *Client) responseSizeLimit() int64 {
+	if c.maxResponseSize <= 0 {
+		return DefaultMaxResponseSize
+	}
+	return c.maxResponseSize
+}
+
+// readBody reads a response body of at most the maximum response size. The buffer is allocated from the
+// announced content length, so that large bodies are not copied while the buffer grows.
+func (c *Client) readBody(resp *http.Response) ([]byte, error) {
+	limit := c.responseSizeLimit()
+
+	var buf bytes.Buffer
+	if resp.ContentLength > 0 && resp.ContentLength <= limit {
+		buf.Grow(int(resp.ContentLength))
+	}
+
+	if _, err := buf.ReadFrom(&limitedBody{r: resp.Body, limit: limit}); err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// decodeBody decodes a successful response body into result as it is read, if the body is large or of
+// unknown size, and reports whether it did. Smaller bodies are left to be read with readBody.
+func (c *Client) decodeBody(resp *http.Response, result any) (bool, error) {
+	if result == nil || (resp.ContentLength >= 0 && resp.ContentLength <= streamDecodeThreshold) {
+		return false, nil
+	}
+
+	body := &limitedBody{r: resp.Body, limit: c.responseSizeLimit()}
+	if err := json.NewDecoder(body).Decode(result); err != nil && !errors.Is(err, io.EOF) {
+		var tooLarge *ResponseTooLargeError
+		if errors.As(err, &tooLarge) {
+			return true, fmt.Errorf("failed to read response body: %w", err)
+		}
+		return true, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	c.logger.Logf("n8n API response body: %d bytes, decoded while reading", body.read)
+	return true, nil
+}
+
+// requestBody returns the body to send for a JSON request body and its content encoding. Large bodies are
+// compressed with gzip if request compression is enabled; n8n decompresses them before parsing.
+func (c *Client) requestBody(jsonData []byte) ([]byte, string, error) {
+	if !c.compressRequests || len(jsonData) < compressRequestThreshold {
+		return jsonData, "", nil
+	}
+
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if _, err := writer.Write(jsonData); err != nil {
+		return nil, "", fmt.Errorf("failed to compress request body: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, "", fmt.Errorf("failed to compress request body: %w", err)
+	}
+	return buf.Bytes(), "gzip", nil
+}
+
+// gzipBody decompresses a gzip-encoded response body as it is read and closes the underlying body with it
+type gzipBody struct {
+	*gzip.Reader
+	body io.ReadCloser
+}
+
+func (b *gzipBody) Close() error {
+	err := b.Reader.Close()
+	if closeErr := b.body.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// decompressBody replaces the body of a gzip-encoded response with its decompressed content. The client
+// asks for compressed responses itself, so the transport does not decompress them. The content length of
+// the decompressed body is unknown, and the maximum response size applies to it.
+func decompressBody(resp *http.Response) error {
+	if resp.Header.Get("Content-Encoding") != "gzip" {
+		return nil
+	}
+
+	reader, err := gzip.NewReader(resp.Body)
+	if errors.Is(err, io.EOF) {
+		// An empty body is not compressed
+		resp.Header.Del("Content-Encoding")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to decompress response body: %w", err)
+	}
+
+	resp.Body = &gzipBody{Reader: reader, body: resp.Body}
+	resp.Header.Del("Content-Encoding")
+	resp.ContentLength = -1
+	return nil
+}
+
+// truncateBody returns a body for logging and error messages, shortened to maxLoggedBodySize bytes
+func truncateBody(body []byte) string {
+	if len(body) <= maxLoggedBodySize {
+		return string(body)
+	}
+	return fmt.Sprintf("%s... (%d more bytes)", body[:maxLoggedBodySize], len(body)-maxLoggedBodySize)
+}
+
+// redactBody returns a request body for logging with the values of redactedFields replaced, both in a JSON
+// object and in the objects of a JSON array. Bodies that are not JSON are not logged at all.
+func redactBody(body []byte) []byte {
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(body, &object); err == nil {
+		redacted, err := json.Marshal(redactFields(object))
+		if err != nil {
+			return []byte("(not logged)")
+		}
+		return redacted
+	}
+
+	var objects []map[string]json.RawMessage
+	if err := json.Unmarshal(body, &objects); err == nil {
+		for i := range objects {
+			objects[i] = redactFields(objects[i])
+		}
+		redacted, err := json.Marshal(objects)
+		if err != nil {
+			return []byte("(not logged)")
+		}
+		return redacted
+	}
+
+	if json.Valid(body) {
+		return body
+	}
+	return []byte("(not logged)")
+}
+
+// redactFields replaces the values of redactedFields in object
+func redactFields(object map[string]json.RawMessage) map[string]json.RawMessage {
+	for _, field := range redactedFields {
+		if _, ok := object[field]; ok {
+			object[field] = json.RawMessage(`"(redacted)"`)
+		}
+	}
+	return object
+}
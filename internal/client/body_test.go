@@ -0,0 +1,210 @@
+package client
+
+import (
+	"compress/gzip"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestClient_MaxResponseSize(t *testing.T) {
+	body := `{"id": "wf-1", "name": "` + strings.Repeat("a", 2048) + `"}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: &APIKeyAuth{APIKey: "test-key"}, MaxResponseSize: 1024})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	_, err = client.GetWorkflow("wf-1")
+	var tooLarge *ResponseTooLargeError
+	if !errors.As(err, &tooLarge) || tooLarge.Limit != 1024 {
+		t.Errorf("Expected the response to exceed the maximum size, got %v", err)
+	}
+
+	// Bodies within the maximum size are read
+	client.maxResponseSize = 4096
+	workflow, err := client.GetWorkflow("wf-1")
+	if err != nil || workflow.ID != "wf-1" {
+		t.Errorf("Expected the workflow, got %v, %v", workflow, err)
+	}
+}
+
+func TestClient_DecodeLargeResponse(t *testing.T) {
+	name := strings.Repeat("a", int(streamDecodeThreshold))
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": "wf-1", "name": "` + name + `"}`))
+	}))
+	defer server.Close()
+
+	var messages []string
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: &APIKeyAuth{APIKey: "test-key"},
+		Logger: &TestLogger{messages: &messages}})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	workflow, err := client.PatchWorkflow("wf-1", map[string]interface{}{"name": name})
+	if err != nil {
+		t.Fatalf("PatchWorkflow() error = %v", err)
+	}
+	if workflow.ID != "wf-1" || len(workflow.Name) != len(name) {
+		t.Errorf("Expected the decoded workflow, got ID %q and a name of %d bytes", workflow.ID, len(workflow.Name))
+	}
+
+	streamed := false
+	for _, line := range messages {
+		if len(line) > 2*maxLoggedBodySize {
+			t.Errorf("Expected logged bodies to be truncated, got a line of %d bytes", len(line))
+		}
+		streamed = streamed || strings.HasSuffix(line, "decoded while reading")
+	}
+	if !streamed {
+		t.Error("Expected the large response to be decoded while it was read")
+	}
+}
+
+func TestTruncateBody(t *testing.T) {
+	if truncateBody([]byte(`{"ok": true}`)) != `{"ok": true}` {
+		t.Error("Expected a small body to be kept")
+	}
+
+	truncated := truncateBody([]byte(strings.Repeat("a", maxLoggedBodySize+10)))
+	if !strings.HasSuffix(truncated, "... (10 more bytes)") || len(truncated) > maxLoggedBodySize+20 {
+		t.Errorf("Expected the body to be truncated, got %d bytes", len(truncated))
+	}
+}
+
+func TestRedactBody(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		expected string
+	}{
+		{
+			name:     "credential",
+			body:     `{"name":"API","type":"httpHeaderAuth","data":{"value":"secret"}}`,
+			expected: `{"data":"(redacted)","name":"API","type":"httpHeaderAuth"}`,
+		},
+		{
+			name:     "batch",
+			body:     `[{"email":"a@example.com","password":"secret"}]`,
+			expected: `[{"email":"a@example.com","password":"(redacted)"}]`,
+		},
+		{name: "no secrets", body: `{"name":"Workflow"}`, expected: `{"name":"Workflow"}`},
+		{name: "not JSON", body: `secret`, expected: `(not logged)`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := string(redactBody([]byte(tt.body))); got != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestClient_CredentialDataNotLogged(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": "cred-1", "name": "API", "type": "httpHeaderAuth"}`))
+	}))
+	defer server.Close()
+
+	var messages []string
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: &APIKeyAuth{APIKey: "test-key"},
+		Logger: &TestLogger{messages: &messages}})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	_, err = client.CreateCredential(&Credential{Name: "API", Type: "httpHeaderAuth",
+		Data: map[string]interface{}{"value": "top-secret"}})
+	if err != nil {
+		t.Fatalf("CreateCredential() error = %v", err)
+	}
+
+	for _, line := range messages {
+		if strings.Contains(line, "top-secret") {
+			t.Errorf("Expected credential data not to be logged, got %q", line)
+		}
+	}
+}
+
+func TestClient_CompressedResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Accept-Encoding") != "gzip" {
+			t.Errorf("Expected compressed responses to be accepted, got %q", r.Header.Get("Accept-Encoding"))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Encoding", "gzip")
+		writer := gzip.NewWriter(w)
+		_, _ = writer.Write([]byte(`{"id": "wf-1", "name": "Orders"}`))
+		_ = writer.Close()
+	}))
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	workflow, err := client.GetWorkflow("wf-1")
+	if err != nil {
+		t.Fatalf("GetWorkflow() error = %v", err)
+	}
+	if workflow.Name != "Orders" {
+		t.Errorf("Expected the decompressed workflow, got %+v", workflow)
+	}
+}
+
+func TestClient_CompressRequests(t *testing.T) {
+	var encodings []string
+	var received []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		encodings = append(encodings, r.Header.Get("Content-Encoding"))
+
+		body := io.Reader(r.Body)
+		if r.Header.Get("Content-Encoding") == "gzip" {
+			reader, err := gzip.NewReader(r.Body)
+			if err != nil {
+				t.Fatalf("Failed to decompress request body: %v", err)
+			}
+			body = reader
+		}
+		received, _ = io.ReadAll(body)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": "wf-1", "name": "Orders"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: &APIKeyAuth{APIKey: "test-key"}, CompressRequests: true})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	// Small bodies are sent as they are
+	if _, err := client.CreateWorkflow(&Workflow{Name: "Orders"}); err != nil {
+		t.Fatalf("CreateWorkflow() error = %v", err)
+	}
+
+	nodes := []interface{}{map[string]interface{}{"name": "Code", "parameters": map[string]interface{}{
+		"jsCode": strings.Repeat("return items;\n", 200)}}}
+	if _, err := client.CreateWorkflow(&Workflow{Name: "Orders", Nodes: nodes}); err != nil {
+		t.Fatalf("CreateWorkflow() error = %v", err)
+	}
+
+	if len(encodings) != 2 || encodings[0] != "" || encodings[1] != "gzip" {
+		t.Errorf("Expected only the large body to be compressed, got encodings %q", encodings)
+	}
+	if !strings.Contains(string(received), "return items;") {
+		t.Errorf("Expected the decompressed body to contain the workflow, got %d bytes", len(received))
+	}
+}
@@ -0,0 +1,119 @@
+package client
+
+import (
+	"sync"
+	"time"
+)
+
+// responseCache is an in-memory cache of successful GET responses keyed by request URL.
+// Entries expire after the TTL and the whole cache is dropped on any write request.
+type responseCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]cacheEntry
+	now     func() time.Time
+	// generation counts the invalidations, so that responses fetched before one are not stored after it
+	generation uint64
+}
+
+// cacheEntry holds a cached response body and its expiry time
+type cacheEntry struct {
+	body    []byte
+	expires time.Time
+}
+
+// newResponseCache creates a response cache with the given TTL
+func newResponseCache(ttl time.Duration) *responseCache {
+	return &responseCache{
+		ttl:     ttl,
+		entries: map[string]cacheEntry{},
+		now:     time.Now,
+	}
+}
+
+// get returns the cached response body for key, if present and not expired
+func (rc *responseCache) get(key string) ([]byte, bool) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	entry, ok := rc.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	if rc.now().After(entry.expires) {
+		delete(rc.entries, key)
+		return nil, false
+	}
+
+	return entry.body, true
+}
+
+// currentGeneration returns the generation to pass to set for a response that is fetched now
+func (rc *responseCache) currentGeneration() uint64 {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	return rc.generation
+}
+
+// set stores a response body for key that was fetched in generation. Responses fetched before the last
+// invalidation are dropped, as a write may have changed them.
+func (rc *responseCache) set(key string, body []byte, generation uint64) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	if generation != rc.generation {
+		return
+	}
+
+	rc.entries[key] = cacheEntry{
+		body:    body,
+		expires: rc.now().Add(rc.ttl),
+	}
+}
+
+// evict drops the cached response for key
+func (rc *responseCache) evict(key string) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	delete(rc.entries, key)
+}
+
+// invalidate drops all cached responses
+func (rc *responseCache) invalidate() {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	rc.entries = map[string]cacheEntry{}
+	rc.generation++
+}
+
+// sharedGet performs a GET request with fetch, unless a request for the same URL is already in flight, in
+// which case it waits for that request and returns its response body. Callers decode the body themselves,
+// so they never share decoded results.
+func (c *Client) sharedGet(url string, fetch func() ([]byte, error)) ([]byte, error) {
+	body, err, shared := c.inflight.Do(url, func() (interface{}, error) {
+		return fetch()
+	})
+	if shared {
+		c.logger.Logf("n8n API request shared: GET %s", url)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return body.([]byte), nil
+}
+
+// evictCached drops the cached response of a GET request of path, so that the next request reads the
+// current state from n8n
+func (c *Client) evictCached(path string) {
+	if c.cache == nil {
+		return
+	}
+	if fullURL, err := c.requestURL(path); err == nil {
+		c.cache.evict(fullURL.String())
+	}
+}
@@ -0,0 +1,237 @@
+package client
+
+import (
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestResponseCache_Expiry(t *testing.T) {
+	now := time.Now()
+	cache := newResponseCache(time.Minute)
+	cache.now = func() time.Time { return now }
+
+	cache.set("workflows", []byte(`{"data":[]}`), cache.currentGeneration())
+
+	if _, ok := cache.get("workflows"); !ok {
+		t.Fatal("Expected cached entry to be returned before expiry")
+	}
+
+	now = now.Add(2 * time.Minute)
+	if _, ok := cache.get("workflows"); ok {
+		t.Error("Expected cached entry to expire after the TTL")
+	}
+}
+
+func TestResponseCache_DropsResponsesFromBeforeInvalidation(t *testing.T) {
+	cache := newResponseCache(time.Minute)
+
+	generation := cache.currentGeneration()
+	cache.invalidate()
+	cache.set("workflows", []byte(`{"data":[]}`), generation)
+
+	if _, ok := cache.get("workflows"); ok {
+		t.Error("Expected a response fetched before the invalidation not to be cached")
+	}
+
+	cache.set("workflows", []byte(`{"data":[]}`), cache.currentGeneration())
+	if _, ok := cache.get("workflows"); !ok {
+		t.Error("Expected a response fetched after the invalidation to be cached")
+	}
+}
+
+func TestClient_ResponseCache(t *testing.T) {
+	var gets int32
+	server := TestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == "GET" {
+			atomic.AddInt32(&gets, 1)
+		}
+		_, _ = w.Write([]byte(`{"id": "wf-1", "name": "Cached"}`))
+	})
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL:  server.URL,
+		Auth:     &APIKeyAuth{APIKey: "test-key"},
+		CacheTTL: time.Minute,
+	})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	for i := 0; i < 3; i++ {
+		workflow, err := client.GetWorkflow("wf-1")
+		if err != nil {
+			t.Fatalf("GetWorkflow() error = %v", err)
+		}
+		if workflow.Name != "Cached" {
+			t.Errorf("Expected name 'Cached', got %q", workflow.Name)
+		}
+	}
+
+	if got := atomic.LoadInt32(&gets); got != 1 {
+		t.Errorf("Expected 1 GET request with caching enabled, got %d", got)
+	}
+
+	// A write invalidates the cache
+	if _, err := client.UpdateWorkflow("wf-1", &Workflow{Name: "Cached"}); err != nil {
+		t.Fatalf("UpdateWorkflow() error = %v", err)
+	}
+
+	if _, err := client.GetWorkflow("wf-1"); err != nil {
+		t.Fatalf("GetWorkflow() error = %v", err)
+	}
+
+	if got := atomic.LoadInt32(&gets); got != 2 {
+		t.Errorf("Expected a fresh GET request after a write, got %d requests", got)
+	}
+}
+
+func TestClient_ResponseCacheWriteDuringRead(t *testing.T) {
+	var gets int32
+	arrived := make(chan struct{})
+	release := make(chan struct{})
+	server := TestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == "GET" && atomic.AddInt32(&gets, 1) == 1 {
+			close(arrived)
+			<-release
+		}
+		_, _ = w.Write([]byte(`{"id": "wf-1", "name": "Cached"}`))
+	})
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL:  server.URL,
+		Auth:     &APIKeyAuth{APIKey: "test-key"},
+		CacheTTL: time.Minute,
+	})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	done := make(chan error)
+	go func() {
+		_, err := client.GetWorkflow("wf-1")
+		done <- err
+	}()
+
+	// Write while the first read is in flight
+	<-arrived
+	if _, err := client.UpdateWorkflow("wf-1", &Workflow{Name: "Cached"}); err != nil {
+		t.Fatalf("UpdateWorkflow() error = %v", err)
+	}
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatalf("GetWorkflow() error = %v", err)
+	}
+
+	if _, err := client.GetWorkflow("wf-1"); err != nil {
+		t.Fatalf("GetWorkflow() error = %v", err)
+	}
+
+	if got := atomic.LoadInt32(&gets); got != 2 {
+		t.Errorf("Expected the read started before the write not to be cached, got %d GET requests", got)
+	}
+}
+
+func TestClient_ResponseCacheDisabled(t *testing.T) {
+	var gets int32
+	server := TestServer(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&gets, 1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": "wf-1", "name": "Uncached"}`))
+	})
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetWorkflow("wf-1"); err != nil {
+			t.Fatalf("GetWorkflow() error = %v", err)
+		}
+	}
+
+	if got := atomic.LoadInt32(&gets); got != 2 {
+		t.Errorf("Expected every GET to reach the server without caching, got %d requests", got)
+	}
+}
+
+func TestClient_ConcurrentGetsShareRequest(t *testing.T) {
+	var requests int32
+	release := make(chan struct{})
+	server := TestServer(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		<-release
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": "wf-1", "name": "Orders"}`))
+	})
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	const readers = 10
+	var wg sync.WaitGroup
+	workflows := make([]*Workflow, readers)
+	errs := make([]error, readers)
+	for i := 0; i < readers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			workflows[i], errs[i] = client.GetWorkflow("wf-1")
+		}(i)
+	}
+
+	// Hold the first request until the other readers have joined it
+	for atomic.LoadInt32(&requests) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if got := atomic.LoadInt32(&requests); got != 1 {
+		t.Errorf("Expected concurrent reads to share 1 request, got %d", got)
+	}
+
+	for i := 0; i < readers; i++ {
+		if errs[i] != nil {
+			t.Fatalf("GetWorkflow() error = %v", errs[i])
+		}
+		if workflows[i].Name != "Orders" {
+			t.Errorf("Expected workflow Orders, got %q", workflows[i].Name)
+		}
+	}
+
+	// Every reader decodes its own copy
+	workflows[0].Name = "Changed"
+	if workflows[1].Name != "Orders" {
+		t.Error("Expected readers not to share decoded workflows")
+	}
+}
+
+func TestClient_SequentialGetsAreNotShared(t *testing.T) {
+	var requests int32
+	server := TestServer(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": "wf-1", "name": "Orders"}`))
+	})
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetWorkflow("wf-1"); err != nil {
+			t.Fatalf("GetWorkflow() error = %v", err)
+		}
+	}
+
+	if got := atomic.LoadInt32(&requests); got != 2 {
+		t.Errorf("Expected completed requests not to be reused without the cache, got %d requests", got)
+	}
+}
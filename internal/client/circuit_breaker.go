@@ -0,0 +1,159 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// CircuitBreakerConfig configures the circuit breaker of a client. After FailureThreshold consecutive
+// requests failed with a 5xx status or a connection error, the circuit opens and requests fail
+// immediately for CoolDown. A single request is then let through to probe the instance; if it fails as
+// well, the circuit opens again with twice the cool-down, up to MaxCoolDown.
+type CircuitBreakerConfig struct {
+	FailureThreshold int           // Consecutive failures that open the circuit; zero disables the circuit breaker
+	CoolDown         time.Duration // How long requests are rejected when the circuit opens; defaults to 30 seconds
+	MaxCoolDown      time.Duration // Upper bound of the cool-down after failed probes; defaults to 5 minutes
+}
+
+// CircuitOpenError is returned for requests that were not sent because the circuit breaker is open
+type CircuitOpenError struct {
+	Failures  int
+	LastError string
+	Until     time.Time
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("n8n instance appears to be unavailable: %d consecutive requests failed, last with: %s. "+
+		"Requests are paused until %s to avoid retrying every resource against it; "+
+		"check that the instance is running and reachable", e.Failures, e.LastError, e.Until.Format(time.RFC3339))
+}
+
+// circuitBreaker tracks consecutive failures of the requests of a client
+type circuitBreaker struct {
+	mu               sync.Mutex
+	failureThreshold int
+	baseCoolDown     time.Duration
+	maxCoolDown      time.Duration
+	coolDown         time.Duration
+	failures         int
+	lastError        string
+	openUntil        time.Time
+	probing          bool
+	now              func() time.Time
+}
+
+// newCircuitBreaker creates a circuit breaker from config, or returns nil if it is disabled
+func newCircuitBreaker(config CircuitBreakerConfig) *circuitBreaker {
+	if config.FailureThreshold <= 0 {
+		return nil
+	}
+
+	coolDown := config.CoolDown
+	if coolDown == 0 {
+		coolDown = 30 * time.Second
+	}
+	maxCoolDown := config.MaxCoolDown
+	if maxCoolDown == 0 {
+		maxCoolDown = 5 * time.Minute
+	}
+
+	return &circuitBreaker{
+		failureThreshold: config.FailureThreshold,
+		baseCoolDown:     coolDown,
+		maxCoolDown:      max(maxCoolDown, coolDown),
+		coolDown:         coolDown,
+		now:              time.Now,
+	}
+}
+
+// allow returns a *CircuitOpenError if a request must not be sent. Once the cool-down has passed, one
+// request at a time is let through as a probe.
+func (cb *circuitBreaker) allow() error {
+	if cb == nil {
+		return nil
+	}
+
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if cb.failures < cb.failureThreshold {
+		return nil
+	}
+
+	if cb.now().Before(cb.openUntil) || cb.probing {
+		return &CircuitOpenError{Failures: cb.failures, LastError: cb.lastError, Until: cb.openUntil}
+	}
+
+	cb.probing = true
+	return nil
+}
+
+// recordSuccess closes the circuit after a request reached the instance
+func (cb *circuitBreaker) recordSuccess() {
+	if cb == nil {
+		return
+	}
+
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	cb.failures = 0
+	cb.probing = false
+	cb.coolDown = cb.baseCoolDown
+}
+
+// recordFailure counts a failed request and opens the circuit once the threshold is reached. A failed
+// probe doubles the cool-down. It returns the time until which the circuit is open if it opened.
+func (cb *circuitBreaker) recordFailure(reason string) (time.Time, bool) {
+	if cb == nil {
+		return time.Time{}, false
+	}
+
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	cb.failures++
+	cb.lastError = reason
+
+	if cb.probing {
+		cb.probing = false
+		cb.coolDown = min(cb.coolDown*2, cb.maxCoolDown)
+		cb.openUntil = cb.now().Add(cb.coolDown)
+		return cb.openUntil, true
+	}
+
+	if cb.failures == cb.failureThreshold {
+		cb.openUntil = cb.now().Add(cb.coolDown)
+		return cb.openUntil, true
+	}
+	return time.Time{}, false
+}
+
+// recordResult records the outcome of a request sent to the instance: connection errors and 5xx responses
+// are failures, any other response shows that the instance is up
+func (c *Client) recordResult(statusCode int, err error) {
+	var reason string
+	switch {
+	case err != nil:
+		reason = err.Error()
+	case statusCode >= 500:
+		reason = fmt.Sprintf("HTTP %d", statusCode)
+	default:
+		c.breaker.recordSuccess()
+		return
+	}
+
+	if until, opened := c.breaker.recordFailure(reason); opened {
+		c.logger.Logf("n8n circuit breaker open: pausing requests until %s after %s", until.Format(time.RFC3339), reason)
+	}
+}
+
+// responseStatus returns the status code of resp, or zero if no response was received
+func responseStatus(resp *http.Response) int {
+	if resp == nil {
+		return 0
+	}
+	return resp.StatusCode
+}
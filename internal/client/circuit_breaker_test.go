@@ -0,0 +1,134 @@
+package client
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	cb := newCircuitBreaker(CircuitBreakerConfig{FailureThreshold: 2, CoolDown: time.Minute, MaxCoolDown: 3 * time.Minute})
+
+	now := time.Now()
+	cb.now = func() time.Time { return now }
+
+	cb.recordFailure("HTTP 502")
+	if err := cb.allow(); err != nil {
+		t.Fatalf("Expected requests below the threshold to be allowed, got %v", err)
+	}
+
+	if _, opened := cb.recordFailure("HTTP 503"); !opened {
+		t.Fatal("Expected the circuit to open at the threshold")
+	}
+	var openErr *CircuitOpenError
+	if err := cb.allow(); !errors.As(err, &openErr) || openErr.Failures != 2 || openErr.LastError != "HTTP 503" {
+		t.Fatalf("Expected a CircuitOpenError after 2 failures, got %v", err)
+	}
+
+	// After the cool-down a single probe is let through
+	now = now.Add(time.Minute)
+	if err := cb.allow(); err != nil {
+		t.Fatalf("Expected a probe after the cool-down, got %v", err)
+	}
+	if err := cb.allow(); err == nil {
+		t.Fatal("Expected only one probe at a time")
+	}
+
+	// A failed probe doubles the cool-down
+	if until, opened := cb.recordFailure("connection refused"); !opened || !until.Equal(now.Add(2*time.Minute)) {
+		t.Fatalf("Expected the circuit to open for 2 minutes, got %v", until)
+	}
+	now = now.Add(2 * time.Minute)
+	if err := cb.allow(); err != nil {
+		t.Fatalf("Expected a probe after the doubled cool-down, got %v", err)
+	}
+	if until, _ := cb.recordFailure("connection refused"); !until.Equal(now.Add(3 * time.Minute)) {
+		t.Fatalf("Expected the cool-down to be capped at 3 minutes, got %v", until)
+	}
+
+	// A successful probe closes the circuit and resets the cool-down
+	now = now.Add(3 * time.Minute)
+	if err := cb.allow(); err != nil {
+		t.Fatalf("Expected a probe after the cool-down, got %v", err)
+	}
+	cb.recordSuccess()
+	if err := cb.allow(); err != nil {
+		t.Fatalf("Expected the circuit to be closed, got %v", err)
+	}
+	if cb.coolDown != time.Minute {
+		t.Errorf("Expected the cool-down to be reset, got %v", cb.coolDown)
+	}
+}
+
+func TestCircuitBreaker_Disabled(t *testing.T) {
+	cb := newCircuitBreaker(CircuitBreakerConfig{})
+	if cb != nil {
+		t.Fatal("Expected no circuit breaker without a failure threshold")
+	}
+
+	for i := 0; i < 10; i++ {
+		cb.recordFailure("HTTP 500")
+	}
+	if err := cb.allow(); err != nil {
+		t.Errorf("Expected a disabled circuit breaker to allow requests, got %v", err)
+	}
+}
+
+func TestClient_CircuitBreakerStopsRetries(t *testing.T) {
+	requests := 0
+	server := TestServer(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL:        server.URL,
+		Auth:           &APIKeyAuth{APIKey: "test-key"},
+		RetryConfig:    RetryConfig{MaxRetries: 5, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond},
+		CircuitBreaker: CircuitBreakerConfig{FailureThreshold: 2, CoolDown: time.Minute},
+	})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	var openErr *CircuitOpenError
+	if _, err := client.GetWorkflow("wf-1"); !errors.As(err, &openErr) {
+		t.Fatalf("Expected a CircuitOpenError, got %v", err)
+	}
+	if requests != 2 {
+		t.Errorf("Expected retries to stop once the circuit opened after 2 requests, got %d requests", requests)
+	}
+
+	// Further requests fail without reaching the instance
+	if _, err := client.GetCredential("cred-1"); !errors.As(err, &openErr) {
+		t.Fatalf("Expected a CircuitOpenError, got %v", err)
+	}
+	if requests != 2 {
+		t.Errorf("Expected no requests while the circuit is open, got %d requests", requests)
+	}
+}
+
+func TestClient_CircuitBreakerIgnoresClientErrors(t *testing.T) {
+	server := TestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "Not found"}`))
+	})
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL:        server.URL,
+		Auth:           &APIKeyAuth{APIKey: "test-key"},
+		CircuitBreaker: CircuitBreakerConfig{FailureThreshold: 1},
+	})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, err := client.GetWorkflow("wf-1"); !IsNotFound(err) {
+			t.Fatalf("Expected a not found error, got %v", err)
+		}
+	}
+}
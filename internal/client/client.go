@@ -3,8 +3,9 @@ package client
 import (
 	"bufio"
 	"bytes"
-	"crypto/tls"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
@@ -16,16 +17,47 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/sync/singleflight"
 )
 
 // Client represents the n8n API client
 type Client struct {
 	baseURL     *url.URL
+	webhookURL  *url.URL
 	httpClient  *http.Client
 	auth        AuthMethod
 	logger      Logger
 	retryConfig RetryConfig
+	cache       *responseCache
+	refresh     *refreshCache
+	headers     map[string]string
+	userAgent   string
+	apiMode     APIMode
+	sessionMu   sync.Mutex
+
+	// inflight deduplicates concurrent GET requests for the same URL
+	inflight singleflight.Group
+
+	instrumentation Instrumentation
+	breaker         *circuitBreaker
+
+	defaultProjectID    string
+	workflowNamePattern string
+
+	instanceMu   sync.Mutex
+	instanceInfo *InstanceInfo
+
+	startupWait         time.Duration
+	startupPollInterval time.Duration
+	startupOnce         sync.Once
+	startupErr          error
+
+	maxResponseSize  int64
+	compressRequests bool
 }
 
 // Logger interface for logging requests and responses
@@ -49,15 +81,36 @@ type RetryConfig struct {
 
 // Config holds configuration for the n8n client
 type Config struct {
-	BaseURL            string
-	Auth               AuthMethod
-	InsecureSkipVerify bool
-	Timeout            time.Duration
-	Logger             Logger
-	RetryConfig        RetryConfig
-	CookieFile         string // Path to cookie file for session authentication
+	BaseURL             string
+	Auth                AuthMethod
+	InsecureSkipVerify  bool
+	Timeout             time.Duration
+	Logger              Logger
+	RetryConfig         RetryConfig
+	CookieFile          string // Path to cookie file for session authentication
+	WebhookURL          string // Public webhook base URL, if it differs from BaseURL (n8n's WEBHOOK_URL)
+	Transport           TransportConfig
+	CacheTTL            time.Duration     // How long GET responses are cached; zero disables caching
+	Headers             map[string]string // Extra headers sent with every request
+	ClientCertPEM       string            // PEM-encoded client certificate for mutual TLS
+	ClientKeyPEM        string            // PEM-encoded private key for the client certificate
+	CACertPEM           string            // PEM-encoded CA bundle trusted in addition to the system roots
+	APIMode             APIMode           // Which API surface requests are sent to; defaults to APIModePublic
+	DefaultProjectID    string            // Project that new workflows and credentials are moved to, if any
+	WorkflowNamePattern string            // Regular expression that names of managed workflows must match, if any
+	Instrumentation     Instrumentation   // Receives the timing of every request sent to n8n, if set
+	CircuitBreaker      CircuitBreakerConfig
+	StartupWait         time.Duration // How long the first request waits for the instance to start; zero disables waiting
+	MaxResponseSize     int64         // Largest response body in bytes that is read; defaults to DefaultMaxResponseSize
+	CompressRequests    bool          // Whether large request bodies are sent compressed with gzip
+	RefreshCache        bool          // Whether single workflow and credential reads are served from one listing each
+	Parallelism         int           // Most requests in flight at once; zero means no limit
+	UserAgent           string        // User-Agent of every request; defaults to DefaultUserAgent
 }
 
+// DefaultUserAgent is the User-Agent of requests from clients that do not configure one
+const DefaultUserAgent = "terraform-provider-n8n"
+
 // AuthMethod interface for different authentication methods
 type AuthMethod interface {
 	ApplyAuth(*http.Request) error
@@ -84,10 +137,14 @@ func (a *BasicAuth) ApplyAuth(req *http.Request) error {
 	return nil
 }
 
-// SessionAuth implements session-based authentication using cookies
+// SessionAuth implements session-based authentication using cookies. The session is either
+// loaded from a cookie file or, when Email and Password are set, obtained by logging in; an
+// expired session is then renewed automatically and persisted to CookieFile if set.
 type SessionAuth struct {
 	CookieJar  http.CookieJar
 	CookieFile string
+	Email      string
+	Password   string
 }
 
 func (a *SessionAuth) ApplyAuth(req *http.Request) error {
@@ -96,6 +153,49 @@ func (a *SessionAuth) ApplyAuth(req *http.Request) error {
 	return nil
 }
 
+// CompositeAuth applies several authentication methods in order, e.g. Basic authentication for a reverse
+// proxy in front of n8n followed by the API key of n8n. At most one of them may set the Authorization header.
+type CompositeAuth []AuthMethod
+
+func (a CompositeAuth) ApplyAuth(req *http.Request) error {
+	for _, method := range a {
+		if err := method.ApplyAuth(req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sessionAuthOf returns the session authentication of auth, which may be part of a CompositeAuth
+func sessionAuthOf(auth AuthMethod) (*SessionAuth, bool) {
+	switch auth := auth.(type) {
+	case *SessionAuth:
+		return auth, true
+	case CompositeAuth:
+		for _, method := range auth {
+			if sessionAuth, ok := sessionAuthOf(method); ok {
+				return sessionAuth, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// bearerAuthOf returns the bearer token authentication of auth, which may be part of a CompositeAuth
+func bearerAuthOf(auth AuthMethod) (*BearerTokenAuth, bool) {
+	switch auth := auth.(type) {
+	case *BearerTokenAuth:
+		return auth, true
+	case CompositeAuth:
+		for _, method := range auth {
+			if bearerAuth, ok := bearerAuthOf(method); ok {
+				return bearerAuth, true
+			}
+		}
+	}
+	return nil, false
+}
+
 // validateCookieFilePath validates that the cookie file path is safe to open
 func validateCookieFilePath(cookieFile string) error {
 	if cookieFile == "" {
@@ -257,6 +357,17 @@ func (e *APIError) Error() string {
 	return fmt.Sprintf("n8n API error (code %d): %s", e.Code, e.Message)
 }
 
+// IsNotFound reports whether err wraps an APIError with a 404 status code
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.Code == http.StatusNotFound
+}
+
+// IsUnauthorized reports whether err wraps an APIError with a 401 or 403 status code
+func IsUnauthorized(err error) bool {
+	return hasStatus(err, http.StatusUnauthorized, http.StatusForbidden)
+}
+
 // NewClient creates a new n8n API client
 func NewClient(config *Config) (*Client, error) {
 	if config.BaseURL == "" {
@@ -272,6 +383,14 @@ func NewClient(config *Config) (*Client, error) {
 		return nil, fmt.Errorf("invalid base URL: %w", err)
 	}
 
+	apiMode := config.APIMode
+	if apiMode == "" {
+		apiMode = APIModePublic
+	}
+	if err := validateAPIMode(apiMode); err != nil {
+		return nil, err
+	}
+
 	// Ensure the base URL has a trailing slash and api path
 	if !strings.HasSuffix(baseURL.Path, "/") {
 		baseURL.Path += "/"
@@ -280,31 +399,50 @@ func NewClient(config *Config) (*Client, error) {
 		baseURL.Path += "api/v1/"
 	}
 
+	var webhookURL *url.URL
+	if config.WebhookURL != "" {
+		webhookURL, err = url.Parse(config.WebhookURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid webhook URL: %w", err)
+		}
+		if !strings.HasSuffix(webhookURL.Path, "/") {
+			webhookURL.Path += "/"
+		}
+	}
+
 	timeout := config.Timeout
 	if timeout == 0 {
 		timeout = 30 * time.Second
 	}
 
-	// Configure TLS settings
-	transport := &http.Transport{
-		TLSClientConfig: &tls.Config{
-			// InsecureSkipVerify should only be used for development/testing environments
-			// with self-signed certificates. In production, proper certificate validation
-			// should be used to prevent man-in-the-middle attacks.
-			InsecureSkipVerify: config.InsecureSkipVerify, // #nosec G402 - Configurable for development environments
-		},
+	// Reuse a pooled transport so parallel requests share keep-alive connections
+	transport, err := sharedTransport(config.Transport, tlsSettings{
+		insecureSkipVerify: config.InsecureSkipVerify,
+		clientCertPEM:      config.ClientCertPEM,
+		clientKeyPEM:       config.ClientKeyPEM,
+		caCertPEM:          config.CACertPEM,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure TLS: %w", err)
 	}
 
+	// Limit the requests in flight per client, i.e. per provider alias
 	httpClient := &http.Client{
 		Timeout:   timeout,
-		Transport: transport,
+		Transport: limitTransport(transport, config.Parallelism),
+	}
+
+	// Token requests use the TLS and proxy settings of n8n requests, but not their parallelism limit, since
+	// a token may be requested while the requests that need it wait for a slot
+	if bearerAuth, ok := bearerAuthOf(config.Auth); ok && bearerAuth.HTTPClient == nil {
+		bearerAuth.HTTPClient = &http.Client{Timeout: timeout, Transport: transport}
 	}
 
 	// If using session authentication, set up cookie jar
-	if sessionAuth, ok := config.Auth.(*SessionAuth); ok && sessionAuth.CookieFile != "" {
-		cookieJar, err := LoadCookiesFromFile(sessionAuth.CookieFile, baseURL)
+	if sessionAuth, ok := sessionAuthOf(config.Auth); ok && (sessionAuth.CookieFile != "" || sessionAuth.hasCredentials()) {
+		cookieJar, err := newSessionCookieJar(sessionAuth, baseURL)
 		if err != nil {
-			return nil, fmt.Errorf("failed to load cookies from file: %w", err)
+			return nil, err
 		}
 		httpClient.Jar = cookieJar
 		sessionAuth.CookieJar = cookieJar
@@ -326,17 +464,96 @@ func NewClient(config *Config) (*Client, error) {
 		retryConfig.MaxDelay = 5 * time.Second
 	}
 
+	userAgent := config.UserAgent
+	if userAgent == "" {
+		userAgent = DefaultUserAgent
+	}
+
+	var cache *responseCache
+	if config.CacheTTL > 0 {
+		cache = newResponseCache(config.CacheTTL)
+	}
+
+	var refresh *refreshCache
+	if config.RefreshCache {
+		refresh = newRefreshCache()
+	}
+
 	return &Client{
 		baseURL:     baseURL,
+		webhookURL:  webhookURL,
 		httpClient:  httpClient,
 		auth:        config.Auth,
 		logger:      logger,
 		retryConfig: retryConfig,
+		cache:       cache,
+		refresh:     refresh,
+		headers:     config.Headers,
+		userAgent:   userAgent,
+		apiMode:     apiMode,
+
+		instrumentation: config.Instrumentation,
+		breaker:         newCircuitBreaker(config.CircuitBreaker),
+
+		defaultProjectID:    config.DefaultProjectID,
+		workflowNamePattern: config.WorkflowNamePattern,
+
+		startupWait:         config.StartupWait,
+		startupPollInterval: defaultStartupPollInterval,
+
+		maxResponseSize:  config.MaxResponseSize,
+		compressRequests: config.CompressRequests,
 	}, nil
 }
 
+// DefaultProjectID returns the project that new workflows and credentials belong to, or an empty string
+// if they stay in the personal project of the authenticated user
+func (c *Client) DefaultProjectID() string {
+	return c.defaultProjectID
+}
+
+// WorkflowNamePattern returns the regular expression that the names of workflows managed by the provider
+// must match, or an empty string if names are not checked
+func (c *Client) WorkflowNamePattern() string {
+	return c.workflowNamePattern
+}
+
+// Ping checks that the n8n instance is reachable and accepts the configured credentials, by listing a
+// single workflow
+func (c *Client) Ping() error {
+	var page Page[json.RawMessage]
+	return c.Get("workflows?limit=1", &page)
+}
+
 // doRequest performs an HTTP request with authentication, retries, and logging
 func (c *Client) doRequest(method, path string, body any, result any) error {
+	return c.doCheckedRequest(method, path, body, result, nil)
+}
+
+// doCheckedRequest performs an HTTP request like doRequest. If check is set, it is run before the request is
+// retried after an attempt that n8n may have processed, see createCheck.
+func (c *Client) doCheckedRequest(method, path string, body any, result any, check createCheck) error {
+	return c.doContextRequest(context.Background(), method, path, body, result, check)
+}
+
+// requestURL resolves a path of the public API, which may carry query parameters, against the base URL
+func (c *Client) requestURL(path string) (*url.URL, error) {
+	if !strings.Contains(path, "?") {
+		// Simple path without query parameters
+		return c.baseURL.ResolveReference(&url.URL{Path: path}), nil
+	}
+
+	// Path contains query parameters, parse it properly
+	pathURL, err := url.Parse(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse path with query: %w", err)
+	}
+	return c.baseURL.ResolveReference(pathURL), nil
+}
+
+// doContextRequest performs an HTTP request like doCheckedRequest, giving up once ctx is done
+func (c *Client) doContextRequest(ctx context.Context, method, path string, body any, result any,
+	check createCheck) error {
 	var jsonData []byte
 	var err error
 
@@ -347,79 +564,229 @@ func (c *Client) doRequest(method, path string, body any, result any) error {
 		}
 	}
 
-	// Construct full URL
-	var fullURL *url.URL
-	if strings.Contains(path, "?") {
-		// Path contains query parameters, parse it properly
-		pathURL, err := url.Parse(path)
-		if err != nil {
-			return fmt.Errorf("failed to parse path with query: %w", err)
+	fullURL, err := c.requestURL(path)
+	if err != nil {
+		return err
+	}
+
+	// Serve reads from the response cache, and drop cached reads around writes
+	if c.cache != nil {
+		if method == "GET" {
+			if cached, ok := c.cache.get(fullURL.String()); ok {
+				c.logger.Logf("n8n API cache hit: %s %s", method, fullURL.String())
+				if result != nil && len(cached) > 0 {
+					if err := json.Unmarshal(cached, result); err != nil {
+						return fmt.Errorf("failed to unmarshal response: %w", err)
+					}
+				}
+				return nil
+			}
+		} else {
+			c.cache.invalidate()
+			defer c.cache.invalidate()
+		}
+	}
+	if c.refresh != nil && method != "GET" {
+		c.refresh.invalidate()
+	}
+
+	// Log in first when using session credentials without an existing session
+	if err := c.ensureSession(); err != nil {
+		return fmt.Errorf("failed to establish session: %w", err)
+	}
+
+	// Reads may be shared with concurrent requests or cached, so only writes decode their response directly
+	options := sendOptions{ctx: ctx, check: check}
+	if method != "GET" {
+		options.result = result
+	}
+
+	send := func() ([]byte, error) {
+		return c.traceRequest(method, fullURL, func(trace *requestTrace) ([]byte, error) {
+			return c.sendWithRetries(method, fullURL, jsonData, options, trace)
+		})
+	}
+	if c.cache != nil && method == "GET" {
+		// Only the request that is actually sent stores its response, and only if no write invalidated the
+		// cache while it was in flight
+		fetch := send
+		send = func() ([]byte, error) {
+			generation := c.cache.currentGeneration()
+			respBody, err := fetch()
+			if err == nil {
+				c.cache.set(fullURL.String(), respBody, generation)
+			}
+			return respBody, err
 		}
-		fullURL = c.baseURL.ResolveReference(pathURL)
+	}
+
+	// Concurrent reads of the same URL, e.g. by data sources during one plan, share a single request
+	var respBody []byte
+	if method == "GET" {
+		respBody, err = c.sharedGet(fullURL.String(), send)
 	} else {
-		// Simple path without query parameters
-		fullURL = c.baseURL.ResolveReference(&url.URL{Path: path})
+		respBody, err = send()
 	}
+	if err != nil {
+		return err
+	}
+
+	// Parse successful response
+	if result != nil && len(respBody) > 0 {
+		if err := json.Unmarshal(respBody, result); err != nil {
+			return fmt.Errorf("failed to unmarshal response: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// sendOptions controls how sendWithRetries handles a request
+type sendOptions struct {
+	// ctx cancels the request and its retries
+	ctx context.Context
+	// check is run before a retry that could repeat a processed request, see createCheck
+	check createCheck
+	// result receives large successful responses, which are then decoded while they are read
+	result any
+}
+
+// sendWithRetries sends a request, retrying transient failures and renewing an expired session once, and
+// returns the body of the successful response. Before a retry that could repeat a processed request, the
+// check of options is run if set, and its result is returned instead if it finds one. A large response is
+// decoded into the result of options instead of being returned. The status code and retries are recorded
+// in trace.
+func (c *Client) sendWithRetries(method string, fullURL *url.URL, jsonData []byte, options sendOptions,
+	trace *requestTrace) ([]byte, error) {
+	check := options.check
+
+	payload, contentEncoding, err := c.requestBody(jsonData)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionRefreshed := false
 
 	for attempt := 0; attempt <= c.retryConfig.MaxRetries; attempt++ {
+		trace.retries = attempt
+
+		if err := options.ctx.Err(); err != nil {
+			return nil, err
+		}
+
 		var reqBody io.Reader
-		if jsonData != nil {
-			reqBody = bytes.NewBuffer(jsonData)
+		if payload != nil {
+			reqBody = bytes.NewBuffer(payload)
 		}
 
-		req, err := http.NewRequest(method, fullURL.String(), reqBody)
+		req, err := http.NewRequestWithContext(options.ctx, method, fullURL.String(), reqBody)
 		if err != nil {
-			return fmt.Errorf("failed to create request: %w", err)
+			return nil, fmt.Errorf("failed to create request: %w", err)
 		}
 
 		// Set headers
 		req.Header.Set("Content-Type", "application/json")
 		req.Header.Set("Accept", "application/json")
+		req.Header.Set("Accept-Encoding", "gzip")
+		if contentEncoding != "" {
+			req.Header.Set("Content-Encoding", contentEncoding)
+		}
+		requestID := c.setHeaders(req)
 
 		// Apply authentication
 		if err := c.auth.ApplyAuth(req); err != nil {
-			return fmt.Errorf("failed to apply authentication: %w", err)
+			return nil, fmt.Errorf("failed to apply authentication: %w", err)
 		}
 
 		// Log request
-		c.logger.Logf("n8n API request: %s %s (attempt %d/%d)", method, fullURL.String(), attempt+1, c.retryConfig.MaxRetries+1)
+		c.logger.Logf("n8n API request: %s %s (attempt %d/%d, request ID %s)", method, fullURL.String(), attempt+1,
+			c.retryConfig.MaxRetries+1, requestID)
 		if len(jsonData) > 0 {
-			c.logger.Logf("n8n API request body: %s", string(jsonData))
+			c.logger.Logf("n8n API request body: %s", truncateBody(redactBody(jsonData)))
+		}
+
+		// Fail fast while the instance is known to be down instead of using up the retries
+		if err := c.breaker.allow(); err != nil {
+			return nil, err
 		}
 
 		resp, err := c.httpClient.Do(req)
+		c.recordResult(responseStatus(resp), err)
 		if err != nil {
+			// A failed request holds no parallelism slot: the limited transport releases it itself, and a
+			// response returned along with an error has its body closed already
 			if attempt < c.retryConfig.MaxRetries && isRetryableError(err) {
+				if check != nil && mayHaveBeenProcessed(0, err) {
+					if body, found, checkErr := c.checkCreate(check, err.Error()); checkErr != nil || found {
+						return body, checkErr
+					}
+				}
 				delay := c.calculateBackoff(attempt)
 				c.logger.Logf("n8n API request failed, retrying in %v: %v", delay, err)
 				time.Sleep(delay)
 				continue
 			}
-			return fmt.Errorf("request failed: %w", err)
+			return nil, fmt.Errorf("request failed: %w", err)
+		}
+
+		// Ensure response body is properly closed. Retries and create checks close it first, since with a
+		// limited parallelism their requests wait for the slot this response holds until its body is closed.
+		var closeOnce sync.Once
+		closeBody := func() {
+			closeOnce.Do(func() {
+				if closeErr := resp.Body.Close(); closeErr != nil {
+					c.logger.Logf("Warning: failed to close response body: %v", closeErr)
+				}
+			})
+		}
+		defer closeBody()
+
+		trace.statusCode = resp.StatusCode
+
+		// Log response
+		c.logger.Logf("n8n API response: %d %s (request ID %s)", resp.StatusCode, resp.Status, requestID)
+
+		if err := decompressBody(resp); err != nil {
+			return nil, err
 		}
 
-		// Ensure response body is properly closed
-		defer func() {
-			if closeErr := resp.Body.Close(); closeErr != nil {
-				c.logger.Logf("Warning: failed to close response body: %v", closeErr)
+		// Large successful responses are decoded while they are read instead of being buffered
+		if resp.StatusCode < 400 {
+			if decoded, err := c.decodeBody(resp, options.result); decoded {
+				return nil, err
 			}
-		}()
+		}
 
-		respBody, err := io.ReadAll(resp.Body)
+		respBody, err := c.readBody(resp)
 		if err != nil {
-			return fmt.Errorf("failed to read response body: %w", err)
+			return nil, err
 		}
-
-		// Log response
-		c.logger.Logf("n8n API response: %d %s", resp.StatusCode, resp.Status)
 		if len(respBody) > 0 {
-			c.logger.Logf("n8n API response body: %s", string(respBody))
+			c.logger.Logf("n8n API response body: %s", truncateBody(respBody))
+		}
+
+		// Renew an expired session once and replay the request without using up a retry
+		if resp.StatusCode == http.StatusUnauthorized && !sessionRefreshed && c.canRefreshSession() {
+			sessionRefreshed = true
+			closeBody()
+			c.logger.Logf("n8n session expired, logging in again")
+			if err := c.Login(); err != nil {
+				return nil, fmt.Errorf("failed to refresh session: %w", err)
+			}
+			attempt--
+			continue
 		}
 
 		// Handle error responses
 		if resp.StatusCode >= 400 {
 			// Check if this is a retryable HTTP error
 			if attempt < c.retryConfig.MaxRetries && isRetryableHTTPStatus(resp.StatusCode) {
+				closeBody()
+				if check != nil && mayHaveBeenProcessed(resp.StatusCode, nil) {
+					if body, found, checkErr := c.checkCreate(check, resp.Status); checkErr != nil || found {
+						return body, checkErr
+					}
+				}
 				delay := c.calculateBackoff(attempt)
 				c.logger.Logf("n8n API request failed with status %d, retrying in %v", resp.StatusCode, delay)
 				time.Sleep(delay)
@@ -429,26 +796,32 @@ func (c *Client) doRequest(method, path string, body any, result any) error {
 			var apiErr APIError
 			if err := json.Unmarshal(respBody, &apiErr); err != nil {
 				// If we can't parse the error response, create a generic error
-				return &APIError{
+				return nil, &APIError{
 					Code:    resp.StatusCode,
-					Message: fmt.Sprintf("HTTP %d: %s", resp.StatusCode, string(respBody)),
+					Message: fmt.Sprintf("HTTP %d: %s", resp.StatusCode, truncateBody(respBody)),
 				}
 			}
 			apiErr.Code = resp.StatusCode
-			return &apiErr
+			return nil, &apiErr
 		}
 
-		// Parse successful response
-		if result != nil && len(respBody) > 0 {
-			if err := json.Unmarshal(respBody, result); err != nil {
-				return fmt.Errorf("failed to unmarshal response: %w", err)
-			}
-		}
-
-		return nil
+		return respBody, nil
 	}
 
-	return fmt.Errorf("max retries exceeded")
+	return nil, fmt.Errorf("max retries exceeded")
+}
+
+// setHeaders sets the User-Agent, a new X-Request-Id and the extra headers of the client on a request, and
+// returns the request ID, so that requests can be found in the logs of n8n or a proxy in front of it. Extra
+// headers may replace both.
+func (c *Client) setHeaders(req *http.Request) string {
+	requestID := uuid.NewString()
+	req.Header.Set("User-Agent", c.userAgent)
+	req.Header.Set("X-Request-Id", requestID)
+	for name, value := range c.headers {
+		req.Header.Set(name, value)
+	}
+	return req.Header.Get("X-Request-Id")
 }
 
 // calculateBackoff calculates exponential backoff delay
@@ -475,24 +848,43 @@ func isRetryableHTTPStatus(statusCode int) bool {
 		statusCode == http.StatusGatewayTimeout
 }
 
+// Request sends a request to an endpoint of the n8n API that the client has no method for yet, with the
+// authentication, retries, caching and API routing of its other methods. path is relative to the API, e.g.
+// "workflows/1/tags", body is sent as JSON if set, and the response is decoded into out if set.
+func (c *Client) Request(ctx context.Context, method, path string, body, out any) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if c.surfaceFor(path) == APISurfaceInternal {
+		return c.doInternalContextRequest(ctx, method, path, body, out)
+	}
+	return c.doContextRequest(ctx, method, path, body, out, nil)
+}
+
 // Get performs a GET request
 func (c *Client) Get(path string, result any) error {
-	return c.doRequest("GET", path, nil, result)
+	return c.request("GET", path, nil, result)
 }
 
 // Post performs a POST request
 func (c *Client) Post(path string, body any, result any) error {
-	return c.doRequest("POST", path, body, result)
+	return c.request("POST", path, body, result)
 }
 
 // Put performs a PUT request
 func (c *Client) Put(path string, body any, result any) error {
-	return c.doRequest("PUT", path, body, result)
+	return c.request("PUT", path, body, result)
+}
+
+// Patch performs a PATCH request
+func (c *Client) Patch(path string, body any, result any) error {
+	return c.request("PATCH", path, body, result)
 }
 
 // Delete performs a DELETE request
 func (c *Client) Delete(path string) error {
-	return c.doRequest("DELETE", path, nil, nil)
+	return c.request("DELETE", path, nil, nil)
 }
 
 // PaginationInfo holds pagination metadata
@@ -506,7 +898,7 @@ type PaginationInfo struct {
 
 // GetWithPagination performs a GET request with pagination support
 func (c *Client) GetWithPagination(path string, result any) (*PaginationInfo, error) {
-	err := c.doRequest("GET", path, nil, result)
+	err := c.request("GET", path, nil, result)
 	if err != nil {
 		return nil, err
 	}
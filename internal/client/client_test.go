@@ -181,6 +181,37 @@ func TestClient_ErrorHandling(t *testing.T) {
 	}
 }
 
+func TestClient_Ping(t *testing.T) {
+	var query string
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Path + "?" + r.URL.RawQuery
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		if status == http.StatusOK {
+			_, _ = w.Write([]byte(`{"data": [], "nextCursor": null}`))
+		} else {
+			_, _ = w.Write([]byte(`{"message": "unauthorized"}`))
+		}
+	}))
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	if err := client.Ping(); err != nil {
+		t.Fatalf("Ping() error = %v", err)
+	}
+	if query != "/api/v1/workflows?limit=1" {
+		t.Errorf("Expected a single workflow to be listed, got %s", query)
+	}
+
+	status = http.StatusUnauthorized
+	err := client.Ping()
+	if !IsUnauthorized(err) {
+		t.Errorf("Expected an unauthorized error, got %v", err)
+	}
+}
+
 func TestClient_RetryLogic(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping retry logic test in short mode")
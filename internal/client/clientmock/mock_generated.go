@@ -0,0 +1,826 @@
+// Code generated by mockgen from api.go; DO NOT EDIT.
+
+// Package clientmock provides mocks of the client interfaces for unit tests.
+package clientmock
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+)
+
+// N8nAPI is a mock of client.N8nAPI. Each method calls the function field of the same name, or returns
+// zero values and an error if it is not set.
+type N8nAPI struct {
+	DefaultProjectIDFunc              func() string
+	WorkflowNamePatternFunc           func() string
+	GetInstanceInfoFunc               func() (*client.InstanceInfo, error)
+	GetInstanceSettingsFunc           func() (*client.InstanceSettings, error)
+	DismissBannerFunc                 func(banner string) error
+	IsMFAEnforcedFunc                 func() (bool, error)
+	EnforceMFAFunc                    func(enforce bool) error
+	IsOwnerSetUpFunc                  func() (bool, error)
+	SetupOwnerFunc                    func(req *client.OwnerSetupRequest) (*client.User, error)
+	RunAuditFunc                      func(options *client.AuditOptions) (*client.Audit, error)
+	GetWorkflowFunc                   func(id string) (*client.Workflow, error)
+	ListWorkflowsFunc                 func(options *client.WorkflowListOptions, maxItems int) (*client.WorkflowList, error)
+	CreateWorkflowFunc                func(workflow *client.Workflow) (*client.Workflow, error)
+	UpdateWorkflowFunc                func(id string, workflow *client.Workflow) (*client.Workflow, error)
+	PatchWorkflowFunc                 func(id string, fields map[string]interface{}) (*client.Workflow, error)
+	DeleteWorkflowFunc                func(id string) error
+	ActivateWorkflowFunc              func(id string) (*client.Workflow, error)
+	DeactivateWorkflowFunc            func(id string) (*client.Workflow, error)
+	ArchiveWorkflowFunc               func(id string) (*client.Workflow, error)
+	UnarchiveWorkflowFunc             func(id string) (*client.Workflow, error)
+	TransferWorkflowFunc              func(id, projectID string) error
+	MoveWorkflowToFolderFunc          func(id, folderID string) error
+	GetWorkflowVersionsFunc           func(workflowID string) ([]client.WorkflowVersion, error)
+	UpdateWorkflowTagsFunc            func(workflowID string, tagIDs []string) ([]client.Tag, error)
+	GetAllTagsFunc                    func() ([]client.Tag, error)
+	CreateTagFunc                     func(name string) (*client.Tag, error)
+	WorkflowWebhooksFunc              func(workflow *client.Workflow) []client.Webhook
+	RunWorkflowFunc                   func(id string, payload map[string]interface{}) (string, error)
+	RunWorkflowWithPinDataFunc        func(id string, pinData map[string]interface{}) (string, error)
+	WaitForExecutionFunc              func(id string, timeout time.Duration) (*client.Execution, error)
+	DeleteExecutionsFunc              func(filter *client.ExecutionDeleteFilter) error
+	GetNodeTypesFunc                  func() ([]client.NodeType, error)
+	GetAllVariablesFunc               func() ([]client.Variable, error)
+	GetAllCredentialsFunc             func(options *client.CredentialListOptions) ([]client.Credential, error)
+	GetCredentialFunc                 func(id string) (*client.Credential, error)
+	CreateCredentialFunc              func(credential *client.Credential) (*client.Credential, error)
+	PatchCredentialFunc               func(id string, fields map[string]interface{}) (*client.Credential, error)
+	DeleteCredentialFunc              func(id string) error
+	TransferCredentialFunc            func(id, projectID string) error
+	GetCredentialSharingFunc          func(id string) ([]string, error)
+	ShareCredentialFunc               func(id string, projectIDs []string) error
+	TestCredentialFunc                func(credential *client.Credential) (*client.CredentialTestResult, error)
+	GetCredentialTypesFunc            func() ([]client.CredentialType, error)
+	GetProjectFunc                    func(id string) (*client.Project, error)
+	CreateProjectFunc                 func(project *client.Project) (*client.Project, error)
+	UpdateProjectFunc                 func(id string, project *client.Project) error
+	DeleteProjectFunc                 func(id string) error
+	DeleteProjectWithContentFunc      func(id, transferToProjectID string) error
+	GetPersonalProjectFunc            func() (*client.Project, error)
+	GetProjectUsersFunc               func(projectID string) ([]client.ProjectUser, error)
+	AddUserToProjectFunc              func(projectUser *client.ProjectUser) (*client.ProjectUser, error)
+	UpdateProjectUserFunc             func(projectID, userID string, projectUser *client.ProjectUser) (*client.ProjectUser, error)
+	RemoveUserFromProjectFunc         func(projectID, userID string) error
+	GetFolderFunc                     func(projectID, id string) (*client.Folder, error)
+	CreateFolderFunc                  func(projectID, name, parentFolderID string) (*client.Folder, error)
+	UpdateFolderFunc                  func(projectID, id, name, parentFolderID string) (*client.Folder, error)
+	DeleteFolderFunc                  func(projectID, id string) error
+	GetUserFunc                       func(id string) (*client.User, error)
+	GetAllUsersFunc                   func(options *client.UserListOptions) ([]client.User, error)
+	CreateUserFunc                    func(userReq *client.CreateUserRequest) (*client.User, error)
+	CreateUsersFunc                   func(userReqs []*client.CreateUserRequest) ([]client.CreateUserResult, error)
+	UpdateUserFunc                    func(id string, user *client.User) (*client.User, error)
+	ChangeUserRoleFunc                func(id, role string) error
+	SetUserPasswordFunc               func(id, password string) error
+	DeleteUserFunc                    func(id string) error
+	DeleteUserWithTransferFunc        func(id, transferToProjectID string) error
+	GetGlobalRolesFunc                func() ([]string, error)
+	GetLDAPConfigFunc                 func() (*client.LDAPConfig, error)
+	UpdateLDAPConfigFunc              func(config *client.LDAPConfig) (*client.LDAPConfig, error)
+	SyncLDAPFunc                      func(runMode string) error
+	GetLastLDAPSyncRunFunc            func() (*client.LDAPSyncRun, error)
+	GetLogStreamingDestinationFunc    func(id string) (*client.LogStreamingDestination, error)
+	SaveLogStreamingDestinationFunc   func(destination *client.LogStreamingDestination) (*client.LogStreamingDestination, error)
+	DeleteLogStreamingDestinationFunc func(id string) error
+
+	// Calls lists the methods called, in order
+	Calls []string
+
+	mu sync.Mutex
+}
+
+// Ensure the mock satisfies the interface.
+var _ client.N8nAPI = &N8nAPI{}
+
+// record adds a method to Calls
+func (m *N8nAPI) record(method string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.Calls = append(m.Calls, method)
+}
+
+// DefaultProjectID calls DefaultProjectIDFunc
+func (m *N8nAPI) DefaultProjectID() string {
+	m.record("DefaultProjectID")
+	if m.DefaultProjectIDFunc == nil {
+		var r0 string
+		return r0
+	}
+	return m.DefaultProjectIDFunc()
+}
+
+// WorkflowNamePattern calls WorkflowNamePatternFunc
+func (m *N8nAPI) WorkflowNamePattern() string {
+	m.record("WorkflowNamePattern")
+	if m.WorkflowNamePatternFunc == nil {
+		var r0 string
+		return r0
+	}
+	return m.WorkflowNamePatternFunc()
+}
+
+// GetInstanceInfo calls GetInstanceInfoFunc
+func (m *N8nAPI) GetInstanceInfo() (*client.InstanceInfo, error) {
+	m.record("GetInstanceInfo")
+	if m.GetInstanceInfoFunc == nil {
+		var r0 *client.InstanceInfo
+		return r0, fmt.Errorf("N8nAPI.GetInstanceInfo is not mocked")
+	}
+	return m.GetInstanceInfoFunc()
+}
+
+// GetInstanceSettings calls GetInstanceSettingsFunc
+func (m *N8nAPI) GetInstanceSettings() (*client.InstanceSettings, error) {
+	m.record("GetInstanceSettings")
+	if m.GetInstanceSettingsFunc == nil {
+		var r0 *client.InstanceSettings
+		return r0, fmt.Errorf("N8nAPI.GetInstanceSettings is not mocked")
+	}
+	return m.GetInstanceSettingsFunc()
+}
+
+// DismissBanner calls DismissBannerFunc
+func (m *N8nAPI) DismissBanner(banner string) error {
+	m.record("DismissBanner")
+	if m.DismissBannerFunc == nil {
+		return fmt.Errorf("N8nAPI.DismissBanner is not mocked")
+	}
+	return m.DismissBannerFunc(banner)
+}
+
+// IsMFAEnforced calls IsMFAEnforcedFunc
+func (m *N8nAPI) IsMFAEnforced() (bool, error) {
+	m.record("IsMFAEnforced")
+	if m.IsMFAEnforcedFunc == nil {
+		var r0 bool
+		return r0, fmt.Errorf("N8nAPI.IsMFAEnforced is not mocked")
+	}
+	return m.IsMFAEnforcedFunc()
+}
+
+// EnforceMFA calls EnforceMFAFunc
+func (m *N8nAPI) EnforceMFA(enforce bool) error {
+	m.record("EnforceMFA")
+	if m.EnforceMFAFunc == nil {
+		return fmt.Errorf("N8nAPI.EnforceMFA is not mocked")
+	}
+	return m.EnforceMFAFunc(enforce)
+}
+
+// IsOwnerSetUp calls IsOwnerSetUpFunc
+func (m *N8nAPI) IsOwnerSetUp() (bool, error) {
+	m.record("IsOwnerSetUp")
+	if m.IsOwnerSetUpFunc == nil {
+		var r0 bool
+		return r0, fmt.Errorf("N8nAPI.IsOwnerSetUp is not mocked")
+	}
+	return m.IsOwnerSetUpFunc()
+}
+
+// SetupOwner calls SetupOwnerFunc
+func (m *N8nAPI) SetupOwner(req *client.OwnerSetupRequest) (*client.User, error) {
+	m.record("SetupOwner")
+	if m.SetupOwnerFunc == nil {
+		var r0 *client.User
+		return r0, fmt.Errorf("N8nAPI.SetupOwner is not mocked")
+	}
+	return m.SetupOwnerFunc(req)
+}
+
+// RunAudit calls RunAuditFunc
+func (m *N8nAPI) RunAudit(options *client.AuditOptions) (*client.Audit, error) {
+	m.record("RunAudit")
+	if m.RunAuditFunc == nil {
+		var r0 *client.Audit
+		return r0, fmt.Errorf("N8nAPI.RunAudit is not mocked")
+	}
+	return m.RunAuditFunc(options)
+}
+
+// GetWorkflow calls GetWorkflowFunc
+func (m *N8nAPI) GetWorkflow(id string) (*client.Workflow, error) {
+	m.record("GetWorkflow")
+	if m.GetWorkflowFunc == nil {
+		var r0 *client.Workflow
+		return r0, fmt.Errorf("N8nAPI.GetWorkflow is not mocked")
+	}
+	return m.GetWorkflowFunc(id)
+}
+
+// ListWorkflows calls ListWorkflowsFunc
+func (m *N8nAPI) ListWorkflows(options *client.WorkflowListOptions, maxItems int) (*client.WorkflowList, error) {
+	m.record("ListWorkflows")
+	if m.ListWorkflowsFunc == nil {
+		var r0 *client.WorkflowList
+		return r0, fmt.Errorf("N8nAPI.ListWorkflows is not mocked")
+	}
+	return m.ListWorkflowsFunc(options, maxItems)
+}
+
+// CreateWorkflow calls CreateWorkflowFunc
+func (m *N8nAPI) CreateWorkflow(workflow *client.Workflow) (*client.Workflow, error) {
+	m.record("CreateWorkflow")
+	if m.CreateWorkflowFunc == nil {
+		var r0 *client.Workflow
+		return r0, fmt.Errorf("N8nAPI.CreateWorkflow is not mocked")
+	}
+	return m.CreateWorkflowFunc(workflow)
+}
+
+// UpdateWorkflow calls UpdateWorkflowFunc
+func (m *N8nAPI) UpdateWorkflow(id string, workflow *client.Workflow) (*client.Workflow, error) {
+	m.record("UpdateWorkflow")
+	if m.UpdateWorkflowFunc == nil {
+		var r0 *client.Workflow
+		return r0, fmt.Errorf("N8nAPI.UpdateWorkflow is not mocked")
+	}
+	return m.UpdateWorkflowFunc(id, workflow)
+}
+
+// PatchWorkflow calls PatchWorkflowFunc
+func (m *N8nAPI) PatchWorkflow(id string, fields map[string]interface{}) (*client.Workflow, error) {
+	m.record("PatchWorkflow")
+	if m.PatchWorkflowFunc == nil {
+		var r0 *client.Workflow
+		return r0, fmt.Errorf("N8nAPI.PatchWorkflow is not mocked")
+	}
+	return m.PatchWorkflowFunc(id, fields)
+}
+
+// DeleteWorkflow calls DeleteWorkflowFunc
+func (m *N8nAPI) DeleteWorkflow(id string) error {
+	m.record("DeleteWorkflow")
+	if m.DeleteWorkflowFunc == nil {
+		return fmt.Errorf("N8nAPI.DeleteWorkflow is not mocked")
+	}
+	return m.DeleteWorkflowFunc(id)
+}
+
+// ActivateWorkflow calls ActivateWorkflowFunc
+func (m *N8nAPI) ActivateWorkflow(id string) (*client.Workflow, error) {
+	m.record("ActivateWorkflow")
+	if m.ActivateWorkflowFunc == nil {
+		var r0 *client.Workflow
+		return r0, fmt.Errorf("N8nAPI.ActivateWorkflow is not mocked")
+	}
+	return m.ActivateWorkflowFunc(id)
+}
+
+// DeactivateWorkflow calls DeactivateWorkflowFunc
+func (m *N8nAPI) DeactivateWorkflow(id string) (*client.Workflow, error) {
+	m.record("DeactivateWorkflow")
+	if m.DeactivateWorkflowFunc == nil {
+		var r0 *client.Workflow
+		return r0, fmt.Errorf("N8nAPI.DeactivateWorkflow is not mocked")
+	}
+	return m.DeactivateWorkflowFunc(id)
+}
+
+// ArchiveWorkflow calls ArchiveWorkflowFunc
+func (m *N8nAPI) ArchiveWorkflow(id string) (*client.Workflow, error) {
+	m.record("ArchiveWorkflow")
+	if m.ArchiveWorkflowFunc == nil {
+		var r0 *client.Workflow
+		return r0, fmt.Errorf("N8nAPI.ArchiveWorkflow is not mocked")
+	}
+	return m.ArchiveWorkflowFunc(id)
+}
+
+// UnarchiveWorkflow calls UnarchiveWorkflowFunc
+func (m *N8nAPI) UnarchiveWorkflow(id string) (*client.Workflow, error) {
+	m.record("UnarchiveWorkflow")
+	if m.UnarchiveWorkflowFunc == nil {
+		var r0 *client.Workflow
+		return r0, fmt.Errorf("N8nAPI.UnarchiveWorkflow is not mocked")
+	}
+	return m.UnarchiveWorkflowFunc(id)
+}
+
+// TransferWorkflow calls TransferWorkflowFunc
+func (m *N8nAPI) TransferWorkflow(id string, projectID string) error {
+	m.record("TransferWorkflow")
+	if m.TransferWorkflowFunc == nil {
+		return fmt.Errorf("N8nAPI.TransferWorkflow is not mocked")
+	}
+	return m.TransferWorkflowFunc(id, projectID)
+}
+
+// MoveWorkflowToFolder calls MoveWorkflowToFolderFunc
+func (m *N8nAPI) MoveWorkflowToFolder(id string, folderID string) error {
+	m.record("MoveWorkflowToFolder")
+	if m.MoveWorkflowToFolderFunc == nil {
+		return fmt.Errorf("N8nAPI.MoveWorkflowToFolder is not mocked")
+	}
+	return m.MoveWorkflowToFolderFunc(id, folderID)
+}
+
+// GetWorkflowVersions calls GetWorkflowVersionsFunc
+func (m *N8nAPI) GetWorkflowVersions(workflowID string) ([]client.WorkflowVersion, error) {
+	m.record("GetWorkflowVersions")
+	if m.GetWorkflowVersionsFunc == nil {
+		var r0 []client.WorkflowVersion
+		return r0, fmt.Errorf("N8nAPI.GetWorkflowVersions is not mocked")
+	}
+	return m.GetWorkflowVersionsFunc(workflowID)
+}
+
+// UpdateWorkflowTags calls UpdateWorkflowTagsFunc
+func (m *N8nAPI) UpdateWorkflowTags(workflowID string, tagIDs []string) ([]client.Tag, error) {
+	m.record("UpdateWorkflowTags")
+	if m.UpdateWorkflowTagsFunc == nil {
+		var r0 []client.Tag
+		return r0, fmt.Errorf("N8nAPI.UpdateWorkflowTags is not mocked")
+	}
+	return m.UpdateWorkflowTagsFunc(workflowID, tagIDs)
+}
+
+// GetAllTags calls GetAllTagsFunc
+func (m *N8nAPI) GetAllTags() ([]client.Tag, error) {
+	m.record("GetAllTags")
+	if m.GetAllTagsFunc == nil {
+		var r0 []client.Tag
+		return r0, fmt.Errorf("N8nAPI.GetAllTags is not mocked")
+	}
+	return m.GetAllTagsFunc()
+}
+
+// CreateTag calls CreateTagFunc
+func (m *N8nAPI) CreateTag(name string) (*client.Tag, error) {
+	m.record("CreateTag")
+	if m.CreateTagFunc == nil {
+		var r0 *client.Tag
+		return r0, fmt.Errorf("N8nAPI.CreateTag is not mocked")
+	}
+	return m.CreateTagFunc(name)
+}
+
+// WorkflowWebhooks calls WorkflowWebhooksFunc
+func (m *N8nAPI) WorkflowWebhooks(workflow *client.Workflow) []client.Webhook {
+	m.record("WorkflowWebhooks")
+	if m.WorkflowWebhooksFunc == nil {
+		var r0 []client.Webhook
+		return r0
+	}
+	return m.WorkflowWebhooksFunc(workflow)
+}
+
+// RunWorkflow calls RunWorkflowFunc
+func (m *N8nAPI) RunWorkflow(id string, payload map[string]interface{}) (string, error) {
+	m.record("RunWorkflow")
+	if m.RunWorkflowFunc == nil {
+		var r0 string
+		return r0, fmt.Errorf("N8nAPI.RunWorkflow is not mocked")
+	}
+	return m.RunWorkflowFunc(id, payload)
+}
+
+// RunWorkflowWithPinData calls RunWorkflowWithPinDataFunc
+func (m *N8nAPI) RunWorkflowWithPinData(id string, pinData map[string]interface{}) (string, error) {
+	m.record("RunWorkflowWithPinData")
+	if m.RunWorkflowWithPinDataFunc == nil {
+		var r0 string
+		return r0, fmt.Errorf("N8nAPI.RunWorkflowWithPinData is not mocked")
+	}
+	return m.RunWorkflowWithPinDataFunc(id, pinData)
+}
+
+// WaitForExecution calls WaitForExecutionFunc
+func (m *N8nAPI) WaitForExecution(id string, timeout time.Duration) (*client.Execution, error) {
+	m.record("WaitForExecution")
+	if m.WaitForExecutionFunc == nil {
+		var r0 *client.Execution
+		return r0, fmt.Errorf("N8nAPI.WaitForExecution is not mocked")
+	}
+	return m.WaitForExecutionFunc(id, timeout)
+}
+
+// DeleteExecutions calls DeleteExecutionsFunc
+func (m *N8nAPI) DeleteExecutions(filter *client.ExecutionDeleteFilter) error {
+	m.record("DeleteExecutions")
+	if m.DeleteExecutionsFunc == nil {
+		return fmt.Errorf("N8nAPI.DeleteExecutions is not mocked")
+	}
+	return m.DeleteExecutionsFunc(filter)
+}
+
+// GetNodeTypes calls GetNodeTypesFunc
+func (m *N8nAPI) GetNodeTypes() ([]client.NodeType, error) {
+	m.record("GetNodeTypes")
+	if m.GetNodeTypesFunc == nil {
+		var r0 []client.NodeType
+		return r0, fmt.Errorf("N8nAPI.GetNodeTypes is not mocked")
+	}
+	return m.GetNodeTypesFunc()
+}
+
+// GetAllVariables calls GetAllVariablesFunc
+func (m *N8nAPI) GetAllVariables() ([]client.Variable, error) {
+	m.record("GetAllVariables")
+	if m.GetAllVariablesFunc == nil {
+		var r0 []client.Variable
+		return r0, fmt.Errorf("N8nAPI.GetAllVariables is not mocked")
+	}
+	return m.GetAllVariablesFunc()
+}
+
+// GetAllCredentials calls GetAllCredentialsFunc
+func (m *N8nAPI) GetAllCredentials(options *client.CredentialListOptions) ([]client.Credential, error) {
+	m.record("GetAllCredentials")
+	if m.GetAllCredentialsFunc == nil {
+		var r0 []client.Credential
+		return r0, fmt.Errorf("N8nAPI.GetAllCredentials is not mocked")
+	}
+	return m.GetAllCredentialsFunc(options)
+}
+
+// GetCredential calls GetCredentialFunc
+func (m *N8nAPI) GetCredential(id string) (*client.Credential, error) {
+	m.record("GetCredential")
+	if m.GetCredentialFunc == nil {
+		var r0 *client.Credential
+		return r0, fmt.Errorf("N8nAPI.GetCredential is not mocked")
+	}
+	return m.GetCredentialFunc(id)
+}
+
+// CreateCredential calls CreateCredentialFunc
+func (m *N8nAPI) CreateCredential(credential *client.Credential) (*client.Credential, error) {
+	m.record("CreateCredential")
+	if m.CreateCredentialFunc == nil {
+		var r0 *client.Credential
+		return r0, fmt.Errorf("N8nAPI.CreateCredential is not mocked")
+	}
+	return m.CreateCredentialFunc(credential)
+}
+
+// PatchCredential calls PatchCredentialFunc
+func (m *N8nAPI) PatchCredential(id string, fields map[string]interface{}) (*client.Credential, error) {
+	m.record("PatchCredential")
+	if m.PatchCredentialFunc == nil {
+		var r0 *client.Credential
+		return r0, fmt.Errorf("N8nAPI.PatchCredential is not mocked")
+	}
+	return m.PatchCredentialFunc(id, fields)
+}
+
+// DeleteCredential calls DeleteCredentialFunc
+func (m *N8nAPI) DeleteCredential(id string) error {
+	m.record("DeleteCredential")
+	if m.DeleteCredentialFunc == nil {
+		return fmt.Errorf("N8nAPI.DeleteCredential is not mocked")
+	}
+	return m.DeleteCredentialFunc(id)
+}
+
+// TransferCredential calls TransferCredentialFunc
+func (m *N8nAPI) TransferCredential(id string, projectID string) error {
+	m.record("TransferCredential")
+	if m.TransferCredentialFunc == nil {
+		return fmt.Errorf("N8nAPI.TransferCredential is not mocked")
+	}
+	return m.TransferCredentialFunc(id, projectID)
+}
+
+// GetCredentialSharing calls GetCredentialSharingFunc
+func (m *N8nAPI) GetCredentialSharing(id string) ([]string, error) {
+	m.record("GetCredentialSharing")
+	if m.GetCredentialSharingFunc == nil {
+		var r0 []string
+		return r0, fmt.Errorf("N8nAPI.GetCredentialSharing is not mocked")
+	}
+	return m.GetCredentialSharingFunc(id)
+}
+
+// ShareCredential calls ShareCredentialFunc
+func (m *N8nAPI) ShareCredential(id string, projectIDs []string) error {
+	m.record("ShareCredential")
+	if m.ShareCredentialFunc == nil {
+		return fmt.Errorf("N8nAPI.ShareCredential is not mocked")
+	}
+	return m.ShareCredentialFunc(id, projectIDs)
+}
+
+// TestCredential calls TestCredentialFunc
+func (m *N8nAPI) TestCredential(credential *client.Credential) (*client.CredentialTestResult, error) {
+	m.record("TestCredential")
+	if m.TestCredentialFunc == nil {
+		var r0 *client.CredentialTestResult
+		return r0, fmt.Errorf("N8nAPI.TestCredential is not mocked")
+	}
+	return m.TestCredentialFunc(credential)
+}
+
+// GetCredentialTypes calls GetCredentialTypesFunc
+func (m *N8nAPI) GetCredentialTypes() ([]client.CredentialType, error) {
+	m.record("GetCredentialTypes")
+	if m.GetCredentialTypesFunc == nil {
+		var r0 []client.CredentialType
+		return r0, fmt.Errorf("N8nAPI.GetCredentialTypes is not mocked")
+	}
+	return m.GetCredentialTypesFunc()
+}
+
+// GetProject calls GetProjectFunc
+func (m *N8nAPI) GetProject(id string) (*client.Project, error) {
+	m.record("GetProject")
+	if m.GetProjectFunc == nil {
+		var r0 *client.Project
+		return r0, fmt.Errorf("N8nAPI.GetProject is not mocked")
+	}
+	return m.GetProjectFunc(id)
+}
+
+// CreateProject calls CreateProjectFunc
+func (m *N8nAPI) CreateProject(project *client.Project) (*client.Project, error) {
+	m.record("CreateProject")
+	if m.CreateProjectFunc == nil {
+		var r0 *client.Project
+		return r0, fmt.Errorf("N8nAPI.CreateProject is not mocked")
+	}
+	return m.CreateProjectFunc(project)
+}
+
+// UpdateProject calls UpdateProjectFunc
+func (m *N8nAPI) UpdateProject(id string, project *client.Project) error {
+	m.record("UpdateProject")
+	if m.UpdateProjectFunc == nil {
+		return fmt.Errorf("N8nAPI.UpdateProject is not mocked")
+	}
+	return m.UpdateProjectFunc(id, project)
+}
+
+// DeleteProject calls DeleteProjectFunc
+func (m *N8nAPI) DeleteProject(id string) error {
+	m.record("DeleteProject")
+	if m.DeleteProjectFunc == nil {
+		return fmt.Errorf("N8nAPI.DeleteProject is not mocked")
+	}
+	return m.DeleteProjectFunc(id)
+}
+
+// DeleteProjectWithContent calls DeleteProjectWithContentFunc
+func (m *N8nAPI) DeleteProjectWithContent(id string, transferToProjectID string) error {
+	m.record("DeleteProjectWithContent")
+	if m.DeleteProjectWithContentFunc == nil {
+		return fmt.Errorf("N8nAPI.DeleteProjectWithContent is not mocked")
+	}
+	return m.DeleteProjectWithContentFunc(id, transferToProjectID)
+}
+
+// GetPersonalProject calls GetPersonalProjectFunc
+func (m *N8nAPI) GetPersonalProject() (*client.Project, error) {
+	m.record("GetPersonalProject")
+	if m.GetPersonalProjectFunc == nil {
+		var r0 *client.Project
+		return r0, fmt.Errorf("N8nAPI.GetPersonalProject is not mocked")
+	}
+	return m.GetPersonalProjectFunc()
+}
+
+// GetProjectUsers calls GetProjectUsersFunc
+func (m *N8nAPI) GetProjectUsers(projectID string) ([]client.ProjectUser, error) {
+	m.record("GetProjectUsers")
+	if m.GetProjectUsersFunc == nil {
+		var r0 []client.ProjectUser
+		return r0, fmt.Errorf("N8nAPI.GetProjectUsers is not mocked")
+	}
+	return m.GetProjectUsersFunc(projectID)
+}
+
+// AddUserToProject calls AddUserToProjectFunc
+func (m *N8nAPI) AddUserToProject(projectUser *client.ProjectUser) (*client.ProjectUser, error) {
+	m.record("AddUserToProject")
+	if m.AddUserToProjectFunc == nil {
+		var r0 *client.ProjectUser
+		return r0, fmt.Errorf("N8nAPI.AddUserToProject is not mocked")
+	}
+	return m.AddUserToProjectFunc(projectUser)
+}
+
+// UpdateProjectUser calls UpdateProjectUserFunc
+func (m *N8nAPI) UpdateProjectUser(projectID string, userID string, projectUser *client.ProjectUser) (*client.ProjectUser, error) {
+	m.record("UpdateProjectUser")
+	if m.UpdateProjectUserFunc == nil {
+		var r0 *client.ProjectUser
+		return r0, fmt.Errorf("N8nAPI.UpdateProjectUser is not mocked")
+	}
+	return m.UpdateProjectUserFunc(projectID, userID, projectUser)
+}
+
+// RemoveUserFromProject calls RemoveUserFromProjectFunc
+func (m *N8nAPI) RemoveUserFromProject(projectID string, userID string) error {
+	m.record("RemoveUserFromProject")
+	if m.RemoveUserFromProjectFunc == nil {
+		return fmt.Errorf("N8nAPI.RemoveUserFromProject is not mocked")
+	}
+	return m.RemoveUserFromProjectFunc(projectID, userID)
+}
+
+// GetFolder calls GetFolderFunc
+func (m *N8nAPI) GetFolder(projectID string, id string) (*client.Folder, error) {
+	m.record("GetFolder")
+	if m.GetFolderFunc == nil {
+		var r0 *client.Folder
+		return r0, fmt.Errorf("N8nAPI.GetFolder is not mocked")
+	}
+	return m.GetFolderFunc(projectID, id)
+}
+
+// CreateFolder calls CreateFolderFunc
+func (m *N8nAPI) CreateFolder(projectID string, name string, parentFolderID string) (*client.Folder, error) {
+	m.record("CreateFolder")
+	if m.CreateFolderFunc == nil {
+		var r0 *client.Folder
+		return r0, fmt.Errorf("N8nAPI.CreateFolder is not mocked")
+	}
+	return m.CreateFolderFunc(projectID, name, parentFolderID)
+}
+
+// UpdateFolder calls UpdateFolderFunc
+func (m *N8nAPI) UpdateFolder(projectID string, id string, name string, parentFolderID string) (*client.Folder, error) {
+	m.record("UpdateFolder")
+	if m.UpdateFolderFunc == nil {
+		var r0 *client.Folder
+		return r0, fmt.Errorf("N8nAPI.UpdateFolder is not mocked")
+	}
+	return m.UpdateFolderFunc(projectID, id, name, parentFolderID)
+}
+
+// DeleteFolder calls DeleteFolderFunc
+func (m *N8nAPI) DeleteFolder(projectID string, id string) error {
+	m.record("DeleteFolder")
+	if m.DeleteFolderFunc == nil {
+		return fmt.Errorf("N8nAPI.DeleteFolder is not mocked")
+	}
+	return m.DeleteFolderFunc(projectID, id)
+}
+
+// GetUser calls GetUserFunc
+func (m *N8nAPI) GetUser(id string) (*client.User, error) {
+	m.record("GetUser")
+	if m.GetUserFunc == nil {
+		var r0 *client.User
+		return r0, fmt.Errorf("N8nAPI.GetUser is not mocked")
+	}
+	return m.GetUserFunc(id)
+}
+
+// GetAllUsers calls GetAllUsersFunc
+func (m *N8nAPI) GetAllUsers(options *client.UserListOptions) ([]client.User, error) {
+	m.record("GetAllUsers")
+	if m.GetAllUsersFunc == nil {
+		var r0 []client.User
+		return r0, fmt.Errorf("N8nAPI.GetAllUsers is not mocked")
+	}
+	return m.GetAllUsersFunc(options)
+}
+
+// CreateUser calls CreateUserFunc
+func (m *N8nAPI) CreateUser(userReq *client.CreateUserRequest) (*client.User, error) {
+	m.record("CreateUser")
+	if m.CreateUserFunc == nil {
+		var r0 *client.User
+		return r0, fmt.Errorf("N8nAPI.CreateUser is not mocked")
+	}
+	return m.CreateUserFunc(userReq)
+}
+
+// CreateUsers calls CreateUsersFunc
+func (m *N8nAPI) CreateUsers(userReqs []*client.CreateUserRequest) ([]client.CreateUserResult, error) {
+	m.record("CreateUsers")
+	if m.CreateUsersFunc == nil {
+		var r0 []client.CreateUserResult
+		return r0, fmt.Errorf("N8nAPI.CreateUsers is not mocked")
+	}
+	return m.CreateUsersFunc(userReqs)
+}
+
+// UpdateUser calls UpdateUserFunc
+func (m *N8nAPI) UpdateUser(id string, user *client.User) (*client.User, error) {
+	m.record("UpdateUser")
+	if m.UpdateUserFunc == nil {
+		var r0 *client.User
+		return r0, fmt.Errorf("N8nAPI.UpdateUser is not mocked")
+	}
+	return m.UpdateUserFunc(id, user)
+}
+
+// ChangeUserRole calls ChangeUserRoleFunc
+func (m *N8nAPI) ChangeUserRole(id string, role string) error {
+	m.record("ChangeUserRole")
+	if m.ChangeUserRoleFunc == nil {
+		return fmt.Errorf("N8nAPI.ChangeUserRole is not mocked")
+	}
+	return m.ChangeUserRoleFunc(id, role)
+}
+
+// SetUserPassword calls SetUserPasswordFunc
+func (m *N8nAPI) SetUserPassword(id string, password string) error {
+	m.record("SetUserPassword")
+	if m.SetUserPasswordFunc == nil {
+		return fmt.Errorf("N8nAPI.SetUserPassword is not mocked")
+	}
+	return m.SetUserPasswordFunc(id, password)
+}
+
+// DeleteUser calls DeleteUserFunc
+func (m *N8nAPI) DeleteUser(id string) error {
+	m.record("DeleteUser")
+	if m.DeleteUserFunc == nil {
+		return fmt.Errorf("N8nAPI.DeleteUser is not mocked")
+	}
+	return m.DeleteUserFunc(id)
+}
+
+// DeleteUserWithTransfer calls DeleteUserWithTransferFunc
+func (m *N8nAPI) DeleteUserWithTransfer(id string, transferToProjectID string) error {
+	m.record("DeleteUserWithTransfer")
+	if m.DeleteUserWithTransferFunc == nil {
+		return fmt.Errorf("N8nAPI.DeleteUserWithTransfer is not mocked")
+	}
+	return m.DeleteUserWithTransferFunc(id, transferToProjectID)
+}
+
+// GetGlobalRoles calls GetGlobalRolesFunc
+func (m *N8nAPI) GetGlobalRoles() ([]string, error) {
+	m.record("GetGlobalRoles")
+	if m.GetGlobalRolesFunc == nil {
+		var r0 []string
+		return r0, fmt.Errorf("N8nAPI.GetGlobalRoles is not mocked")
+	}
+	return m.GetGlobalRolesFunc()
+}
+
+// GetLDAPConfig calls GetLDAPConfigFunc
+func (m *N8nAPI) GetLDAPConfig() (*client.LDAPConfig, error) {
+	m.record("GetLDAPConfig")
+	if m.GetLDAPConfigFunc == nil {
+		var r0 *client.LDAPConfig
+		return r0, fmt.Errorf("N8nAPI.GetLDAPConfig is not mocked")
+	}
+	return m.GetLDAPConfigFunc()
+}
+
+// UpdateLDAPConfig calls UpdateLDAPConfigFunc
+func (m *N8nAPI) UpdateLDAPConfig(config *client.LDAPConfig) (*client.LDAPConfig, error) {
+	m.record("UpdateLDAPConfig")
+	if m.UpdateLDAPConfigFunc == nil {
+		var r0 *client.LDAPConfig
+		return r0, fmt.Errorf("N8nAPI.UpdateLDAPConfig is not mocked")
+	}
+	return m.UpdateLDAPConfigFunc(config)
+}
+
+// SyncLDAP calls SyncLDAPFunc
+func (m *N8nAPI) SyncLDAP(runMode string) error {
+	m.record("SyncLDAP")
+	if m.SyncLDAPFunc == nil {
+		return fmt.Errorf("N8nAPI.SyncLDAP is not mocked")
+	}
+	return m.SyncLDAPFunc(runMode)
+}
+
+// GetLastLDAPSyncRun calls GetLastLDAPSyncRunFunc
+func (m *N8nAPI) GetLastLDAPSyncRun() (*client.LDAPSyncRun, error) {
+	m.record("GetLastLDAPSyncRun")
+	if m.GetLastLDAPSyncRunFunc == nil {
+		var r0 *client.LDAPSyncRun
+		return r0, fmt.Errorf("N8nAPI.GetLastLDAPSyncRun is not mocked")
+	}
+	return m.GetLastLDAPSyncRunFunc()
+}
+
+// GetLogStreamingDestination calls GetLogStreamingDestinationFunc
+func (m *N8nAPI) GetLogStreamingDestination(id string) (*client.LogStreamingDestination, error) {
+	m.record("GetLogStreamingDestination")
+	if m.GetLogStreamingDestinationFunc == nil {
+		var r0 *client.LogStreamingDestination
+		return r0, fmt.Errorf("N8nAPI.GetLogStreamingDestination is not mocked")
+	}
+	return m.GetLogStreamingDestinationFunc(id)
+}
+
+// SaveLogStreamingDestination calls SaveLogStreamingDestinationFunc
+func (m *N8nAPI) SaveLogStreamingDestination(destination *client.LogStreamingDestination) (*client.LogStreamingDestination, error) {
+	m.record("SaveLogStreamingDestination")
+	if m.SaveLogStreamingDestinationFunc == nil {
+		var r0 *client.LogStreamingDestination
+		return r0, fmt.Errorf("N8nAPI.SaveLogStreamingDestination is not mocked")
+	}
+	return m.SaveLogStreamingDestinationFunc(destination)
+}
+
+// DeleteLogStreamingDestination calls DeleteLogStreamingDestinationFunc
+func (m *N8nAPI) DeleteLogStreamingDestination(id string) error {
+	m.record("DeleteLogStreamingDestination")
+	if m.DeleteLogStreamingDestinationFunc == nil {
+		return fmt.Errorf("N8nAPI.DeleteLogStreamingDestination is not mocked")
+	}
+	return m.DeleteLogStreamingDestinationFunc(id)
+}
@@ -9,14 +9,22 @@ import (
 
 // Credential represents an n8n credential
 type Credential struct {
-	ID         string                 `json:"id,omitempty"`
-	Name       string                 `json:"name"`
-	Type       string                 `json:"type"`
-	Data       map[string]interface{} `json:"data"`
-	SharedWith []string               `json:"sharedWith,omitempty"`
-	ProjectID  string                 `json:"projectId,omitempty"`
-	CreatedAt  *time.Time             `json:"createdAt,omitempty"`
-	UpdatedAt  *time.Time             `json:"updatedAt,omitempty"`
+	ID                 string                 `json:"id,omitempty"`
+	Name               string                 `json:"name"`
+	Type               string                 `json:"type"`
+	Data               map[string]interface{} `json:"data"`
+	ProjectID          string                 `json:"projectId,omitempty"`
+	SharedWithProjects []SharedProject        `json:"sharedWithProjects,omitempty"`
+	CreatedAt          *time.Time             `json:"createdAt,omitempty"`
+	UpdatedAt          *time.Time             `json:"updatedAt,omitempty"`
+}
+
+// SharedProject identifies a project a credential is shared with. Only the internal API reports the
+// projects a credential is shared with.
+type SharedProject struct {
+	ID   string `json:"id"`
+	Name string `json:"name,omitempty"`
+	Type string `json:"type,omitempty"`
 }
 
 // CredentialListOptions represents options for listing credentials
@@ -77,9 +85,13 @@ func (c *Client) GetCredential(id string) (*Credential, error) {
 		return nil, fmt.Errorf("credential ID is required")
 	}
 
+	var credential Credential
+	if c.getListed("credentials", id, &credential) {
+		return &credential, nil
+	}
+
 	// Try direct endpoint first
 	path := fmt.Sprintf("credentials/%s", id)
-	var credential Credential
 	err := c.Get(path, &credential)
 	if err == nil {
 		return &credential, nil
@@ -117,8 +129,20 @@ func (c *Client) CreateCredential(credential *Credential) (*Credential, error) {
 		return nil, fmt.Errorf("credential type is required")
 	}
 
+	// A retried create adopts the credential an earlier attempt created instead of creating it again
+	started := time.Now()
+	check := func() ([]byte, bool, error) {
+		credentials, err := ListAll[Credential](c, "credentials", nil, 0)
+		if err != nil {
+			return nil, false, err
+		}
+		return findCreated(credentials, started,
+			func(cred Credential) bool { return cred.Name == credential.Name && cred.Type == credential.Type },
+			func(cred Credential) *time.Time { return cred.CreatedAt })
+	}
+
 	var result Credential
-	err := c.Post("credentials", credential, &result)
+	err := c.create("credentials", credential, &result, check)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create credential: %w", err)
 	}
@@ -147,6 +171,23 @@ func (c *Client) UpdateCredential(id string, credential *Credential) (*Credentia
 	return &result, nil
 }
 
+// PatchCredential updates only the given fields of a credential, keyed by their JSON name, so that the
+// credential data is only sent when it changes
+func (c *Client) PatchCredential(id string, fields map[string]interface{}) (*Credential, error) {
+	if id == "" {
+		return nil, fmt.Errorf("credential ID is required")
+	}
+
+	path := fmt.Sprintf("credentials/%s", id)
+
+	var result Credential
+	if err := c.Patch(path, fields, &result); err != nil {
+		return nil, fmt.Errorf("failed to update credential %s: %w", id, err)
+	}
+
+	return &result, nil
+}
+
 // DeleteCredential deletes a credential
 func (c *Client) DeleteCredential(id string) error {
 	if id == "" {
@@ -162,3 +203,132 @@ func (c *Client) DeleteCredential(id string) error {
 
 	return nil
 }
+
+// TransferCredential moves a credential to another project
+func (c *Client) TransferCredential(id, projectID string) error {
+	if id == "" {
+		return fmt.Errorf("credential ID is required")
+	}
+
+	if projectID == "" {
+		return fmt.Errorf("destination project ID is required")
+	}
+
+	path := fmt.Sprintf("credentials/%s/transfer", id)
+
+	err := c.Put(path, &transferRequest{DestinationProjectID: projectID}, nil)
+	if err != nil {
+		return fmt.Errorf("failed to transfer credential %s to project %s: %w", id, projectID, err)
+	}
+
+	return nil
+}
+
+// credentialShareRequest is the body of a credential share request
+type credentialShareRequest struct {
+	ShareWithIDs []string `json:"shareWithIds"`
+}
+
+// GetCredentialSharing returns the IDs of the projects a credential is shared with, other than the project
+// that owns it. Requires session authentication.
+func (c *Client) GetCredentialSharing(id string) ([]string, error) {
+	if id == "" {
+		return nil, fmt.Errorf("credential ID is required")
+	}
+
+	var result Credential
+	if err := c.doInternalRequest("GET", fmt.Sprintf("credentials/%s", id), nil, &result); err != nil {
+		return nil, fmt.Errorf("failed to get sharing of credential %s: %w", id, err)
+	}
+
+	projectIDs := make([]string, len(result.SharedWithProjects))
+	for i, project := range result.SharedWithProjects {
+		projectIDs[i] = project.ID
+	}
+	return projectIDs, nil
+}
+
+// ShareCredential shares a credential with exactly the given projects, so that workflows in them can use
+// it. Projects that are not listed lose access. Requires session authentication.
+func (c *Client) ShareCredential(id string, projectIDs []string) error {
+	if id == "" {
+		return fmt.Errorf("credential ID is required")
+	}
+
+	body := &credentialShareRequest{ShareWithIDs: append([]string{}, projectIDs...)}
+	if err := c.doInternalRequest("PUT", fmt.Sprintf("credentials/%s/share", id), body, nil); err != nil {
+		return fmt.Errorf("failed to share credential %s: %w", id, err)
+	}
+
+	return nil
+}
+
+// CredentialTestResult is the outcome of testing a credential against the service it authenticates with
+type CredentialTestResult struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+// credentialTestStatusOK is the status of a credential that authenticated successfully
+const credentialTestStatusOK = "OK"
+
+// credentialNotTestableMessage is the message n8n returns for credential types it cannot test
+const credentialNotTestableMessage = "No testing function found for this credential."
+
+// OK reports whether the service accepted the credential
+func (r *CredentialTestResult) OK() bool {
+	return r.Status == credentialTestStatusOK
+}
+
+// NotTestable reports whether n8n has no way to test credentials of this type
+func (r *CredentialTestResult) NotTestable() bool {
+	return r.Status != credentialTestStatusOK && r.Message == credentialNotTestableMessage
+}
+
+// credentialTestRequest is the body of a credential test request
+type credentialTestRequest struct {
+	Credentials *Credential `json:"credentials"`
+}
+
+// TestCredential tests a stored credential against the service it authenticates with, using the data of
+// credential. Failed authentication is reported in the result, not as an error. Requires session
+// authentication.
+func (c *Client) TestCredential(credential *Credential) (*CredentialTestResult, error) {
+	if credential == nil || credential.ID == "" {
+		return nil, fmt.Errorf("credential ID is required")
+	}
+
+	body := &credentialTestRequest{Credentials: &Credential{
+		ID:   credential.ID,
+		Name: credential.Name,
+		Type: credential.Type,
+		Data: credential.Data,
+	}}
+
+	var result CredentialTestResult
+	if err := c.doInternalRequest("POST", "credentials/test", body, &result); err != nil {
+		return nil, fmt.Errorf("failed to test credential %s: %w", credential.ID, err)
+	}
+
+	return &result, nil
+}
+
+// CredentialType describes a credential type installed on the n8n instance, including those of community nodes
+type CredentialType struct {
+	Name             string                   `json:"name"`
+	DisplayName      string                   `json:"displayName"`
+	Extends          []string                 `json:"extends,omitempty"`
+	DocumentationURL string                   `json:"documentationUrl,omitempty"`
+	Properties       []map[string]interface{} `json:"properties"`
+}
+
+// GetCredentialTypes retrieves the credential types installed on the instance. They are read from the type
+// definitions the editor loads, which require session authentication.
+func (c *Client) GetCredentialTypes() ([]CredentialType, error) {
+	var credentialTypes []CredentialType
+	if err := c.getTypeDefinitions("credentials", &credentialTypes); err != nil {
+		return nil, fmt.Errorf("failed to get credential types: %w", err)
+	}
+
+	return credentialTypes, nil
+}
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"reflect"
 	"testing"
 	"time"
 )
@@ -290,3 +291,157 @@ func TestClient_DeleteCredential(t *testing.T) {
 		t.Errorf("DeleteCredential() error = %v", err)
 	}
 }
+
+func TestClient_TransferCredential(t *testing.T) {
+	server := TestServer(TransferTestHandler(t, "/api/v1/credentials/test-id/transfer", "project-1"))
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	if err := client.TransferCredential("test-id", "project-1"); err != nil {
+		t.Errorf("TransferCredential() error = %v", err)
+	}
+
+	if err := client.TransferCredential("test-id", ""); err == nil {
+		t.Error("Expected error for missing project ID")
+	}
+}
+
+func TestClient_GetCredentialSharing(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" || r.URL.Path != "/rest/credentials/cred-1" {
+			t.Errorf("Expected GET /rest/credentials/cred-1, got %s %s", r.Method, r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": {"id": "cred-1", "name": "OpenAI", "type": "openAiApi", ` +
+			`"sharedWithProjects": [{"id": "project-2", "name": "Marketing", "type": "team"}, {"id": "project-3"}]}}`))
+	}))
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	projectIDs, err := client.GetCredentialSharing("cred-1")
+	if err != nil {
+		t.Fatalf("GetCredentialSharing() error = %v", err)
+	}
+	if !reflect.DeepEqual(projectIDs, []string{"project-2", "project-3"}) {
+		t.Errorf("Expected the projects the credential is shared with, got %v", projectIDs)
+	}
+
+	if _, err := client.GetCredentialSharing(""); err == nil {
+		t.Error("Expected error for missing credential ID")
+	}
+}
+
+func TestClient_ShareCredential(t *testing.T) {
+	var body map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" || r.URL.Path != "/rest/credentials/cred-1/share" {
+			t.Errorf("Expected PUT /rest/credentials/cred-1/share, got %s %s", r.Method, r.URL.Path)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Failed to decode request body: %v", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": {}}`))
+	}))
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	if err := client.ShareCredential("cred-1", []string{"project-2"}); err != nil {
+		t.Fatalf("ShareCredential() error = %v", err)
+	}
+	if !reflect.DeepEqual(body, map[string]interface{}{"shareWithIds": []interface{}{"project-2"}}) {
+		t.Errorf("Expected the project IDs to be sent, got %v", body)
+	}
+
+	// Unsharing sends an empty list rather than null
+	if err := client.ShareCredential("cred-1", nil); err != nil {
+		t.Fatalf("ShareCredential() error = %v", err)
+	}
+	if !reflect.DeepEqual(body, map[string]interface{}{"shareWithIds": []interface{}{}}) {
+		t.Errorf("Expected an empty list of project IDs, got %v", body)
+	}
+
+	if err := client.ShareCredential("", nil); err == nil {
+		t.Error("Expected error for missing credential ID")
+	}
+}
+
+func TestClient_TestCredential(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/rest/credentials/test" {
+			t.Errorf("Expected POST /rest/credentials/test, got %s %s", r.Method, r.URL.Path)
+		}
+
+		var body credentialTestRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Failed to decode request body: %v", err)
+		}
+		if body.Credentials.ID != "cred-1" || body.Credentials.Data["apiKey"] != "secret" {
+			t.Errorf("Expected the credential with its data, got %+v", body.Credentials)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": {"status": "Error", "message": "Authorization failed"}}`))
+	}))
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	result, err := client.TestCredential(&Credential{ID: "cred-1", Type: "openAiApi", Data: map[string]interface{}{"apiKey": "secret"}})
+	if err != nil {
+		t.Fatalf("TestCredential() error = %v", err)
+	}
+	if result.OK() || result.NotTestable() || result.Message != "Authorization failed" {
+		t.Errorf("Expected failed authentication, got %+v", result)
+	}
+
+	if _, err := client.TestCredential(&Credential{Type: "openAiApi"}); err == nil {
+		t.Error("Expected error for missing credential ID")
+	}
+}
+
+func TestCredentialTestResult(t *testing.T) {
+	if !(&CredentialTestResult{Status: "OK"}).OK() {
+		t.Error("Expected status OK to be accepted")
+	}
+	if !(&CredentialTestResult{Status: "Error", Message: credentialNotTestableMessage}).NotTestable() {
+		t.Error("Expected the missing testing function to be reported as not testable")
+	}
+}
+
+func TestClient_GetCredentialTypes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/types/credentials.json" {
+			t.Errorf("Expected path /types/credentials.json, got %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[
+			{"name": "slackApi", "displayName": "Slack API", "properties": [{"name": "accessToken", "type": "string"}]},
+			{"name": "githubOAuth2Api", "displayName": "GitHub OAuth2 API", "extends": ["oAuth2Api"], "properties": []}
+		]`))
+	}))
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	credentialTypes, err := client.GetCredentialTypes()
+	if err != nil {
+		t.Fatalf("GetCredentialTypes failed: %v", err)
+	}
+
+	if len(credentialTypes) != 2 {
+		t.Fatalf("Expected 2 credential types, got %d", len(credentialTypes))
+	}
+	if credentialTypes[0].Name != "slackApi" || credentialTypes[0].Properties[0]["name"] != "accessToken" {
+		t.Errorf("Unexpected credential type: %+v", credentialTypes[0])
+	}
+	if len(credentialTypes[1].Extends) != 1 || credentialTypes[1].Extends[0] != "oAuth2Api" {
+		t.Errorf("Expected githubOAuth2Api to extend oAuth2Api, got %v", credentialTypes[1].Extends)
+	}
+}
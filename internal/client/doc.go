@@ -0,0 +1,19 @@
+// Package client is a client for the n8n API, used by the provider and reusable by other tooling.
+//
+// A client is created with NewClient from a Config, which sets the instance URL, the authentication and
+// optional behaviour such as caching, retries and the API surface requests are sent to. Every request gets
+// the same authentication, retries, circuit breaker and logging.
+//
+// The endpoints the provider manages have typed methods, e.g. GetWorkflow or CreateCredential. The
+// workflow, credential, user and project methods are also grouped into services:
+//
+//	workflows, err := c.Workflows().List(&client.WorkflowListOptions{ProjectID: projectID})
+//
+// Endpoints without a typed method can be called with Request, which decodes the response into any value:
+//
+//	var tags []client.Tag
+//	err := c.Request(ctx, http.MethodGet, "workflows/"+id+"/tags", nil, &tags)
+//
+// The provider's resources and data sources depend on the N8nAPI interface instead of *Client, so that
+// they can be tested against the mock in the clientmock package.
+package client
@@ -503,6 +503,28 @@ func TestClient_RetryExhaustionDetailed(t *testing.T) {
 	}
 }
 
+func TestIsNotFound(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "not found", err: &APIError{Code: 404, Message: "Not Found"}, want: true},
+		{name: "wrapped not found", err: fmt.Errorf("failed to get workflow: %w", &APIError{Code: 404}), want: true},
+		{name: "other status", err: &APIError{Code: 500, Message: "Internal Server Error"}, want: false},
+		{name: "non-API error", err: fmt.Errorf("connection refused"), want: false},
+		{name: "nil error", err: nil, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsNotFound(tt.err); got != tt.want {
+				t.Errorf("IsNotFound() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestClient_PartialRetrySuccess(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping partial retry success test in short mode")
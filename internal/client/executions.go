@@ -0,0 +1,233 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Execution statuses reported by n8n
+const (
+	ExecutionStatusSuccess  = "success"
+	ExecutionStatusError    = "error"
+	ExecutionStatusCrashed  = "crashed"
+	ExecutionStatusCanceled = "canceled"
+	ExecutionStatusRunning  = "running"
+	ExecutionStatusWaiting  = "waiting"
+)
+
+// IsDone reports whether the execution has stopped, successfully or not
+func (e *Execution) IsDone() bool {
+	switch e.Status {
+	case ExecutionStatusSuccess, ExecutionStatusError, ExecutionStatusCrashed, ExecutionStatusCanceled:
+		return true
+	case "":
+		// Older n8n versions only report whether the execution finished
+		return e.Finished || e.StoppedAt != nil
+	default:
+		return false
+	}
+}
+
+// runWorkflowRequest represents the request body for running a workflow manually
+type runWorkflowRequest struct {
+	WorkflowData *Workflow `json:"workflowData"`
+}
+
+// runWorkflowResponse represents the response to a manual workflow run
+type runWorkflowResponse struct {
+	ExecutionID json.Number `json:"executionId"`
+}
+
+// ExecutionDeleteFilter selects the executions to delete. Either IDs or DeleteBefore must be set;
+// WorkflowID and Status narrow down a DeleteBefore selection.
+type ExecutionDeleteFilter struct {
+	IDs          []string
+	DeleteBefore *time.Time
+	WorkflowID   string
+	Status       []string
+}
+
+// deleteExecutionsFilters represents the filters of a bulk execution deletion
+type deleteExecutionsFilters struct {
+	WorkflowID string   `json:"workflowId,omitempty"`
+	Status     []string `json:"status,omitempty"`
+}
+
+// deleteExecutionsRequest represents the request body for deleting executions in bulk
+type deleteExecutionsRequest struct {
+	IDs          []string                 `json:"ids,omitempty"`
+	DeleteBefore *time.Time               `json:"deleteBefore,omitempty"`
+	Filters      *deleteExecutionsFilters `json:"filters,omitempty"`
+}
+
+// executionPollInterval is how often WaitForExecution checks the status of an execution
+var executionPollInterval = 2 * time.Second
+
+// RunWorkflow starts a manual execution of a workflow and returns the execution ID. If payload is
+// set, it is passed to the workflow as the output of its trigger node. Manual runs are served by the
+// internal REST API, so this requires session authentication.
+func (c *Client) RunWorkflow(id string, payload map[string]interface{}) (string, error) {
+	if id == "" {
+		return "", fmt.Errorf("workflow ID is required")
+	}
+
+	workflow, err := c.GetWorkflow(id)
+	if err != nil {
+		return "", err
+	}
+
+	if payload != nil {
+		trigger, err := workflowTriggerNode(workflow)
+		if err != nil {
+			return "", fmt.Errorf("unable to pass the payload to workflow %s: %w", id, err)
+		}
+		if workflow.PinnedData == nil {
+			workflow.PinnedData = make(map[string]interface{})
+		}
+		workflow.PinnedData[trigger] = []interface{}{map[string]interface{}{"json": payload}}
+	}
+
+	return c.runWorkflow(id, workflow)
+}
+
+// RunWorkflowWithPinData starts a manual execution of a workflow with pinData, the output of nodes keyed
+// by node name, pinned in addition to the pinned data of the workflow. It returns the execution ID.
+// Requires session authentication.
+func (c *Client) RunWorkflowWithPinData(id string, pinData map[string]interface{}) (string, error) {
+	if id == "" {
+		return "", fmt.Errorf("workflow ID is required")
+	}
+
+	workflow, err := c.GetWorkflow(id)
+	if err != nil {
+		return "", err
+	}
+
+	if len(pinData) > 0 && workflow.PinnedData == nil {
+		workflow.PinnedData = make(map[string]interface{}, len(pinData))
+	}
+	for node, data := range pinData {
+		workflow.PinnedData[node] = data
+	}
+
+	return c.runWorkflow(id, workflow)
+}
+
+// runWorkflow starts a manual execution of workflow as given and returns the execution ID
+func (c *Client) runWorkflow(id string, workflow *Workflow) (string, error) {
+	var result runWorkflowResponse
+	path := fmt.Sprintf("workflows/%s/run", url.PathEscape(id))
+	if err := c.doInternalRequest("POST", path, &runWorkflowRequest{WorkflowData: workflow}, &result); err != nil {
+		return "", fmt.Errorf("failed to run workflow %s: %w", id, err)
+	}
+
+	if result.ExecutionID == "" {
+		return "", fmt.Errorf("failed to run workflow %s: n8n did not start an execution", id)
+	}
+
+	return result.ExecutionID.String(), nil
+}
+
+// WaitForExecution polls an execution until it has stopped or the timeout expires. On timeout the last
+// known state of the execution is returned together with an error.
+func (c *Client) WaitForExecution(id string, timeout time.Duration) (*Execution, error) {
+	deadline := time.Now().Add(timeout)
+
+	for {
+		// The execution changes while it runs, so each poll has to bypass the response cache
+		c.evictCached(fmt.Sprintf("executions/%s", url.PathEscape(id)))
+
+		execution, err := c.GetExecution(id, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		if execution.IsDone() {
+			return execution, nil
+		}
+
+		if time.Now().Add(executionPollInterval).After(deadline) {
+			return execution, fmt.Errorf("execution %s did not finish within %s", id, timeout)
+		}
+
+		time.Sleep(executionPollInterval)
+	}
+}
+
+// workflowTriggerNode returns the name of the node that n8n starts manual executions from: the enabled
+// trigger node of the workflow or, if it has none, the enabled node without incoming connections. Sticky
+// notes are never connected and are skipped. It fails if there is no such node or more than one.
+func workflowTriggerNode(workflow *Workflow) (string, error) {
+	nodes, err := ParseNodes(workflow.Nodes)
+	if err != nil {
+		return "", err
+	}
+	connections, err := ParseConnections(workflow.Connections)
+	if err != nil {
+		return "", err
+	}
+
+	targets := make(map[string]bool)
+	for _, connectionTypes := range connections {
+		for _, outputs := range connectionTypes {
+			for _, output := range outputs {
+				for _, target := range output {
+					targets[target.Node] = true
+				}
+			}
+		}
+	}
+
+	var triggers, starts []string
+	for _, node := range nodes {
+		if node.Name == "" || node.Disabled || node.Type == stickyNoteNodeType {
+			continue
+		}
+		if IsTriggerNodeType(node.Type) {
+			triggers = append(triggers, node.Name)
+		} else if !targets[node.Name] {
+			starts = append(starts, node.Name)
+		}
+	}
+
+	candidates := triggers
+	if len(candidates) == 0 {
+		candidates = starts
+	}
+	switch len(candidates) {
+	case 0:
+		return "", fmt.Errorf("the workflow has no enabled trigger node")
+	case 1:
+		return candidates[0], nil
+	default:
+		return "", fmt.Errorf("the workflow has several trigger nodes: %s", strings.Join(candidates, ", "))
+	}
+}
+
+// stickyNoteNodeType is the type of the notes placed on the canvas, which are nodes that never run
+const stickyNoteNodeType = "n8n-nodes-base.stickyNote"
+
+// DeleteExecutions deletes the executions selected by filter in a single request. Bulk deletion is
+// served by the internal REST API, so this requires session authentication.
+func (c *Client) DeleteExecutions(filter *ExecutionDeleteFilter) error {
+	if filter == nil || (len(filter.IDs) == 0 && filter.DeleteBefore == nil) {
+		return fmt.Errorf("execution IDs or a deleteBefore date are required")
+	}
+
+	body := &deleteExecutionsRequest{IDs: filter.IDs}
+	if len(filter.IDs) == 0 {
+		body.DeleteBefore = filter.DeleteBefore
+		if filter.WorkflowID != "" || len(filter.Status) > 0 {
+			body.Filters = &deleteExecutionsFilters{WorkflowID: filter.WorkflowID, Status: filter.Status}
+		}
+	}
+
+	if err := c.doInternalRequest("POST", "executions/delete", body, nil); err != nil {
+		return fmt.Errorf("failed to delete executions: %w", err)
+	}
+
+	return nil
+}
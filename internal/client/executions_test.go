@@ -0,0 +1,316 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClient_RunWorkflow(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/api/v1/workflows/wf-1":
+			_, _ = w.Write([]byte(`{
+				"id": "wf-1",
+				"name": "Test",
+				"nodes": [{"name": "Set"}, {"name": "Webhook"}],
+				"connections": {"Webhook": {"main": [[{"node": "Set", "type": "main", "index": 0}]]}}
+			}`))
+		case "/rest/workflows/wf-1/run":
+			if r.Method != "POST" {
+				t.Errorf("Expected POST request, got %s", r.Method)
+			}
+
+			var body struct {
+				WorkflowData map[string]interface{} `json:"workflowData"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("Failed to decode request body: %v", err)
+			}
+			pinData, _ := body.WorkflowData["pinData"].(map[string]interface{})
+			pinned, ok := pinData["Webhook"].([]interface{})
+			if !ok || len(pinned) != 1 {
+				t.Errorf("Expected the payload to be pinned to the Webhook node, got %v", body.WorkflowData)
+			}
+
+			_, _ = w.Write([]byte(`{"data": {"executionId": "42"}}`))
+		default:
+			t.Errorf("Unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	id, err := client.RunWorkflow("wf-1", map[string]interface{}{"key": "value"})
+	if err != nil {
+		t.Fatalf("RunWorkflow() error = %v", err)
+	}
+	if id != "42" {
+		t.Errorf("Expected execution ID 42, got %s", id)
+	}
+}
+
+func TestWorkflowTriggerNode(t *testing.T) {
+	tests := []struct {
+		name    string
+		nodes   string
+		want    string
+		wantErr string
+	}{
+		{
+			name: "sticky note before the trigger",
+			nodes: `[{"name": "Note", "type": "n8n-nodes-base.stickyNote"},
+				{"name": "Set", "type": "n8n-nodes-base.set"},
+				{"name": "Webhook", "type": "n8n-nodes-base.webhook"}]`,
+			want: "Webhook",
+		},
+		{
+			name: "disabled trigger",
+			nodes: `[{"name": "Old", "type": "n8n-nodes-base.scheduleTrigger", "disabled": true},
+				{"name": "Start", "type": "n8n-nodes-base.manualTrigger"},
+				{"name": "Set", "type": "n8n-nodes-base.set"}]`,
+			want: "Start",
+		},
+		{
+			name:  "no trigger type",
+			nodes: `[{"name": "Note", "type": "n8n-nodes-base.stickyNote"}, {"name": "Start", "type": "n8n-nodes-base.noOp"}]`,
+			want:  "Start",
+		},
+		{
+			name: "several triggers",
+			nodes: `[{"name": "Schedule", "type": "n8n-nodes-base.scheduleTrigger"},
+				{"name": "Webhook", "type": "n8n-nodes-base.webhook"}]`,
+			wantErr: "several trigger nodes: Schedule, Webhook",
+		},
+		{
+			name:    "only notes",
+			nodes:   `[{"name": "Note", "type": "n8n-nodes-base.stickyNote"}]`,
+			wantErr: "no enabled trigger node",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var workflow Workflow
+			if err := json.Unmarshal([]byte(`{"nodes": `+tt.nodes+`,
+				"connections": {"Webhook": {"main": [[{"node": "Set", "type": "main", "index": 0}]]},
+					"Start": {"main": [[{"node": "Set", "type": "main", "index": 0}]]}}}`), &workflow); err != nil {
+				t.Fatalf("Failed to decode workflow: %v", err)
+			}
+
+			got, err := workflowTriggerNode(&workflow)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("Expected an error containing %q, got %q (%v)", tt.wantErr, got, err)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("Expected %s to be the trigger node, got %q (%v)", tt.want, got, err)
+			}
+		})
+	}
+}
+
+func TestClient_RunWorkflowWithPinData(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/api/v1/workflows/wf-1":
+			_, _ = w.Write([]byte(`{
+				"id": "wf-1",
+				"name": "Test",
+				"nodes": [{"name": "Webhook"}, {"name": "Lookup"}],
+				"pinData": {"Webhook": [{"json": {"id": 1}}]}
+			}`))
+		case "/rest/workflows/wf-1/run":
+			var body struct {
+				WorkflowData map[string]interface{} `json:"workflowData"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("Failed to decode request body: %v", err)
+			}
+			pinData, _ := body.WorkflowData["pinData"].(map[string]interface{})
+			if _, ok := pinData["Webhook"]; !ok {
+				t.Errorf("Expected the pinned data of the workflow to be kept, got %v", body.WorkflowData)
+			}
+			if _, ok := pinData["Lookup"]; !ok {
+				t.Errorf("Expected the Lookup node to be pinned, got %v", body.WorkflowData)
+			}
+
+			_, _ = w.Write([]byte(`{"data": {"executionId": "43"}}`))
+		default:
+			t.Errorf("Unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	pinData := map[string]interface{}{"Lookup": []interface{}{map[string]interface{}{"json": map[string]interface{}{"found": true}}}}
+	id, err := client.RunWorkflowWithPinData("wf-1", pinData)
+	if err != nil {
+		t.Fatalf("RunWorkflowWithPinData() error = %v", err)
+	}
+	if id != "43" {
+		t.Errorf("Expected execution ID 43, got %s", id)
+	}
+}
+
+func TestClient_WaitForExecution(t *testing.T) {
+	defer func(interval time.Duration) { executionPollInterval = interval }(executionPollInterval)
+	executionPollInterval = time.Millisecond
+
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/executions/42" {
+			t.Errorf("Expected path /api/v1/executions/42, got %s", r.URL.Path)
+		}
+
+		polls++
+		w.Header().Set("Content-Type", "application/json")
+		if polls < 3 {
+			_, _ = w.Write([]byte(`{"id": 42, "status": "running", "finished": false}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"id": 42, "status": "success", "finished": true, "stoppedAt": "2024-01-01T00:00:00Z"}`))
+	}))
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	execution, err := client.WaitForExecution("42", time.Minute)
+	if err != nil {
+		t.Fatalf("WaitForExecution() error = %v", err)
+	}
+	if execution.Status != ExecutionStatusSuccess || execution.ID.String() != "42" {
+		t.Errorf("Expected successful execution 42, got %+v", execution)
+	}
+	if polls != 3 {
+		t.Errorf("Expected 3 polls, got %d", polls)
+	}
+}
+
+func TestClient_WaitForExecution_Cached(t *testing.T) {
+	defer func(interval time.Duration) { executionPollInterval = interval }(executionPollInterval)
+	executionPollInterval = time.Millisecond
+
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		w.Header().Set("Content-Type", "application/json")
+		if polls < 2 {
+			_, _ = w.Write([]byte(`{"id": 42, "status": "running"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"id": 42, "status": "success"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL:  server.URL,
+		Auth:     &APIKeyAuth{APIKey: "test-key"},
+		CacheTTL: time.Minute,
+	})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	// Polls must not be answered from the response cache
+	execution, err := client.WaitForExecution("42", time.Second)
+	if err != nil {
+		t.Fatalf("WaitForExecution() error = %v", err)
+	}
+	if execution.Status != ExecutionStatusSuccess || polls != 2 {
+		t.Errorf("Expected a successful execution after 2 polls, got %q after %d", execution.Status, polls)
+	}
+}
+
+func TestClient_WaitForExecution_Timeout(t *testing.T) {
+	defer func(interval time.Duration) { executionPollInterval = interval }(executionPollInterval)
+	executionPollInterval = time.Millisecond
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": "42", "status": "waiting"}`))
+	}))
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	execution, err := client.WaitForExecution("42", 0)
+	if err == nil {
+		t.Fatal("Expected timeout error")
+	}
+	if execution == nil || execution.Status != ExecutionStatusWaiting {
+		t.Errorf("Expected the last known execution state, got %+v", execution)
+	}
+}
+
+func TestClient_DeleteExecutions(t *testing.T) {
+	deleteBefore := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		filter *ExecutionDeleteFilter
+		want   map[string]interface{}
+	}{
+		{
+			name:   "by ID",
+			filter: &ExecutionDeleteFilter{IDs: []string{"1", "2"}, WorkflowID: "ignored"},
+			want:   map[string]interface{}{"ids": []interface{}{"1", "2"}},
+		},
+		{
+			name:   "by date",
+			filter: &ExecutionDeleteFilter{DeleteBefore: &deleteBefore, WorkflowID: "wf-1", Status: []string{"error"}},
+			want: map[string]interface{}{
+				"deleteBefore": "2024-01-01T00:00:00Z",
+				"filters":      map[string]interface{}{"workflowId": "wf-1", "status": []interface{}{"error"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != "POST" || r.URL.Path != "/rest/executions/delete" {
+					t.Errorf("Expected POST /rest/executions/delete, got %s %s", r.Method, r.URL.Path)
+				}
+
+				var body map[string]interface{}
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					t.Fatalf("Failed to decode request body: %v", err)
+				}
+				if !reflect.DeepEqual(body, tt.want) {
+					t.Errorf("Expected body %v, got %v", tt.want, body)
+				}
+
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			client := CreateTestClient(t, server.URL)
+			if err := client.DeleteExecutions(tt.filter); err != nil {
+				t.Fatalf("DeleteExecutions() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestClient_DeleteExecutions_EmptyFilter(t *testing.T) {
+	client := CreateTestClient(t, "http://localhost:5678")
+
+	if err := client.DeleteExecutions(&ExecutionDeleteFilter{WorkflowID: "wf-1"}); err == nil {
+		t.Error("Expected error for a filter without IDs or deleteBefore")
+	}
+}
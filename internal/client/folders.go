@@ -0,0 +1,137 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// ProjectRootFolderID is the parent folder ID that moves a folder or workflow to the top level of its project
+const ProjectRootFolderID = "0"
+
+// Folder represents a folder that organizes the workflows of an n8n project
+type Folder struct {
+	ID             string           `json:"id,omitempty"`
+	Name           string           `json:"name"`
+	ParentFolderID string           `json:"parentFolderId,omitempty"`
+	ParentFolder   *FolderReference `json:"parentFolder,omitempty"`
+	WorkflowCount  int              `json:"workflowCount,omitempty"`
+	SubFolderCount int              `json:"subFolderCount,omitempty"`
+	CreatedAt      *time.Time       `json:"createdAt,omitempty"`
+	UpdatedAt      *time.Time       `json:"updatedAt,omitempty"`
+}
+
+// FolderReference identifies the parent folder of a folder or workflow in API responses
+type FolderReference struct {
+	ID             string `json:"id"`
+	Name           string `json:"name,omitempty"`
+	ParentFolderID string `json:"parentFolderId,omitempty"`
+}
+
+// ParentID returns the ID of the parent folder, or an empty string for a folder at the top level of its
+// project. Depending on the endpoint, n8n returns either the ID or the parent folder itself.
+func (f *Folder) ParentID() string {
+	if f.ParentFolderID != "" {
+		return f.ParentFolderID
+	}
+	if f.ParentFolder != nil {
+		return f.ParentFolder.ID
+	}
+	return ""
+}
+
+// folderRequest represents the request body for creating or updating a folder
+type folderRequest struct {
+	Name           string `json:"name,omitempty"`
+	ParentFolderID string `json:"parentFolderId,omitempty"`
+}
+
+// folderListResponse represents the response from listing the folders of a project
+type folderListResponse struct {
+	Count int      `json:"count"`
+	Data  []Folder `json:"data"`
+}
+
+// GetFolder retrieves a folder of a project. Folders are only available on the internal API and require
+// session authentication.
+func (c *Client) GetFolder(projectID, id string) (*Folder, error) {
+	if projectID == "" || id == "" {
+		return nil, fmt.Errorf("project ID and folder ID are required")
+	}
+
+	// There is no endpoint for a single folder, so the project's folders are filtered by ID
+	filter, err := json.Marshal(map[string]string{"folderId": id})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode folder filter: %w", err)
+	}
+
+	path := fmt.Sprintf("projects/%s/folders?filter=%s", projectID, url.QueryEscape(string(filter)))
+
+	var result folderListResponse
+	if err := c.doInternalRequest("GET", path, nil, &result); err != nil {
+		return nil, fmt.Errorf("failed to get folder %s: %w", id, err)
+	}
+
+	for _, folder := range result.Data {
+		if folder.ID == id {
+			return &folder, nil
+		}
+	}
+
+	return nil, &APIError{Code: http.StatusNotFound, Message: fmt.Sprintf("folder %s not found in project %s", id, projectID)}
+}
+
+// CreateFolder creates a folder in a project, inside parentFolderID or at the top level of the project if
+// it is empty. Requires session authentication.
+func (c *Client) CreateFolder(projectID, name, parentFolderID string) (*Folder, error) {
+	if projectID == "" {
+		return nil, fmt.Errorf("project ID is required")
+	}
+
+	if name == "" {
+		return nil, fmt.Errorf("folder name is required")
+	}
+
+	body := folderRequest{Name: name, ParentFolderID: parentFolderID}
+
+	var result Folder
+	if err := c.doInternalRequest("POST", fmt.Sprintf("projects/%s/folders", projectID), body, &result); err != nil {
+		return nil, fmt.Errorf("failed to create folder: %w", err)
+	}
+
+	return &result, nil
+}
+
+// UpdateFolder renames a folder and moves it to parentFolderID. Use ProjectRootFolderID to move it to the
+// top level of the project, or an empty string to keep its parent. Requires session authentication.
+func (c *Client) UpdateFolder(projectID, id, name, parentFolderID string) (*Folder, error) {
+	if projectID == "" || id == "" {
+		return nil, fmt.Errorf("project ID and folder ID are required")
+	}
+
+	body := folderRequest{Name: name, ParentFolderID: parentFolderID}
+	path := fmt.Sprintf("projects/%s/folders/%s", projectID, id)
+
+	if err := c.doInternalRequest("PATCH", path, body, nil); err != nil {
+		return nil, fmt.Errorf("failed to update folder %s: %w", id, err)
+	}
+
+	// The update response does not include the folder
+	return c.GetFolder(projectID, id)
+}
+
+// DeleteFolder deletes a folder. n8n archives the workflows and deletes the subfolders it contains.
+// Requires session authentication.
+func (c *Client) DeleteFolder(projectID, id string) error {
+	if projectID == "" || id == "" {
+		return fmt.Errorf("project ID and folder ID are required")
+	}
+
+	if err := c.doInternalRequest("DELETE", fmt.Sprintf("projects/%s/folders/%s", projectID, id), nil, nil); err != nil {
+		return fmt.Errorf("failed to delete folder %s: %w", id, err)
+	}
+
+	return nil
+}
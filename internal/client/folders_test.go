@@ -0,0 +1,126 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient_GetFolder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/projects/proj-1/folders" {
+			t.Errorf("Expected path /rest/projects/proj-1/folders, got %s", r.URL.Path)
+		}
+		if filter := r.URL.Query().Get("filter"); filter != `{"folderId":"folder-2"}` {
+			t.Errorf("Expected a filter on the folder ID, got %s", filter)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": {"count": 1, "data": [
+			{"id": "folder-2", "name": "Billing", "parentFolder": {"id": "folder-1", "name": "Finance"}, "workflowCount": 3}
+		]}}`))
+	}))
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	folder, err := client.GetFolder("proj-1", "folder-2")
+	if err != nil {
+		t.Fatalf("GetFolder failed: %v", err)
+	}
+
+	if folder.Name != "Billing" || folder.ParentID() != "folder-1" || folder.WorkflowCount != 3 {
+		t.Errorf("Unexpected folder: %+v", folder)
+	}
+
+	if _, err := client.GetFolder("proj-1", ""); err == nil {
+		t.Error("Expected error for an empty folder ID")
+	}
+}
+
+func TestClient_GetFolderNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": {"count": 0, "data": []}}`))
+	}))
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	_, err := client.GetFolder("proj-1", "missing")
+	if apiErr, ok := err.(*APIError); !ok || apiErr.Code != http.StatusNotFound {
+		t.Errorf("Expected a not found error, got %v", err)
+	}
+}
+
+func TestClient_CreateUpdateDeleteFolder(t *testing.T) {
+	var requests []string
+	var bodies []map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		if r.Method == "POST" || r.Method == "PATCH" {
+			var body map[string]string
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("Failed to decode request body: %v", err)
+			}
+			bodies = append(bodies, body)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case "POST":
+			_, _ = w.Write([]byte(`{"data": {"id": "folder-2", "name": "Billing", "parentFolderId": "folder-1"}}`))
+		case "GET":
+			_, _ = w.Write([]byte(`{"data": {"count": 1, "data": [{"id": "folder-2", "name": "Invoices"}]}}`))
+		default:
+			_, _ = w.Write([]byte(`{"data": true}`))
+		}
+	}))
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	created, err := client.CreateFolder("proj-1", "Billing", "folder-1")
+	if err != nil {
+		t.Fatalf("CreateFolder failed: %v", err)
+	}
+	if created.ID != "folder-2" || created.ParentID() != "folder-1" {
+		t.Errorf("Unexpected created folder: %+v", created)
+	}
+
+	updated, err := client.UpdateFolder("proj-1", "folder-2", "Invoices", ProjectRootFolderID)
+	if err != nil {
+		t.Fatalf("UpdateFolder failed: %v", err)
+	}
+	if updated.Name != "Invoices" || updated.ParentID() != "" {
+		t.Errorf("Unexpected updated folder: %+v", updated)
+	}
+
+	if err := client.DeleteFolder("proj-1", "folder-2"); err != nil {
+		t.Fatalf("DeleteFolder failed: %v", err)
+	}
+
+	expected := []string{
+		"POST /rest/projects/proj-1/folders",
+		"PATCH /rest/projects/proj-1/folders/folder-2",
+		"GET /rest/projects/proj-1/folders",
+		"DELETE /rest/projects/proj-1/folders/folder-2",
+	}
+	if len(requests) != len(expected) {
+		t.Fatalf("Expected requests %v, got %v", expected, requests)
+	}
+	for i := range expected {
+		if requests[i] != expected[i] {
+			t.Errorf("Expected request %s, got %s", expected[i], requests[i])
+		}
+	}
+
+	if bodies[0]["name"] != "Billing" || bodies[0]["parentFolderId"] != "folder-1" {
+		t.Errorf("Unexpected create body: %v", bodies[0])
+	}
+	if bodies[1]["name"] != "Invoices" || bodies[1]["parentFolderId"] != ProjectRootFolderID {
+		t.Errorf("Unexpected update body: %v", bodies[1])
+	}
+}
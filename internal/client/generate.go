@@ -0,0 +1,5 @@
+package client
+
+// The bindings in api_generated.go are generated from the subset of the n8n public API OpenAPI document
+// checked in under tools/openapigen. Run "make generate-client" after changing it.
+//go:generate go run ../../tools/openapigen -spec ../../tools/openapigen/n8n-openapi.yml -out api_generated.go
@@ -0,0 +1,83 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// createClockSkew is how much earlier than the start of a create request, by the local clock, n8n may
+// report a resource created by that request
+const createClockSkew = time.Minute
+
+// createCheck looks for the resource an earlier attempt of a create request may have created. It returns
+// the resource encoded as the response of the create request, and whether it found one. n8n has no
+// idempotency keys, so a create that failed with a gateway error may still have created the resource, and
+// blindly retrying it would create a duplicate.
+type createCheck func() ([]byte, bool, error)
+
+// create sends a create request. If an attempt fails in a way that n8n may still have processed, check is
+// run before the request is retried, and the resource it finds is returned instead of creating another.
+// The internal API does not retry requests, so they need no check.
+func (c *Client) create(path string, body any, result any, check createCheck) error {
+	if c.surfaceFor(path) == APISurfaceInternal {
+		return c.doInternalRequest("POST", path, body, result)
+	}
+	return c.doCheckedRequest("POST", path, body, result, check)
+}
+
+// checkCreate runs check after a create attempt failed with cause. A failed check is returned as an error,
+// since the create cannot be retried safely without knowing whether it succeeded.
+func (c *Client) checkCreate(check createCheck, cause string) ([]byte, bool, error) {
+	c.logger.Logf("n8n create request failed (%s), checking whether it succeeded before retrying", cause)
+
+	body, found, err := check()
+	if err != nil {
+		return nil, false, fmt.Errorf("create request failed (%s) and was not retried, since it could not be "+
+			"checked whether n8n created the resource anyway: %w", cause, err)
+	}
+	if found {
+		c.logger.Logf("n8n create request succeeded despite the error, using the created resource")
+	}
+	return body, found, nil
+}
+
+// mayHaveBeenProcessed reports whether n8n may have processed a request whose attempt failed with the
+// given status code or transport error. Rate limiting, unavailability and refused connections mean that
+// n8n did not process the request; a gateway may time out or fail after n8n has.
+func mayHaveBeenProcessed(statusCode int, err error) bool {
+	if err != nil {
+		return !strings.Contains(err.Error(), "connection refused")
+	}
+	return statusCode == http.StatusInternalServerError ||
+		statusCode == http.StatusBadGateway ||
+		statusCode == http.StatusGatewayTimeout
+}
+
+// findCreated returns the only item that matches and was created since started, encoded as JSON. Several
+// such items are an error, since it cannot be told which of them the request created.
+func findCreated[T any](items []T, started time.Time, matches func(T) bool,
+	createdAt func(T) *time.Time) ([]byte, bool, error) {
+	var found []T
+	for _, item := range items {
+		created := createdAt(item)
+		if matches(item) && created != nil && !created.Before(started.Add(-createClockSkew)) {
+			found = append(found, item)
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return nil, false, nil
+	case 1:
+		body, err := json.Marshal(found[0])
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to encode the created resource: %w", err)
+		}
+		return body, true, nil
+	default:
+		return nil, false, fmt.Errorf("found %d resources with the same name created since the request started", len(found))
+	}
+}
@@ -0,0 +1,184 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// createServer serves workflow creates that fail with status after creating the workflow, and lists the
+// workflows created so far
+func createServer(t *testing.T, status int) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var creates atomic.Int32
+	var created []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method {
+		case "POST":
+			n := creates.Add(1)
+			workflow := map[string]interface{}{"id": fmt.Sprintf("wf-%d", n), "name": "Orders",
+				"createdAt": time.Now().UTC().Format(time.RFC3339)}
+			if status != http.StatusServiceUnavailable || n > 1 {
+				created = append(created, workflow)
+			}
+			if n == 1 {
+				w.WriteHeader(status)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(workflow)
+		case "GET":
+			if r.URL.Query().Get("name") != "Orders" {
+				t.Errorf("Expected the workflows to be listed by name, got query %q", r.URL.RawQuery)
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": created})
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &creates
+}
+
+func TestClient_CreateWorkflow_GatewayTimeout(t *testing.T) {
+	server, creates := createServer(t, http.StatusGatewayTimeout)
+	client := CreateTestClient(t, server.URL)
+
+	workflow, err := client.CreateWorkflow(&Workflow{Name: "Orders"})
+	if err != nil {
+		t.Fatalf("CreateWorkflow() error = %v", err)
+	}
+
+	// The workflow created by the failed attempt is used instead of creating a duplicate
+	if workflow.ID != "wf-1" {
+		t.Errorf("Expected the workflow of the first attempt, got %q", workflow.ID)
+	}
+	if creates.Load() != 1 {
+		t.Errorf("Expected the create not to be retried, got %d creates", creates.Load())
+	}
+}
+
+func TestClient_CreateWorkflow_GatewayTimeoutWithParallelism(t *testing.T) {
+	server, creates := createServer(t, http.StatusGatewayTimeout)
+	client, err := NewClient(&Config{
+		BaseURL:     server.URL,
+		Auth:        &APIKeyAuth{APIKey: "test-key"},
+		Timeout:     2 * time.Second,
+		RetryConfig: RetryConfig{MaxRetries: 1, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond},
+		Parallelism: 1,
+	})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	// The check must not wait for the slot held by the failed create
+	workflow, err := client.CreateWorkflow(&Workflow{Name: "Orders"})
+	if err != nil {
+		t.Fatalf("CreateWorkflow() error = %v", err)
+	}
+	if workflow.ID != "wf-1" || creates.Load() != 1 {
+		t.Errorf("Expected the workflow of the first attempt, got %q after %d creates", workflow.ID, creates.Load())
+	}
+}
+
+func TestClient_CreateWorkflow_ConnectionResetWithParallelism(t *testing.T) {
+	var creates atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		workflow := map[string]interface{}{"id": "wf-1", "name": "Orders", "createdAt": time.Now().UTC().Format(time.RFC3339)}
+		if r.Method == "GET" {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": []interface{}{workflow}})
+			return
+		}
+
+		// Create the workflow, then reset the connection before responding
+		creates.Add(1)
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			t.Errorf("Failed to hijack the connection: %v", err)
+			return
+		}
+		_ = conn.(*net.TCPConn).SetLinger(0)
+		_ = conn.Close()
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL:     server.URL,
+		Auth:        &APIKeyAuth{APIKey: "test-key"},
+		Timeout:     2 * time.Second,
+		RetryConfig: RetryConfig{MaxRetries: 1, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond},
+		Parallelism: 1,
+	})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	workflow, err := client.CreateWorkflow(&Workflow{Name: "Orders"})
+	if err != nil {
+		t.Fatalf("CreateWorkflow() error = %v", err)
+	}
+	if workflow.ID != "wf-1" || creates.Load() != 1 {
+		t.Errorf("Expected the workflow of the first attempt, got %q after %d creates", workflow.ID, creates.Load())
+	}
+}
+
+func TestClient_CreateWorkflow_ServiceUnavailable(t *testing.T) {
+	server, creates := createServer(t, http.StatusServiceUnavailable)
+	client := CreateTestClient(t, server.URL)
+
+	// An unavailable instance did not process the request, so it is retried without checking
+	workflow, err := client.CreateWorkflow(&Workflow{Name: "Orders"})
+	if err != nil {
+		t.Fatalf("CreateWorkflow() error = %v", err)
+	}
+	if workflow.ID != "wf-2" || creates.Load() != 2 {
+		t.Errorf("Expected the retried create, got %q after %d creates", workflow.ID, creates.Load())
+	}
+}
+
+func TestFindCreated(t *testing.T) {
+	started := time.Now()
+	before := started.Add(-time.Hour)
+	after := started.Add(time.Second)
+	credentials := []Credential{
+		{ID: "c1", Name: "Slack", Type: "slackApi", CreatedAt: &before},
+		{ID: "c2", Name: "Slack", Type: "slackApi", CreatedAt: &after},
+		{ID: "c3", Name: "Slack", Type: "httpHeaderAuth", CreatedAt: &after},
+	}
+	matches := func(cred Credential) bool { return cred.Name == "Slack" && cred.Type == "slackApi" }
+	createdAt := func(cred Credential) *time.Time { return cred.CreatedAt }
+
+	// Credentials with the same name that existed before are not adopted
+	body, found, err := findCreated(credentials, started, matches, createdAt)
+	if err != nil || !found || !strings.Contains(string(body), `"id":"c2"`) {
+		t.Errorf("Expected the credential created since the request started, got %s, %v, %v", body, found, err)
+	}
+
+	if _, found, err := findCreated(credentials[:1], started, matches, createdAt); err != nil || found {
+		t.Errorf("Expected no credential, got %v, %v", found, err)
+	}
+
+	// It cannot be told which of several new credentials was created by the request
+	credentials = append(credentials, Credential{ID: "c4", Name: "Slack", Type: "slackApi", CreatedAt: &after})
+	if _, _, err := findCreated(credentials, started, matches, createdAt); err == nil {
+		t.Error("Expected an error for several matching credentials")
+	}
+}
+
+func TestMayHaveBeenProcessed(t *testing.T) {
+	if !mayHaveBeenProcessed(http.StatusGatewayTimeout, nil) || mayHaveBeenProcessed(http.StatusTooManyRequests, nil) {
+		t.Error("Expected only failures after n8n may have processed the request")
+	}
+	if mayHaveBeenProcessed(0, errors.New("dial tcp: connection refused")) {
+		t.Error("Expected a refused connection not to be processed")
+	}
+}
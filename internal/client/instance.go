@@ -0,0 +1,141 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Enterprise features reported by n8n instances
+const (
+	FeatureLDAP            = "ldap"
+	FeatureLogStreaming    = "logStreaming"
+	FeatureProjects        = "projects"
+	FeatureSharing         = "sharing"
+	FeatureVariables       = "variables"
+	FeatureWorkflowHistory = "workflowHistory"
+)
+
+// InstanceInfo describes the version, license and enabled features of an n8n instance
+type InstanceInfo struct {
+	Version          string
+	PlanName         string
+	PublicAPIEnabled bool
+	// Features maps enterprise feature names to whether the license enables them. Features the
+	// instance does not know about are absent.
+	Features map[string]bool
+}
+
+// HasFeature reports whether the instance license enables an enterprise feature
+func (i *InstanceInfo) HasFeature(name string) bool {
+	return i.Features[name]
+}
+
+// KnowsFeature reports whether the instance version supports an enterprise feature at all
+func (i *InstanceInfo) KnowsFeature(name string) bool {
+	_, ok := i.Features[name]
+	return ok
+}
+
+// VersionAtLeast reports whether the instance runs at least the given version. An unknown or
+// unparsable instance version is assumed to be recent enough.
+func (i *InstanceInfo) VersionAtLeast(minVersion string) bool {
+	current, ok := parseVersion(i.Version)
+	if !ok {
+		return true
+	}
+
+	required, ok := parseVersion(minVersion)
+	if !ok {
+		return true
+	}
+
+	for k := range current {
+		if current[k] != required[k] {
+			return current[k] > required[k]
+		}
+	}
+	return true
+}
+
+// parseVersion parses a major.minor.patch version, ignoring any pre-release suffix
+func parseVersion(version string) ([3]int, bool) {
+	var parsed [3]int
+
+	version = strings.TrimPrefix(version, "v")
+	if i := strings.IndexAny(version, "-+"); i >= 0 {
+		version = version[:i]
+	}
+
+	parts := strings.Split(version, ".")
+	if version == "" || len(parts) > 3 {
+		return parsed, false
+	}
+
+	for k, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return parsed, false
+		}
+		parsed[k] = n
+	}
+
+	return parsed, true
+}
+
+// instanceInfoFromSettings builds instance information from the editor settings
+func instanceInfoFromSettings(settings *frontendSettings) *InstanceInfo {
+	info := &InstanceInfo{
+		Version:          settings.VersionCli,
+		PlanName:         settings.License.PlanName,
+		PublicAPIEnabled: settings.PublicAPI.Enabled,
+		Features:         make(map[string]bool, len(settings.Enterprise)),
+	}
+
+	// Instances without a license do not report a plan
+	if info.PlanName == "" {
+		info.PlanName = "Community"
+	}
+
+	for name, raw := range settings.Enterprise {
+		var enabled bool
+		if err := json.Unmarshal(raw, &enabled); err == nil {
+			info.Features[name] = enabled
+			continue
+		}
+
+		// Team projects are reported as a limit rather than a flag, where 0 means not licensed
+		if name == FeatureProjects {
+			var projects struct {
+				Team struct {
+					Limit int `json:"limit"`
+				} `json:"team"`
+			}
+			if err := json.Unmarshal(raw, &projects); err == nil {
+				info.Features[name] = projects.Team.Limit != 0
+			}
+		}
+	}
+
+	return info
+}
+
+// GetInstanceInfo returns the version, license and features of the n8n instance. The result is read
+// from the editor settings, which do not require authentication, and cached for the client lifetime.
+func (c *Client) GetInstanceInfo() (*InstanceInfo, error) {
+	c.instanceMu.Lock()
+	defer c.instanceMu.Unlock()
+
+	if c.instanceInfo != nil {
+		return c.instanceInfo, nil
+	}
+
+	var settings frontendSettings
+	if err := c.doRESTRequest("GET", "settings", nil, &settings); err != nil {
+		return nil, fmt.Errorf("failed to get instance info: %w", err)
+	}
+
+	c.instanceInfo = instanceInfoFromSettings(&settings)
+	return c.instanceInfo, nil
+}
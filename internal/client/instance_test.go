@@ -0,0 +1,89 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient_GetInstanceInfo(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/rest/settings" {
+			t.Errorf("Expected path /rest/settings, got %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": {
+			"versionCli": "1.64.2",
+			"license": {"planName": "Enterprise"},
+			"publicApi": {"enabled": true},
+			"enterprise": {
+				"ldap": true,
+				"variables": false,
+				"projects": {"team": {"limit": -1}}
+			}
+		}}`))
+	}))
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	info, err := client.GetInstanceInfo()
+	if err != nil {
+		t.Fatalf("GetInstanceInfo() error = %v", err)
+	}
+
+	if info.Version != "1.64.2" {
+		t.Errorf("Expected version 1.64.2, got %s", info.Version)
+	}
+	if info.PlanName != "Enterprise" {
+		t.Errorf("Expected plan Enterprise, got %s", info.PlanName)
+	}
+	if !info.PublicAPIEnabled {
+		t.Error("Expected the public API to be enabled")
+	}
+	if !info.HasFeature(FeatureLDAP) || !info.HasFeature(FeatureProjects) {
+		t.Errorf("Expected ldap and projects to be enabled, got %v", info.Features)
+	}
+	if info.HasFeature(FeatureVariables) || !info.KnowsFeature(FeatureVariables) {
+		t.Errorf("Expected variables to be known but not enabled, got %v", info.Features)
+	}
+	if info.KnowsFeature(FeatureWorkflowHistory) {
+		t.Errorf("Expected workflow history to be unknown, got %v", info.Features)
+	}
+
+	// The result is cached
+	if _, err := client.GetInstanceInfo(); err != nil {
+		t.Fatalf("GetInstanceInfo() error = %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("Expected 1 request, got %d", requests)
+	}
+}
+
+func TestInstanceInfo_VersionAtLeast(t *testing.T) {
+	tests := []struct {
+		version    string
+		minVersion string
+		want       bool
+	}{
+		{version: "1.64.2", minVersion: "1.40.0", want: true},
+		{version: "1.40.0", minVersion: "1.40.0", want: true},
+		{version: "1.39.9", minVersion: "1.40.0", want: false},
+		{version: "0.236.0", minVersion: "1.0.0", want: false},
+		{version: "1.41.0-rc.1", minVersion: "1.40", want: true},
+		{version: "", minVersion: "1.40.0", want: true},
+		{version: "nightly", minVersion: "1.40.0", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version+">="+tt.minVersion, func(t *testing.T) {
+			info := &InstanceInfo{Version: tt.version}
+			if got := info.VersionAtLeast(tt.minVersion); got != tt.want {
+				t.Errorf("VersionAtLeast(%q) = %v, want %v", tt.minVersion, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,232 @@
+package client
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Instrumentation receives the timing of the HTTP requests a client sends to n8n. Cached reads and
+// requests shared with a concurrent read are not sent and therefore not reported. Implementations
+// must be safe for concurrent use.
+type Instrumentation interface {
+	// RequestStarted is called before the first attempt of a request
+	RequestStarted(method, url string)
+	// RequestCompleted is called once a request has succeeded or failed, after all retries
+	RequestCompleted(event RequestEvent)
+}
+
+// RequestEvent describes a completed request
+type RequestEvent struct {
+	Method     string
+	URL        string
+	Endpoint   string        // Path relative to the instance URL with IDs replaced by {id}, e.g. "api/v1/workflows/{id}"
+	StatusCode int           // Status code of the last response, or zero if no response was received
+	Retries    int           // Number of attempts after the first one
+	Duration   time.Duration // Time from the first attempt until completion, including backoff delays
+	Err        error
+}
+
+// requestTrace collects the outcome of a request while it is sent
+type requestTrace struct {
+	statusCode int
+	retries    int
+}
+
+// traceRequest sends a request with send and reports its timing to the instrumentation of the client
+func (c *Client) traceRequest(method string, fullURL *url.URL, send func(trace *requestTrace) ([]byte, error)) ([]byte, error) {
+	if err := c.waitForStartup(); err != nil {
+		return nil, err
+	}
+
+	if c.instrumentation == nil {
+		return send(&requestTrace{})
+	}
+
+	c.instrumentation.RequestStarted(method, fullURL.String())
+
+	trace := &requestTrace{}
+	start := time.Now()
+	body, err := send(trace)
+
+	c.instrumentation.RequestCompleted(RequestEvent{
+		Method:     method,
+		URL:        fullURL.String(),
+		Endpoint:   endpointPattern(strings.TrimPrefix(fullURL.Path, c.instanceURL().Path)),
+		StatusCode: trace.statusCode,
+		Retries:    trace.retries,
+		Duration:   time.Since(start),
+		Err:        err,
+	})
+
+	return body, err
+}
+
+// endpointPattern replaces the IDs in a request path relative to the instance URL with {id}, so that
+// requests for different workflows or credentials are aggregated as one endpoint. Path segments after
+// the endpoint name that contain a digit or are as long as an n8n ID are considered IDs.
+func endpointPattern(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	// Keep the API prefix and the endpoint name, e.g. "api/v1/workflows" or "rest/workflows"
+	keep := 1
+	switch {
+	case len(segments) > 2 && segments[0] == "api" && segments[1] == "v1":
+		keep = 3
+	case len(segments) > 1 && segments[0] == "rest":
+		keep = 2
+	}
+
+	for i := keep; i < len(segments); i++ {
+		if len(segments[i]) >= 16 || strings.ContainsAny(segments[i], "0123456789") {
+			segments[i] = "{id}"
+		}
+	}
+
+	return strings.Join(segments, "/")
+}
+
+// EndpointStats holds the aggregate timing of the requests to one endpoint
+type EndpointStats struct {
+	Endpoint string // Method and endpoint pattern, e.g. "PUT api/v1/workflows/{id}"
+	Requests int
+	Errors   int
+	Retries  int
+	Total    time.Duration
+	Max      time.Duration
+}
+
+// Average returns the average duration of a request to the endpoint
+func (s EndpointStats) Average() time.Duration {
+	if s.Requests == 0 {
+		return 0
+	}
+	return s.Total / time.Duration(s.Requests)
+}
+
+// RequestStats is an Instrumentation that aggregates request timings per endpoint and logs a summary
+// of the endpoints that took the most time, at most once per interval. It shows which resources
+// dominate a long plan or apply in the debug logs.
+type RequestStats struct {
+	mu         sync.Mutex
+	logger     Logger
+	interval   time.Duration
+	inFlight   int
+	endpoints  map[string]*EndpointStats
+	lastLogged time.Time
+	now        func() time.Time
+}
+
+// requestStatsTopEndpoints is how many endpoints a summary lists
+const requestStatsTopEndpoints = 5
+
+// NewRequestStats creates a RequestStats that logs a summary to logger at most once per interval; a zero
+// interval disables the periodic summary
+func NewRequestStats(logger Logger, interval time.Duration) *RequestStats {
+	if logger == nil {
+		logger = &DefaultLogger{}
+	}
+
+	return &RequestStats{
+		logger:     logger,
+		interval:   interval,
+		endpoints:  map[string]*EndpointStats{},
+		lastLogged: time.Now(),
+		now:        time.Now,
+	}
+}
+
+// RequestStarted counts a request as in flight
+func (s *RequestStats) RequestStarted(method, url string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.inFlight++
+}
+
+// RequestCompleted adds a request to the statistics of its endpoint and logs a summary when the interval
+// has passed
+func (s *RequestStats) RequestCompleted(event RequestEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.inFlight--
+
+	key := event.Method + " " + event.Endpoint
+	stats, ok := s.endpoints[key]
+	if !ok {
+		stats = &EndpointStats{Endpoint: key}
+		s.endpoints[key] = stats
+	}
+	stats.Requests++
+	stats.Retries += event.Retries
+	stats.Total += event.Duration
+	stats.Max = max(stats.Max, event.Duration)
+	if event.Err != nil {
+		stats.Errors++
+	}
+
+	if s.interval > 0 && s.now().Sub(s.lastLogged) >= s.interval {
+		s.logSummary()
+	}
+}
+
+// Snapshot returns the statistics of every endpoint, sorted by total duration in descending order
+func (s *RequestStats) Snapshot() []EndpointStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.snapshot()
+}
+
+// LogSummary logs the number and total duration of all requests and the endpoints that took the most time
+func (s *RequestStats) LogSummary() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.logSummary()
+}
+
+func (s *RequestStats) snapshot() []EndpointStats {
+	snapshot := make([]EndpointStats, 0, len(s.endpoints))
+	for _, stats := range s.endpoints {
+		snapshot = append(snapshot, *stats)
+	}
+
+	sort.Slice(snapshot, func(i, j int) bool {
+		if snapshot[i].Total != snapshot[j].Total {
+			return snapshot[i].Total > snapshot[j].Total
+		}
+		return snapshot[i].Endpoint < snapshot[j].Endpoint
+	})
+
+	return snapshot
+}
+
+func (s *RequestStats) logSummary() {
+	s.lastLogged = s.now()
+
+	snapshot := s.snapshot()
+	var requests int
+	var total time.Duration
+	for _, stats := range snapshot {
+		requests += stats.Requests
+		total += stats.Total
+	}
+
+	s.logger.Logf("n8n API timing: %d requests took %v in total, %d in flight", requests, total.Round(time.Millisecond),
+		s.inFlight)
+	for _, stats := range snapshot[:min(len(snapshot), requestStatsTopEndpoints)] {
+		s.logger.Logf("n8n API timing: %s", formatEndpointStats(stats))
+	}
+}
+
+// formatEndpointStats formats the statistics of an endpoint for the summary
+func formatEndpointStats(stats EndpointStats) string {
+	return fmt.Sprintf("%s: %d requests, %v total, %v average, %v max, %d retries, %d errors", stats.Endpoint,
+		stats.Requests, stats.Total.Round(time.Millisecond), stats.Average().Round(time.Millisecond),
+		stats.Max.Round(time.Millisecond), stats.Retries, stats.Errors)
+}
@@ -0,0 +1,167 @@
+package client
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// recordingInstrumentation records the events it receives
+type recordingInstrumentation struct {
+	mu      sync.Mutex
+	started []string
+	events  []RequestEvent
+}
+
+func (r *recordingInstrumentation) RequestStarted(method, url string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.started = append(r.started, method+" "+url)
+}
+
+func (r *recordingInstrumentation) RequestCompleted(event RequestEvent) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, event)
+}
+
+func TestClient_Instrumentation(t *testing.T) {
+	attempts := 0
+	server := TestServer(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": "AbCdEfGhIjKlMnOp", "name": "Orders"}`))
+	})
+	defer server.Close()
+
+	instrumentation := &recordingInstrumentation{}
+	client, err := NewClient(&Config{
+		BaseURL:         server.URL,
+		Auth:            &APIKeyAuth{APIKey: "test-key"},
+		RetryConfig:     RetryConfig{MaxRetries: 2, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond},
+		Instrumentation: instrumentation,
+	})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	if _, err := client.GetWorkflow("AbCdEfGhIjKlMnOp"); err != nil {
+		t.Fatalf("GetWorkflow() error = %v", err)
+	}
+
+	if len(instrumentation.started) != 1 || len(instrumentation.events) != 1 {
+		t.Fatalf("Expected one started and one completed request, got %v and %+v", instrumentation.started,
+			instrumentation.events)
+	}
+
+	event := instrumentation.events[0]
+	if event.Method != "GET" || event.Endpoint != "api/v1/workflows/{id}" {
+		t.Errorf("Expected GET api/v1/workflows/{id}, got %s %s", event.Method, event.Endpoint)
+	}
+	if event.StatusCode != http.StatusOK || event.Retries != 1 || event.Err != nil {
+		t.Errorf("Expected a successful request after 1 retry, got %+v", event)
+	}
+	if event.Duration <= 0 {
+		t.Errorf("Expected a positive duration, got %v", event.Duration)
+	}
+}
+
+func TestClient_InstrumentationInternalAPI(t *testing.T) {
+	server := TestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "Not found"}`))
+	})
+	defer server.Close()
+
+	instrumentation := &recordingInstrumentation{}
+	client, err := NewClient(&Config{
+		BaseURL:         server.URL,
+		Auth:            &APIKeyAuth{APIKey: "test-key"},
+		Instrumentation: instrumentation,
+	})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	if err := client.doRESTRequest("POST", "workflows/wf-1/archive", nil, nil); err == nil {
+		t.Fatal("Expected error for a missing workflow")
+	}
+
+	if len(instrumentation.events) != 1 {
+		t.Fatalf("Expected one completed request, got %+v", instrumentation.events)
+	}
+	event := instrumentation.events[0]
+	if event.Endpoint != "rest/workflows/{id}/archive" || event.StatusCode != http.StatusNotFound || event.Err == nil {
+		t.Errorf("Expected a failed request to rest/workflows/{id}/archive, got %+v", event)
+	}
+}
+
+func TestEndpointPattern(t *testing.T) {
+	tests := map[string]string{
+		"api/v1/workflows":                  "api/v1/workflows",
+		"/api/v1/workflows/wf-1":            "api/v1/workflows/{id}",
+		"api/v1/workflows/AbCdEfGhIjKlMnOp": "api/v1/workflows/{id}",
+		"api/v1/workflows/wf-1/activate":    "api/v1/workflows/{id}/activate",
+		"rest/projects/p1/folders":          "rest/projects/{id}/folders",
+		"rest/login":                        "rest/login",
+		"types/nodes.json":                  "types/nodes.json",
+	}
+
+	for path, expected := range tests {
+		if got := endpointPattern(path); got != expected {
+			t.Errorf("endpointPattern(%q) = %q, expected %q", path, got, expected)
+		}
+	}
+}
+
+func TestRequestStats(t *testing.T) {
+	var messages []string
+	stats := NewRequestStats(&TestLogger{messages: &messages}, time.Minute)
+
+	now := time.Now()
+	stats.lastLogged = now
+	stats.now = func() time.Time { return now }
+
+	complete := func(method, endpoint string, duration time.Duration, retries int) {
+		stats.RequestStarted(method, endpoint)
+		stats.RequestCompleted(RequestEvent{Method: method, Endpoint: endpoint, Duration: duration, Retries: retries})
+	}
+
+	complete("PUT", "api/v1/workflows/{id}", 2*time.Second, 1)
+	complete("PUT", "api/v1/workflows/{id}", 4*time.Second, 0)
+	complete("GET", "api/v1/tags", 100*time.Millisecond, 0)
+
+	snapshot := stats.Snapshot()
+	if len(snapshot) != 2 {
+		t.Fatalf("Expected 2 endpoints, got %+v", snapshot)
+	}
+	slowest := snapshot[0]
+	if slowest.Endpoint != "PUT api/v1/workflows/{id}" || slowest.Requests != 2 || slowest.Retries != 1 ||
+		slowest.Total != 6*time.Second || slowest.Max != 4*time.Second || slowest.Average() != 3*time.Second {
+		t.Errorf("Unexpected statistics of the slowest endpoint: %+v", slowest)
+	}
+
+	if len(messages) != 0 {
+		t.Errorf("Expected no summary before the interval has passed, got %v", messages)
+	}
+
+	now = now.Add(time.Minute)
+	complete("GET", "api/v1/tags", 100*time.Millisecond, 0)
+
+	if len(messages) != 3 {
+		t.Fatalf("Expected a summary with 2 endpoints, got %v", messages)
+	}
+	if !strings.Contains(messages[0], "4 requests took 6.2s in total") {
+		t.Errorf("Unexpected summary: %s", messages[0])
+	}
+	if !strings.HasPrefix(messages[1], "n8n API timing: PUT api/v1/workflows/{id}: 2 requests, 6s total, 3s average") {
+		t.Errorf("Expected the slowest endpoint first, got %s", messages[1])
+	}
+}
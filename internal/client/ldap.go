@@ -2,6 +2,7 @@ package client
 
 import (
 	"fmt"
+	"time"
 )
 
 // LDAPConfig represents LDAP configuration (Enterprise feature)
@@ -27,6 +28,31 @@ type LDAPTestResult struct {
 	Message string `json:"message,omitempty"`
 }
 
+// LDAP synchronization run modes
+const (
+	LDAPSyncModeLive = "live"
+	LDAPSyncModeDry  = "dry"
+)
+
+// LDAPSyncRun represents a single LDAP synchronization run
+type LDAPSyncRun struct {
+	ID        int        `json:"id"`
+	StartedAt *time.Time `json:"startedAt,omitempty"`
+	EndedAt   *time.Time `json:"endedAt,omitempty"`
+	Created   int        `json:"created"`
+	Updated   int        `json:"updated"`
+	Disabled  int        `json:"disabled"`
+	Scanned   int        `json:"scanned"`
+	RunMode   string     `json:"runMode"`
+	Status    string     `json:"status"`
+	Error     string     `json:"error,omitempty"`
+}
+
+// LDAPSyncRequest represents the request body for triggering an LDAP synchronization
+type LDAPSyncRequest struct {
+	Type string `json:"type"`
+}
+
 // GetLDAPConfig retrieves the current LDAP configuration
 func (c *Client) GetLDAPConfig() (*LDAPConfig, error) {
 	var config LDAPConfig
@@ -90,3 +116,50 @@ func (c *Client) TestLDAPConnectionWithConfig(config *LDAPConfig) (*LDAPTestResu
 
 	return &result, nil
 }
+
+// SyncLDAP triggers an LDAP synchronization run. In dry mode the run only reports the
+// changes it would make without applying them.
+func (c *Client) SyncLDAP(runMode string) error {
+	if runMode != LDAPSyncModeLive && runMode != LDAPSyncModeDry {
+		return fmt.Errorf("invalid LDAP sync mode %q, must be %q or %q", runMode, LDAPSyncModeLive, LDAPSyncModeDry)
+	}
+
+	err := c.Post("ldap/sync", &LDAPSyncRequest{Type: runMode}, nil)
+	if err != nil {
+		return fmt.Errorf("failed to trigger LDAP sync: %w", err)
+	}
+
+	return nil
+}
+
+// GetLDAPSyncRuns retrieves the LDAP synchronization history
+func (c *Client) GetLDAPSyncRuns() ([]LDAPSyncRun, error) {
+	var runs []LDAPSyncRun
+	err := c.Get("ldap/sync", &runs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get LDAP sync runs: %w", err)
+	}
+
+	return runs, nil
+}
+
+// GetLastLDAPSyncRun retrieves the most recent LDAP synchronization run, or nil if LDAP was never synchronized
+func (c *Client) GetLastLDAPSyncRun() (*LDAPSyncRun, error) {
+	runs, err := c.GetLDAPSyncRuns()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(runs) == 0 {
+		return nil, nil
+	}
+
+	last := runs[0]
+	for _, run := range runs[1:] {
+		if run.ID > last.ID {
+			last = run
+		}
+	}
+
+	return &last, nil
+}
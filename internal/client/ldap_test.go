@@ -26,12 +26,12 @@ func TestClient_GetLDAPConfig(t *testing.T) {
 		if r.Method != "GET" {
 			t.Errorf("Expected GET request, got %s", r.Method)
 		}
-		if r.URL.Path != "/api/v1/ldap/config" {
-			t.Errorf("Expected path /api/v1/ldap/config, got %s", r.URL.Path)
+		if r.URL.Path != "/rest/ldap/config" {
+			t.Errorf("Expected path /rest/ldap/config, got %s", r.URL.Path)
 		}
 
 		w.Header().Set("Content-Type", "application/json")
-		_ = json.NewEncoder(w).Encode(mockConfig)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": mockConfig})
 	}))
 	defer server.Close()
 
@@ -93,8 +93,8 @@ func TestClient_UpdateLDAPConfig(t *testing.T) {
 		if r.Method != "PUT" {
 			t.Errorf("Expected PUT request, got %s", r.Method)
 		}
-		if r.URL.Path != "/api/v1/ldap/config" {
-			t.Errorf("Expected path /api/v1/ldap/config, got %s", r.URL.Path)
+		if r.URL.Path != "/rest/ldap/config" {
+			t.Errorf("Expected path /rest/ldap/config, got %s", r.URL.Path)
 		}
 
 		// Verify request body
@@ -110,7 +110,7 @@ func TestClient_UpdateLDAPConfig(t *testing.T) {
 		}
 
 		w.Header().Set("Content-Type", "application/json")
-		_ = json.NewEncoder(w).Encode(mockResponse)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": mockResponse})
 	}))
 	defer server.Close()
 
@@ -149,12 +149,12 @@ func TestClient_TestLDAPConnection(t *testing.T) {
 		if r.Method != "POST" {
 			t.Errorf("Expected POST request, got %s", r.Method)
 		}
-		if r.URL.Path != "/api/v1/ldap/test" {
-			t.Errorf("Expected path /api/v1/ldap/test, got %s", r.URL.Path)
+		if r.URL.Path != "/rest/ldap/test" {
+			t.Errorf("Expected path /rest/ldap/test, got %s", r.URL.Path)
 		}
 
 		w.Header().Set("Content-Type", "application/json")
-		_ = json.NewEncoder(w).Encode(mockResult)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": mockResult})
 	}))
 	defer server.Close()
 
@@ -199,8 +199,8 @@ func TestClient_TestLDAPConnectionWithConfig(t *testing.T) {
 		if r.Method != "POST" {
 			t.Errorf("Expected POST request, got %s", r.Method)
 		}
-		if r.URL.Path != "/api/v1/ldap/test" {
-			t.Errorf("Expected path /api/v1/ldap/test, got %s", r.URL.Path)
+		if r.URL.Path != "/rest/ldap/test" {
+			t.Errorf("Expected path /rest/ldap/test, got %s", r.URL.Path)
 		}
 
 		// Verify request body
@@ -213,7 +213,7 @@ func TestClient_TestLDAPConnectionWithConfig(t *testing.T) {
 		}
 
 		w.Header().Set("Content-Type", "application/json")
-		_ = json.NewEncoder(w).Encode(mockResult)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": mockResult})
 	}))
 	defer server.Close()
 
@@ -283,3 +283,86 @@ func TestClient_UpdateLDAPConfig_ValidationErrors(t *testing.T) {
 		t.Error("Expected error for missing bind password, got nil")
 	}
 }
+
+func TestClient_SyncLDAP(t *testing.T) {
+	// Create test server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("Expected POST request, got %s", r.Method)
+		}
+		if r.URL.Path != "/rest/ldap/sync" {
+			t.Errorf("Expected path /rest/ldap/sync, got %s", r.URL.Path)
+		}
+
+		// Verify request body
+		var requestBody LDAPSyncRequest
+		if err := json.NewDecoder(r.Body).Decode(&requestBody); err != nil {
+			t.Errorf("Failed to decode request body: %v", err)
+		}
+		if requestBody.Type != LDAPSyncModeDry {
+			t.Errorf("Expected sync type 'dry', got '%s'", requestBody.Type)
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	if err := client.SyncLDAP(LDAPSyncModeDry); err != nil {
+		t.Fatalf("SyncLDAP failed: %v", err)
+	}
+
+	if err := client.SyncLDAP("full"); err == nil {
+		t.Error("Expected error for invalid sync mode, got nil")
+	}
+}
+
+func TestClient_GetLastLDAPSyncRun(t *testing.T) {
+	responses := []string{
+		`{"data": []}`,
+		`{"data": [
+			{"id": 1, "runMode": "live", "status": "success", "scanned": 10, "created": 2},
+			{"id": 3, "runMode": "dry", "status": "error", "error": "Invalid credentials", "startedAt": "2024-01-02T10:00:00Z"},
+			{"id": 2, "runMode": "live", "status": "success"}
+		]}`,
+	}
+	requests := 0
+
+	// Create test server
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if r.URL.Path != "/rest/ldap/sync" {
+			t.Errorf("Expected path /rest/ldap/sync, got %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(responses[requests]))
+		requests++
+	}))
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	// No sync has run yet
+	run, err := client.GetLastLDAPSyncRun()
+	if err != nil {
+		t.Fatalf("GetLastLDAPSyncRun failed: %v", err)
+	}
+	if run != nil {
+		t.Errorf("Expected no sync run, got %+v", run)
+	}
+
+	run, err = client.GetLastLDAPSyncRun()
+	if err != nil {
+		t.Fatalf("GetLastLDAPSyncRun failed: %v", err)
+	}
+	if run == nil || run.ID != 3 {
+		t.Fatalf("Expected the run with the highest ID, got %+v", run)
+	}
+	if run.Status != "error" || run.Error != "Invalid credentials" || run.StartedAt == nil {
+		t.Errorf("Unexpected sync run details: %+v", run)
+	}
+}
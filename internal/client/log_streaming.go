@@ -0,0 +1,106 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Log streaming destination types, as n8n identifies them in the __type field
+const (
+	LogStreamingTypeWebhook = "$$MessageEventBusDestinationWebhook"
+	LogStreamingTypeSentry  = "$$MessageEventBusDestinationSentry"
+	LogStreamingTypeSyslog  = "$$MessageEventBusDestinationSyslog"
+)
+
+// LogStreamingDestination represents a destination n8n streams its event log to (Enterprise feature).
+// Which of the type-specific fields apply depends on Type.
+type LogStreamingDestination struct {
+	ID                     string   `json:"id,omitempty"`
+	Type                   string   `json:"__type"`
+	Label                  string   `json:"label"`
+	Enabled                bool     `json:"enabled"`
+	SubscribedEvents       []string `json:"subscribedEvents"`
+	AnonymizeAuditMessages bool     `json:"anonymizeAuditMessages"`
+
+	// Webhook destinations
+	URL              string                  `json:"url,omitempty"`
+	Method           string                  `json:"method,omitempty"`
+	SendHeaders      bool                    `json:"sendHeaders,omitempty"`
+	HeaderParameters *LogStreamingParameters `json:"headerParameters,omitempty"`
+
+	// Sentry destinations
+	DSN string `json:"dsn,omitempty"`
+
+	// Syslog destinations
+	Host     string `json:"host,omitempty"`
+	Port     int    `json:"port,omitempty"`
+	Protocol string `json:"protocol,omitempty"`
+	Facility *int   `json:"facility,omitempty"`
+	AppName  string `json:"app_name,omitempty"`
+}
+
+// LogStreamingParameters holds the name/value pairs a webhook destination sends, e.g. as headers
+type LogStreamingParameters struct {
+	Parameters []LogStreamingParameter `json:"parameters"`
+}
+
+// LogStreamingParameter is a single name/value pair of a webhook destination
+type LogStreamingParameter struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// GetLogStreamingDestination retrieves a log streaming destination. Log streaming is only available on the
+// internal API and requires session authentication.
+func (c *Client) GetLogStreamingDestination(id string) (*LogStreamingDestination, error) {
+	if id == "" {
+		return nil, fmt.Errorf("log streaming destination ID is required")
+	}
+
+	var result []LogStreamingDestination
+	path := "eventbus/destination?id=" + url.QueryEscape(id)
+	if err := c.doInternalRequest("GET", path, nil, &result); err != nil {
+		return nil, fmt.Errorf("failed to get log streaming destination %s: %w", id, err)
+	}
+
+	for _, destination := range result {
+		if destination.ID == id {
+			return &destination, nil
+		}
+	}
+
+	return nil, &APIError{Code: http.StatusNotFound, Message: fmt.Sprintf("log streaming destination %s not found", id)}
+}
+
+// SaveLogStreamingDestination creates a log streaming destination, or replaces the destination with the
+// same ID. n8n assigns an ID to destinations without one. Requires session authentication.
+func (c *Client) SaveLogStreamingDestination(destination *LogStreamingDestination) (*LogStreamingDestination, error) {
+	if destination == nil {
+		return nil, fmt.Errorf("log streaming destination is required")
+	}
+
+	if destination.Type == "" {
+		return nil, fmt.Errorf("log streaming destination type is required")
+	}
+
+	var result LogStreamingDestination
+	if err := c.doInternalRequest("POST", "eventbus/destination", destination, &result); err != nil {
+		return nil, fmt.Errorf("failed to save log streaming destination: %w", err)
+	}
+
+	return &result, nil
+}
+
+// DeleteLogStreamingDestination deletes a log streaming destination. Requires session authentication.
+func (c *Client) DeleteLogStreamingDestination(id string) error {
+	if id == "" {
+		return fmt.Errorf("log streaming destination ID is required")
+	}
+
+	if err := c.doInternalRequest("DELETE", "eventbus/destination?id="+url.QueryEscape(id), nil, nil); err != nil {
+		return fmt.Errorf("failed to delete log streaming destination %s: %w", id, err)
+	}
+
+	return nil
+}
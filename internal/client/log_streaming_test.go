@@ -0,0 +1,98 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient_GetLogStreamingDestination(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" || r.URL.Path != "/rest/eventbus/destination" || r.URL.Query().Get("id") != "dest-1" {
+			t.Errorf("Expected GET /rest/eventbus/destination?id=dest-1, got %s %s", r.Method, r.URL)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": [{"id": "dest-1", "__type": "$$MessageEventBusDestinationSyslog", "label": "SIEM",
+			"enabled": true, "subscribedEvents": ["n8n.audit"], "host": "siem.example.com", "port": 6514,
+			"protocol": "tls", "facility": 16, "app_name": "n8n"}]}`))
+	}))
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	destination, err := client.GetLogStreamingDestination("dest-1")
+	if err != nil {
+		t.Fatalf("GetLogStreamingDestination() error = %v", err)
+	}
+
+	if destination.Type != LogStreamingTypeSyslog || destination.Host != "siem.example.com" || destination.Port != 6514 ||
+		destination.Facility == nil || *destination.Facility != 16 {
+		t.Errorf("Unexpected destination: %+v", destination)
+	}
+}
+
+func TestClient_GetLogStreamingDestinationNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": []}`))
+	}))
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	if _, err := client.GetLogStreamingDestination("missing"); !IsNotFound(err) {
+		t.Errorf("Expected a not found error, got %v", err)
+	}
+}
+
+func TestClient_SaveAndDeleteLogStreamingDestination(t *testing.T) {
+	var requests []string
+	var saved map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.RequestURI())
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Method == "POST" {
+			if err := json.NewDecoder(r.Body).Decode(&saved); err != nil {
+				t.Fatalf("Failed to decode request body: %v", err)
+			}
+			saved["id"] = "dest-1"
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": saved})
+		}
+	}))
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	destination, err := client.SaveLogStreamingDestination(&LogStreamingDestination{
+		Type:             LogStreamingTypeSentry,
+		Label:            "Errors",
+		Enabled:          true,
+		SubscribedEvents: []string{"n8n.workflow.failed"},
+		DSN:              "https://key@sentry.example.com/1",
+	})
+	if err != nil {
+		t.Fatalf("SaveLogStreamingDestination() error = %v", err)
+	}
+	if destination.ID != "dest-1" || destination.DSN != "https://key@sentry.example.com/1" {
+		t.Errorf("Expected the saved destination, got %+v", destination)
+	}
+	if _, ok := saved["host"]; ok || saved["__type"] != LogStreamingTypeSentry {
+		t.Errorf("Expected only the fields of a Sentry destination to be sent, got %v", saved)
+	}
+
+	if err := client.DeleteLogStreamingDestination("dest-1"); err != nil {
+		t.Fatalf("DeleteLogStreamingDestination() error = %v", err)
+	}
+
+	expected := []string{"POST /rest/eventbus/destination", "DELETE /rest/eventbus/destination?id=dest-1"}
+	if len(requests) != 2 || requests[0] != expected[0] || requests[1] != expected[1] {
+		t.Errorf("Expected %v, got %v", expected, requests)
+	}
+
+	if _, err := client.SaveLogStreamingDestination(&LogStreamingDestination{Label: "Untyped"}); err == nil {
+		t.Error("Expected error for a destination without a type")
+	}
+}
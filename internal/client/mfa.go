@@ -0,0 +1,31 @@
+package client
+
+import (
+	"fmt"
+)
+
+// enforceMFARequest is the request body of the internal MFA enforcement endpoint
+type enforceMFARequest struct {
+	Enforce bool `json:"enforce"`
+}
+
+// IsMFAEnforced reports whether the instance requires all users to set up multi-factor authentication
+// before they can use n8n. It is read from the editor settings, which do not require authentication.
+func (c *Client) IsMFAEnforced() (bool, error) {
+	var settings frontendSettings
+	if err := c.doRESTRequest("GET", "settings", nil, &settings); err != nil {
+		return false, fmt.Errorf("failed to get MFA enforcement: %w", err)
+	}
+
+	return settings.MFA.Enforced, nil
+}
+
+// EnforceMFA requires all users of the instance to set up multi-factor authentication, or lifts that
+// requirement. Requires session authentication as the owner or an admin.
+func (c *Client) EnforceMFA(enforce bool) error {
+	if err := c.doInternalRequest("POST", "mfa/enforce-mfa", &enforceMFARequest{Enforce: enforce}, nil); err != nil {
+		return fmt.Errorf("failed to set MFA enforcement: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestClient_IsMFAEnforced(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		expected bool
+	}{
+		{name: "enforced", response: `{"data": {"mfa": {"enabled": true, "enforced": true}}}`, expected: true},
+		{name: "not enforced", response: `{"data": {"mfa": {"enabled": true, "enforced": false}}}`, expected: false},
+		{name: "older instance", response: `{"data": {"versionCli": "1.50.0"}}`, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := TestServer(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != "GET" || r.URL.Path != "/rest/settings" {
+					t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(tt.response))
+			})
+			defer server.Close()
+
+			client := CreateTestClient(t, server.URL)
+
+			enforced, err := client.IsMFAEnforced()
+			if err != nil {
+				t.Fatalf("IsMFAEnforced() error = %v", err)
+			}
+			if enforced != tt.expected {
+				t.Errorf("IsMFAEnforced() = %v, want %v", enforced, tt.expected)
+			}
+		})
+	}
+}
+
+func TestClient_EnforceMFA(t *testing.T) {
+	var requests []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/rest/mfa/enforce-mfa" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Failed to decode request body: %v", err)
+		}
+		requests = append(requests, body)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": {}}`))
+	}))
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	for _, enforce := range []bool{true, false} {
+		if err := client.EnforceMFA(enforce); err != nil {
+			t.Fatalf("EnforceMFA(%v) error = %v", enforce, err)
+		}
+	}
+
+	expected := []map[string]interface{}{{"enforce": true}, {"enforce": false}}
+	if !reflect.DeepEqual(requests, expected) {
+		t.Errorf("Expected requests %v, got %v", expected, requests)
+	}
+}
@@ -0,0 +1,151 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Packages of the nodes that ship with n8n. Nodes of other packages are community nodes.
+var builtinNodePackages = []string{"n8n-nodes-base", "@n8n/n8n-nodes-langchain"}
+
+// NodeType describes a node type installed on the n8n instance
+type NodeType struct {
+	Name           string    `json:"name"`
+	DisplayName    string    `json:"displayName"`
+	Versions       []float64 `json:"-"`
+	DefaultVersion float64   `json:"defaultVersion,omitempty"`
+}
+
+// nodeTypeFields is an alias of NodeType without its JSON methods
+type nodeTypeFields NodeType
+
+// UnmarshalJSON decodes a node type, whose version is either a single number or a list of numbers
+func (n *NodeType) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		nodeTypeFields
+		Version json.RawMessage `json:"version"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	*n = NodeType(raw.nodeTypeFields)
+
+	if len(raw.Version) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(raw.Version, &n.Versions); err == nil {
+		return nil
+	}
+
+	var version float64
+	if err := json.Unmarshal(raw.Version, &version); err != nil {
+		return fmt.Errorf("invalid version of node type %s: %w", n.Name, err)
+	}
+	n.Versions = []float64{version}
+	return nil
+}
+
+// Package returns the npm package the node type belongs to, e.g. 'n8n-nodes-base'
+func (n *NodeType) Package() string {
+	if i := strings.LastIndex(n.Name, "."); i >= 0 {
+		return n.Name[:i]
+	}
+	return ""
+}
+
+// IsCommunity reports whether the node type belongs to a community package rather than to n8n itself
+func (n *NodeType) IsCommunity() bool {
+	pkg := n.Package()
+	for _, builtin := range builtinNodePackages {
+		if pkg == builtin {
+			return false
+		}
+	}
+	return true
+}
+
+// LatestVersion returns the highest version of the node type
+func (n *NodeType) LatestVersion() float64 {
+	var latest float64
+	for _, version := range n.Versions {
+		if version > latest {
+			latest = version
+		}
+	}
+	return latest
+}
+
+// GetNodeTypes retrieves the node types installed on the instance, sorted by name. They are read from the
+// type definitions the editor loads, which require session authentication.
+func (c *Client) GetNodeTypes() ([]NodeType, error) {
+	var definitions []NodeType
+	if err := c.getTypeDefinitions("nodes", &definitions); err != nil {
+		return nil, fmt.Errorf("failed to get node types: %w", err)
+	}
+
+	return mergeNodeTypes(definitions), nil
+}
+
+// mergeNodeTypes combines the definitions of a node type that n8n lists once per version
+func mergeNodeTypes(definitions []NodeType) []NodeType {
+	indexes := make(map[string]int, len(definitions))
+	var nodeTypes []NodeType
+	for _, definition := range definitions {
+		i, ok := indexes[definition.Name]
+		if !ok {
+			indexes[definition.Name] = len(nodeTypes)
+			nodeTypes = append(nodeTypes, definition)
+			continue
+		}
+
+		existing := &nodeTypes[i]
+
+		for _, version := range definition.Versions {
+			if !containsVersion(existing.Versions, version) {
+				existing.Versions = append(existing.Versions, version)
+			}
+		}
+		if definition.DefaultVersion > existing.DefaultVersion {
+			existing.DefaultVersion = definition.DefaultVersion
+		}
+	}
+
+	for i := range nodeTypes {
+		sort.Float64s(nodeTypes[i].Versions)
+	}
+	sort.Slice(nodeTypes, func(i, j int) bool { return nodeTypes[i].Name < nodeTypes[j].Name })
+
+	return nodeTypes
+}
+
+// containsVersion reports whether versions contains version
+func containsVersion(versions []float64, version float64) bool {
+	for _, v := range versions {
+		if v == version {
+			return true
+		}
+	}
+	return false
+}
+
+// getTypeDefinitions reads the node or credential type definitions the editor loads from
+// /types/<kind>.json into result
+func (c *Client) getTypeDefinitions(kind string, result any) error {
+	if err := c.ensureSession(); err != nil {
+		return fmt.Errorf("failed to establish session: %w", err)
+	}
+
+	body, err := c.doInstanceRequest(context.Background(), "GET", fmt.Sprintf("types/%s.json", kind), nil)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(body, result); err != nil {
+		return fmt.Errorf("failed to decode %s types: %w", kind, err)
+	}
+
+	return nil
+}
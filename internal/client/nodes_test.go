@@ -0,0 +1,56 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient_GetNodeTypes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/types/nodes.json" {
+			t.Errorf("Expected path /types/nodes.json, got %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[
+			{"name": "n8n-nodes-base.slack", "displayName": "Slack", "version": [2, 2.1, 2.2], "defaultVersion": 2.2},
+			{"name": "n8n-nodes-base.slack", "displayName": "Slack", "version": 1},
+			{"name": "@acme/n8n-nodes-crm.contact", "displayName": "Contact", "version": 1},
+			{"name": "@n8n/n8n-nodes-langchain.agent", "displayName": "AI Agent", "version": 1.7}
+		]`))
+	}))
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	nodeTypes, err := client.GetNodeTypes()
+	if err != nil {
+		t.Fatalf("GetNodeTypes failed: %v", err)
+	}
+
+	if len(nodeTypes) != 3 {
+		t.Fatalf("Expected 3 node types, got %d: %+v", len(nodeTypes), nodeTypes)
+	}
+
+	contact, agent, slack := nodeTypes[0], nodeTypes[1], nodeTypes[2]
+	if contact.Name != "@acme/n8n-nodes-crm.contact" || !contact.IsCommunity() || contact.Package() != "@acme/n8n-nodes-crm" {
+		t.Errorf("Expected a community node first, got %+v", contact)
+	}
+	if agent.IsCommunity() || agent.LatestVersion() != 1.7 {
+		t.Errorf("Expected a built-in langchain node at version 1.7, got %+v", agent)
+	}
+
+	expectedVersions := []float64{1, 2, 2.1, 2.2}
+	if len(slack.Versions) != len(expectedVersions) {
+		t.Fatalf("Expected Slack versions %v, got %v", expectedVersions, slack.Versions)
+	}
+	for i, version := range expectedVersions {
+		if slack.Versions[i] != version {
+			t.Errorf("Expected Slack versions %v, got %v", expectedVersions, slack.Versions)
+		}
+	}
+	if slack.IsCommunity() || slack.LatestVersion() != 2.2 || slack.DefaultVersion != 2.2 {
+		t.Errorf("Unexpected Slack node type: %+v", slack)
+	}
+}
@@ -0,0 +1,78 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// OwnerSetupRequest represents the request body for setting up the instance owner
+type OwnerSetupRequest struct {
+	Email     string `json:"email"`
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+	Password  string `json:"password"`
+}
+
+// frontendSettings holds the parts of the n8n editor settings used by the client
+type frontendSettings struct {
+	VersionCli     string `json:"versionCli"`
+	UserManagement struct {
+		ShowSetupOnFirstLoad bool `json:"showSetupOnFirstLoad"`
+	} `json:"userManagement"`
+	License struct {
+		PlanName string `json:"planName"`
+	} `json:"license"`
+	PublicAPI struct {
+		Enabled bool `json:"enabled"`
+	} `json:"publicApi"`
+	Enterprise map[string]json.RawMessage `json:"enterprise"`
+	MFA        struct {
+		Enabled  bool `json:"enabled"`
+		Enforced bool `json:"enforced"`
+	} `json:"mfa"`
+	// Pruning and Telemetry are nil on instances that do not report them
+	Pruning *struct {
+		IsEnabled bool `json:"isEnabled"`
+		MaxAge    int  `json:"maxAge"`
+		MaxCount  int  `json:"maxCount"`
+	} `json:"pruning"`
+	Telemetry *struct {
+		Enabled bool `json:"enabled"`
+	} `json:"telemetry"`
+	Banners struct {
+		Dismissed []string `json:"dismissed"`
+	} `json:"banners"`
+}
+
+// IsOwnerSetUp reports whether the owner account of the n8n instance has been created
+func (c *Client) IsOwnerSetUp() (bool, error) {
+	var settings frontendSettings
+	if err := c.doRESTRequest("GET", "settings", nil, &settings); err != nil {
+		return false, fmt.Errorf("failed to get instance settings: %w", err)
+	}
+
+	return !settings.UserManagement.ShowSetupOnFirstLoad, nil
+}
+
+// SetupOwner creates the owner account of a fresh n8n instance. This only succeeds once;
+// afterwards the instance rejects further setup requests.
+func (c *Client) SetupOwner(req *OwnerSetupRequest) (*User, error) {
+	if req == nil {
+		return nil, fmt.Errorf("owner setup request is required")
+	}
+
+	if req.Email == "" {
+		return nil, fmt.Errorf("owner email is required")
+	}
+
+	if req.Password == "" {
+		return nil, fmt.Errorf("owner password is required")
+	}
+
+	var user User
+	if err := c.doRESTRequest("POST", "owner/setup", req, &user); err != nil {
+		return nil, fmt.Errorf("failed to set up instance owner: %w", err)
+	}
+
+	return &user, nil
+}
@@ -0,0 +1,103 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestClient_IsOwnerSetUp(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		expected bool
+	}{
+		{name: "fresh instance", response: `{"data": {"userManagement": {"showSetupOnFirstLoad": true}}}`, expected: false},
+		{name: "owner set up", response: `{"data": {"userManagement": {"showSetupOnFirstLoad": false}}}`, expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := TestServer(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != "GET" {
+					t.Errorf("Expected GET request, got %s", r.Method)
+				}
+				if r.URL.Path != "/rest/settings" {
+					t.Errorf("Expected path /rest/settings, got %s", r.URL.Path)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(tt.response))
+			})
+			defer server.Close()
+
+			client := CreateTestClient(t, server.URL)
+
+			setUp, err := client.IsOwnerSetUp()
+			if err != nil {
+				t.Fatalf("IsOwnerSetUp() error = %v", err)
+			}
+			if setUp != tt.expected {
+				t.Errorf("IsOwnerSetUp() = %v, want %v", setUp, tt.expected)
+			}
+		})
+	}
+}
+
+func TestClient_SetupOwner(t *testing.T) {
+	server := TestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("Expected POST request, got %s", r.Method)
+		}
+		if r.URL.Path != "/rest/owner/setup" {
+			t.Errorf("Expected path /rest/owner/setup, got %s", r.URL.Path)
+		}
+
+		var req OwnerSetupRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request body: %v", err)
+		}
+		if req.Email != "owner@example.com" || req.FirstName != "Jane" || req.Password != "Secret123" {
+			t.Errorf("Unexpected setup request: %+v", req)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"data": User{ID: "owner-1", Email: req.Email, FirstName: req.FirstName, LastName: req.LastName, Role: "global:owner"},
+		})
+	})
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	user, err := client.SetupOwner(&OwnerSetupRequest{
+		Email:     "owner@example.com",
+		FirstName: "Jane",
+		LastName:  "Doe",
+		Password:  "Secret123",
+	})
+	if err != nil {
+		t.Fatalf("SetupOwner() error = %v", err)
+	}
+	if user.ID != "owner-1" || user.Role != "global:owner" {
+		t.Errorf("Unexpected owner returned: %+v", user)
+	}
+}
+
+func TestClient_SetupOwner_AlreadySetUp(t *testing.T) {
+	server := TestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"code": 400, "message": "Instance owner already setup"}`))
+	})
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	_, err := client.SetupOwner(&OwnerSetupRequest{Email: "owner@example.com", Password: "Secret123"})
+	if err == nil {
+		t.Fatal("Expected an error when the owner is already set up")
+	}
+
+	if _, err := client.SetupOwner(&OwnerSetupRequest{Email: "owner@example.com"}); err == nil {
+		t.Error("Expected an error for a missing password")
+	}
+}
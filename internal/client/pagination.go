@@ -0,0 +1,202 @@
+package client
+
+import (
+	"fmt"
+	"iter"
+	"net/url"
+	"strconv"
+)
+
+// DefaultPageSize is the page size used when paginating list endpoints without an explicit limit
+const DefaultPageSize = 100
+
+// MaxPageSize is the largest page size accepted by the n8n public API
+const MaxPageSize = 250
+
+// Page represents a single page of a cursor-paginated list response
+type Page[T any] struct {
+	Data       []T    `json:"data"`
+	NextCursor string `json:"nextCursor,omitempty"`
+}
+
+// Paginate returns an iterator over every item of a cursor-paginated list endpoint.
+// Pages are fetched lazily, following nextCursor until it is empty. Iteration stops
+// after yielding the first error.
+func Paginate[T any](c *Client, path string, params url.Values, pageSize int) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		cursor := ""
+
+		for {
+			page, err := fetchPage[T](c, path, params, pageSize, cursor)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range page.Data {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			// Guard against endpoints that keep returning the same cursor
+			if page.NextCursor == "" || page.NextCursor == cursor {
+				return
+			}
+			cursor = page.NextCursor
+		}
+	}
+}
+
+// ListAll fetches every item of a cursor-paginated list endpoint
+func ListAll[T any](c *Client, path string, params url.Values, pageSize int) ([]T, error) {
+	var items []T
+
+	for item, err := range Paginate[T](c, path, params, pageSize) {
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// fetchPage retrieves a single page of a list endpoint starting at cursor
+func fetchPage[T any](c *Client, path string, params url.Values, pageSize int, cursor string) (*Page[T], error) {
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	if pageSize > MaxPageSize {
+		pageSize = MaxPageSize
+	}
+
+	query := url.Values{}
+	for key, values := range params {
+		query[key] = append([]string(nil), values...)
+	}
+	query.Set("limit", strconv.Itoa(pageSize))
+	if cursor != "" {
+		query.Set("cursor", cursor)
+	}
+
+	var page Page[T]
+	if err := c.Get(path+"?"+query.Encode(), &page); err != nil {
+		return nil, fmt.Errorf("failed to list %s: %w", path, err)
+	}
+
+	return &page, nil
+}
+
+// GetAllWorkflows retrieves every workflow matching the options, following pagination.
+// options.Limit sets the page size; options.Offset is ignored.
+func (c *Client) GetAllWorkflows(options *WorkflowListOptions) ([]Workflow, error) {
+	params, pageSize := workflowListParams(options)
+
+	var workflows []Workflow
+	for workflow, err := range Paginate[Workflow](c, "workflows", params, pageSize) {
+		if err != nil {
+			return nil, err
+		}
+		if options.includes(workflow) {
+			workflows = append(workflows, workflow)
+		}
+	}
+	return workflows, nil
+}
+
+// WorkflowList is a listing of workflows that may stop before every matching workflow is returned
+type WorkflowList struct {
+	Workflows []Workflow
+	// Total is the number of matching workflows, including those that were not returned
+	Total int
+}
+
+// ListWorkflows retrieves up to maxItems workflows matching the options, following pagination, or every
+// matching workflow if maxItems is not positive. The remaining pages are still read to count the matching
+// workflows, since n8n does not report the total. options.Limit sets the page size; options.Offset is ignored.
+func (c *Client) ListWorkflows(options *WorkflowListOptions, maxItems int) (*WorkflowList, error) {
+	params, pageSize := workflowListParams(options)
+
+	result := &WorkflowList{Workflows: []Workflow{}}
+	for workflow, err := range Paginate[Workflow](c, "workflows", params, pageSize) {
+		if err != nil {
+			return nil, err
+		}
+		if !options.includes(workflow) {
+			continue
+		}
+		if maxItems <= 0 || len(result.Workflows) < maxItems {
+			result.Workflows = append(result.Workflows, workflow)
+		}
+		result.Total++
+	}
+
+	return result, nil
+}
+
+// includes reports whether workflow is listed with the options. Archived workflows are only listed with
+// IncludeArchived.
+func (o *WorkflowListOptions) includes(workflow Workflow) bool {
+	return !workflow.IsArchived || (o != nil && o.IncludeArchived)
+}
+
+// workflowListParams returns the query parameters and page size for listing workflows with options
+func workflowListParams(options *WorkflowListOptions) (url.Values, int) {
+	params := url.Values{}
+	if options == nil {
+		return params, 0
+	}
+
+	if options.Active != nil {
+		params.Set("active", strconv.FormatBool(*options.Active))
+	}
+	for _, tag := range options.Tags {
+		params.Add("tags", tag)
+	}
+	if options.ProjectID != "" {
+		params.Set("projectId", options.ProjectID)
+	}
+	return params, options.Limit
+}
+
+// GetAllUsers retrieves every user matching the options, following pagination.
+// options.Limit sets the page size; options.Offset and options.Cursor are ignored.
+func (c *Client) GetAllUsers(options *UserListOptions) ([]User, error) {
+	params := url.Values{}
+	pageSize := 0
+
+	if options != nil {
+		if options.Role != "" {
+			params.Set("role", options.Role)
+		}
+		pageSize = options.Limit
+	}
+
+	return ListAll[User](c, "users", params, pageSize)
+}
+
+// GetAllCredentials retrieves every credential matching the options, following pagination.
+// options.Limit sets the page size; options.Offset is ignored.
+func (c *Client) GetAllCredentials(options *CredentialListOptions) ([]Credential, error) {
+	params := url.Values{}
+	pageSize := 0
+
+	if options != nil {
+		if options.Type != "" {
+			params.Set("type", options.Type)
+		}
+		if options.ProjectID != "" {
+			params.Set("projectId", options.ProjectID)
+		}
+		pageSize = options.Limit
+	}
+
+	return ListAll[Credential](c, "credentials", params, pageSize)
+}
+
+// GetAllVariables retrieves every variable of the instance, global and project-scoped, following pagination
+func (c *Client) GetAllVariables() ([]Variable, error) {
+	return ListAll[Variable](c, "variables", nil, 0)
+}
@@ -2,6 +2,7 @@ package client
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -460,3 +461,240 @@ func TestClient_GetWithPagination_NonMapResult(t *testing.T) {
 		t.Errorf("Expected Total to be 0, got %d", pagination.Total)
 	}
 }
+
+// cursorPagesHandler serves the given pages in order, linking them with nextCursor values
+func cursorPagesHandler(t *testing.T, expectedPath string, pages [][]map[string]interface{}) http.HandlerFunc {
+	t.Helper()
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != expectedPath {
+			t.Errorf("Expected path %s, got %s", expectedPath, r.URL.Path)
+		}
+
+		index := 0
+		if cursor := r.URL.Query().Get("cursor"); cursor != "" {
+			if _, err := fmt.Sscanf(cursor, "page-%d", &index); err != nil {
+				t.Errorf("Unexpected cursor %q", cursor)
+			}
+		}
+
+		response := map[string]interface{}{"data": pages[index]}
+		if index+1 < len(pages) {
+			response["nextCursor"] = fmt.Sprintf("page-%d", index+1)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(response)
+	}
+}
+
+func TestClient_GetAllWorkflows(t *testing.T) {
+	var limits []string
+	pages := [][]map[string]interface{}{
+		{{"id": "1", "name": "first"}, {"id": "2", "name": "second"}},
+		{{"id": "3", "name": "third"}},
+	}
+	handler := cursorPagesHandler(t, "/api/v1/workflows", pages)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		limits = append(limits, r.URL.Query().Get("limit"))
+		if r.URL.Query().Get("active") != "true" {
+			t.Errorf("Expected active filter on every page, got query %q", r.URL.RawQuery)
+		}
+		handler(w, r)
+	}))
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	active := true
+	workflows, err := client.GetAllWorkflows(&WorkflowListOptions{Active: &active, Limit: 2})
+	if err != nil {
+		t.Fatalf("GetAllWorkflows() error = %v", err)
+	}
+
+	if len(workflows) != 3 {
+		t.Fatalf("Expected 3 workflows, got %d", len(workflows))
+	}
+	if workflows[2].ID != "3" {
+		t.Errorf("Expected last workflow ID '3', got %q", workflows[2].ID)
+	}
+	if len(limits) != 2 || limits[0] != "2" {
+		t.Errorf("Expected 2 requests with limit 2, got %v", limits)
+	}
+}
+
+func TestClient_ListWorkflows(t *testing.T) {
+	pages := [][]map[string]interface{}{
+		{{"id": "1", "name": "first"}, {"id": "2", "name": "second"}},
+		{{"id": "3", "name": "third"}},
+	}
+	server := httptest.NewServer(cursorPagesHandler(t, "/api/v1/workflows", pages))
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	// Workflows beyond maxItems are counted but not returned
+	list, err := client.ListWorkflows(&WorkflowListOptions{Limit: 2}, 2)
+	if err != nil {
+		t.Fatalf("ListWorkflows() error = %v", err)
+	}
+	if len(list.Workflows) != 2 || list.Workflows[1].ID != "2" {
+		t.Errorf("Expected the first 2 workflows, got %+v", list.Workflows)
+	}
+	if list.Total != 3 {
+		t.Errorf("Expected a total of 3 workflows, got %d", list.Total)
+	}
+
+	list, err = client.ListWorkflows(nil, 0)
+	if err != nil {
+		t.Fatalf("ListWorkflows() error = %v", err)
+	}
+	if len(list.Workflows) != 3 || list.Total != 3 {
+		t.Errorf("Expected every workflow without a maximum, got %d of %d", len(list.Workflows), list.Total)
+	}
+}
+
+func TestClient_ListWorkflowsArchived(t *testing.T) {
+	pages := [][]map[string]interface{}{
+		{{"id": "1", "name": "live"}, {"id": "2", "name": "old", "isArchived": true}},
+		{{"id": "3", "name": "other"}},
+	}
+	server := httptest.NewServer(cursorPagesHandler(t, "/api/v1/workflows", pages))
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	// Archived workflows are neither returned nor counted by default
+	list, err := client.ListWorkflows(nil, 0)
+	if err != nil {
+		t.Fatalf("ListWorkflows() error = %v", err)
+	}
+	if len(list.Workflows) != 2 || list.Total != 2 || list.Workflows[1].ID != "3" {
+		t.Errorf("Expected the 2 live workflows, got %+v of %d", list.Workflows, list.Total)
+	}
+
+	list, err = client.ListWorkflows(&WorkflowListOptions{IncludeArchived: true}, 0)
+	if err != nil {
+		t.Fatalf("ListWorkflows() error = %v", err)
+	}
+	if len(list.Workflows) != 3 || !list.Workflows[1].IsArchived {
+		t.Errorf("Expected every workflow with IncludeArchived, got %+v", list.Workflows)
+	}
+
+	workflows, err := client.GetAllWorkflows(&WorkflowListOptions{})
+	if err != nil {
+		t.Fatalf("GetAllWorkflows() error = %v", err)
+	}
+	if len(workflows) != 2 {
+		t.Errorf("Expected the 2 live workflows, got %+v", workflows)
+	}
+}
+
+func TestClient_GetAllUsers(t *testing.T) {
+	pages := [][]map[string]interface{}{
+		{{"id": "u1", "email": "one@example.com"}},
+		{{"id": "u2", "email": "two@example.com"}},
+		{{"id": "u3", "email": "three@example.com"}},
+	}
+	server := httptest.NewServer(cursorPagesHandler(t, "/api/v1/users", pages))
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	users, err := client.GetAllUsers(nil)
+	if err != nil {
+		t.Fatalf("GetAllUsers() error = %v", err)
+	}
+
+	if len(users) != 3 {
+		t.Fatalf("Expected 3 users, got %d", len(users))
+	}
+}
+
+func TestClient_GetAllCredentials(t *testing.T) {
+	pages := [][]map[string]interface{}{
+		{{"id": "c1", "name": "one", "type": "httpBasicAuth"}},
+		{{"id": "c2", "name": "two", "type": "httpBasicAuth"}},
+	}
+	server := httptest.NewServer(cursorPagesHandler(t, "/api/v1/credentials", pages))
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	credentials, err := client.GetAllCredentials(&CredentialListOptions{Type: "httpBasicAuth"})
+	if err != nil {
+		t.Fatalf("GetAllCredentials() error = %v", err)
+	}
+
+	if len(credentials) != 2 {
+		t.Fatalf("Expected 2 credentials, got %d", len(credentials))
+	}
+}
+
+func TestClient_GetAllVariables(t *testing.T) {
+	pages := [][]map[string]interface{}{
+		{{"id": "v1", "key": "BASE_URL", "value": "https://example.com"}},
+		{{"id": "v2", "key": "API_TOKEN", "value": "secret", "projectId": "p1"}},
+	}
+	server := httptest.NewServer(cursorPagesHandler(t, "/api/v1/variables", pages))
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	variables, err := client.GetAllVariables()
+	if err != nil {
+		t.Fatalf("GetAllVariables() error = %v", err)
+	}
+
+	if len(variables) != 2 {
+		t.Fatalf("Expected 2 variables, got %d", len(variables))
+	}
+	if variables[1].ProjectID != "p1" {
+		t.Errorf("Expected the second variable to be scoped to p1, got %q", variables[1].ProjectID)
+	}
+}
+
+func TestPaginate_StopsEarly(t *testing.T) {
+	requests := 0
+	pages := [][]map[string]interface{}{
+		{{"id": "1"}, {"id": "2"}},
+		{{"id": "3"}},
+	}
+	handler := cursorPagesHandler(t, "/api/v1/workflows", pages)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		handler(w, r)
+	}))
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	for workflow, err := range Paginate[Workflow](client, "workflows", nil, 2) {
+		if err != nil {
+			t.Fatalf("Paginate() error = %v", err)
+		}
+		if workflow.ID == "1" {
+			break
+		}
+	}
+
+	if requests != 1 {
+		t.Errorf("Expected iteration to stop after the first page, got %d requests", requests)
+	}
+}
+
+func TestListAll_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"message": "Unauthorized"}`))
+	}))
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	if _, err := ListAll[User](client, "users", nil, 0); err == nil {
+		t.Error("Expected an error from ListAll")
+	}
+}
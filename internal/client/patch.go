@@ -0,0 +1,103 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// toFields converts an API object into its JSON fields
+func toFields(v any) (map[string]interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal object: %w", err)
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal object: %w", err)
+	}
+
+	return fields, nil
+}
+
+// changedFields returns the JSON fields of desired that differ from current. Fields that are only set in
+// current are returned with the zero value of their JSON type.
+func changedFields(current, desired any) (map[string]interface{}, error) {
+	currentFields, err := toFields(current)
+	if err != nil {
+		return nil, err
+	}
+	desiredFields, err := toFields(desired)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make(map[string]interface{})
+	for key, value := range desiredFields {
+		if !reflect.DeepEqual(currentFields[key], value) {
+			changes[key] = value
+		}
+	}
+	for key, value := range currentFields {
+		if _, ok := desiredFields[key]; !ok {
+			changes[key] = zeroJSONValue(value)
+		}
+	}
+
+	return changes, nil
+}
+
+// zeroJSONValue returns the empty value of the JSON type of value
+func zeroJSONValue(value interface{}) interface{} {
+	switch value.(type) {
+	case map[string]interface{}:
+		return map[string]interface{}{}
+	case []interface{}:
+		return []interface{}{}
+	case bool:
+		return false
+	case string:
+		return ""
+	case float64:
+		return 0
+	default:
+		return nil
+	}
+}
+
+// jsonFieldNames returns the JSON names of the fields of a struct type
+func jsonFieldNames(t reflect.Type) []string {
+	names := make([]string, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name != "" && name != "-" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// mergeFields returns a copy of a workflow with the given JSON fields replaced
+func mergeFields(workflow *Workflow, fields map[string]interface{}) (*Workflow, error) {
+	merged, err := toFields(workflow)
+	if err != nil {
+		return nil, err
+	}
+	for key, value := range fields {
+		merged[key] = value
+	}
+
+	data, err := json.Marshal(merged)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal object: %w", err)
+	}
+
+	var result Workflow
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal object: %w", err)
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,174 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestWorkflowChanges(t *testing.T) {
+	current := &Workflow{
+		Name:       "Orders",
+		Active:     true,
+		Nodes:      []interface{}{map[string]interface{}{"name": "Start"}},
+		Settings:   map[string]interface{}{"executionOrder": "v1"},
+		PinnedData: map[string]interface{}{"Start": []interface{}{}},
+	}
+	desired := &Workflow{
+		Name:     "Orders v2",
+		Nodes:    []interface{}{map[string]interface{}{"name": "Start"}},
+		Settings: map[string]interface{}{"executionOrder": "v1"},
+	}
+
+	changes, err := WorkflowChanges(current, desired)
+	if err != nil {
+		t.Fatalf("WorkflowChanges() error = %v", err)
+	}
+
+	expected := map[string]interface{}{
+		"name":    "Orders v2",
+		"active":  false,
+		"pinData": map[string]interface{}{},
+	}
+	if !reflect.DeepEqual(changes, expected) {
+		t.Errorf("Expected changes %v, got %v", expected, changes)
+	}
+}
+
+func TestClient_PatchWorkflow_PublicAPI(t *testing.T) {
+	var updated map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method {
+		case "GET":
+			_, _ = w.Write([]byte(`{
+				"id": "wf-1",
+				"name": "Orders",
+				"nodes": [{"name": "Start"}],
+				"connections": {},
+				"staticData": {"lastId": 42},
+				"versionId": "v1",
+				"active": true,
+				"isArchived": false,
+				"triggerCount": 1,
+				"tags": [{"id": "tag-1", "name": "billing"}],
+				"meta": {"templateId": "1234"},
+				"pinData": {"Start": [{"json": {"id": 1}}]},
+				"shared": [{"role": "workflow:owner"}]
+			}`))
+		case "PUT":
+			if err := json.NewDecoder(r.Body).Decode(&updated); err != nil {
+				t.Fatalf("Failed to decode request body: %v", err)
+			}
+			_, _ = w.Write([]byte(`{"id": "wf-1", "name": "Orders v2"}`))
+		default:
+			t.Errorf("Unexpected %s request", r.Method)
+		}
+	}))
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	result, err := client.PatchWorkflow("wf-1", map[string]interface{}{"name": "Orders v2"})
+	if err != nil {
+		t.Fatalf("PatchWorkflow() error = %v", err)
+	}
+	if result.Name != "Orders v2" {
+		t.Errorf("Expected the updated workflow, got %+v", result)
+	}
+
+	// Fields that were not changed are sent as they are in n8n, fields the public API rejects are not sent
+	if updated["name"] != "Orders v2" || !reflect.DeepEqual(updated["staticData"], map[string]interface{}{"lastId": float64(42)}) {
+		t.Errorf("Expected the change merged into the current workflow, got %v", updated)
+	}
+	var fields []string
+	for field := range updated {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	if expected := []string{"connections", "name", "nodes", "settings", "staticData"}; !reflect.DeepEqual(fields, expected) {
+		t.Errorf("Expected only the fields %v to be sent, got %v", expected, updated)
+	}
+}
+
+func TestClient_PatchWorkflow_PublicAPIPinData(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected %s request", r.Method)
+	}))
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	fields := map[string]interface{}{"pinData": map[string]interface{}{"Start": []interface{}{}}}
+	if _, err := client.PatchWorkflow("wf-1", fields); err == nil || !strings.Contains(err.Error(), "internal API") {
+		t.Errorf("Expected pinned data to be rejected by the public API, got %v", err)
+	}
+}
+
+func TestClient_PatchWorkflow_InternalAPI(t *testing.T) {
+	var patched map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PATCH" || r.URL.Path != "/rest/workflows/wf-1" {
+			t.Errorf("Expected PATCH /rest/workflows/wf-1, got %s %s", r.Method, r.URL.Path)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&patched); err != nil {
+			t.Fatalf("Failed to decode request body: %v", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": {"id": "wf-1", "name": "Orders v2"}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL: server.URL,
+		Auth:    &APIKeyAuth{APIKey: "test-key"},
+		APIMode: APIModeInternal,
+	})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	if _, err := client.PatchWorkflow("wf-1", map[string]interface{}{"name": "Orders v2"}); err != nil {
+		t.Fatalf("PatchWorkflow() error = %v", err)
+	}
+
+	if !reflect.DeepEqual(patched, map[string]interface{}{"name": "Orders v2"}) {
+		t.Errorf("Expected only the changed field to be sent, got %v", patched)
+	}
+}
+
+func TestClient_PatchCredential(t *testing.T) {
+	var patched map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PATCH" || r.URL.Path != "/api/v1/credentials/cred-1" {
+			t.Errorf("Expected PATCH /api/v1/credentials/cred-1, got %s %s", r.Method, r.URL.Path)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&patched); err != nil {
+			t.Fatalf("Failed to decode request body: %v", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": "cred-1", "name": "Renamed", "type": "apiKey"}`))
+	}))
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	if _, err := client.PatchCredential("cred-1", map[string]interface{}{"name": "Renamed"}); err != nil {
+		t.Fatalf("PatchCredential() error = %v", err)
+	}
+
+	if !reflect.DeepEqual(patched, map[string]interface{}{"name": "Renamed"}) {
+		t.Errorf("Expected only the changed field to be sent, got %v", patched)
+	}
+
+	if _, err := client.PatchCredential("", nil); err == nil {
+		t.Error("Expected error for an empty credential ID")
+	}
+}
@@ -1,26 +1,14 @@
 package client
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
 	"net/url"
-	"strconv"
+	"slices"
 	"time"
 )
 
-// Project represents an n8n project (Enterprise feature)
-type Project struct {
-	ID          string                 `json:"id,omitempty"`
-	Name        string                 `json:"name"`
-	Description string                 `json:"description,omitempty"`
-	Settings    map[string]interface{} `json:"settings,omitempty"`
-	Icon        string                 `json:"icon,omitempty"`
-	Color       string                 `json:"color,omitempty"`
-	OwnerID     string                 `json:"ownerId,omitempty"`
-	MemberCount int                    `json:"memberCount,omitempty"`
-	CreatedAt   *time.Time             `json:"createdAt,omitempty"`
-	UpdatedAt   *time.Time             `json:"updatedAt,omitempty"`
-}
-
 // ProjectUser represents a user's membership in a project
 type ProjectUser struct {
 	ID        string     `json:"id,omitempty"`
@@ -30,47 +18,17 @@ type ProjectUser struct {
 	AddedAt   *time.Time `json:"addedAt,omitempty"`
 }
 
+// transferRequest represents the request body for moving a workflow or credential to another project
+type transferRequest struct {
+	DestinationProjectID string `json:"destinationProjectId"`
+}
+
 // ProjectListOptions represents options for listing projects
 type ProjectListOptions struct {
 	Limit  int
 	Offset int
 }
 
-// ProjectListResponse represents the response from listing projects
-type ProjectListResponse struct {
-	Data       []Project `json:"data"`
-	NextCursor string    `json:"nextCursor,omitempty"`
-}
-
-// GetProjects retrieves a list of projects
-func (c *Client) GetProjects(options *ProjectListOptions) (*ProjectListResponse, error) {
-	path := "projects"
-
-	if options != nil {
-		params := url.Values{}
-
-		if options.Limit > 0 {
-			params.Set("limit", strconv.Itoa(options.Limit))
-		}
-
-		if options.Offset > 0 {
-			params.Set("offset", strconv.Itoa(options.Offset))
-		}
-
-		if len(params) > 0 {
-			path += "?" + params.Encode()
-		}
-	}
-
-	var result ProjectListResponse
-	err := c.Get(path, &result)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get projects: %w", err)
-	}
-
-	return &result, nil
-}
-
 // GetProject retrieves a specific project by ID
 func (c *Client) GetProject(id string) (*Project, error) {
 	if id == "" {
@@ -88,60 +46,33 @@ func (c *Client) GetProject(id string) (*Project, error) {
 	return &project, nil
 }
 
-// CreateProject creates a new project
-func (c *Client) CreateProject(project *Project) (*Project, error) {
-	if project == nil {
-		return nil, fmt.Errorf("project is required")
-	}
-
-	if project.Name == "" {
-		return nil, fmt.Errorf("project name is required")
-	}
-
-	var result Project
-	err := c.Post("projects", project, &result)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create project: %w", err)
-	}
-
-	return &result, nil
-}
-
-// UpdateProject updates an existing project
-func (c *Client) UpdateProject(id string, project *Project) (*Project, error) {
+// DeleteProjectWithContent deletes a project along with the workflows and credentials it contains, or moves
+// them to the project transferToProjectID first if it is set. Requires session authentication.
+func (c *Client) DeleteProjectWithContent(id, transferToProjectID string) error {
 	if id == "" {
-		return nil, fmt.Errorf("project ID is required")
-	}
-
-	if project == nil {
-		return nil, fmt.Errorf("project is required")
+		return fmt.Errorf("project ID is required")
 	}
 
 	path := fmt.Sprintf("projects/%s", id)
-
-	var result Project
-	err := c.Put(path, project, &result)
-	if err != nil {
-		return nil, fmt.Errorf("failed to update project %s: %w", id, err)
+	if transferToProjectID != "" {
+		path += "?" + url.Values{"transferId": {transferToProjectID}}.Encode()
 	}
 
-	return &result, nil
-}
-
-// DeleteProject deletes a project
-func (c *Client) DeleteProject(id string) error {
-	if id == "" {
-		return fmt.Errorf("project ID is required")
+	if err := c.doInternalRequest("DELETE", path, nil, nil); err != nil {
+		return fmt.Errorf("failed to delete project %s: %w", id, err)
 	}
 
-	path := fmt.Sprintf("projects/%s", id)
+	return nil
+}
 
-	err := c.Delete(path)
-	if err != nil {
-		return fmt.Errorf("failed to delete project %s: %w", id, err)
+// GetPersonalProject retrieves the personal project of the authenticated user. Requires session authentication.
+func (c *Client) GetPersonalProject() (*Project, error) {
+	var project Project
+	if err := c.doInternalRequest("GET", "projects/personal", nil, &project); err != nil {
+		return nil, fmt.Errorf("failed to get personal project: %w", err)
 	}
 
-	return nil
+	return &project, nil
 }
 
 // GetProjectUsers retrieves users for a specific project
@@ -163,7 +94,25 @@ func (c *Client) GetProjectUsers(projectID string) ([]ProjectUser, error) {
 	return result.Data, nil
 }
 
-// AddUserToProject adds a user to a project
+// projectRelation is a user's membership in a project as the project relations API takes it
+type projectRelation struct {
+	UserID string `json:"userId"`
+	Role   string `json:"role"`
+}
+
+// addProjectRelationsRequest is the body of a request adding users to a project through the relations API
+type addProjectRelationsRequest struct {
+	Relations []projectRelation `json:"relations"`
+}
+
+// updateProjectRelationRequest is the body of a request changing a user's role through the relations API
+type updateProjectRelationRequest struct {
+	Role string `json:"role"`
+}
+
+// AddUserToProject adds a user to a project. Newer n8n versions take the membership as a relation with a
+// scoped role and respond without content; older versions that reject the relations body are sent the
+// project user as is.
 func (c *Client) AddUserToProject(projectUser *ProjectUser) (*ProjectUser, error) {
 	if projectUser == nil {
 		return nil, fmt.Errorf("project user is required")
@@ -180,15 +129,24 @@ func (c *Client) AddUserToProject(projectUser *ProjectUser) (*ProjectUser, error
 	path := fmt.Sprintf("projects/%s/users", projectUser.ProjectID)
 
 	var result ProjectUser
-	err := c.Post(path, projectUser, &result)
+	body := &addProjectRelationsRequest{Relations: []projectRelation{{
+		UserID: projectUser.UserID,
+		Role:   NormalizeProjectRole(projectUser.Role),
+	}}}
+	err := c.Post(path, body, &result)
+	if hasStatus(err, http.StatusBadRequest) {
+		result = ProjectUser{}
+		err = c.Post(path, projectUser, &result)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to add user to project: %w", err)
 	}
 
-	return &result, nil
+	return projectUserResult(&result, projectUser.ProjectID, projectUser.UserID, projectUser.Role), nil
 }
 
-// UpdateProjectUser updates a user's role in a project
+// UpdateProjectUser updates a user's role in a project. Newer n8n versions change the role with a PATCH of
+// the relation; older versions without that endpoint replace the project user with a PUT.
 func (c *Client) UpdateProjectUser(projectID, userID string, projectUser *ProjectUser) (*ProjectUser, error) {
 	if projectID == "" {
 		return nil, fmt.Errorf("project ID is required")
@@ -205,12 +163,37 @@ func (c *Client) UpdateProjectUser(projectID, userID string, projectUser *Projec
 	path := fmt.Sprintf("projects/%s/users/%s", projectID, userID)
 
 	var result ProjectUser
-	err := c.Put(path, projectUser, &result)
+	err := c.Patch(path, &updateProjectRelationRequest{Role: NormalizeProjectRole(projectUser.Role)}, &result)
+	if hasStatus(err, http.StatusNotFound, http.StatusMethodNotAllowed) {
+		result = ProjectUser{}
+		err = c.Put(path, projectUser, &result)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to update project user: %w", err)
 	}
 
-	return &result, nil
+	return projectUserResult(&result, projectID, userID, projectUser.Role), nil
+}
+
+// projectUserResult completes a project user returned by n8n with the requested membership, since the
+// relations API responds without content
+func projectUserResult(result *ProjectUser, projectID, userID, role string) *ProjectUser {
+	if result.ProjectID == "" {
+		result.ProjectID = projectID
+	}
+	if result.UserID == "" {
+		result.UserID = userID
+	}
+	if result.Role == "" {
+		result.Role = role
+	}
+	return result
+}
+
+// hasStatus reports whether err wraps an APIError with one of the given status codes
+func hasStatus(err error, codes ...int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && slices.Contains(codes, apiErr.Code)
 }
 
 // RemoveUserFromProject removes a user from a project
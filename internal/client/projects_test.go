@@ -4,13 +4,14 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"testing"
 	"time"
 )
 
 func TestClient_GetProjects(t *testing.T) {
 	// Mock response
-	mockResponse := ProjectListResponse{
+	mockResponse := ProjectList{
 		Data: []Project{
 			{
 				ID:          "proj-1",
@@ -183,15 +184,6 @@ func TestClient_UpdateProject(t *testing.T) {
 		Description: "An updated project",
 	}
 
-	mockResponse := Project{
-		ID:          "proj-1",
-		Name:        "Updated Project",
-		Description: "An updated project",
-		OwnerID:     "user-1",
-		MemberCount: 2,
-		UpdatedAt:   &time.Time{},
-	}
-
 	// Create test server
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != "PUT" {
@@ -201,8 +193,13 @@ func TestClient_UpdateProject(t *testing.T) {
 			t.Errorf("Expected path /api/v1/projects/proj-1, got %s", r.URL.Path)
 		}
 
-		w.Header().Set("Content-Type", "application/json")
-		_ = json.NewEncoder(w).Encode(mockResponse)
+		var body Project
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.Name != "Updated Project" {
+			t.Errorf("Expected the project in the request body, got %+v (%v)", body, err)
+		}
+
+		// The public API does not return the updated project
+		w.WriteHeader(http.StatusNoContent)
 	}))
 	defer server.Close()
 
@@ -216,14 +213,9 @@ func TestClient_UpdateProject(t *testing.T) {
 	}
 
 	// Test UpdateProject
-	result, err := client.UpdateProject("proj-1", inputProject)
-	if err != nil {
+	if err := client.UpdateProject("proj-1", inputProject); err != nil {
 		t.Fatalf("UpdateProject failed: %v", err)
 	}
-
-	if result.Name != "Updated Project" {
-		t.Errorf("Expected project name 'Updated Project', got '%s'", result.Name)
-	}
 }
 
 func TestClient_DeleteProject(t *testing.T) {
@@ -256,6 +248,47 @@ func TestClient_DeleteProject(t *testing.T) {
 	}
 }
 
+func TestClient_DeleteProjectWithContent(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.RequestURI())
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == "GET" {
+			_, _ = w.Write([]byte(`{"data": {"id": "personal-1", "name": "Owner <owner@example.com>"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data": true}`))
+	}))
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	personal, err := client.GetPersonalProject()
+	if err != nil {
+		t.Fatalf("GetPersonalProject failed: %v", err)
+	}
+	if personal.ID != "personal-1" {
+		t.Errorf("Unexpected personal project: %+v", personal)
+	}
+
+	if err := client.DeleteProjectWithContent("proj-1", personal.ID); err != nil {
+		t.Fatalf("DeleteProjectWithContent failed: %v", err)
+	}
+	if err := client.DeleteProjectWithContent("proj-2", ""); err != nil {
+		t.Fatalf("DeleteProjectWithContent failed: %v", err)
+	}
+
+	expected := []string{
+		"GET /rest/projects/personal",
+		"DELETE /rest/projects/proj-1?transferId=personal-1",
+		"DELETE /rest/projects/proj-2",
+	}
+	if !reflect.DeepEqual(requests, expected) {
+		t.Errorf("Expected requests %v, got %v", expected, requests)
+	}
+}
+
 func TestClient_GetProjectUsers(t *testing.T) {
 	// Mock response
 	mockUsers := []ProjectUser{
@@ -405,3 +438,111 @@ func TestClient_RemoveUserFromProject(t *testing.T) {
 		t.Fatalf("RemoveUserFromProject failed: %v", err)
 	}
 }
+
+func TestClient_AddUserToProject_Relations(t *testing.T) {
+	var bodies []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("Failed to decode request body: %v", err)
+		}
+		bodies = append(bodies, body)
+
+		// Newer versions take relations and respond without content, older versions reject them
+		if _, ok := body["relations"]; ok && len(bodies) == 1 {
+			w.WriteHeader(http.StatusCreated)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"message": "request/body must have required property 'relations'"}`))
+	}))
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	projectUser, err := client.AddUserToProject(&ProjectUser{ProjectID: "proj-1", UserID: "user-1", Role: "editor"})
+	if err != nil {
+		t.Fatalf("AddUserToProject() error = %v", err)
+	}
+	if projectUser.ProjectID != "proj-1" || projectUser.UserID != "user-1" || projectUser.Role != "editor" {
+		t.Errorf("Expected the requested membership, got %+v", projectUser)
+	}
+
+	relations, _ := bodies[0]["relations"].([]interface{})
+	relation, _ := relations[0].(map[string]interface{})
+	if relation["userId"] != "user-1" || relation["role"] != "project:editor" {
+		t.Errorf("Expected a relation with the scoped role, got %v", bodies[0])
+	}
+
+	// The second request is rejected in both formats
+	if _, err := client.AddUserToProject(&ProjectUser{ProjectID: "proj-1", UserID: "user-2"}); err == nil {
+		t.Error("Expected error when both formats are rejected")
+	}
+	if len(bodies) != 3 || bodies[2]["userId"] != "user-2" {
+		t.Errorf("Expected a fallback to the project user body, got %v", bodies)
+	}
+}
+
+func TestClient_UpdateProjectUser(t *testing.T) {
+	tests := []struct {
+		name           string
+		patchStatus    int
+		expectedMethod []string
+	}{
+		{
+			name:           "relations API",
+			patchStatus:    http.StatusOK,
+			expectedMethod: []string{"PATCH"},
+		},
+		{
+			name:           "older version",
+			patchStatus:    http.StatusNotFound,
+			expectedMethod: []string{"PATCH", "PUT"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var methods []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				methods = append(methods, r.Method)
+				if r.URL.Path != "/api/v1/projects/proj-1/users/user-1" {
+					t.Errorf("Expected path /api/v1/projects/proj-1/users/user-1, got %s", r.URL.Path)
+				}
+
+				var body map[string]interface{}
+				_ = json.NewDecoder(r.Body).Decode(&body)
+
+				switch r.Method {
+				case "PATCH":
+					if body["role"] != "project:admin" {
+						t.Errorf("Expected the scoped role, got %v", body)
+					}
+					w.WriteHeader(tt.patchStatus)
+				case "PUT":
+					if body["role"] != "admin" || body["userId"] != "user-1" {
+						t.Errorf("Expected the project user, got %v", body)
+					}
+					w.Header().Set("Content-Type", "application/json")
+					_, _ = w.Write([]byte(`{"projectId": "proj-1", "userId": "user-1", "role": "admin"}`))
+				}
+			}))
+			defer server.Close()
+
+			client := CreateTestClient(t, server.URL)
+
+			projectUser, err := client.UpdateProjectUser("proj-1", "user-1",
+				&ProjectUser{ProjectID: "proj-1", UserID: "user-1", Role: "admin"})
+			if err != nil {
+				t.Fatalf("UpdateProjectUser() error = %v", err)
+			}
+			if projectUser.Role != "admin" || projectUser.UserID != "user-1" {
+				t.Errorf("Unexpected project user: %+v", projectUser)
+			}
+			if !reflect.DeepEqual(methods, tt.expectedMethod) {
+				t.Errorf("Expected requests %v, got %v", tt.expectedMethod, methods)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package client
+
+import (
+	"encoding/json"
+	"sync"
+)
+
+// refreshCache serves reads of single workflows and credentials from one listing per endpoint, so that
+// refreshing hundreds of resources sends a few list requests instead of a request per resource. Each
+// endpoint is listed on its first read. Once anything is written, the listings may be outdated, so they
+// are dropped for good and reads are sent one by one again.
+type refreshCache struct {
+	mu       sync.Mutex
+	stale    bool
+	listings map[string]map[string]json.RawMessage
+}
+
+// newRefreshCache creates an empty refresh cache
+func newRefreshCache() *refreshCache {
+	return &refreshCache{listings: map[string]map[string]json.RawMessage{}}
+}
+
+// get returns the listed item of endpoint with the given ID, listing the endpoint with list first if it
+// has not been listed yet. Concurrent reads wait for the listing, so that the endpoint is listed once.
+func (rc *refreshCache) get(endpoint, id string, list func() (map[string]json.RawMessage, error)) (json.RawMessage, bool) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	if rc.stale {
+		return nil, false
+	}
+
+	items, ok := rc.listings[endpoint]
+	if !ok {
+		var err error
+		if items, err = list(); err != nil {
+			// Reads of the endpoint fall back to single requests
+			items = map[string]json.RawMessage{}
+		}
+		rc.listings[endpoint] = items
+	}
+
+	item, ok := items[id]
+	return item, ok
+}
+
+// invalidate drops all listings and stops serving reads from the cache
+func (rc *refreshCache) invalidate() {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	rc.stale = true
+	rc.listings = nil
+}
+
+// getListed decodes the item of endpoint with the given ID into result from the refresh cache and reports
+// whether it was found there. Items the listing does not have, e.g. because they were deleted, are left to
+// single requests, which report them as not found. The internal API lists workflows without their nodes,
+// so only listings of the public API are used.
+func (c *Client) getListed(endpoint, id string, result any) bool {
+	if c.refresh == nil || c.surfaceFor(endpoint) != APISurfacePublic {
+		return false
+	}
+
+	item, ok := c.refresh.get(endpoint, id, func() (map[string]json.RawMessage, error) {
+		return c.listByID(endpoint)
+	})
+	if !ok {
+		return false
+	}
+	if err := json.Unmarshal(item, result); err != nil {
+		return false
+	}
+
+	c.logger.Logf("n8n API refresh cache hit: %s/%s", endpoint, id)
+	return true
+}
+
+// listByID lists every item of a public list endpoint, keyed by ID. Items are kept as JSON, so that every
+// read decodes its own copy.
+func (c *Client) listByID(endpoint string) (map[string]json.RawMessage, error) {
+	items := map[string]json.RawMessage{}
+	for item, err := range Paginate[json.RawMessage](c, endpoint, nil, MaxPageSize) {
+		if err != nil {
+			c.logger.Logf("n8n API refresh cache disabled for %s: %s", endpoint, err)
+			return nil, err
+		}
+
+		var listed struct {
+			ID string `json:"id"`
+		}
+		if err := json.Unmarshal(item, &listed); err != nil || listed.ID == "" {
+			continue
+		}
+		items[listed.ID] = item
+	}
+	return items, nil
+}
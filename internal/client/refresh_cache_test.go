@@ -0,0 +1,76 @@
+package client
+
+import (
+	"net/http"
+	"sync"
+	"testing"
+)
+
+func TestClient_RefreshCache(t *testing.T) {
+	var mu sync.Mutex
+	requests := map[string]int{}
+	server := TestServer(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests[r.Method+" "+r.URL.Path]++
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/v1/workflows" && r.URL.Query().Get("cursor") == "":
+			_, _ = w.Write([]byte(`{"data": [{"id": "wf-1", "name": "Orders", "nodes": [{"name": "Start"}]}],
+				"nextCursor": "page-2"}`))
+		case r.Method == "GET" && r.URL.Path == "/api/v1/workflows":
+			_, _ = w.Write([]byte(`{"data": [{"id": "wf-2", "name": "Invoices"}]}`))
+		case r.Method == "GET" && r.URL.Path == "/api/v1/workflows/wf-3":
+			_, _ = w.Write([]byte(`{"id": "wf-3", "name": "Archived"}`))
+		default:
+			_, _ = w.Write([]byte(`{"id": "wf-1", "name": "Orders"}`))
+		}
+	})
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL:      server.URL,
+		Auth:         &APIKeyAuth{APIKey: "test-key"},
+		RefreshCache: true,
+	})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for _, id := range []string{"wf-1", "wf-2", "wf-1", "wf-2"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.GetWorkflow(id); err != nil {
+				t.Errorf("GetWorkflow(%s) error = %v", id, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	workflow, err := client.GetWorkflow("wf-1")
+	if err != nil || workflow.Name != "Orders" || len(workflow.Nodes) != 1 {
+		t.Fatalf("Expected the listed workflow, got %+v, %v", workflow, err)
+	}
+	if requests["GET /api/v1/workflows"] != 2 || requests["GET /api/v1/workflows/wf-1"] != 0 {
+		t.Errorf("Expected one listing of two pages and no single reads, got %v", requests)
+	}
+
+	// Workflows missing from the listing are read one by one
+	if workflow, err := client.GetWorkflow("wf-3"); err != nil || workflow.Name != "Archived" {
+		t.Fatalf("Expected the workflow from a single read, got %+v, %v", workflow, err)
+	}
+
+	// Writes make the listing outdated
+	if _, err := client.UpdateWorkflow("wf-1", &Workflow{Name: "Orders"}); err != nil {
+		t.Fatalf("UpdateWorkflow() error = %v", err)
+	}
+	if _, err := client.GetWorkflow("wf-1"); err != nil {
+		t.Fatalf("GetWorkflow() error = %v", err)
+	}
+	if requests["GET /api/v1/workflows/wf-1"] != 1 || requests["GET /api/v1/workflows"] != 2 {
+		t.Errorf("Expected a single read after the write, got %v", requests)
+	}
+}
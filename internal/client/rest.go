@@ -0,0 +1,145 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// restResponse wraps responses of the internal n8n REST API, which nests payloads under data
+type restResponse struct {
+	Data json.RawMessage `json:"data"`
+}
+
+// doRESTRequest performs a request against the internal REST API (/rest/) that backs the n8n
+// editor. It covers instance-level endpoints the public API does not expose. Session cookies
+// are sent and stored through the client's cookie jar; requests are not retried or cached.
+func (c *Client) doRESTRequest(method, path string, body any, result any) error {
+	return c.doRESTContextRequest(context.Background(), method, path, body, result)
+}
+
+// doRESTContextRequest performs a request like doRESTRequest, giving up once ctx is done
+func (c *Client) doRESTContextRequest(ctx context.Context, method, path string, body any, result any) error {
+	respBody, err := c.doInstanceRequest(ctx, method, "rest/"+path, body)
+	if err != nil {
+		return err
+	}
+
+	if result != nil && len(respBody) > 0 {
+		var wrapped restResponse
+		if err := json.Unmarshal(respBody, &wrapped); err != nil {
+			return fmt.Errorf("failed to unmarshal response: %w", err)
+		}
+		if len(wrapped.Data) > 0 {
+			// List responses that carry metadata next to data decode into result as a whole
+			if err := json.Unmarshal(wrapped.Data, result); err != nil {
+				if err := json.Unmarshal(respBody, result); err != nil {
+					return fmt.Errorf("failed to unmarshal response: %w", err)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// doInstanceRequest performs a request against a path relative to the n8n instance URL and returns the
+// response body, giving up once ctx is done. Error responses are returned as an *APIError.
+func (c *Client) doInstanceRequest(ctx context.Context, method, path string, body any) ([]byte, error) {
+	var reqBody io.Reader
+	var contentEncoding string
+	if body != nil {
+		jsonData, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal request body: %w", err)
+		}
+		payload, encoding, err := c.requestBody(jsonData)
+		if err != nil {
+			return nil, err
+		}
+		reqBody, contentEncoding = bytes.NewBuffer(payload), encoding
+	}
+
+	// Parse the path so that query parameters are kept
+	pathURL, err := url.Parse(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse path: %w", err)
+	}
+	fullURL := c.instanceURL().ResolveReference(pathURL)
+
+	send := func() ([]byte, error) {
+		return c.traceRequest(method, fullURL, func(trace *requestTrace) ([]byte, error) {
+			return c.sendInstanceRequest(ctx, method, fullURL, reqBody, contentEncoding, trace)
+		})
+	}
+
+	if method == "GET" {
+		return c.sharedGet(fullURL.String(), send)
+	}
+	return send()
+}
+
+// sendInstanceRequest sends a request to the n8n instance and returns the response body. The status code
+// is recorded in trace.
+func (c *Client) sendInstanceRequest(ctx context.Context, method string, fullURL *url.URL, reqBody io.Reader,
+	contentEncoding string, trace *requestTrace) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, method, fullURL.String(), reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Accept-Encoding", "gzip")
+	if contentEncoding != "" {
+		req.Header.Set("Content-Encoding", contentEncoding)
+	}
+	requestID := c.setHeaders(req)
+
+	// Sessions are sent as cookies, but other methods, e.g. Basic authentication for a reverse proxy, apply too
+	if err := c.auth.ApplyAuth(req); err != nil {
+		return nil, fmt.Errorf("failed to apply authentication: %w", err)
+	}
+
+	c.logger.Logf("n8n REST request: %s %s (request ID %s)", method, fullURL.String(), requestID)
+
+	if err := c.breaker.allow(); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	c.recordResult(responseStatus(resp), err)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if err := decompressBody(resp); err != nil {
+		return nil, err
+	}
+
+	respBody, err := c.readBody(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	trace.statusCode = resp.StatusCode
+	c.logger.Logf("n8n REST response: %d %s (request ID %s)", resp.StatusCode, resp.Status, requestID)
+
+	if resp.StatusCode >= 400 {
+		var apiErr APIError
+		if err := json.Unmarshal(respBody, &apiErr); err != nil || apiErr.Message == "" {
+			return nil, &APIError{
+				Code:    resp.StatusCode,
+				Message: fmt.Sprintf("HTTP %d: %s", resp.StatusCode, truncateBody(respBody)),
+			}
+		}
+		apiErr.Code = resp.StatusCode
+		return nil, &apiErr
+	}
+
+	return respBody, nil
+}
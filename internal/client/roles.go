@@ -0,0 +1,105 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Global roles of n8n users
+const (
+	GlobalRoleOwner  = "global:owner"
+	GlobalRoleAdmin  = "global:admin"
+	GlobalRoleMember = "global:member"
+)
+
+// GlobalRoles lists the global roles of instances that do not list their roles
+var GlobalRoles = []string{GlobalRoleOwner, GlobalRoleAdmin, GlobalRoleMember}
+
+// Scope prefixes of role names since n8n 1.0
+const (
+	globalRolePrefix  = "global:"
+	projectRolePrefix = "project:"
+)
+
+// NormalizeGlobalRole returns the name a global role has since n8n 1.0, mapping the names older versions
+// use, e.g. admin, to their scoped name, e.g. global:admin. Scoped names are returned as is.
+func NormalizeGlobalRole(role string) string {
+	return scopeRole(globalRolePrefix, role)
+}
+
+// NormalizeProjectRole returns the scoped name of a project role, e.g. project:editor for editor. Scoped
+// names are returned as is.
+func NormalizeProjectRole(role string) string {
+	return scopeRole(projectRolePrefix, role)
+}
+
+// scopeRole prefixes a role name without a scope with prefix
+func scopeRole(prefix, role string) string {
+	if role == "" || strings.Contains(role, ":") {
+		return role
+	}
+	return prefix + role
+}
+
+// roleEntry is a role as listed by the internal REST API. Older versions name the role in role, newer
+// versions in slug.
+type roleEntry struct {
+	Role     string `json:"role"`
+	Slug     string `json:"slug"`
+	RoleType string `json:"roleType"`
+}
+
+// name returns the scoped name of the role
+func (r roleEntry) name() string {
+	if r.Slug != "" {
+		return r.Slug
+	}
+	return r.Role
+}
+
+// GetGlobalRoles returns the global roles users of the instance can have, including custom roles. Roles
+// are listed by the internal REST API, so this requires session authentication.
+func (c *Client) GetGlobalRoles() ([]string, error) {
+	var raw json.RawMessage
+	if err := c.doInternalRequest("GET", "roles", nil, &raw); err != nil {
+		return nil, fmt.Errorf("failed to get roles: %w", err)
+	}
+
+	roles, err := parseGlobalRoles(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get roles: %w", err)
+	}
+
+	return roles, nil
+}
+
+// parseGlobalRoles decodes the global roles of a role listing, which n8n returns as an object of roles
+// keyed by scope, or as a list of roles with their type since custom roles were introduced
+func parseGlobalRoles(raw json.RawMessage) ([]string, error) {
+	var entries []roleEntry
+
+	var byScope map[string][]roleEntry
+	if err := json.Unmarshal(raw, &byScope); err == nil {
+		entries = byScope["global"]
+	} else if err := json.Unmarshal(raw, &entries); err != nil {
+		return nil, fmt.Errorf("failed to parse roles: %w", err)
+	}
+
+	var roles []string
+	for _, entry := range entries {
+		name := entry.name()
+		if entry.RoleType != "" && entry.RoleType != "global" {
+			continue
+		}
+		if strings.HasPrefix(name, globalRolePrefix) {
+			roles = append(roles, name)
+		}
+	}
+
+	if len(roles) == 0 {
+		return nil, fmt.Errorf("no global roles found")
+	}
+
+	return roles, nil
+}
@@ -0,0 +1,72 @@
+package client
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestNormalizeGlobalRole(t *testing.T) {
+	tests := map[string]string{
+		"admin":         "global:admin",
+		"member":        "global:member",
+		"global:admin":  "global:admin",
+		"project:admin": "project:admin",
+		"":              "",
+	}
+
+	for role, expected := range tests {
+		if got := NormalizeGlobalRole(role); got != expected {
+			t.Errorf("NormalizeGlobalRole(%q) = %q, expected %q", role, got, expected)
+		}
+	}
+}
+
+func TestParseGlobalRoles(t *testing.T) {
+	tests := []struct {
+		name     string
+		raw      string
+		expected []string
+		wantErr  bool
+	}{
+		{
+			name: "roles keyed by scope",
+			raw: `{
+				"global": [{"name": "Owner", "role": "global:owner"}, {"name": "Admin", "role": "global:admin"}],
+				"project": [{"name": "Project Admin", "role": "project:admin"}]
+			}`,
+			expected: []string{"global:owner", "global:admin"},
+		},
+		{
+			name: "list of roles with custom roles",
+			raw: `[
+				{"slug": "global:owner", "roleType": "global"},
+				{"slug": "global:auditor", "roleType": "global"},
+				{"slug": "project:editor", "roleType": "project"}
+			]`,
+			expected: []string{"global:owner", "global:auditor"},
+		},
+		{
+			name:    "no global roles",
+			raw:     `{"project": [{"role": "project:admin"}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid listing",
+			raw:     `"roles"`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			roles, err := parseGlobalRoles(json.RawMessage(tt.raw))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseGlobalRoles() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(roles, tt.expected) {
+				t.Errorf("Expected roles %v, got %v", tt.expected, roles)
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package client
+
+// The services group the methods of the client by resource, for tooling that reuses the client outside the
+// provider. They are thin wrappers around the methods of Client and behave exactly like them.
+
+// WorkflowService groups the workflow endpoints
+type WorkflowService struct {
+	client *Client
+}
+
+// Workflows returns the workflow endpoints of the client
+func (c *Client) Workflows() *WorkflowService {
+	return &WorkflowService{client: c}
+}
+
+// List retrieves every workflow matching the options, following pagination
+func (s *WorkflowService) List(options *WorkflowListOptions) ([]Workflow, error) {
+	return s.client.GetAllWorkflows(options)
+}
+
+// Get retrieves the workflow with the given ID
+func (s *WorkflowService) Get(id string) (*Workflow, error) {
+	return s.client.GetWorkflow(id)
+}
+
+// Create creates a workflow
+func (s *WorkflowService) Create(workflow *Workflow) (*Workflow, error) {
+	return s.client.CreateWorkflow(workflow)
+}
+
+// Update replaces the workflow with the given ID
+func (s *WorkflowService) Update(id string, workflow *Workflow) (*Workflow, error) {
+	return s.client.UpdateWorkflow(id, workflow)
+}
+
+// Delete deletes the workflow with the given ID
+func (s *WorkflowService) Delete(id string) error {
+	return s.client.DeleteWorkflow(id)
+}
+
+// Activate activates the workflow with the given ID
+func (s *WorkflowService) Activate(id string) (*Workflow, error) {
+	return s.client.ActivateWorkflow(id)
+}
+
+// Deactivate deactivates the workflow with the given ID
+func (s *WorkflowService) Deactivate(id string) (*Workflow, error) {
+	return s.client.DeactivateWorkflow(id)
+}
+
+// Transfer moves the workflow with the given ID to another project
+func (s *WorkflowService) Transfer(id, projectID string) error {
+	return s.client.TransferWorkflow(id, projectID)
+}
+
+// CredentialService groups the credential endpoints
+type CredentialService struct {
+	client *Client
+}
+
+// Credentials returns the credential endpoints of the client
+func (c *Client) Credentials() *CredentialService {
+	return &CredentialService{client: c}
+}
+
+// List retrieves every credential matching the options, following pagination
+func (s *CredentialService) List(options *CredentialListOptions) ([]Credential, error) {
+	return s.client.GetAllCredentials(options)
+}
+
+// Get retrieves the credential with the given ID
+func (s *CredentialService) Get(id string) (*Credential, error) {
+	return s.client.GetCredential(id)
+}
+
+// Create creates a credential
+func (s *CredentialService) Create(credential *Credential) (*Credential, error) {
+	return s.client.CreateCredential(credential)
+}
+
+// Update replaces the credential with the given ID
+func (s *CredentialService) Update(id string, credential *Credential) (*Credential, error) {
+	return s.client.UpdateCredential(id, credential)
+}
+
+// Delete deletes the credential with the given ID
+func (s *CredentialService) Delete(id string) error {
+	return s.client.DeleteCredential(id)
+}
+
+// Transfer moves the credential with the given ID to another project
+func (s *CredentialService) Transfer(id, projectID string) error {
+	return s.client.TransferCredential(id, projectID)
+}
+
+// UserService groups the user endpoints
+type UserService struct {
+	client *Client
+}
+
+// Users returns the user endpoints of the client
+func (c *Client) Users() *UserService {
+	return &UserService{client: c}
+}
+
+// List retrieves every user matching the options, following pagination
+func (s *UserService) List(options *UserListOptions) ([]User, error) {
+	return s.client.GetAllUsers(options)
+}
+
+// Get retrieves the user with the given ID
+func (s *UserService) Get(id string) (*User, error) {
+	return s.client.GetUser(id)
+}
+
+// Create invites a user
+func (s *UserService) Create(user *CreateUserRequest) (*User, error) {
+	return s.client.CreateUser(user)
+}
+
+// Update updates the user with the given ID
+func (s *UserService) Update(id string, user *User) (*User, error) {
+	return s.client.UpdateUser(id, user)
+}
+
+// Delete deletes the user with the given ID
+func (s *UserService) Delete(id string) error {
+	return s.client.DeleteUser(id)
+}
+
+// ProjectService groups the project endpoints
+type ProjectService struct {
+	client *Client
+}
+
+// Projects returns the project endpoints of the client
+func (c *Client) Projects() *ProjectService {
+	return &ProjectService{client: c}
+}
+
+// List retrieves every project, following pagination. options.Limit sets the page size; options.Offset is
+// ignored.
+func (s *ProjectService) List(options *ProjectListOptions) ([]Project, error) {
+	pageSize := 0
+	if options != nil {
+		pageSize = options.Limit
+	}
+	return ListAll[Project](s.client, "projects", nil, pageSize)
+}
+
+// Get retrieves the project with the given ID
+func (s *ProjectService) Get(id string) (*Project, error) {
+	return s.client.GetProject(id)
+}
+
+// Create creates a project
+func (s *ProjectService) Create(project *Project) (*Project, error) {
+	return s.client.CreateProject(project)
+}
+
+// Update updates the project with the given ID and returns it as updated. The public API does not return
+// the project, so it is read again.
+func (s *ProjectService) Update(id string, project *Project) (*Project, error) {
+	if err := s.client.UpdateProject(id, project); err != nil {
+		return nil, err
+	}
+	return s.client.GetProject(id)
+}
+
+// Delete deletes the project with the given ID
+func (s *ProjectService) Delete(id string) error {
+	return s.client.DeleteProject(id)
+}
@@ -0,0 +1,110 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestClient_Request(t *testing.T) {
+	var requests int32
+	server := TestServer(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		if r.Method != http.MethodPut || r.URL.Path != "/api/v1/workflows/wf-1/tags" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var tags []map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&tags); err != nil || len(tags) != 1 || tags[0]["id"] != "tag-1" {
+			t.Errorf("Unexpected request body %v, %v", tags, err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"id": "tag-1", "name": "billing"}]`))
+	})
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: &APIKeyAuth{APIKey: "test-key"}})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	var tags []Tag
+	body := []map[string]string{{"id": "tag-1"}}
+	if err := client.Request(context.Background(), http.MethodPut, "workflows/wf-1/tags", body, &tags); err != nil {
+		t.Fatalf("Request() error = %v", err)
+	}
+	if len(tags) != 1 || tags[0].Name != "billing" {
+		t.Errorf("Expected the decoded response, got %+v", tags)
+	}
+
+	// Requests are not sent once the context is done
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := client.Request(ctx, http.MethodPut, "workflows/wf-1/tags", body, nil); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected the context error, got %v", err)
+	}
+	if got := atomic.LoadInt32(&requests); got != 1 {
+		t.Errorf("Expected 1 request, got %d", got)
+	}
+}
+
+func TestClient_RequestInternalContext(t *testing.T) {
+	release := make(chan struct{})
+	server := TestServer(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/settings" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	})
+	defer server.Close()
+	defer close(release)
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: &APIKeyAuth{APIKey: "test-key"}})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	// Requests to the internal API stop once the context is done, like requests to the public API
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if err := client.Request(ctx, http.MethodGet, "settings", nil, nil); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected the context error, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Expected the request to stop with the context, took %s", elapsed)
+	}
+}
+
+func TestClient_Services(t *testing.T) {
+	server := TestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/projects":
+			_, _ = w.Write([]byte(`{"data": [{"id": "project-1", "name": "Billing"}]}`))
+		default:
+			_, _ = w.Write([]byte(`{"id": "wf-1", "name": "Orders"}`))
+		}
+	})
+	defer server.Close()
+
+	client, err := NewClient(&Config{BaseURL: server.URL, Auth: &APIKeyAuth{APIKey: "test-key"}})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	workflow, err := client.Workflows().Get("wf-1")
+	if err != nil || workflow.Name != "Orders" {
+		t.Errorf("Workflows().Get() = %+v, %v", workflow, err)
+	}
+	projects, err := client.Projects().List(nil)
+	if err != nil || len(projects) != 1 || projects[0].Name != "Billing" {
+		t.Errorf("Projects().List() = %+v, %v", projects, err)
+	}
+}
@@ -0,0 +1,213 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// sessionCookieName is the cookie n8n uses for browser sessions
+const sessionCookieName = "n8n-auth"
+
+// loginRequest is the body of the n8n REST login endpoint. Newer n8n versions read
+// emailOrLdapLoginId, older ones read email; unknown fields are ignored by both.
+type loginRequest struct {
+	Email              string `json:"email"`
+	EmailOrLdapLoginID string `json:"emailOrLdapLoginId"`
+	Password           string `json:"password"`
+}
+
+// hasCredentials reports whether the session can be (re)established by logging in
+func (a *SessionAuth) hasCredentials() bool {
+	return a.Email != "" && a.Password != ""
+}
+
+// newSessionCookieJar creates the cookie jar for session authentication. Cookies are loaded
+// from the cookie file when it exists; a missing file is only an error without login credentials.
+func newSessionCookieJar(auth *SessionAuth, targetURL *url.URL) (http.CookieJar, error) {
+	if auth.CookieFile != "" {
+		_, statErr := os.Stat(filepath.Clean(auth.CookieFile))
+		if statErr == nil || !auth.hasCredentials() {
+			jar, err := LoadCookiesFromFile(auth.CookieFile, targetURL)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load cookies from file: %w", err)
+			}
+			return jar, nil
+		}
+	}
+
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cookie jar: %w", err)
+	}
+	return jar, nil
+}
+
+// hasSession reports whether the cookie jar holds an n8n session cookie
+func (c *Client) hasSession() bool {
+	if c.httpClient.Jar == nil {
+		return false
+	}
+
+	for _, cookie := range c.httpClient.Jar.Cookies(c.instanceURL()) {
+		if cookie.Name == sessionCookieName {
+			return true
+		}
+	}
+	return false
+}
+
+// Login signs in through the n8n REST login endpoint and stores the session cookie in the
+// client's cookie jar. When a cookie file is configured the session is persisted to it.
+func (c *Client) Login() error {
+	c.sessionMu.Lock()
+	defer c.sessionMu.Unlock()
+
+	return c.login()
+}
+
+// login signs in like Login; the caller must hold sessionMu
+func (c *Client) login() error {
+	auth, ok := sessionAuthOf(c.auth)
+	if !ok || !auth.hasCredentials() {
+		return fmt.Errorf("login requires session authentication with email and password")
+	}
+
+	if err := c.waitForStartup(); err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(loginRequest{
+		Email:              auth.Email,
+		EmailOrLdapLoginID: auth.Email,
+		Password:           auth.Password,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal login request: %w", err)
+	}
+
+	loginURL := c.instanceURL().ResolveReference(&url.URL{Path: "rest/login"})
+	req, err := http.NewRequest("POST", loginURL.String(), bytes.NewBuffer(body))
+	if err != nil {
+		return fmt.Errorf("failed to create login request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	requestID := c.setHeaders(req)
+	if err := c.auth.ApplyAuth(req); err != nil {
+		return fmt.Errorf("failed to apply authentication: %w", err)
+	}
+
+	c.logger.Logf("n8n login request: POST %s (request ID %s)", loginURL.String(), requestID)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("login request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read login response: %w", err)
+	}
+
+	if resp.StatusCode >= 400 {
+		return &APIError{
+			Code:    resp.StatusCode,
+			Message: fmt.Sprintf("login failed: HTTP %d: %s", resp.StatusCode, truncateBody(respBody)),
+		}
+	}
+
+	var sessionCookies []*http.Cookie
+	for _, cookie := range resp.Cookies() {
+		if cookie.Name == sessionCookieName {
+			sessionCookies = append(sessionCookies, cookie)
+		}
+	}
+	if len(sessionCookies) == 0 {
+		return fmt.Errorf("login succeeded but no %s session cookie was returned", sessionCookieName)
+	}
+
+	if auth.CookieFile != "" {
+		if err := SaveCookiesToFile(auth.CookieFile, c.instanceURL().Hostname(), sessionCookies); err != nil {
+			// Persisting the session is best effort; the in-memory session is still usable
+			c.logger.Logf("Warning: failed to persist session cookie: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// ensureSession logs in when session credentials are configured but no session cookie exists yet.
+// Concurrent first requests wait for a single login instead of each logging in.
+func (c *Client) ensureSession() error {
+	auth, ok := sessionAuthOf(c.auth)
+	if !ok || !auth.hasCredentials() || c.hasSession() {
+		return nil
+	}
+
+	c.sessionMu.Lock()
+	defer c.sessionMu.Unlock()
+
+	// Another request may have logged in while this one waited
+	if c.hasSession() {
+		return nil
+	}
+	return c.login()
+}
+
+// canRefreshSession reports whether an expired session can be renewed by logging in again
+func (c *Client) canRefreshSession() bool {
+	auth, ok := sessionAuthOf(c.auth)
+	return ok && auth.hasCredentials()
+}
+
+// SaveCookiesToFile writes cookies to a Netscape format cookie file readable by LoadCookiesFromFile
+func SaveCookiesToFile(cookieFile, domain string, cookies []*http.Cookie) error {
+	if err := validateCookieFilePath(cookieFile); err != nil {
+		return fmt.Errorf("invalid cookie file path: %w", err)
+	}
+
+	var content strings.Builder
+	content.WriteString("# Netscape HTTP Cookie File\n")
+
+	for _, cookie := range cookies {
+		cookieDomain := cookie.Domain
+		if cookieDomain == "" {
+			cookieDomain = domain
+		}
+		cookiePath := cookie.Path
+		if cookiePath == "" {
+			cookiePath = "/"
+		}
+		expiration := "0"
+		if !cookie.Expires.IsZero() {
+			expiration = strconv.FormatInt(cookie.Expires.Unix(), 10)
+		}
+
+		fields := []string{
+			cookieDomain,
+			"FALSE",
+			cookiePath,
+			strings.ToUpper(strconv.FormatBool(cookie.Secure)),
+			expiration,
+			cookie.Name,
+			cookie.Value,
+		}
+		content.WriteString(strings.Join(fields, "\t") + "\n")
+	}
+
+	if err := os.WriteFile(filepath.Clean(cookieFile), []byte(content.String()), 0600); err != nil {
+		return fmt.Errorf("failed to write cookie file: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,257 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// sessionTestServer simulates n8n session auth: /rest/login issues a new session token and
+// API requests are only accepted with the most recently issued token
+func sessionTestServer(t *testing.T, logins *int32) *http.ServeMux {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/login", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("Failed to decode login body: %v", err)
+		}
+		if body["emailOrLdapLoginId"] != "owner@example.com" || body["password"] != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"message": "Wrong username or password"}`))
+			return
+		}
+
+		count := atomic.AddInt32(logins, 1)
+		http.SetCookie(w, &http.Cookie{
+			Name:    sessionCookieName,
+			Value:   fmt.Sprintf("token-%d", count),
+			Path:    "/",
+			Expires: time.Now().Add(time.Hour),
+		})
+		_, _ = w.Write([]byte(`{"data": {}}`))
+	})
+	mux.HandleFunc("/api/v1/workflows", func(w http.ResponseWriter, r *http.Request) {
+		cookie, err := r.Cookie(sessionCookieName)
+		if err != nil || cookie.Value != fmt.Sprintf("token-%d", atomic.LoadInt32(logins)) {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"message": "Unauthorized"}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": []}`))
+	})
+
+	return mux
+}
+
+func TestSessionAuth_Login(t *testing.T) {
+	var logins int32
+	server := TestServer(sessionTestServer(t, &logins).ServeHTTP)
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL: server.URL,
+		Auth:    &SessionAuth{Email: "owner@example.com", Password: "secret"},
+	})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetWorkflows(nil); err != nil {
+			t.Fatalf("GetWorkflows() error = %v", err)
+		}
+	}
+
+	if got := atomic.LoadInt32(&logins); got != 1 {
+		t.Errorf("Expected a single login for consecutive requests, got %d", got)
+	}
+}
+
+func TestCompositeAuth_ReverseProxy(t *testing.T) {
+	var logins int32
+	mux := sessionTestServer(t, &logins)
+	server := TestServer(func(w http.ResponseWriter, r *http.Request) {
+		// The reverse proxy requires its own credentials on every request, including the login
+		if username, password, ok := r.BasicAuth(); !ok || username != "proxy" || password != "proxy-secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"message": "Proxy authentication required"}`))
+			return
+		}
+		mux.ServeHTTP(w, r)
+	})
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL: server.URL,
+		Auth: CompositeAuth{
+			&BasicAuth{Email: "proxy", Password: "proxy-secret"},
+			&SessionAuth{Email: "owner@example.com", Password: "secret"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	if _, err := client.GetWorkflows(nil); err != nil {
+		t.Fatalf("GetWorkflows() error = %v", err)
+	}
+	if got := atomic.LoadInt32(&logins); got != 1 {
+		t.Errorf("Expected the session to be established through the proxy, got %d logins", got)
+	}
+}
+
+func TestSessionAuth_ConcurrentLogin(t *testing.T) {
+	var logins int32
+	server := TestServer(sessionTestServer(t, &logins).ServeHTTP)
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL: server.URL,
+		Auth:    &SessionAuth{Email: "owner@example.com", Password: "secret"},
+	})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.GetWorkflows(nil); err != nil {
+				t.Errorf("GetWorkflows() error = %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := atomic.LoadInt32(&logins); got != 1 {
+		t.Errorf("Expected a single login for concurrent requests, got %d", got)
+	}
+}
+
+func TestSessionAuth_RefreshExpiredSession(t *testing.T) {
+	var logins int32
+	server := TestServer(sessionTestServer(t, &logins).ServeHTTP)
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL:     server.URL,
+		Auth:        &SessionAuth{Email: "owner@example.com", Password: "secret"},
+		RetryConfig: RetryConfig{MaxRetries: 1, BaseDelay: time.Millisecond},
+	})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	if _, err := client.GetWorkflows(nil); err != nil {
+		t.Fatalf("GetWorkflows() error = %v", err)
+	}
+
+	// Invalidate the current session on the server side
+	atomic.AddInt32(&logins, 1)
+
+	if _, err := client.GetWorkflows(nil); err != nil {
+		t.Fatalf("GetWorkflows() after session expiry error = %v", err)
+	}
+
+	if got := atomic.LoadInt32(&logins); got != 3 {
+		t.Errorf("Expected the client to log in again after the session expired, login counter = %d", got)
+	}
+}
+
+func TestSessionAuth_InvalidCredentials(t *testing.T) {
+	var logins int32
+	server := TestServer(sessionTestServer(t, &logins).ServeHTTP)
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL: server.URL,
+		Auth:    &SessionAuth{Email: "owner@example.com", Password: "wrong"},
+	})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	_, err = client.GetWorkflows(nil)
+	if err == nil || !strings.Contains(err.Error(), "failed to establish session") {
+		t.Errorf("Expected a session error, got %v", err)
+	}
+}
+
+func TestSessionAuth_PersistCookieFile(t *testing.T) {
+	var logins int32
+	server := TestServer(sessionTestServer(t, &logins).ServeHTTP)
+	defer server.Close()
+
+	cookieFile := filepath.Join(t.TempDir(), "session.cookies")
+	newSessionClient := func() *Client {
+		client, err := NewClient(&Config{
+			BaseURL: server.URL,
+			Auth: &SessionAuth{
+				Email:      "owner@example.com",
+				Password:   "secret",
+				CookieFile: cookieFile,
+			},
+		})
+		if err != nil {
+			t.Fatalf("NewClient() error = %v", err)
+		}
+		return client
+	}
+
+	// The cookie file does not exist yet, so the first client logs in and writes it
+	if _, err := newSessionClient().GetWorkflows(nil); err != nil {
+		t.Fatalf("GetWorkflows() error = %v", err)
+	}
+	if _, err := os.Stat(cookieFile); err != nil {
+		t.Fatalf("Expected session cookie to be persisted: %v", err)
+	}
+
+	// A second client reuses the persisted session without logging in
+	if _, err := newSessionClient().GetWorkflows(nil); err != nil {
+		t.Fatalf("GetWorkflows() with persisted session error = %v", err)
+	}
+	if got := atomic.LoadInt32(&logins); got != 1 {
+		t.Errorf("Expected the persisted session to be reused, got %d logins", got)
+	}
+}
+
+func TestSaveCookiesToFile_RoundTrip(t *testing.T) {
+	cookieFile := filepath.Join(t.TempDir(), "cookies.txt")
+	expires := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	cookies := []*http.Cookie{
+		{Name: sessionCookieName, Value: "abc123", Path: "/", Expires: expires},
+	}
+
+	if err := SaveCookiesToFile(cookieFile, "n8n.example.com", cookies); err != nil {
+		t.Fatalf("SaveCookiesToFile() error = %v", err)
+	}
+
+	targetURL, _ := url.Parse("https://n8n.example.com/api/v1/")
+	jar, err := LoadCookiesFromFile(cookieFile, targetURL)
+	if err != nil {
+		t.Fatalf("LoadCookiesFromFile() error = %v", err)
+	}
+
+	loaded := jar.Cookies(targetURL)
+	if len(loaded) != 1 || loaded[0].Name != sessionCookieName || loaded[0].Value != "abc123" {
+		t.Errorf("Expected the saved session cookie to load back, got %v", loaded)
+	}
+
+	if err := SaveCookiesToFile("/etc/cookies.exe", "n8n.example.com", cookies); err == nil {
+		t.Error("Expected an error for an invalid cookie file path")
+	}
+}
@@ -0,0 +1,56 @@
+package client
+
+import (
+	"fmt"
+)
+
+// InstanceSettings represents the instance-level settings of n8n as reported by the editor settings. n8n
+// reads them from its environment, so they cannot be changed through the API, except that banners can be
+// dismissed with DismissBanner. Nil fields are not reported by the instance.
+type InstanceSettings struct {
+	ExecutionsDataPruning       *bool
+	ExecutionsDataMaxAge        *int
+	ExecutionsDataPruneMaxCount *int
+	DiagnosticsEnabled          *bool
+	BannersDismissed            []string
+}
+
+// dismissBannerRequest is the request body of the internal banner dismissal endpoint
+type dismissBannerRequest struct {
+	Banner string `json:"banner"`
+}
+
+// GetInstanceSettings retrieves the current instance settings. They are read from the editor settings of the
+// internal API, which the public API has no equivalent of.
+func (c *Client) GetInstanceSettings() (*InstanceSettings, error) {
+	var frontend frontendSettings
+	if err := c.doRESTRequest("GET", "settings", nil, &frontend); err != nil {
+		return nil, fmt.Errorf("failed to get instance settings: %w", err)
+	}
+
+	settings := &InstanceSettings{BannersDismissed: frontend.Banners.Dismissed}
+	if pruning := frontend.Pruning; pruning != nil {
+		settings.ExecutionsDataPruning = &pruning.IsEnabled
+		settings.ExecutionsDataMaxAge = &pruning.MaxAge
+		settings.ExecutionsDataPruneMaxCount = &pruning.MaxCount
+	}
+	if telemetry := frontend.Telemetry; telemetry != nil {
+		settings.DiagnosticsEnabled = &telemetry.Enabled
+	}
+
+	return settings, nil
+}
+
+// DismissBanner dismisses a banner of the editor, e.g. V1 or NON_PRODUCTION_LICENSE, for all users. Dismissed
+// banners cannot be shown again. Requires session authentication as the owner.
+func (c *Client) DismissBanner(banner string) error {
+	if banner == "" {
+		return fmt.Errorf("banner is required")
+	}
+
+	if err := c.doInternalRequest("POST", "owner/dismiss-banner", &dismissBannerRequest{Banner: banner}, nil); err != nil {
+		return fmt.Errorf("failed to dismiss banner %s: %w", banner, err)
+	}
+
+	return nil
+}
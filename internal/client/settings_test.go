@@ -0,0 +1,98 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestClient_GetInstanceSettings(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Errorf("Expected GET request, got %s", r.Method)
+		}
+		if r.URL.Path != "/rest/settings" {
+			t.Errorf("Expected path /rest/settings, got %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": {
+			"pruning": {"isEnabled": true, "maxAge": 336, "maxCount": 10000},
+			"telemetry": {"enabled": false},
+			"banners": {"dismissed": ["V1"]}
+		}}`))
+	}))
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	settings, err := client.GetInstanceSettings()
+	if err != nil {
+		t.Fatalf("GetInstanceSettings failed: %v", err)
+	}
+
+	if settings.ExecutionsDataPruning == nil || !*settings.ExecutionsDataPruning {
+		t.Error("Expected execution pruning to be enabled")
+	}
+	if settings.ExecutionsDataMaxAge == nil || *settings.ExecutionsDataMaxAge != 336 {
+		t.Errorf("Expected max age 336, got %v", settings.ExecutionsDataMaxAge)
+	}
+	if settings.ExecutionsDataPruneMaxCount == nil || *settings.ExecutionsDataPruneMaxCount != 10000 {
+		t.Errorf("Expected max count 10000, got %v", settings.ExecutionsDataPruneMaxCount)
+	}
+	if settings.DiagnosticsEnabled == nil || *settings.DiagnosticsEnabled {
+		t.Error("Expected diagnostics to be disabled")
+	}
+	if len(settings.BannersDismissed) != 1 || settings.BannersDismissed[0] != "V1" {
+		t.Errorf("Expected dismissed banners [V1], got %v", settings.BannersDismissed)
+	}
+}
+
+func TestClient_GetInstanceSettings_NotReported(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": {"versionCli": "1.0.0"}}`))
+	}))
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	settings, err := client.GetInstanceSettings()
+	if err != nil {
+		t.Fatalf("GetInstanceSettings failed: %v", err)
+	}
+	if settings.ExecutionsDataPruning != nil || settings.DiagnosticsEnabled != nil || settings.BannersDismissed != nil {
+		t.Errorf("Expected settings the instance does not report to be unset, got %+v", settings)
+	}
+}
+
+func TestClient_DismissBanner(t *testing.T) {
+	var dismissed map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/rest/owner/dismiss-banner" {
+			t.Errorf("Expected POST /rest/owner/dismiss-banner, got %s %s", r.Method, r.URL.Path)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&dismissed); err != nil {
+			t.Fatalf("Failed to decode request body: %v", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": {}}`))
+	}))
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	if err := client.DismissBanner("V1"); err != nil {
+		t.Fatalf("DismissBanner() error = %v", err)
+	}
+	if !reflect.DeepEqual(dismissed, map[string]interface{}{"banner": "V1"}) {
+		t.Errorf("Expected the banner to be dismissed, got %v", dismissed)
+	}
+
+	if err := client.DismissBanner(""); err == nil {
+		t.Error("Expected error for an empty banner")
+	}
+}
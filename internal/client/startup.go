@@ -0,0 +1,70 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// defaultStartupPollInterval is how often the instance is polled while waiting for it to start
+const defaultStartupPollInterval = 5 * time.Second
+
+// waitForStartup blocks the first request until the instance is ready, for at most the configured
+// startup wait, so that an instance provisioned in the same apply has time to start. Once the wait
+// has failed, every request fails with the same error.
+func (c *Client) waitForStartup() error {
+	if c.startupWait <= 0 {
+		return nil
+	}
+
+	c.startupOnce.Do(func() {
+		c.startupErr = c.pollUntilReady()
+	})
+	return c.startupErr
+}
+
+// pollUntilReady polls the readiness endpoint of the instance until it is ready or the startup wait
+// has passed
+func (c *Client) pollUntilReady() error {
+	readinessURL := c.instanceURL().ResolveReference(&url.URL{Path: "healthz/readiness"})
+	deadline := time.Now().Add(c.startupWait)
+
+	for {
+		err := c.checkReady(readinessURL)
+		if err == nil {
+			return nil
+		}
+
+		if time.Now().Add(c.startupPollInterval).After(deadline) {
+			return fmt.Errorf("n8n at %s was not ready after %s: %w", c.instanceURL(), c.startupWait, err)
+		}
+
+		c.logger.Logf("n8n is not ready yet, checking again in %v: %v", c.startupPollInterval, err)
+		time.Sleep(c.startupPollInterval)
+	}
+}
+
+// checkReady returns an error if the instance refuses connections or reports that it is not ready. Instances
+// without a readiness endpoint are ready once they respond.
+func (c *Client) checkReady(readinessURL *url.URL) error {
+	req, err := http.NewRequest("GET", readinessURL.String(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	c.setHeaders(req)
+	if err := c.auth.ApplyAuth(req); err != nil {
+		return fmt.Errorf("failed to apply authentication: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 500 {
+		return fmt.Errorf("HTTP %d", resp.StatusCode)
+	}
+	return nil
+}
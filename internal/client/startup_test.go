@@ -0,0 +1,95 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// startupServer serves the readiness endpoint with 503 until it has been polled notReady times
+func startupServer(t *testing.T, notReady int32) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var polls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/healthz/readiness" {
+			if polls.Add(1) <= notReady {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			_, _ = w.Write([]byte(`{"status": "ok"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data": []}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &polls
+}
+
+func newStartupClient(t *testing.T, serverURL string, wait time.Duration) *Client {
+	t.Helper()
+
+	client, err := NewClient(&Config{
+		BaseURL:     serverURL,
+		Auth:        &APIKeyAuth{APIKey: "test-key"},
+		StartupWait: wait,
+	})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	client.startupPollInterval = 10 * time.Millisecond
+	return client
+}
+
+func TestClient_WaitForStartup(t *testing.T) {
+	server, polls := startupServer(t, 2)
+	client := newStartupClient(t, server.URL, time.Second)
+
+	if err := client.Ping(); err != nil {
+		t.Fatalf("Ping() error = %v", err)
+	}
+	if polls.Load() != 3 {
+		t.Errorf("Expected the instance to be polled until ready, got %d polls", polls.Load())
+	}
+
+	// Only the first request waits
+	if err := client.Ping(); err != nil {
+		t.Fatalf("Ping() error = %v", err)
+	}
+	if polls.Load() != 3 {
+		t.Errorf("Expected no polls after the instance was ready, got %d polls", polls.Load())
+	}
+}
+
+func TestClient_WaitForStartup_Timeout(t *testing.T) {
+	server, polls := startupServer(t, 1000)
+	client := newStartupClient(t, server.URL, 50*time.Millisecond)
+
+	err := client.Ping()
+	if err == nil || !strings.Contains(err.Error(), "was not ready after 50ms: HTTP 503") {
+		t.Fatalf("Expected a startup timeout, got %v", err)
+	}
+
+	// Later requests fail with the same error without waiting again
+	polled := polls.Load()
+	if err := client.Ping(); err == nil || polls.Load() != polled {
+		t.Errorf("Expected the startup error without polling again, got %v after %d polls", err, polls.Load())
+	}
+}
+
+func TestClient_WaitForStartup_Disabled(t *testing.T) {
+	server, polls := startupServer(t, 1000)
+	client := newStartupClient(t, server.URL, 0)
+
+	if err := client.Ping(); err != nil {
+		t.Fatalf("Ping() error = %v", err)
+	}
+	if polls.Load() != 0 {
+		t.Errorf("Expected no readiness polls without a startup wait, got %d", polls.Load())
+	}
+}
@@ -0,0 +1,136 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// APISurface identifies one of the HTTP APIs exposed by an n8n instance
+type APISurface string
+
+const (
+	// APISurfacePublic is the public REST API under /api/v1, authenticated with an API key
+	APISurfacePublic APISurface = "public"
+	// APISurfaceInternal is the internal REST API under /rest that backs the editor, authenticated with a session
+	APISurfaceInternal APISurface = "internal"
+)
+
+// APIMode controls which API surface requests are sent to
+type APIMode string
+
+const (
+	// APIModePublic sends every request to the public API
+	APIModePublic APIMode = "public"
+	// APIModeInternal sends requests to the internal API, except for endpoints only the public API has
+	APIModeInternal APIMode = "internal"
+	// APIModeAuto routes each endpoint to the surface that has it, preferring the one that matches the
+	// configured authentication when both do
+	APIModeAuto APIMode = "auto"
+)
+
+// APIModes lists the supported API modes
+var APIModes = []APIMode{APIModePublic, APIModeInternal, APIModeAuto}
+
+// endpointSurfaces lists the API surfaces that expose each endpoint, keyed by the first path segment.
+// Endpoints that are not listed are only available on the public API.
+var endpointSurfaces = map[string][]APISurface{
+	"workflows":        {APISurfacePublic, APISurfaceInternal},
+	"credentials":      {APISurfacePublic, APISurfaceInternal},
+	"tags":             {APISurfacePublic, APISurfaceInternal},
+	"users":            {APISurfacePublic, APISurfaceInternal},
+	"projects":         {APISurfacePublic, APISurfaceInternal},
+	"variables":        {APISurfacePublic, APISurfaceInternal},
+	"executions":       {APISurfacePublic, APISurfaceInternal},
+	"settings":         {APISurfaceInternal},
+	"ldap":             {APISurfaceInternal},
+	"owner":            {APISurfaceInternal},
+	"workflow-history": {APISurfaceInternal},
+}
+
+// validateAPIMode returns an error if mode is not a supported API mode
+func validateAPIMode(mode APIMode) error {
+	for _, m := range APIModes {
+		if mode == m {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid API mode %q, must be one of: public, internal, auto", mode)
+}
+
+// endpointName returns the endpoint of a request path, i.e. its first path segment
+func endpointName(path string) string {
+	path = strings.TrimPrefix(path, "/")
+	if i := strings.IndexAny(path, "/?"); i >= 0 {
+		return path[:i]
+	}
+	return path
+}
+
+// surfaceFor returns the API surface a request to path is sent to. Endpoints that only one surface has are
+// always sent there, whatever the API mode.
+func (c *Client) surfaceFor(path string) APISurface {
+	surfaces, ok := endpointSurfaces[endpointName(path)]
+	if !ok {
+		return APISurfacePublic
+	}
+	if len(surfaces) == 1 {
+		return surfaces[0]
+	}
+	if c.apiMode == APIModePublic {
+		return APISurfacePublic
+	}
+
+	// Both surfaces expose the endpoint
+	if c.apiMode == APIModeInternal {
+		return APISurfaceInternal
+	}
+	if _, ok := sessionAuthOf(c.auth); ok {
+		return APISurfaceInternal
+	}
+	return APISurfacePublic
+}
+
+// request sends a request to the API surface that serves path in the client's API mode
+func (c *Client) request(method, path string, body any, result any) error {
+	if c.surfaceFor(path) == APISurfaceInternal {
+		return c.doInternalRequest(method, path, body, result)
+	}
+	return c.doRequest(method, path, body, result)
+}
+
+// doInternalRequest performs a request against the internal API, establishing the session first and
+// logging in again once if it has expired
+func (c *Client) doInternalRequest(method, path string, body any, result any) error {
+	return c.doInternalContextRequest(context.Background(), method, path, body, result)
+}
+
+// doInternalContextRequest performs a request like doInternalRequest, giving up once ctx is done
+func (c *Client) doInternalContextRequest(ctx context.Context, method, path string, body any, result any) error {
+	if err := c.ensureSession(); err != nil {
+		return fmt.Errorf("failed to establish session: %w", err)
+	}
+
+	// Internal requests bypass the response cache, so writes still have to drop cached reads
+	if c.cache != nil && method != "GET" {
+		defer c.cache.invalidate()
+	}
+	if c.refresh != nil && method != "GET" {
+		c.refresh.invalidate()
+	}
+
+	err := c.doRESTContextRequest(ctx, method, path, body, result)
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.Code == http.StatusUnauthorized && c.canRefreshSession() {
+		c.logger.Logf("n8n session expired, logging in again")
+		if loginErr := c.Login(); loginErr != nil {
+			return fmt.Errorf("failed to refresh session: %w", loginErr)
+		}
+		err = c.doRESTContextRequest(ctx, method, path, body, result)
+	}
+
+	return err
+}
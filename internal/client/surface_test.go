@@ -0,0 +1,104 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestEndpointName(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: "workflows", want: "workflows"},
+		{path: "workflows/123/activate", want: "workflows"},
+		{path: "users?limit=10", want: "users"},
+		{path: "/settings", want: "settings"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if got := endpointName(tt.path); got != tt.want {
+				t.Errorf("endpointName(%q) = %q, want %q", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_SurfaceFor(t *testing.T) {
+	apiKey := &APIKeyAuth{APIKey: "test-key"}
+	session := &SessionAuth{}
+
+	tests := []struct {
+		name string
+		mode APIMode
+		auth AuthMethod
+		path string
+		want APISurface
+	}{
+		{name: "public mode", mode: APIModePublic, auth: session, path: "workflows", want: APISurfacePublic},
+		{name: "public mode internal-only endpoint", mode: APIModePublic, auth: apiKey, path: "settings",
+			want: APISurfaceInternal},
+		{name: "internal mode", mode: APIModeInternal, auth: apiKey, path: "workflows/1", want: APISurfaceInternal},
+		{name: "internal mode public-only endpoint", mode: APIModeInternal, auth: session, path: "audit",
+			want: APISurfacePublic},
+		{name: "auto with API key", mode: APIModeAuto, auth: apiKey, path: "workflows", want: APISurfacePublic},
+		{name: "auto with session", mode: APIModeAuto, auth: session, path: "workflows", want: APISurfaceInternal},
+		{name: "auto internal-only endpoint", mode: APIModeAuto, auth: apiKey, path: "ldap/config",
+			want: APISurfaceInternal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{apiMode: tt.mode, auth: tt.auth}
+			if got := c.surfaceFor(tt.path); got != tt.want {
+				t.Errorf("surfaceFor(%q) = %q, want %q", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewClient_InvalidAPIMode(t *testing.T) {
+	_, err := NewClient(&Config{
+		BaseURL: "http://localhost:5678",
+		Auth:    &APIKeyAuth{APIKey: "test-key"},
+		APIMode: "legacy",
+	})
+	if err == nil {
+		t.Error("Expected error for invalid API mode")
+	}
+}
+
+func TestClient_InternalAPIMode(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/workflows" {
+			t.Errorf("Expected path /rest/workflows, got %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("active") != "true" {
+			t.Errorf("Expected active=true query parameter, got %q", r.URL.RawQuery)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": [{"id": "1", "name": "First"}, {"id": "2", "name": "Second"}], "count": 2}`))
+	}))
+	defer server.Close()
+
+	c, err := NewClient(&Config{
+		BaseURL: server.URL,
+		Auth:    &APIKeyAuth{APIKey: "test-key"},
+		APIMode: APIModeInternal,
+	})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	workflows, err := c.GetWorkflows(&WorkflowListOptions{Active: boolPtr(true)})
+	if err != nil {
+		t.Fatalf("GetWorkflows() error = %v", err)
+	}
+
+	if len(workflows.Data) != 2 || workflows.Data[1].Name != "Second" {
+		t.Errorf("Expected 2 workflows, got %+v", workflows.Data)
+	}
+}
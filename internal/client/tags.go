@@ -0,0 +1,87 @@
+package client
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// Tag represents an n8n tag, which labels workflows
+type Tag struct {
+	ID        string     `json:"id,omitempty"`
+	Name      string     `json:"name"`
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+	// UsageCount is the number of workflows with the tag. Only the internal API reports it.
+	UsageCount *int `json:"usageCount,omitempty"`
+}
+
+// tagReference identifies a tag in the body of a workflow tags update
+type tagReference struct {
+	ID string `json:"id"`
+}
+
+// GetAllTags retrieves every tag of the instance, following pagination. Tags listed through the internal
+// API include their usage count; the public API does not accept the parameter.
+func (c *Client) GetAllTags() ([]Tag, error) {
+	var params url.Values
+	if c.surfaceFor("tags") == APISurfaceInternal {
+		params = url.Values{"withUsageCount": {"true"}}
+	}
+	return ListAll[Tag](c, "tags", params, 0)
+}
+
+// CreateTag creates a tag
+func (c *Client) CreateTag(name string) (*Tag, error) {
+	if name == "" {
+		return nil, fmt.Errorf("tag name is required")
+	}
+
+	var result Tag
+	if err := c.Post("tags", &Tag{Name: name}, &result); err != nil {
+		return nil, fmt.Errorf("failed to create tag %s: %w", name, err)
+	}
+
+	return &result, nil
+}
+
+// UpdateWorkflowTags replaces the tags of a workflow with the tags of the given IDs and returns the tags
+// the workflow has afterwards. The internal API has no tags endpoint for workflows, so there the tags
+// are set by updating the workflow.
+func (c *Client) UpdateWorkflowTags(workflowID string, tagIDs []string) ([]Tag, error) {
+	if workflowID == "" {
+		return nil, fmt.Errorf("workflow ID is required")
+	}
+
+	path := fmt.Sprintf("workflows/%s/tags", workflowID)
+
+	if c.surfaceFor(path) == APISurfaceInternal {
+		ids := append([]string{}, tagIDs...)
+		var result Workflow
+		if err := c.Patch(fmt.Sprintf("workflows/%s", workflowID), map[string]interface{}{"tags": ids}, &result); err != nil {
+			return nil, fmt.Errorf("failed to update tags of workflow %s: %w", workflowID, err)
+		}
+		return result.Tags, nil
+	}
+
+	references := make([]tagReference, len(tagIDs))
+	for i, id := range tagIDs {
+		references[i] = tagReference{ID: id}
+	}
+
+	var result []Tag
+	if err := c.Put(path, references, &result); err != nil {
+		return nil, fmt.Errorf("failed to update tags of workflow %s: %w", workflowID, err)
+	}
+
+	return result, nil
+}
+
+// TagNames returns the names of tags
+func TagNames(tags []Tag) []string {
+	names := make([]string, len(tags))
+	for i, tag := range tags {
+		names[i] = tag.Name
+	}
+	return names
+}
@@ -0,0 +1,162 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestClient_GetAllTags(t *testing.T) {
+	pages := [][]map[string]interface{}{
+		{{"id": "t1", "name": "billing"}},
+		{{"id": "t2", "name": "prod"}},
+	}
+	server := httptest.NewServer(cursorPagesHandler(t, "/api/v1/tags", pages))
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	tags, err := client.GetAllTags()
+	if err != nil {
+		t.Fatalf("GetAllTags() error = %v", err)
+	}
+
+	if !reflect.DeepEqual(TagNames(tags), []string{"billing", "prod"}) {
+		t.Errorf("Expected the tags of both pages, got %v", tags)
+	}
+}
+
+func TestClient_GetAllTags_InternalAPI(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/tags" || r.URL.Query().Get("withUsageCount") != "true" {
+			t.Errorf("Expected the tags to be listed with their usage count, got %s", r.URL)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": [{"id": "t1", "name": "billing", "usageCount": 3}]}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL: server.URL,
+		Auth:    &APIKeyAuth{APIKey: "test-key"},
+		APIMode: APIModeInternal,
+	})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	tags, err := client.GetAllTags()
+	if err != nil {
+		t.Fatalf("GetAllTags() error = %v", err)
+	}
+
+	if len(tags) != 1 || tags[0].UsageCount == nil || *tags[0].UsageCount != 3 {
+		t.Errorf("Expected the tag with its usage count, got %+v", tags)
+	}
+}
+
+func TestClient_CreateTag(t *testing.T) {
+	var created map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/api/v1/tags" {
+			t.Errorf("Expected POST /api/v1/tags, got %s %s", r.Method, r.URL.Path)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&created); err != nil {
+			t.Fatalf("Failed to decode request body: %v", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": "t3", "name": "billing", "createdAt": "2025-01-02T03:04:05.000Z"}`))
+	}))
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	tag, err := client.CreateTag("billing")
+	if err != nil {
+		t.Fatalf("CreateTag() error = %v", err)
+	}
+
+	if tag.ID != "t3" || tag.CreatedAt == nil {
+		t.Errorf("Expected the created tag, got %+v", tag)
+	}
+	if !reflect.DeepEqual(created, map[string]interface{}{"name": "billing"}) {
+		t.Errorf("Expected only the name to be sent, got %v", created)
+	}
+
+	if _, err := client.CreateTag(""); err == nil {
+		t.Error("Expected error for an empty tag name")
+	}
+}
+
+func TestClient_UpdateWorkflowTags(t *testing.T) {
+	var body []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" || r.URL.Path != "/api/v1/workflows/wf-1/tags" {
+			t.Errorf("Expected PUT /api/v1/workflows/wf-1/tags, got %s %s", r.Method, r.URL.Path)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Failed to decode request body: %v", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"id": "t1", "name": "billing"}, {"id": "t2", "name": "prod"}]`))
+	}))
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	tags, err := client.UpdateWorkflowTags("wf-1", []string{"t1", "t2"})
+	if err != nil {
+		t.Fatalf("UpdateWorkflowTags() error = %v", err)
+	}
+
+	expected := []map[string]interface{}{{"id": "t1"}, {"id": "t2"}}
+	if !reflect.DeepEqual(body, expected) {
+		t.Errorf("Expected the tag IDs to be sent, got %v", body)
+	}
+	if !reflect.DeepEqual(TagNames(tags), []string{"billing", "prod"}) {
+		t.Errorf("Expected the tags of the workflow, got %v", tags)
+	}
+}
+
+func TestClient_UpdateWorkflowTags_InternalAPI(t *testing.T) {
+	var patched map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PATCH" || r.URL.Path != "/rest/workflows/wf-1" {
+			t.Errorf("Expected PATCH /rest/workflows/wf-1, got %s %s", r.Method, r.URL.Path)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&patched); err != nil {
+			t.Fatalf("Failed to decode request body: %v", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": {"id": "wf-1", "name": "Orders", "tags": []}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL: server.URL,
+		Auth:    &APIKeyAuth{APIKey: "test-key"},
+		APIMode: APIModeInternal,
+	})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	tags, err := client.UpdateWorkflowTags("wf-1", nil)
+	if err != nil {
+		t.Fatalf("UpdateWorkflowTags() error = %v", err)
+	}
+
+	// Removing all tags sends an empty list rather than null
+	if !reflect.DeepEqual(patched, map[string]interface{}{"tags": []interface{}{}}) {
+		t.Errorf("Expected the tag IDs to be patched, got %v", patched)
+	}
+	if len(tags) != 0 {
+		t.Errorf("Expected no tags, got %v", tags)
+	}
+}
@@ -47,6 +47,31 @@ func DeleteTestHandler(t *testing.T, expectedPath string) http.HandlerFunc {
 	}
 }
 
+// TransferTestHandler creates a request handler for moving a resource to another project
+func TransferTestHandler(t *testing.T, expectedPath, expectedProjectID string) http.HandlerFunc {
+	t.Helper()
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" {
+			t.Errorf("Expected PUT request, got %s", r.Method)
+		}
+
+		if r.URL.Path != expectedPath {
+			t.Errorf("Expected path %s, got %s", expectedPath, r.URL.Path)
+		}
+
+		var body map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("Failed to decode request body: %v", err)
+		}
+		if body["destinationProjectId"] != expectedProjectID {
+			t.Errorf("Expected destinationProjectId %s, got %s", expectedProjectID, body["destinationProjectId"])
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
 // ListTestHandler creates a generic list request handler that validates query parameters
 func ListTestHandler(t *testing.T, expectedQuery url.Values, responseData interface{}) http.HandlerFunc {
 	t.Helper()
@@ -69,3 +94,13 @@ func ListTestHandler(t *testing.T, expectedQuery url.Values, responseData interf
 		}
 	}
 }
+
+// boolPtr returns a pointer to v
+func boolPtr(v bool) *bool {
+	return &v
+}
+
+// intPtr returns a pointer to v
+func intPtr(v int) *int {
+	return &v
+}
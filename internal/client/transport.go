@@ -0,0 +1,217 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"golang.org/x/net/http/httpproxy"
+)
+
+// TransportConfig holds connection pooling and proxy settings for the HTTP transport
+type TransportConfig struct {
+	MaxIdleConns        int
+	MaxIdleConnsPerHost int
+	IdleConnTimeout     time.Duration
+	DisableHTTP2        bool
+
+	// Proxy settings; when all are empty the standard proxy environment variables are used
+	HTTPProxy  string
+	HTTPSProxy string
+	NoProxy    string
+}
+
+// proxyFunc returns the proxy selection function for the transport
+func (tc TransportConfig) proxyFunc() func(*http.Request) (*url.URL, error) {
+	if tc.HTTPProxy == "" && tc.HTTPSProxy == "" && tc.NoProxy == "" {
+		return http.ProxyFromEnvironment
+	}
+
+	proxyConfig := &httpproxy.Config{
+		HTTPProxy:  tc.HTTPProxy,
+		HTTPSProxy: tc.HTTPSProxy,
+		NoProxy:    tc.NoProxy,
+	}
+	proxyForURL := proxyConfig.ProxyFunc()
+
+	return func(req *http.Request) (*url.URL, error) {
+		return proxyForURL(req.URL)
+	}
+}
+
+// tlsSettings holds the TLS options a transport is built with
+type tlsSettings struct {
+	insecureSkipVerify bool
+	clientCertPEM      string
+	clientKeyPEM       string
+	caCertPEM          string
+}
+
+// transportKey identifies transports that can be shared between clients
+type transportKey struct {
+	transport TransportConfig
+	tls       tlsSettings
+}
+
+var (
+	sharedTransportsMu sync.Mutex
+	sharedTransports   = map[transportKey]*http.Transport{}
+)
+
+// withDefaults returns a copy of the transport config with unset values filled in
+func (tc TransportConfig) withDefaults() TransportConfig {
+	if tc.MaxIdleConns == 0 {
+		tc.MaxIdleConns = 100
+	}
+	if tc.MaxIdleConnsPerHost == 0 {
+		tc.MaxIdleConnsPerHost = 32
+	}
+	if tc.IdleConnTimeout == 0 {
+		tc.IdleConnTimeout = 90 * time.Second
+	}
+	// The total limit would otherwise cap a larger per-host limit
+	if tc.MaxIdleConns < tc.MaxIdleConnsPerHost {
+		tc.MaxIdleConns = tc.MaxIdleConnsPerHost
+	}
+	return tc
+}
+
+// sharedTransport returns a pooled transport for the given settings, creating it on first use.
+// Clients with the same settings share idle connections instead of opening their own.
+func sharedTransport(tc TransportConfig, ts tlsSettings) (*http.Transport, error) {
+	key := transportKey{transport: tc.withDefaults(), tls: ts}
+
+	sharedTransportsMu.Lock()
+	defer sharedTransportsMu.Unlock()
+
+	if transport, ok := sharedTransports[key]; ok {
+		return transport, nil
+	}
+
+	transport, err := newTransport(key.transport, ts)
+	if err != nil {
+		return nil, err
+	}
+	sharedTransports[key] = transport
+	return transport, nil
+}
+
+// newTransport builds an HTTP transport with the given pooling and TLS settings
+func newTransport(tc TransportConfig, ts tlsSettings) (*http.Transport, error) {
+	tlsConfig, err := newTLSConfig(ts)
+	if err != nil {
+		return nil, err
+	}
+
+	transport := &http.Transport{
+		Proxy: tc.proxyFunc(),
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		MaxIdleConns:          tc.MaxIdleConns,
+		MaxIdleConnsPerHost:   tc.MaxIdleConnsPerHost,
+		IdleConnTimeout:       tc.IdleConnTimeout,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+		ForceAttemptHTTP2:     !tc.DisableHTTP2,
+		TLSClientConfig:       tlsConfig,
+	}
+
+	if tc.DisableHTTP2 {
+		// A non-nil, empty map disables the transport's automatic HTTP/2 upgrade
+		transport.TLSNextProto = map[string]func(string, *tls.Conn) http.RoundTripper{}
+	}
+
+	return transport, nil
+}
+
+// newTLSConfig builds the TLS configuration for connections to the n8n instance
+func newTLSConfig(ts tlsSettings) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		// InsecureSkipVerify should only be used for development/testing environments
+		// with self-signed certificates. In production, proper certificate validation
+		// should be used to prevent man-in-the-middle attacks.
+		InsecureSkipVerify: ts.insecureSkipVerify, // #nosec G402 - Configurable for development environments
+	}
+
+	// Trust a custom CA bundle in addition to the system roots
+	if ts.caCertPEM != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM([]byte(ts.caCertPEM)) {
+			return nil, fmt.Errorf("no valid certificates found in the CA bundle")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	// Present a client certificate for instances that require mutual TLS
+	if ts.clientCertPEM != "" || ts.clientKeyPEM != "" {
+		if ts.clientCertPEM == "" || ts.clientKeyPEM == "" {
+			return nil, fmt.Errorf("both a client certificate and a client key are required for mutual TLS")
+		}
+
+		cert, err := tls.X509KeyPair([]byte(ts.clientCertPEM), []byte(ts.clientKeyPEM))
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+// limitedTransport sends requests through a transport with at most as many requests in flight as it has
+// slots. A slot is released when the response body is closed, since n8n is busy with a request until the
+// response has been sent.
+type limitedTransport struct {
+	base  http.RoundTripper
+	slots chan struct{}
+}
+
+// limitTransport returns base limited to limit requests in flight, or base itself if limit is not positive.
+// Each call creates its own limit, so that clients sharing a pooled transport are limited separately.
+func limitTransport(base http.RoundTripper, limit int) http.RoundTripper {
+	if limit <= 0 {
+		return base
+	}
+	return &limitedTransport{base: base, slots: make(chan struct{}, limit)}
+}
+
+func (t *limitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	select {
+	case t.slots <- struct{}{}:
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		<-t.slots
+		return nil, err
+	}
+
+	resp.Body = &slotReleasingBody{ReadCloser: resp.Body, release: func() { <-t.slots }}
+	return resp, nil
+}
+
+// slotReleasingBody releases the slot of a limitedTransport the first time the response body is closed
+type slotReleasingBody struct {
+	io.ReadCloser
+	release func()
+	once    sync.Once
+}
+
+func (b *slotReleasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
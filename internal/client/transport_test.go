@@ -0,0 +1,418 @@
+package client
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestTransportConfig_Defaults(t *testing.T) {
+	tc := TransportConfig{}.withDefaults()
+
+	if tc.MaxIdleConns != 100 {
+		t.Errorf("MaxIdleConns = %d, want 100", tc.MaxIdleConns)
+	}
+	if tc.MaxIdleConnsPerHost != 32 {
+		t.Errorf("MaxIdleConnsPerHost = %d, want 32", tc.MaxIdleConnsPerHost)
+	}
+	if tc.IdleConnTimeout != 90*time.Second {
+		t.Errorf("IdleConnTimeout = %v, want 90s", tc.IdleConnTimeout)
+	}
+
+	custom := TransportConfig{MaxIdleConnsPerHost: 8}.withDefaults()
+	if custom.MaxIdleConnsPerHost != 8 {
+		t.Errorf("MaxIdleConnsPerHost = %d, want explicit value 8", custom.MaxIdleConnsPerHost)
+	}
+
+	large := TransportConfig{MaxIdleConnsPerHost: 200}.withDefaults()
+	if large.MaxIdleConns != 200 {
+		t.Errorf("MaxIdleConns = %d, want at least the per-host limit of 200", large.MaxIdleConns)
+	}
+}
+
+func TestNewClient_SharedTransport(t *testing.T) {
+	newTestClient := func(config TransportConfig, insecure bool) *Client {
+		client, err := NewClient(&Config{
+			BaseURL:            "https://n8n.example.com",
+			Auth:               &APIKeyAuth{APIKey: "test-key"},
+			InsecureSkipVerify: insecure,
+			Transport:          config,
+		})
+		if err != nil {
+			t.Fatalf("NewClient() error = %v", err)
+		}
+		return client
+	}
+
+	first := newTestClient(TransportConfig{}, false)
+	second := newTestClient(TransportConfig{}, false)
+	if first.httpClient.Transport != second.httpClient.Transport {
+		t.Error("Expected clients with the same settings to share a transport")
+	}
+
+	insecure := newTestClient(TransportConfig{}, true)
+	if insecure.httpClient.Transport == first.httpClient.Transport {
+		t.Error("Expected clients with different TLS settings to use separate transports")
+	}
+
+	transport, ok := insecure.httpClient.Transport.(*http.Transport)
+	if !ok {
+		t.Fatalf("Expected *http.Transport, got %T", insecure.httpClient.Transport)
+	}
+	if !transport.TLSClientConfig.InsecureSkipVerify {
+		t.Error("Expected InsecureSkipVerify to be set on the transport")
+	}
+	if !transport.ForceAttemptHTTP2 {
+		t.Error("Expected HTTP/2 to be enabled by default")
+	}
+}
+
+func TestNewTransport_DisableHTTP2(t *testing.T) {
+	transport, err := newTransport(TransportConfig{DisableHTTP2: true}.withDefaults(), tlsSettings{})
+	if err != nil {
+		t.Fatalf("newTransport() error = %v", err)
+	}
+
+	if transport.ForceAttemptHTTP2 {
+		t.Error("Expected ForceAttemptHTTP2 to be false when HTTP/2 is disabled")
+	}
+	if transport.TLSNextProto == nil || len(transport.TLSNextProto) != 0 {
+		t.Error("Expected an empty TLSNextProto map when HTTP/2 is disabled")
+	}
+	if transport.MaxIdleConnsPerHost != 32 {
+		t.Errorf("MaxIdleConnsPerHost = %d, want 32", transport.MaxIdleConnsPerHost)
+	}
+}
+
+func TestTransportConfig_ProxyFunc(t *testing.T) {
+	tc := TransportConfig{
+		HTTPProxy:  "http://proxy.internal:3128",
+		HTTPSProxy: "http://secure-proxy.internal:3128",
+		NoProxy:    "n8n.local",
+	}
+	proxy := tc.proxyFunc()
+
+	tests := []struct {
+		name     string
+		target   string
+		expected string
+	}{
+		{name: "http request", target: "http://n8n.example.com/api/v1/workflows", expected: "http://proxy.internal:3128"},
+		{name: "https request", target: "https://n8n.example.com/api/v1/workflows", expected: "http://secure-proxy.internal:3128"},
+		{name: "bypassed host", target: "https://n8n.local/api/v1/workflows", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest("GET", tt.target, nil)
+			if err != nil {
+				t.Fatalf("NewRequest() error = %v", err)
+			}
+
+			proxyURL, err := proxy(req)
+			if err != nil {
+				t.Fatalf("proxy() error = %v", err)
+			}
+
+			got := ""
+			if proxyURL != nil {
+				got = proxyURL.String()
+			}
+			if got != tt.expected {
+				t.Errorf("proxy(%s) = %q, want %q", tt.target, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestClient_ExtraHeaders(t *testing.T) {
+	server := TestServer(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("X-Proxy-Token"); got != "secret" {
+			t.Errorf("Expected X-Proxy-Token header 'secret', got %q", got)
+		}
+		if got := r.Header.Get("X-N8N-API-KEY"); got != "test-key" {
+			t.Errorf("Expected API key header to be preserved, got %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": []}`))
+	})
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL: server.URL,
+		Auth:    &APIKeyAuth{APIKey: "test-key"},
+		Headers: map[string]string{"X-Proxy-Token": "secret"},
+	})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	if _, err := client.GetWorkflows(nil); err != nil {
+		t.Fatalf("GetWorkflows() error = %v", err)
+	}
+}
+
+func TestClient_RequestHeaders(t *testing.T) {
+	var userAgents, requestIDs []string
+	server := TestServer(func(w http.ResponseWriter, r *http.Request) {
+		userAgents = append(userAgents, r.Header.Get("User-Agent"))
+		requestIDs = append(requestIDs, r.Header.Get("X-Request-Id"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": []}`))
+	})
+	defer server.Close()
+
+	var messages []string
+	client, err := NewClient(&Config{
+		BaseURL:   server.URL,
+		Auth:      &APIKeyAuth{APIKey: "test-key"},
+		UserAgent: "terraform-provider-n8n/1.2.3",
+		Logger:    &TestLogger{messages: &messages},
+	})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := client.Get(fmt.Sprintf("tags?page=%d", i), &map[string]interface{}{}); err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+	}
+
+	if userAgents[0] != "terraform-provider-n8n/1.2.3" {
+		t.Errorf("Expected the configured User-Agent, got %q", userAgents[0])
+	}
+	if requestIDs[0] == "" || requestIDs[0] == requestIDs[1] {
+		t.Errorf("Expected a new request ID per request, got %v", requestIDs)
+	}
+	if !strings.Contains(strings.Join(messages, "\n"), "request ID "+requestIDs[0]) {
+		t.Errorf("Expected the request ID to be logged, got %v", messages)
+	}
+}
+
+func TestClient_Parallelism(t *testing.T) {
+	var inFlight, maxInFlight int32
+	server := TestServer(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			seen := atomic.LoadInt32(&maxInFlight)
+			if current <= seen || atomic.CompareAndSwapInt32(&maxInFlight, seen, current) {
+				break
+			}
+		}
+
+		time.Sleep(20 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": "1", "name": "Workflow"}`))
+	})
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL:     server.URL,
+		Auth:        &APIKeyAuth{APIKey: "test-key"},
+		Parallelism: 2,
+	})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(id int) {
+			defer wg.Done()
+			if _, err := client.GetWorkflow(fmt.Sprintf("wf-%d", id)); err != nil {
+				t.Errorf("GetWorkflow() error = %v", err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if got := atomic.LoadInt32(&maxInFlight); got != 2 {
+		t.Errorf("Expected at most 2 requests in flight, got %d", got)
+	}
+}
+
+func TestClient_ParallelismRetry(t *testing.T) {
+	var calls int32
+	server := TestServer(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{"message": "Service Unavailable"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"id": "1", "name": "Workflow"}`))
+	})
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL:     server.URL,
+		Auth:        &APIKeyAuth{APIKey: "test-key"},
+		Timeout:     2 * time.Second,
+		RetryConfig: RetryConfig{MaxRetries: 1, BaseDelay: time.Millisecond, MaxDelay: time.Millisecond},
+		Parallelism: 1,
+	})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	// The retry must not wait for the slot held by the failed attempt
+	if _, err := client.GetWorkflow("1"); err != nil {
+		t.Fatalf("GetWorkflow() error = %v", err)
+	}
+	if got := atomic.LoadInt32(&calls); got != 2 {
+		t.Errorf("Expected 2 calls, got %d", got)
+	}
+}
+
+// generateTestCertificate creates a self-signed certificate and key, PEM-encoded
+func generateTestCertificate(t *testing.T, commonName string) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: commonName},
+		DNSNames:              []string{"localhost"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("Failed to create certificate: %v", err)
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("Failed to marshal key: %v", err)
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+
+	return string(certPEM), string(keyPEM)
+}
+
+func TestNewTLSConfig_ClientCertificate(t *testing.T) {
+	certPEM, keyPEM := generateTestCertificate(t, "terraform")
+
+	tests := []struct {
+		name     string
+		settings tlsSettings
+		wantErr  bool
+		wantCert bool
+	}{
+		{name: "no client certificate", settings: tlsSettings{}, wantErr: false, wantCert: false},
+		{name: "valid key pair", settings: tlsSettings{clientCertPEM: certPEM, clientKeyPEM: keyPEM}, wantErr: false, wantCert: true},
+		{name: "certificate without key", settings: tlsSettings{clientCertPEM: certPEM}, wantErr: true},
+		{name: "invalid PEM", settings: tlsSettings{clientCertPEM: "invalid", clientKeyPEM: "invalid"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tlsConfig, err := newTLSConfig(tt.settings)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("newTLSConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && (len(tlsConfig.Certificates) == 1) != tt.wantCert {
+				t.Errorf("Expected client certificate = %v, got %d certificates", tt.wantCert, len(tlsConfig.Certificates))
+			}
+		})
+	}
+}
+
+func TestClient_MutualTLS(t *testing.T) {
+	certPEM, keyPEM := generateTestCertificate(t, "terraform")
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.TLS == nil || len(r.TLS.PeerCertificates) == 0 {
+			t.Error("Expected the client to present a certificate")
+		} else if cn := r.TLS.PeerCertificates[0].Subject.CommonName; cn != "terraform" {
+			t.Errorf("Expected client certificate CN 'terraform', got %q", cn)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": []}`))
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	server.StartTLS()
+	defer server.Close()
+
+	client, err := NewClient(&Config{
+		BaseURL:            server.URL,
+		Auth:               &APIKeyAuth{APIKey: "test-key"},
+		InsecureSkipVerify: true,
+		ClientCertPEM:      certPEM,
+		ClientKeyPEM:       keyPEM,
+		RetryConfig:        RetryConfig{MaxRetries: 1},
+	})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	if _, err := client.GetWorkflows(nil); err != nil {
+		t.Fatalf("GetWorkflows() error = %v", err)
+	}
+}
+
+func TestClient_CustomCABundle(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": []}`))
+	}))
+	defer server.Close()
+
+	caPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+
+	newTestClient := func(caCertPEM string) *Client {
+		client, err := NewClient(&Config{
+			BaseURL:     server.URL,
+			Auth:        &APIKeyAuth{APIKey: "test-key"},
+			CACertPEM:   caCertPEM,
+			RetryConfig: RetryConfig{MaxRetries: 1, BaseDelay: time.Millisecond},
+		})
+		if err != nil {
+			t.Fatalf("NewClient() error = %v", err)
+		}
+		return client
+	}
+
+	// Verification succeeds once the server's CA is trusted
+	if _, err := newTestClient(caPEM).GetWorkflows(nil); err != nil {
+		t.Fatalf("GetWorkflows() with CA bundle error = %v", err)
+	}
+
+	// Without the CA bundle the self-signed certificate is rejected
+	if _, err := newTestClient("").GetWorkflows(nil); err == nil {
+		t.Error("Expected certificate verification to fail without the CA bundle")
+	}
+}
+
+func TestNewTLSConfig_InvalidCABundle(t *testing.T) {
+	if _, err := newTLSConfig(tlsSettings{caCertPEM: "not a certificate"}); err == nil {
+		t.Error("Expected an error for a CA bundle without certificates")
+	}
+}
@@ -16,6 +16,7 @@ type User struct {
 	Role        string       `json:"role,omitempty"`
 	IsOwner     bool         `json:"isOwner,omitempty"`
 	IsPending   bool         `json:"isPending,omitempty"`
+	MFAEnabled  bool         `json:"mfaEnabled,omitempty"`
 	SignupToken string       `json:"signupToken,omitempty"`
 	Settings    UserSettings `json:"settings,omitempty"`
 	CreatedAt   *time.Time   `json:"createdAt,omitempty"`
@@ -30,9 +31,11 @@ type UserSettings struct {
 
 // UserListOptions represents options for listing users
 type UserListOptions struct {
-	Role   string
-	Limit  int
-	Offset int
+	Role        string
+	IncludeRole bool // n8n leaves the role out of listed users unless it is requested
+	Limit       int
+	Offset      int
+	Cursor      string
 }
 
 // UserListResponse represents the response from listing users
@@ -50,6 +53,12 @@ type CreateUserRequest struct {
 	Password  string `json:"password,omitempty"`
 }
 
+// CreateUserResult represents the outcome of a single invitation in a batch create request
+type CreateUserResult struct {
+	User  User   `json:"user"`
+	Error string `json:"error"`
+}
+
 // GetUsers retrieves a list of users
 func (c *Client) GetUsers(options *UserListOptions) (*UserListResponse, error) {
 	u, err := url.Parse("users")
@@ -64,6 +73,10 @@ func (c *Client) GetUsers(options *UserListOptions) (*UserListResponse, error) {
 			params.Set("role", options.Role)
 		}
 
+		if options.IncludeRole {
+			params.Set("includeRole", "true")
+		}
+
 		if options.Limit > 0 {
 			params.Set("limit", strconv.Itoa(options.Limit))
 		}
@@ -72,6 +85,10 @@ func (c *Client) GetUsers(options *UserListOptions) (*UserListResponse, error) {
 			params.Set("offset", strconv.Itoa(options.Offset))
 		}
 
+		if options.Cursor != "" {
+			params.Set("cursor", options.Cursor)
+		}
+
 		u.RawQuery = params.Encode()
 	}
 
@@ -107,23 +124,9 @@ func (c *Client) CreateUser(userReq *CreateUserRequest) (*User, error) {
 		return nil, fmt.Errorf("user request is required")
 	}
 
-	if userReq.Email == "" {
-		return nil, fmt.Errorf("user email is required")
-	}
-
-	// n8n API expects an array of users, so wrap single user in array
-	userArray := []*CreateUserRequest{userReq}
-
-	// n8n returns array of {user: User, error: string} objects
-	type CreateUserResponse struct {
-		User  User   `json:"user"`
-		Error string `json:"error"`
-	}
-
-	var resultArray []CreateUserResponse
-	err := c.Post("users", userArray, &resultArray)
+	resultArray, err := c.CreateUsers([]*CreateUserRequest{userReq})
 	if err != nil {
-		return nil, fmt.Errorf("failed to create user: %w", err)
+		return nil, err
 	}
 
 	if len(resultArray) == 0 {
@@ -137,6 +140,32 @@ func (c *Client) CreateUser(userReq *CreateUserRequest) (*User, error) {
 	return &resultArray[0].User, nil
 }
 
+// CreateUsers invites multiple users in a single request. The n8n API reports
+// failures per user, so callers must inspect the Error field of each result.
+func (c *Client) CreateUsers(userReqs []*CreateUserRequest) ([]CreateUserResult, error) {
+	if len(userReqs) == 0 {
+		return nil, fmt.Errorf("at least one user request is required")
+	}
+
+	for i, userReq := range userReqs {
+		if userReq == nil {
+			return nil, fmt.Errorf("user request %d is required", i)
+		}
+
+		if userReq.Email == "" {
+			return nil, fmt.Errorf("user email is required")
+		}
+	}
+
+	var resultArray []CreateUserResult
+	err := c.Post("users", userReqs, &resultArray)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create users: %w", err)
+	}
+
+	return resultArray, nil
+}
+
 // UpdateUser updates an existing user
 func (c *Client) UpdateUser(id string, user *User) (*User, error) {
 	if id == "" {
@@ -158,6 +187,76 @@ func (c *Client) UpdateUser(id string, user *User) (*User, error) {
 	return &result, nil
 }
 
+// changeRoleRequest is the request body for changing the global role of a user
+type changeRoleRequest struct {
+	NewRoleName string `json:"newRoleName"`
+}
+
+// ChangeUserRole changes the global role of a user, e.g. to global:admin
+func (c *Client) ChangeUserRole(id, role string) error {
+	if id == "" {
+		return fmt.Errorf("user ID is required")
+	}
+
+	if role == "" {
+		return fmt.Errorf("role is required")
+	}
+
+	path := fmt.Sprintf("users/%s/role", id)
+
+	if err := c.Patch(path, &changeRoleRequest{NewRoleName: role}, nil); err != nil {
+		return fmt.Errorf("failed to change role of user %s: %w", id, err)
+	}
+
+	return nil
+}
+
+// passwordResetLink is the response of the internal password reset link endpoint
+type passwordResetLink struct {
+	Link string `json:"link"`
+}
+
+// changePasswordRequest redeems a password reset token
+type changePasswordRequest struct {
+	Token    string `json:"token"`
+	UserID   string `json:"userId,omitempty"`
+	Password string `json:"password"`
+}
+
+// SetUserPassword sets the password of a user. n8n has no endpoint to set the password of another user,
+// so a password reset link is generated and redeemed with the new password. Requires session authentication.
+func (c *Client) SetUserPassword(id, password string) error {
+	if id == "" {
+		return fmt.Errorf("user ID is required")
+	}
+
+	if password == "" {
+		return fmt.Errorf("password is required")
+	}
+
+	var resetLink passwordResetLink
+	if err := c.doInternalRequest("GET", fmt.Sprintf("users/%s/password-reset-link", id), nil, &resetLink); err != nil {
+		return fmt.Errorf("failed to create password reset link for user %s: %w", id, err)
+	}
+
+	link, err := url.Parse(resetLink.Link)
+	if err != nil || link.Query().Get("token") == "" {
+		return fmt.Errorf("failed to create password reset link for user %s: no token in link", id)
+	}
+
+	// Older n8n versions identify the user by a separate userId parameter
+	body := &changePasswordRequest{
+		Token:    link.Query().Get("token"),
+		UserID:   link.Query().Get("userId"),
+		Password: password,
+	}
+	if err := c.doInternalRequest("POST", "change-password", body, nil); err != nil {
+		return fmt.Errorf("failed to set password of user %s: %w", id, err)
+	}
+
+	return nil
+}
+
 // DeleteUser deletes a user
 func (c *Client) DeleteUser(id string) error {
 	if id == "" {
@@ -173,3 +272,20 @@ func (c *Client) DeleteUser(id string) error {
 
 	return nil
 }
+
+// DeleteUserWithTransfer deletes a user after moving the workflows and credentials they own to the project
+// transferToProjectID, e.g. the personal project of another user
+func (c *Client) DeleteUserWithTransfer(id, transferToProjectID string) error {
+	if id == "" || transferToProjectID == "" {
+		return fmt.Errorf("user ID and transfer project ID are required")
+	}
+
+	path := fmt.Sprintf("users/%s?%s", id, url.Values{"transferId": {transferToProjectID}}.Encode())
+
+	err := c.Delete(path)
+	if err != nil {
+		return fmt.Errorf("failed to delete user %s: %w", id, err)
+	}
+
+	return nil
+}
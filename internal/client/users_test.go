@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"reflect"
 	"testing"
 	"time"
 )
@@ -71,8 +72,10 @@ func TestClient_GetUsers(t *testing.T) {
 
 func TestClient_GetUsersWithOptions(t *testing.T) {
 	expectedQuery := url.Values{
-		"role":  []string{"admin"},
-		"limit": []string{"5"},
+		"role":        []string{"admin"},
+		"includeRole": []string{"true"},
+		"limit":       []string{"5"},
+		"cursor":      []string{"next-page"},
 	}
 
 	response := UserListResponse{
@@ -85,8 +88,10 @@ func TestClient_GetUsersWithOptions(t *testing.T) {
 	client := CreateTestClient(t, server.URL)
 
 	options := &UserListOptions{
-		Role:  "admin",
-		Limit: 5,
+		Role:        "admin",
+		IncludeRole: true,
+		Limit:       5,
+		Cursor:      "next-page",
 	}
 
 	_, err := client.GetUsers(options)
@@ -259,6 +264,71 @@ func TestClient_CreateUserValidation(t *testing.T) {
 	}
 }
 
+func TestClient_CreateUsers(t *testing.T) {
+	userReqs := []*CreateUserRequest{
+		{Email: "first@example.com", Role: "global:member"},
+		{Email: "second@example.com", Role: "global:admin"},
+	}
+
+	expectedResult := []CreateUserResult{
+		{User: User{ID: "id-1", Email: "first@example.com"}},
+		{User: User{Email: "second@example.com"}, Error: "user already exists"},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("Expected POST request, got %s", r.Method)
+		}
+
+		var receivedUserReqArray []*CreateUserRequest
+		_ = json.NewDecoder(r.Body).Decode(&receivedUserReqArray)
+
+		if len(receivedUserReqArray) != 2 {
+			t.Errorf("Expected 2 users in a single request, got %d", len(receivedUserReqArray))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(expectedResult)
+	}))
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	results, err := client.CreateUsers(userReqs)
+	if err != nil {
+		t.Fatalf("CreateUsers() error = %v", err)
+	}
+
+	if len(results) != 2 {
+		t.Fatalf("CreateUsers() returned %d results, expected 2", len(results))
+	}
+
+	if results[0].User.ID != "id-1" || results[0].Error != "" {
+		t.Errorf("CreateUsers() first result = %+v, expected successful invite", results[0])
+	}
+
+	if results[1].Error != "user already exists" {
+		t.Errorf("CreateUsers() second result error = %q, expected per-user error", results[1].Error)
+	}
+}
+
+func TestClient_CreateUsersValidation(t *testing.T) {
+	client := CreateTestClient(t, "https://example.com")
+
+	if _, err := client.CreateUsers(nil); err == nil {
+		t.Error("CreateUsers() with no user requests should return error")
+	}
+
+	if _, err := client.CreateUsers([]*CreateUserRequest{{Email: "a@example.com"}, nil}); err == nil {
+		t.Error("CreateUsers() with nil user request should return error")
+	}
+
+	if _, err := client.CreateUsers([]*CreateUserRequest{{FirstName: "Test"}}); err == nil {
+		t.Error("CreateUsers() with empty email should return error")
+	}
+}
+
 func TestClient_UpdateUser(t *testing.T) {
 	user := &User{
 		Email:     "updated@example.com",
@@ -297,6 +367,71 @@ func TestClient_UpdateUser(t *testing.T) {
 	}
 }
 
+func TestClient_ChangeUserRole(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PATCH" {
+			t.Errorf("Expected PATCH request, got %s", r.Method)
+		}
+
+		if r.URL.Path != "/api/v1/users/test-id/role" {
+			t.Errorf("Expected path /api/v1/users/test-id/role, got %s", r.URL.Path)
+		}
+
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body["newRoleName"] != "global:admin" {
+			t.Errorf("Expected the new role name in the request body, got %v (%v)", body, err)
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	if err := client.ChangeUserRole("test-id", "global:admin"); err != nil {
+		t.Errorf("ChangeUserRole() error = %v", err)
+	}
+	if err := client.ChangeUserRole("test-id", ""); err == nil {
+		t.Error("Expected an error for an empty role")
+	}
+}
+
+func TestClient_SetUserPassword(t *testing.T) {
+	var changed map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method + " " + r.URL.Path {
+		case "GET /rest/users/test-id/password-reset-link":
+			_, _ = w.Write([]byte(`{"data": {"link": "https://n8n.example.com/change-password?token=reset-token&mfaEnabled=false"}}`))
+		case "POST /rest/change-password":
+			if err := json.NewDecoder(r.Body).Decode(&changed); err != nil {
+				t.Fatalf("Failed to decode request body: %v", err)
+			}
+			_, _ = w.Write([]byte(`{"data": {}}`))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	if err := client.SetUserPassword("test-id", "n3w-Password"); err != nil {
+		t.Fatalf("SetUserPassword() error = %v", err)
+	}
+
+	expected := map[string]interface{}{"token": "reset-token", "password": "n3w-Password"}
+	if !reflect.DeepEqual(changed, expected) {
+		t.Errorf("Expected change password request %v, got %v", expected, changed)
+	}
+
+	if err := client.SetUserPassword("test-id", ""); err == nil {
+		t.Error("Expected error for an empty password")
+	}
+}
+
 func TestClient_DeleteUser(t *testing.T) {
 	server := TestServer(DeleteTestHandler(t, "/api/v1/users/test-id"))
 	defer server.Close()
@@ -308,3 +443,25 @@ func TestClient_DeleteUser(t *testing.T) {
 		t.Errorf("DeleteUser() error = %v", err)
 	}
 }
+
+func TestClient_DeleteUserWithTransfer(t *testing.T) {
+	var transferID string
+	server := TestServer(func(w http.ResponseWriter, r *http.Request) {
+		transferID = r.URL.Query().Get("transferId")
+		DeleteTestHandler(t, "/api/v1/users/test-id")(w, r)
+	})
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	if err := client.DeleteUserWithTransfer("test-id", "personal-2"); err != nil {
+		t.Fatalf("DeleteUserWithTransfer() error = %v", err)
+	}
+	if transferID != "personal-2" {
+		t.Errorf("Expected transferId personal-2, got %q", transferID)
+	}
+
+	if err := client.DeleteUserWithTransfer("test-id", ""); err == nil {
+		t.Error("Expected error for an empty transfer project ID")
+	}
+}
@@ -0,0 +1,87 @@
+package client
+
+import (
+	"net/url"
+	"strings"
+)
+
+// Webhook describes a webhook exposed by a trigger node of a workflow
+type Webhook struct {
+	NodeName      string
+	HTTPMethod    string
+	Path          string
+	ProductionURL string
+	TestURL       string
+}
+
+// webhookPrefixes maps trigger node types to the URL prefixes n8n serves them under
+// for production and test executions
+var webhookPrefixes = map[string][2]string{
+	"n8n-nodes-base.webhook":     {"webhook", "webhook-test"},
+	"n8n-nodes-base.formTrigger": {"form", "form-test"},
+}
+
+// InstanceURL returns the root URL of the n8n instance, with a trailing slash
+func (c *Client) InstanceURL() string {
+	return c.instanceURL().String()
+}
+
+// instanceURL returns the parsed root URL of the n8n instance
+func (c *Client) instanceURL() *url.URL {
+	instanceURL := *c.baseURL
+	instanceURL.Path = strings.TrimSuffix(instanceURL.Path, "api/v1/")
+	instanceURL.RawQuery = ""
+	return &instanceURL
+}
+
+// WebhookBaseURL returns the base URL webhooks are served under, with a trailing slash.
+// It is the configured webhook URL if set, otherwise the instance URL.
+func (c *Client) WebhookBaseURL() string {
+	if c.webhookURL != nil {
+		return c.webhookURL.String()
+	}
+	return c.InstanceURL()
+}
+
+// WorkflowWebhooks returns the webhooks exposed by the trigger nodes of a workflow
+func (c *Client) WorkflowWebhooks(workflow *Workflow) []Webhook {
+	baseURL := c.WebhookBaseURL()
+	var webhooks []Webhook
+
+	nodes, err := ParseNodes(workflow.Nodes)
+	if err != nil {
+		return nil
+	}
+
+	for _, node := range nodes {
+		prefixes, ok := webhookPrefixes[node.Type]
+		if !ok {
+			continue
+		}
+
+		// n8n falls back to the node's webhook ID when no path is configured
+		webhookPath, _ := node.Parameters["path"].(string)
+		if webhookPath == "" {
+			webhookPath = node.WebhookID
+		}
+		if webhookPath == "" {
+			continue
+		}
+		webhookPath = strings.TrimPrefix(webhookPath, "/")
+
+		method, _ := node.Parameters["httpMethod"].(string)
+		if method == "" {
+			method = "GET"
+		}
+
+		webhooks = append(webhooks, Webhook{
+			NodeName:      node.Name,
+			HTTPMethod:    method,
+			Path:          webhookPath,
+			ProductionURL: baseURL + prefixes[0] + "/" + webhookPath,
+			TestURL:       baseURL + prefixes[1] + "/" + webhookPath,
+		})
+	}
+
+	return webhooks
+}
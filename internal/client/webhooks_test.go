@@ -0,0 +1,132 @@
+package client
+
+import (
+	"testing"
+)
+
+func TestClient_InstanceURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		baseURL  string
+		expected string
+	}{
+		{name: "plain host", baseURL: "https://n8n.example.com", expected: "https://n8n.example.com/"},
+		{name: "trailing slash", baseURL: "https://n8n.example.com/", expected: "https://n8n.example.com/"},
+		{name: "sub path", baseURL: "https://example.com/n8n", expected: "https://example.com/n8n/"},
+		{name: "api path included", baseURL: "https://n8n.example.com/api/v1", expected: "https://n8n.example.com/"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := CreateTestClient(t, tt.baseURL)
+
+			if got := client.InstanceURL(); got != tt.expected {
+				t.Errorf("InstanceURL() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestClient_WebhookBaseURL(t *testing.T) {
+	client := CreateTestClient(t, "https://n8n.internal:5678")
+	if got := client.WebhookBaseURL(); got != "https://n8n.internal:5678/" {
+		t.Errorf("WebhookBaseURL() = %q, want instance URL", got)
+	}
+
+	client, err := NewClient(&Config{
+		BaseURL:    "https://n8n.internal:5678",
+		Auth:       &APIKeyAuth{APIKey: "test-key"},
+		WebhookURL: "https://hooks.example.com",
+	})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	if got := client.WebhookBaseURL(); got != "https://hooks.example.com/" {
+		t.Errorf("WebhookBaseURL() = %q, want %q", got, "https://hooks.example.com/")
+	}
+
+	webhooks := client.WorkflowWebhooks(&Workflow{
+		Nodes: []interface{}{
+			map[string]interface{}{
+				"name":       "Webhook",
+				"type":       "n8n-nodes-base.webhook",
+				"parameters": map[string]interface{}{"path": "orders"},
+			},
+		},
+	})
+	if len(webhooks) != 1 || webhooks[0].ProductionURL != "https://hooks.example.com/webhook/orders" {
+		t.Errorf("Expected webhook URL based on the configured webhook URL, got %+v", webhooks)
+	}
+}
+
+func TestClient_WorkflowWebhooks(t *testing.T) {
+	client := CreateTestClient(t, "https://n8n.example.com")
+
+	workflow := &Workflow{
+		Nodes: []interface{}{
+			map[string]interface{}{
+				"name": "Incoming Order",
+				"type": "n8n-nodes-base.webhook",
+				"parameters": map[string]interface{}{
+					"path":       "orders",
+					"httpMethod": "POST",
+				},
+			},
+			map[string]interface{}{
+				"name":       "Default Path",
+				"type":       "n8n-nodes-base.webhook",
+				"webhookId":  "0f5c3f9e-1234",
+				"parameters": map[string]interface{}{},
+			},
+			map[string]interface{}{
+				"name": "Signup Form",
+				"type": "n8n-nodes-base.formTrigger",
+				"parameters": map[string]interface{}{
+					"path": "signup",
+				},
+			},
+			map[string]interface{}{
+				"name":       "Set",
+				"type":       "n8n-nodes-base.set",
+				"parameters": map[string]interface{}{},
+			},
+		},
+	}
+
+	webhooks := client.WorkflowWebhooks(workflow)
+
+	if len(webhooks) != 3 {
+		t.Fatalf("Expected 3 webhooks, got %d", len(webhooks))
+	}
+
+	expected := []Webhook{
+		{
+			NodeName:      "Incoming Order",
+			HTTPMethod:    "POST",
+			Path:          "orders",
+			ProductionURL: "https://n8n.example.com/webhook/orders",
+			TestURL:       "https://n8n.example.com/webhook-test/orders",
+		},
+		{
+			NodeName:      "Default Path",
+			HTTPMethod:    "GET",
+			Path:          "0f5c3f9e-1234",
+			ProductionURL: "https://n8n.example.com/webhook/0f5c3f9e-1234",
+			TestURL:       "https://n8n.example.com/webhook-test/0f5c3f9e-1234",
+		},
+		{
+			NodeName:      "Signup Form",
+			HTTPMethod:    "GET",
+			Path:          "signup",
+			ProductionURL: "https://n8n.example.com/form/signup",
+			TestURL:       "https://n8n.example.com/form-test/signup",
+		},
+	}
+
+	for i, want := range expected {
+		if webhooks[i] != want {
+			t.Errorf("Webhook %d = %+v, want %+v", i, webhooks[i], want)
+		}
+	}
+}
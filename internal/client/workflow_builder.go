@@ -0,0 +1,316 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// ConnectionTypeMain is the connection type of the regular data flow between nodes. AI nodes use other
+// types, e.g. "ai_languageModel" or "ai_tool".
+const ConnectionTypeMain = "main"
+
+// nodeSpacing is the horizontal distance between nodes that the builder places automatically
+const nodeSpacing = 220
+
+// Node is a node of a workflow in the format n8n stores it
+type Node struct {
+	ID          string                    `json:"id,omitempty"`
+	Name        string                    `json:"name"`
+	Type        string                    `json:"type"`
+	TypeVersion float64                   `json:"typeVersion"`
+	Position    [2]float64                `json:"position"`
+	Parameters  map[string]interface{}    `json:"parameters"`
+	Credentials map[string]NodeCredential `json:"credentials,omitempty"`
+	WebhookID   string                    `json:"webhookId,omitempty"`
+	Disabled    bool                      `json:"disabled,omitempty"`
+
+	// Extra holds the node settings that are not modeled above (e.g. notes or retryOnFail), so that
+	// they are kept when a node that was parsed is marshaled again
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// triggerNodeTypes lists trigger node types whose type name does not end in "Trigger"
+var triggerNodeTypes = map[string]bool{
+	"n8n-nodes-base.start":    true,
+	"n8n-nodes-base.webhook":  true,
+	"n8n-nodes-base.cron":     true,
+	"n8n-nodes-base.interval": true,
+}
+
+// IsTriggerNodeType reports whether nodes of the given type start a workflow
+func IsTriggerNodeType(nodeType string) bool {
+	return strings.HasSuffix(nodeType, "Trigger") || triggerNodeTypes[nodeType]
+}
+
+// NodeCredential references the credential a node uses for a credential type
+type NodeCredential struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// nodeFields is an alias of Node without its JSON methods
+type nodeFields Node
+
+// UnmarshalJSON decodes a node and keeps the settings that are not modeled in Extra
+func (n *Node) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, (*nodeFields)(n)); err != nil {
+		return err
+	}
+
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(data, &all); err != nil {
+		return err
+	}
+	for _, name := range jsonFieldNames(reflect.TypeOf(nodeFields{})) {
+		delete(all, name)
+	}
+
+	n.Extra = nil
+	if len(all) > 0 {
+		n.Extra = all
+	}
+
+	return nil
+}
+
+// MarshalJSON encodes a node including the settings kept in Extra
+func (n Node) MarshalJSON() ([]byte, error) {
+	if n.Parameters == nil {
+		n.Parameters = map[string]interface{}{}
+	}
+
+	data, err := json.Marshal(nodeFields(n))
+	if err != nil || len(n.Extra) == 0 {
+		return data, err
+	}
+
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(data, &all); err != nil {
+		return nil, err
+	}
+	for name, value := range n.Extra {
+		if _, ok := all[name]; !ok {
+			all[name] = value
+		}
+	}
+
+	return json.Marshal(all)
+}
+
+// Connection is the target of a connection: an input of a node
+type Connection struct {
+	Node  string `json:"node"`
+	Type  string `json:"type"`
+	Index int    `json:"index"`
+}
+
+// Connections are the connections of a workflow in the format n8n stores them: keyed by the name of the
+// source node and the connection type, with the targets of each output of the source node
+type Connections map[string]map[string][][]Connection
+
+// Targets returns the targets of an output of a node
+func (c Connections) Targets(source, connectionType string, output int) []Connection {
+	outputs := c[source][connectionType]
+	if output < 0 || output >= len(outputs) {
+		return nil
+	}
+	return outputs[output]
+}
+
+// add connects an output of source to target, unless they are already connected
+func (c Connections) add(source, connectionType string, output int, target Connection) {
+	if c[source] == nil {
+		c[source] = map[string][][]Connection{}
+	}
+
+	outputs := c[source][connectionType]
+	for len(outputs) <= output {
+		outputs = append(outputs, []Connection{})
+	}
+	for _, existing := range outputs[output] {
+		if existing == target {
+			return
+		}
+	}
+	outputs[output] = append(outputs[output], target)
+	c[source][connectionType] = outputs
+}
+
+// ParseNodes decodes the nodes of a workflow
+func ParseNodes(nodes []interface{}) ([]Node, error) {
+	var result []Node
+	if err := convertJSON(nodes, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse nodes: %w", err)
+	}
+	return result, nil
+}
+
+// ParseConnections decodes the connections of a workflow
+func ParseConnections(connections map[string]interface{}) (Connections, error) {
+	result := Connections{}
+	if err := convertJSON(connections, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse connections: %w", err)
+	}
+	if result == nil {
+		result = Connections{}
+	}
+	return result, nil
+}
+
+// WorkflowBuilder constructs the nodes and connections of a workflow
+type WorkflowBuilder struct {
+	name        string
+	nodes       []*Node
+	connections Connections
+	settings    map[string]interface{}
+}
+
+// NewWorkflowBuilder creates a builder for a workflow with the given name and no nodes
+func NewWorkflowBuilder(name string) *WorkflowBuilder {
+	return &WorkflowBuilder{
+		name:        name,
+		connections: Connections{},
+		settings:    map[string]interface{}{"executionOrder": "v1"},
+	}
+}
+
+// NewWorkflowBuilderFrom creates a builder that starts from the nodes, connections and settings of an
+// existing workflow
+func NewWorkflowBuilderFrom(workflow *Workflow) (*WorkflowBuilder, error) {
+	nodes, err := ParseNodes(workflow.Nodes)
+	if err != nil {
+		return nil, err
+	}
+	connections, err := ParseConnections(workflow.Connections)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &WorkflowBuilder{
+		name:        workflow.Name,
+		connections: connections,
+		settings:    map[string]interface{}{},
+	}
+	for i := range nodes {
+		b.nodes = append(b.nodes, &nodes[i])
+	}
+	for key, value := range workflow.Settings {
+		b.settings[key] = value
+	}
+
+	return b, nil
+}
+
+// AddNode adds a node to the workflow and returns it, so that it can be adjusted further. Node names
+// must be unique, since connections refer to nodes by name. A node without an ID gets a random one, and
+// a node without a position is placed to the right of the previous node.
+func (b *WorkflowBuilder) AddNode(node Node) (*Node, error) {
+	if node.Name == "" || node.Type == "" {
+		return nil, fmt.Errorf("node name and type are required")
+	}
+	if b.Node(node.Name) != nil {
+		return nil, fmt.Errorf("workflow already has a node named %q", node.Name)
+	}
+
+	if node.ID == "" {
+		node.ID = uuid.NewString()
+	}
+	if node.TypeVersion == 0 {
+		node.TypeVersion = 1
+	}
+	if node.Position == [2]float64{} && len(b.nodes) > 0 {
+		previous := b.nodes[len(b.nodes)-1].Position
+		node.Position = [2]float64{previous[0] + nodeSpacing, previous[1]}
+	}
+
+	b.nodes = append(b.nodes, &node)
+	return &node, nil
+}
+
+// Node returns the node with the given name, or nil if the workflow has none
+func (b *WorkflowBuilder) Node(name string) *Node {
+	for _, node := range b.nodes {
+		if node.Name == name {
+			return node
+		}
+	}
+	return nil
+}
+
+// Connect connects the first output of source to the first input of target
+func (b *WorkflowBuilder) Connect(source, target string) error {
+	return b.ConnectOutput(source, 0, target, 0, ConnectionTypeMain)
+}
+
+// ConnectOutput connects an output of source to an input of target with the given connection type
+func (b *WorkflowBuilder) ConnectOutput(source string, output int, target string, input int, connectionType string) error {
+	for _, name := range []string{source, target} {
+		if b.Node(name) == nil {
+			return fmt.Errorf("workflow has no node named %q", name)
+		}
+	}
+	if output < 0 || input < 0 {
+		return fmt.Errorf("output and input indexes must not be negative")
+	}
+
+	b.connections.add(source, connectionType, output, Connection{Node: target, Type: connectionType, Index: input})
+	return nil
+}
+
+// SetCredential makes a node use a credential for a credential type, e.g. "slackApi"
+func (b *WorkflowBuilder) SetCredential(nodeName, credentialType, credentialID, credentialName string) error {
+	node := b.Node(nodeName)
+	if node == nil {
+		return fmt.Errorf("workflow has no node named %q", nodeName)
+	}
+
+	if node.Credentials == nil {
+		node.Credentials = map[string]NodeCredential{}
+	}
+	node.Credentials[credentialType] = NodeCredential{ID: credentialID, Name: credentialName}
+	return nil
+}
+
+// SetSetting sets a workflow setting, e.g. "timezone" or "errorWorkflow"
+func (b *WorkflowBuilder) SetSetting(key string, value interface{}) {
+	b.settings[key] = value
+}
+
+// Build returns the workflow in the format the client sends to n8n
+func (b *WorkflowBuilder) Build() (*Workflow, error) {
+	nodes := make([]Node, len(b.nodes))
+	for i, node := range b.nodes {
+		nodes[i] = *node
+	}
+
+	workflow := &Workflow{
+		Name:        b.name,
+		Nodes:       []interface{}{},
+		Connections: map[string]interface{}{},
+		Settings:    map[string]interface{}{},
+	}
+	if err := convertJSON(nodes, &workflow.Nodes); err != nil {
+		return nil, fmt.Errorf("failed to encode nodes: %w", err)
+	}
+	if err := convertJSON(b.connections, &workflow.Connections); err != nil {
+		return nil, fmt.Errorf("failed to encode connections: %w", err)
+	}
+	for key, value := range b.settings {
+		workflow.Settings[key] = value
+	}
+
+	return workflow, nil
+}
+
+// convertJSON converts a value into result through its JSON encoding
+func convertJSON(value, result interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, result)
+}
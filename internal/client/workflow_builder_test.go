@@ -0,0 +1,152 @@
+package client
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestWorkflowBuilder(t *testing.T) {
+	b := NewWorkflowBuilder("Orders")
+
+	if _, err := b.AddNode(Node{Name: "Webhook", Type: "n8n-nodes-base.webhook", TypeVersion: 2,
+		Parameters: map[string]interface{}{"path": "orders"}}); err != nil {
+		t.Fatalf("AddNode() error = %v", err)
+	}
+	slack, err := b.AddNode(Node{Name: "Slack", Type: "n8n-nodes-base.slack"})
+	if err != nil {
+		t.Fatalf("AddNode() error = %v", err)
+	}
+	slack.Parameters = map[string]interface{}{"channel": "#orders"}
+
+	if _, err := b.AddNode(Node{Name: "Slack", Type: "n8n-nodes-base.slack"}); err == nil {
+		t.Error("Expected error for a duplicate node name")
+	}
+
+	if err := b.Connect("Webhook", "Slack"); err != nil {
+		t.Fatalf("Connect() error = %v", err)
+	}
+	if err := b.Connect("Webhook", "Slack"); err != nil {
+		t.Fatalf("Connect() error = %v", err)
+	}
+	if err := b.Connect("Webhook", "Missing"); err == nil {
+		t.Error("Expected error for a connection to a missing node")
+	}
+	if err := b.SetCredential("Slack", "slackApi", "cred-1", "Slack Bot"); err != nil {
+		t.Fatalf("SetCredential() error = %v", err)
+	}
+
+	workflow, err := b.Build()
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+
+	if workflow.Name != "Orders" || workflow.Settings["executionOrder"] != "v1" {
+		t.Errorf("Unexpected workflow: %+v", workflow)
+	}
+	if len(workflow.Nodes) != 2 {
+		t.Fatalf("Expected 2 nodes, got %d", len(workflow.Nodes))
+	}
+
+	// Nodes are encoded as generic maps, like the nodes of workflows read from n8n
+	node, ok := workflow.Nodes[1].(map[string]interface{})
+	if !ok {
+		t.Fatalf("Expected a node map, got %T", workflow.Nodes[1])
+	}
+	if node["id"] == "" || node["typeVersion"] != float64(1) {
+		t.Errorf("Expected a generated ID and type version 1, got %v", node)
+	}
+	if !reflect.DeepEqual(node["position"], []interface{}{float64(nodeSpacing), float64(0)}) {
+		t.Errorf("Expected the node to be placed next to the previous one, got %v", node["position"])
+	}
+	expectedCredentials := map[string]interface{}{"slackApi": map[string]interface{}{"id": "cred-1", "name": "Slack Bot"}}
+	if !reflect.DeepEqual(node["credentials"], expectedCredentials) {
+		t.Errorf("Expected credentials %v, got %v", expectedCredentials, node["credentials"])
+	}
+	if node["parameters"].(map[string]interface{})["channel"] != "#orders" {
+		t.Errorf("Expected changes to the added node to be built, got %v", node["parameters"])
+	}
+
+	expectedConnections := map[string]interface{}{
+		"Webhook": map[string]interface{}{
+			"main": []interface{}{
+				[]interface{}{map[string]interface{}{"node": "Slack", "type": "main", "index": float64(0)}},
+			},
+		},
+	}
+	if !reflect.DeepEqual(workflow.Connections, expectedConnections) {
+		t.Errorf("Expected connections %v, got %v", expectedConnections, workflow.Connections)
+	}
+}
+
+func TestWorkflowBuilder_ConnectOutput(t *testing.T) {
+	b := NewWorkflowBuilder("Routing")
+	for _, name := range []string{"If", "Yes", "No"} {
+		if _, err := b.AddNode(Node{Name: name, Type: "n8n-nodes-base.noOp"}); err != nil {
+			t.Fatalf("AddNode() error = %v", err)
+		}
+	}
+
+	if err := b.ConnectOutput("If", 1, "No", 0, ConnectionTypeMain); err != nil {
+		t.Fatalf("ConnectOutput() error = %v", err)
+	}
+	if err := b.ConnectOutput("If", -1, "Yes", 0, ConnectionTypeMain); err == nil {
+		t.Error("Expected error for a negative output index")
+	}
+
+	workflow, err := b.Build()
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+	connections, err := ParseConnections(workflow.Connections)
+	if err != nil {
+		t.Fatalf("ParseConnections() error = %v", err)
+	}
+
+	if targets := connections.Targets("If", ConnectionTypeMain, 0); len(targets) != 0 {
+		t.Errorf("Expected no targets on the first output, got %v", targets)
+	}
+	if targets := connections.Targets("If", ConnectionTypeMain, 1); len(targets) != 1 || targets[0].Node != "No" {
+		t.Errorf("Expected No on the second output, got %v", targets)
+	}
+}
+
+func TestNewWorkflowBuilderFrom(t *testing.T) {
+	var workflow Workflow
+	if err := json.Unmarshal([]byte(`{
+		"name": "Orders",
+		"nodes": [{"id": "1", "name": "Start", "type": "n8n-nodes-base.manualTrigger", "typeVersion": 1,
+			"position": [0, 0], "parameters": {}, "notes": "Runs by hand"}],
+		"connections": {},
+		"settings": {"timezone": "Europe/Berlin"}
+	}`), &workflow); err != nil {
+		t.Fatalf("Failed to decode workflow: %v", err)
+	}
+
+	b, err := NewWorkflowBuilderFrom(&workflow)
+	if err != nil {
+		t.Fatalf("NewWorkflowBuilderFrom() error = %v", err)
+	}
+	if _, err := b.AddNode(Node{Name: "Set", Type: "n8n-nodes-base.set"}); err != nil {
+		t.Fatalf("AddNode() error = %v", err)
+	}
+	if err := b.Connect("Start", "Set"); err != nil {
+		t.Fatalf("Connect() error = %v", err)
+	}
+
+	built, err := b.Build()
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+
+	start := built.Nodes[0].(map[string]interface{})
+	if start["notes"] != "Runs by hand" || start["id"] != "1" {
+		t.Errorf("Expected the existing node to be kept unchanged, got %v", start)
+	}
+	if built.Settings["timezone"] != "Europe/Berlin" {
+		t.Errorf("Expected the existing settings to be kept, got %v", built.Settings)
+	}
+	if trigger, err := workflowTriggerNode(built); err != nil || trigger != "Start" {
+		t.Errorf("Expected Start to be the trigger node, got %q (%v)", trigger, err)
+	}
+}
@@ -1,8 +1,10 @@
 package client
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/url"
+	"reflect"
 	"strconv"
 	"time"
 )
@@ -16,11 +18,66 @@ type Workflow struct {
 	Connections map[string]interface{} `json:"connections"`
 	Settings    map[string]interface{} `json:"settings,omitempty"`
 	StaticData  map[string]interface{} `json:"staticData,omitempty"`
-	PinnedData  map[string]interface{} `json:"pinnedData,omitempty"`
-	Tags        []string               `json:"tags,omitempty"`
+	PinnedData  map[string]interface{} `json:"pinData,omitempty"`
+	Tags        []Tag                  `json:"tags,omitempty"` // Read-only; set with UpdateWorkflowTags
 	VersionID   string                 `json:"versionId,omitempty"`
-	CreatedAt   *time.Time             `json:"createdAt,omitempty"`
-	UpdatedAt   *time.Time             `json:"updatedAt,omitempty"`
+	Meta        map[string]interface{} `json:"meta,omitempty"`
+	// TriggerCount and IsArchived are maintained by n8n
+	TriggerCount int  `json:"triggerCount,omitempty"`
+	IsArchived   bool `json:"isArchived,omitempty"`
+	// ParentFolder is only returned by the internal API, and is nil for workflows outside of folders
+	ParentFolder *FolderReference `json:"parentFolder,omitempty"`
+	CreatedAt    *time.Time       `json:"createdAt,omitempty"`
+	UpdatedAt    *time.Time       `json:"updatedAt,omitempty"`
+
+	// Extra holds the fields returned by n8n that are not modeled above (e.g. shared), so that
+	// they are sent back unchanged when a workflow that was read is updated
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// workflowFields is an alias of Workflow without its JSON methods
+type workflowFields Workflow
+
+// UnmarshalJSON decodes a workflow and keeps the fields that are not modeled in Extra
+func (w *Workflow) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, (*workflowFields)(w)); err != nil {
+		return err
+	}
+
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(data, &all); err != nil {
+		return err
+	}
+	for _, name := range jsonFieldNames(reflect.TypeOf(workflowFields{})) {
+		delete(all, name)
+	}
+
+	w.Extra = nil
+	if len(all) > 0 {
+		w.Extra = all
+	}
+
+	return nil
+}
+
+// MarshalJSON encodes a workflow including the fields kept in Extra
+func (w Workflow) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(workflowFields(w))
+	if err != nil || len(w.Extra) == 0 {
+		return data, err
+	}
+
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(data, &all); err != nil {
+		return nil, err
+	}
+	for name, value := range w.Extra {
+		if _, ok := all[name]; !ok {
+			all[name] = value
+		}
+	}
+
+	return json.Marshal(all)
 }
 
 // WorkflowListOptions represents options for listing workflows
@@ -30,6 +87,9 @@ type WorkflowListOptions struct {
 	ProjectID string
 	Limit     int
 	Offset    int
+	// IncludeArchived also lists archived workflows, which ListWorkflows and GetAllWorkflows leave out otherwise.
+	// n8n does not filter them itself, so GetWorkflows returns every workflow of the page regardless.
+	IncludeArchived bool
 }
 
 // WorkflowListResponse represents the response from listing workflows
@@ -87,9 +147,12 @@ func (c *Client) GetWorkflow(id string) (*Workflow, error) {
 		return nil, fmt.Errorf("workflow ID is required")
 	}
 
-	path := fmt.Sprintf("workflows/%s", id)
-
 	var workflow Workflow
+	if c.getListed("workflows", id, &workflow) {
+		return &workflow, nil
+	}
+
+	path := fmt.Sprintf("workflows/%s", id)
 	err := c.Get(path, &workflow)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get workflow %s: %w", id, err)
@@ -108,8 +171,20 @@ func (c *Client) CreateWorkflow(workflow *Workflow) (*Workflow, error) {
 		return nil, fmt.Errorf("workflow name is required")
 	}
 
+	// A retried create adopts the workflow an earlier attempt created instead of creating it again
+	started := time.Now()
+	check := func() ([]byte, bool, error) {
+		workflows, err := ListAll[Workflow](c, "workflows", url.Values{"name": {workflow.Name}}, 0)
+		if err != nil {
+			return nil, false, err
+		}
+		return findCreated(workflows, started,
+			func(w Workflow) bool { return w.Name == workflow.Name },
+			func(w Workflow) *time.Time { return w.CreatedAt })
+	}
+
 	var result Workflow
-	err := c.Post("workflows", workflow, &result)
+	err := c.create("workflows", workflow, &result, check)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create workflow: %w", err)
 	}
@@ -117,7 +192,40 @@ func (c *Client) CreateWorkflow(workflow *Workflow) (*Workflow, error) {
 	return &result, nil
 }
 
-// UpdateWorkflow updates an existing workflow
+// workflowUpdateRequest is the body of a workflow update through the public API, which rejects any
+// field but these
+type workflowUpdateRequest struct {
+	Name        string                 `json:"name"`
+	Nodes       []interface{}          `json:"nodes"`
+	Connections map[string]interface{} `json:"connections"`
+	Settings    map[string]interface{} `json:"settings"`
+	StaticData  map[string]interface{} `json:"staticData,omitempty"`
+}
+
+// newWorkflowUpdateRequest builds the public API update of a workflow. The public API requires nodes,
+// connections and settings, so missing ones are sent empty.
+func newWorkflowUpdateRequest(workflow *Workflow) *workflowUpdateRequest {
+	update := &workflowUpdateRequest{
+		Name:        workflow.Name,
+		Nodes:       workflow.Nodes,
+		Connections: workflow.Connections,
+		Settings:    workflow.Settings,
+		StaticData:  workflow.StaticData,
+	}
+	if update.Nodes == nil {
+		update.Nodes = []interface{}{}
+	}
+	if update.Connections == nil {
+		update.Connections = map[string]interface{}{}
+	}
+	if update.Settings == nil {
+		update.Settings = map[string]interface{}{}
+	}
+	return update
+}
+
+// UpdateWorkflow updates an existing workflow. The public API only updates the name, nodes, connections,
+// settings and static data; the other fields of workflow are not sent there.
 func (c *Client) UpdateWorkflow(id string, workflow *Workflow) (*Workflow, error) {
 	if id == "" {
 		return nil, fmt.Errorf("workflow ID is required")
@@ -129,8 +237,13 @@ func (c *Client) UpdateWorkflow(id string, workflow *Workflow) (*Workflow, error
 
 	path := fmt.Sprintf("workflows/%s", id)
 
+	var body any = workflow
+	if c.surfaceFor(path) == APISurfacePublic {
+		body = newWorkflowUpdateRequest(workflow)
+	}
+
 	var result Workflow
-	err := c.Put(path, workflow, &result)
+	err := c.Put(path, body, &result)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update workflow %s: %w", id, err)
 	}
@@ -138,6 +251,49 @@ func (c *Client) UpdateWorkflow(id string, workflow *Workflow) (*Workflow, error
 	return &result, nil
 }
 
+// PatchWorkflow updates only the given fields of a workflow, keyed by their JSON name, so that fields
+// the caller does not know about are kept. The internal API applies the fields with PATCH. The public API
+// can only replace whole workflows, so there the fields are merged into the current workflow first.
+func (c *Client) PatchWorkflow(id string, fields map[string]interface{}) (*Workflow, error) {
+	if id == "" {
+		return nil, fmt.Errorf("workflow ID is required")
+	}
+
+	path := fmt.Sprintf("workflows/%s", id)
+
+	if c.surfaceFor(path) == APISurfaceInternal {
+		var result Workflow
+		if err := c.Patch(path, fields, &result); err != nil {
+			return nil, fmt.Errorf("failed to update workflow %s: %w", id, err)
+		}
+		return &result, nil
+	}
+
+	// The public API cannot set pinned data, so pinning it would silently be dropped
+	if pinData, ok := fields["pinData"].(map[string]interface{}); ok && len(pinData) > 0 {
+		return nil, fmt.Errorf("failed to update workflow %s: pinned data can only be set through the internal API, "+
+			"which requires session authentication and api_mode 'internal' or 'auto'", id)
+	}
+
+	current, err := c.GetWorkflow(id)
+	if err != nil {
+		return nil, err
+	}
+
+	merged, err := mergeFields(current, fields)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update workflow %s: %w", id, err)
+	}
+
+	return c.UpdateWorkflow(id, merged)
+}
+
+// WorkflowChanges returns the fields of desired that differ from current, keyed by their JSON name. Fields
+// that desired no longer sets are returned with their zero value, so that they are cleared.
+func WorkflowChanges(current, desired *Workflow) (map[string]interface{}, error) {
+	return changedFields(current, desired)
+}
+
 // DeleteWorkflow deletes a workflow
 func (c *Client) DeleteWorkflow(id string) error {
 	if id == "" {
@@ -187,3 +343,114 @@ func (c *Client) DeactivateWorkflow(id string) (*Workflow, error) {
 
 	return &result, nil
 }
+
+// ArchiveWorkflow archives a workflow, which also deactivates it. Archiving is only available on the
+// internal API and requires session authentication.
+func (c *Client) ArchiveWorkflow(id string) (*Workflow, error) {
+	if id == "" {
+		return nil, fmt.Errorf("workflow ID is required")
+	}
+
+	var result Workflow
+	if err := c.doInternalRequest("POST", fmt.Sprintf("workflows/%s/archive", id), nil, &result); err != nil {
+		return nil, fmt.Errorf("failed to archive workflow %s: %w", id, err)
+	}
+
+	return &result, nil
+}
+
+// UnarchiveWorkflow restores an archived workflow. Requires session authentication.
+func (c *Client) UnarchiveWorkflow(id string) (*Workflow, error) {
+	if id == "" {
+		return nil, fmt.Errorf("workflow ID is required")
+	}
+
+	var result Workflow
+	if err := c.doInternalRequest("POST", fmt.Sprintf("workflows/%s/unarchive", id), nil, &result); err != nil {
+		return nil, fmt.Errorf("failed to unarchive workflow %s: %w", id, err)
+	}
+
+	return &result, nil
+}
+
+// TransferWorkflow moves a workflow to another project
+func (c *Client) TransferWorkflow(id, projectID string) error {
+	if id == "" {
+		return fmt.Errorf("workflow ID is required")
+	}
+
+	if projectID == "" {
+		return fmt.Errorf("destination project ID is required")
+	}
+
+	path := fmt.Sprintf("workflows/%s/transfer", id)
+
+	err := c.Put(path, &transferRequest{DestinationProjectID: projectID}, nil)
+	if err != nil {
+		return fmt.Errorf("failed to transfer workflow %s to project %s: %w", id, projectID, err)
+	}
+
+	return nil
+}
+
+// MoveWorkflowToFolder moves a workflow to a folder of its project. Use ProjectRootFolderID to move it
+// out of its folder. Requires session authentication.
+func (c *Client) MoveWorkflowToFolder(id, folderID string) error {
+	if id == "" {
+		return fmt.Errorf("workflow ID is required")
+	}
+
+	if folderID == "" {
+		return fmt.Errorf("folder ID is required")
+	}
+
+	body := map[string]string{"parentFolderId": folderID}
+	if err := c.doInternalRequest("PATCH", fmt.Sprintf("workflows/%s", id), body, nil); err != nil {
+		return fmt.Errorf("failed to move workflow %s to folder %s: %w", id, folderID, err)
+	}
+
+	return nil
+}
+
+// WorkflowVersion represents an entry in the version history of a workflow
+type WorkflowVersion struct {
+	VersionID  string     `json:"versionId"`
+	WorkflowID string     `json:"workflowId"`
+	Authors    string     `json:"authors"`
+	CreatedAt  *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt  *time.Time `json:"updatedAt,omitempty"`
+}
+
+// workflowHistoryPageSize is the number of versions requested per page of workflow history
+const workflowHistoryPageSize = 100
+
+// GetWorkflowVersions retrieves the version history of a workflow, most recent version first.
+// Workflow history is served by the internal REST API, so it requires session authentication
+// and an n8n version with workflow history enabled.
+func (c *Client) GetWorkflowVersions(workflowID string) ([]WorkflowVersion, error) {
+	if workflowID == "" {
+		return nil, fmt.Errorf("workflow ID is required")
+	}
+
+	if err := c.ensureSession(); err != nil {
+		return nil, fmt.Errorf("failed to establish session: %w", err)
+	}
+
+	var versions []WorkflowVersion
+	for skip := 0; ; skip += workflowHistoryPageSize {
+		params := url.Values{}
+		params.Set("take", strconv.Itoa(workflowHistoryPageSize))
+		params.Set("skip", strconv.Itoa(skip))
+
+		var page []WorkflowVersion
+		path := fmt.Sprintf("workflow-history/workflow/%s?%s", url.PathEscape(workflowID), params.Encode())
+		if err := c.doRESTRequest("GET", path, nil, &page); err != nil {
+			return nil, fmt.Errorf("failed to get versions of workflow %s: %w", workflowID, err)
+		}
+
+		versions = append(versions, page...)
+		if len(page) < workflowHistoryPageSize {
+			return versions, nil
+		}
+	}
+}
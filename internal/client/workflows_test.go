@@ -2,8 +2,10 @@ package client
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 )
@@ -15,13 +17,13 @@ func TestClient_GetWorkflows(t *testing.T) {
 				ID:     "1",
 				Name:   "Test Workflow 1",
 				Active: true,
-				Tags:   []string{"tag1", "tag2"},
+				Tags:   []Tag{{ID: "t1", Name: "tag1"}, {ID: "t2", Name: "tag2"}},
 			},
 			{
 				ID:     "2",
 				Name:   "Test Workflow 2",
 				Active: false,
-				Tags:   []string{"tag3"},
+				Tags:   []Tag{{ID: "t3", Name: "tag3"}},
 			},
 		},
 		NextCursor: "next-cursor-123",
@@ -125,7 +127,7 @@ func TestClient_GetWorkflow(t *testing.T) {
 		ID:        "test-id",
 		Name:      "Test Workflow",
 		Active:    true,
-		Tags:      []string{"test"},
+		Tags:      []Tag{{ID: "t1", Name: "test"}},
 		CreatedAt: &time.Time{},
 		UpdatedAt: &time.Time{},
 	}
@@ -495,3 +497,175 @@ func TestClient_DeactivateWorkflowEmptyID(t *testing.T) {
 		t.Errorf("Expected 'workflow ID is required', got %s", err.Error())
 	}
 }
+
+func TestClient_GetWorkflowVersions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Errorf("Expected GET method, got %s", r.Method)
+		}
+		if r.URL.Path != "/rest/workflow-history/workflow/wf-1" {
+			t.Errorf("Expected path /rest/workflow-history/workflow/wf-1, got %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("take") != "100" {
+			t.Errorf("Expected take=100, got %s", r.URL.Query().Get("take"))
+		}
+
+		// Serve 101 versions in pages of 100
+		count := 100
+		if r.URL.Query().Get("skip") == "100" {
+			count = 1
+		}
+
+		page := make([]WorkflowVersion, count)
+		for i := range page {
+			page[i] = WorkflowVersion{VersionID: fmt.Sprintf("%s-%d", r.URL.Query().Get("skip"), i), WorkflowID: "wf-1"}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": page})
+	}))
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	versions, err := client.GetWorkflowVersions("wf-1")
+	if err != nil {
+		t.Fatalf("GetWorkflowVersions failed: %v", err)
+	}
+
+	if len(versions) != 101 {
+		t.Fatalf("Expected 101 versions, got %d", len(versions))
+	}
+	if versions[0].VersionID != "0-0" || versions[100].VersionID != "100-0" {
+		t.Errorf("Unexpected version order: first %s, last %s", versions[0].VersionID, versions[100].VersionID)
+	}
+
+	if _, err := client.GetWorkflowVersions(""); err == nil {
+		t.Error("Expected error for empty workflow ID")
+	}
+}
+
+func TestClient_TransferWorkflow(t *testing.T) {
+	server := TestServer(TransferTestHandler(t, "/api/v1/workflows/test-id/transfer", "project-1"))
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	if err := client.TransferWorkflow("test-id", "project-1"); err != nil {
+		t.Errorf("TransferWorkflow() error = %v", err)
+	}
+
+	if err := client.TransferWorkflow("", "project-1"); err == nil {
+		t.Error("Expected error for missing workflow ID")
+	}
+}
+
+func TestWorkflow_PreservesUnknownFields(t *testing.T) {
+	var workflow Workflow
+	err := json.Unmarshal([]byte(`{
+		"id": "wf-1",
+		"name": "Orders",
+		"connections": {},
+		"meta": {"templateId": "1234"},
+		"triggerCount": 2,
+		"isArchived": true,
+		"pinData": {"Start": [{"json": {"id": 1}}]},
+		"shared": [{"role": "workflow:owner"}]
+	}`), &workflow)
+	if err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+
+	if workflow.TriggerCount != 2 || !workflow.IsArchived || workflow.Meta["templateId"] != "1234" {
+		t.Errorf("Expected the n8n-maintained fields to be decoded, got %+v", workflow)
+	}
+	if workflow.PinnedData["Start"] == nil {
+		t.Errorf("Expected pinData to be decoded, got %v", workflow.PinnedData)
+	}
+	if len(workflow.Extra) != 1 || workflow.Extra["shared"] == nil {
+		t.Fatalf("Expected shared to be kept as an extra field, got %v", workflow.Extra)
+	}
+
+	workflow.Name = "Orders v2"
+	data, err := json.Marshal(&workflow)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if fields["name"] != "Orders v2" || fields["pinData"] == nil || fields["shared"] == nil || fields["meta"] == nil {
+		t.Errorf("Expected the extra fields to be sent back, got %v", fields)
+	}
+}
+
+func TestClient_ArchiveWorkflow(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		archived := strings.HasSuffix(r.URL.Path, "/archive")
+		_, _ = w.Write([]byte(fmt.Sprintf(`{"data": {"id": "wf-1", "name": "Orders", "isArchived": %t}}`, archived)))
+	}))
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	archived, err := client.ArchiveWorkflow("wf-1")
+	if err != nil {
+		t.Fatalf("ArchiveWorkflow failed: %v", err)
+	}
+	if !archived.IsArchived {
+		t.Error("Expected the workflow to be archived")
+	}
+
+	restored, err := client.UnarchiveWorkflow("wf-1")
+	if err != nil {
+		t.Fatalf("UnarchiveWorkflow failed: %v", err)
+	}
+	if restored.IsArchived {
+		t.Error("Expected the workflow to be restored")
+	}
+
+	expected := "POST /rest/workflows/wf-1/archive, POST /rest/workflows/wf-1/unarchive"
+	if got := strings.Join(requests, ", "); got != expected {
+		t.Errorf("Expected requests %s, got %s", expected, got)
+	}
+
+	if _, err := client.ArchiveWorkflow(""); err == nil {
+		t.Error("Expected error for an empty workflow ID")
+	}
+}
+
+func TestClient_MoveWorkflowToFolder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PATCH" || r.URL.Path != "/rest/workflows/wf-1" {
+			t.Errorf("Expected PATCH /rest/workflows/wf-1, got %s %s", r.Method, r.URL.Path)
+		}
+
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("Failed to decode request body: %v", err)
+		}
+		if len(body) != 1 || body["parentFolderId"] != "folder-1" {
+			t.Errorf("Expected only parentFolderId in the body, got %v", body)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": {"id": "wf-1", "name": "Orders", "parentFolder": {"id": "folder-1"}}}`))
+	}))
+	defer server.Close()
+
+	client := CreateTestClient(t, server.URL)
+
+	if err := client.MoveWorkflowToFolder("wf-1", "folder-1"); err != nil {
+		t.Fatalf("MoveWorkflowToFolder failed: %v", err)
+	}
+
+	if err := client.MoveWorkflowToFolder("wf-1", ""); err == nil {
+		t.Error("Expected error for an empty folder ID")
+	}
+}
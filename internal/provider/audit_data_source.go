@@ -0,0 +1,229 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AuditDataSource{}
+
+func NewAuditDataSource() datasource.DataSource {
+	return &AuditDataSource{}
+}
+
+// AuditDataSource defines the data source implementation.
+type AuditDataSource struct {
+	client client.N8nAPI
+}
+
+// AuditDataSourceModel describes the data source data model.
+type AuditDataSourceModel struct {
+	Categories            types.List   `tfsdk:"categories"`
+	DaysAbandonedWorkflow types.Int64  `tfsdk:"days_abandoned_workflow"`
+	Report                types.String `tfsdk:"report"`
+	Reports               types.List   `tfsdk:"reports"`
+}
+
+// auditSectionAttrTypes describes the object type of each sections entry
+func auditSectionAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"title":          types.StringType,
+		"description":    types.StringType,
+		"recommendation": types.StringType,
+		"location":       types.StringType,
+	}
+}
+
+// auditReportAttrTypes describes the object type of each reports entry
+func auditReportAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"title":    types.StringType,
+		"risk":     types.StringType,
+		"sections": types.ListType{ElemType: types.ObjectType{AttrTypes: auditSectionAttrTypes()}},
+	}
+}
+
+func (d *AuditDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audit"
+}
+
+func (d *AuditDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Runs a security audit of the n8n instance, which reports risks such as unused " +
+			"credentials, nodes that run code or access the file system, and outdated n8n versions. The audit runs " +
+			"every time the data source is read, so `report` can be persisted on each Terraform run, e.g. with the " +
+			"`local_file` resource.",
+
+		Attributes: map[string]schema.Attribute{
+			"categories": schema.ListAttribute{
+				MarkdownDescription: "Risk categories to audit: " + strings.Join(client.AuditCategories, ", ") +
+					". Defaults to all categories.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					stringListOneOf(client.AuditCategories...),
+				},
+			},
+			"days_abandoned_workflow": schema.Int64Attribute{
+				MarkdownDescription: "Number of days without executions after which a workflow is considered abandoned. " +
+					"n8n defaults to 90 days.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64AtLeast(1),
+				},
+			},
+			"report": schema.StringAttribute{
+				MarkdownDescription: "JSON string containing the complete audit report as returned by n8n, keyed by " +
+					"report title. An empty object if there are no findings.",
+				Computed: true,
+			},
+			"reports": schema.ListNestedAttribute{
+				MarkdownDescription: "Reports of the risk categories with findings, sorted by title",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"title": schema.StringAttribute{
+							MarkdownDescription: "Title of the report (e.g., 'Credentials Risk Report')",
+							Computed:            true,
+						},
+						"risk": schema.StringAttribute{
+							MarkdownDescription: "Risk category of the report",
+							Computed:            true,
+						},
+						"sections": schema.ListNestedAttribute{
+							MarkdownDescription: "Findings of the report",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"title": schema.StringAttribute{
+										MarkdownDescription: "Title of the finding",
+										Computed:            true,
+									},
+									"description": schema.StringAttribute{
+										MarkdownDescription: "Description of the finding",
+										Computed:            true,
+									},
+									"recommendation": schema.StringAttribute{
+										MarkdownDescription: "Recommended action",
+										Computed:            true,
+									},
+									"location": schema.StringAttribute{
+										MarkdownDescription: "JSON string containing the workflows, nodes or credentials " +
+											"the finding applies to, or null if it applies to the instance",
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AuditDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.N8nAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.N8nAPI, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *AuditDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AuditDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	options := auditOptions(ctx, data.Categories, data.DaysAbandonedWorkflow, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	audit, err := d.client.RunAudit(options)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to run audit, got error: %s", err))
+		return
+	}
+
+	data.Report = types.StringValue(string(audit.Raw))
+	data.Reports = auditReportsList(audit.Reports)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// auditOptions returns the audit options of the categories and days_abandoned_workflow attributes
+func auditOptions(ctx context.Context, categories types.List, daysAbandonedWorkflow types.Int64,
+	diags *diag.Diagnostics) *client.AuditOptions {
+	options := &client.AuditOptions{DaysAbandonedWorkflow: int(daysAbandonedWorkflow.ValueInt64())}
+	if !categories.IsNull() {
+		diags.Append(categories.ElementsAs(ctx, &options.Categories, false)...)
+	}
+	return options
+}
+
+// auditReportsList converts audit reports into the reports attribute value
+func auditReportsList(reports []client.AuditReport) types.List {
+	sectionType := types.ObjectType{AttrTypes: auditSectionAttrTypes()}
+
+	values := make([]attr.Value, 0, len(reports))
+	for _, report := range reports {
+		sections := make([]attr.Value, 0, len(report.Sections))
+		for _, section := range report.Sections {
+			location := types.StringNull()
+			if len(section.Location) > 0 {
+				if locationJSON, err := json.Marshal(section.Location); err == nil {
+					location = types.StringValue(string(locationJSON))
+				}
+			}
+
+			sections = append(sections, types.ObjectValueMust(auditSectionAttrTypes(), map[string]attr.Value{
+				"title":          types.StringValue(section.Title),
+				"description":    types.StringValue(section.Description),
+				"recommendation": types.StringValue(section.Recommendation),
+				"location":       location,
+			}))
+		}
+
+		values = append(values, types.ObjectValueMust(auditReportAttrTypes(), map[string]attr.Value{
+			"title":    types.StringValue(report.Title),
+			"risk":     types.StringValue(report.Risk),
+			"sections": types.ListValueMust(sectionType, sections),
+		}))
+	}
+
+	return types.ListValueMust(types.ObjectType{AttrTypes: auditReportAttrTypes()}, values)
+}
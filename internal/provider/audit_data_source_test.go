@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+)
+
+func TestAccAuditDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "n8n_audit" "test" {
+  categories = ["credentials", "nodes"]
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.n8n_audit.test", "report"),
+				),
+			},
+		},
+	})
+}
+
+func TestAuditReportsList(t *testing.T) {
+	reports := auditReportsList([]client.AuditReport{
+		{
+			Title: "Credentials Risk Report",
+			Risk:  "credentials",
+			Sections: []client.AuditSection{
+				{
+					Title:          "Credentials not used in any workflow",
+					Description:    "These credentials are not used in any workflow.",
+					Recommendation: "Consider deleting these credentials.",
+					Location:       []map[string]interface{}{{"kind": "credential", "id": "1", "name": "Slack"}},
+				},
+			},
+		},
+		{
+			Title:    "Instance Risk Report",
+			Risk:     "instance",
+			Sections: []client.AuditSection{{Title: "Outdated instance"}},
+		},
+	})
+
+	elements := reports.Elements()
+	if len(elements) != 2 {
+		t.Fatalf("Expected 2 reports, got %d", len(elements))
+	}
+
+	credentials := elements[0].(types.Object).Attributes()
+	if credentials["risk"].(types.String).ValueString() != "credentials" {
+		t.Errorf("Expected the credentials report first, got %v", credentials)
+	}
+	section := credentials["sections"].(types.List).Elements()[0].(types.Object).Attributes()
+	expectedLocation := `[{"id":"1","kind":"credential","name":"Slack"}]`
+	if section["location"].(types.String).ValueString() != expectedLocation {
+		t.Errorf("Expected location %s, got %s", expectedLocation, section["location"])
+	}
+
+	instance := elements[1].(types.Object).Attributes()
+	instanceSection := instance["sections"].(types.List).Elements()[0].(types.Object).Attributes()
+	if !instanceSection["location"].IsNull() {
+		t.Errorf("Expected no location for an instance finding, got %s", instanceSection["location"])
+	}
+}
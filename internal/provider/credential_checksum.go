@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+)
+
+// n8n never returns credential data, so changes to the secrets of a credential made outside Terraform do
+// not show up as drift. Instead, the private state records a salted hash of the data Terraform last applied
+// and when n8n last updated the credential; a later update in n8n is reported as a warning on refresh.
+
+// credentialChecksumKey is the private state key holding the checksum of the applied credential data
+const credentialChecksumKey = "credential_data_checksum"
+
+// credentialChecksum is the salted hash of the credential data Terraform last applied, with the time n8n
+// reported the credential as updated afterwards
+type credentialChecksum struct {
+	Salt      string     `json:"salt"`
+	Hash      string     `json:"hash"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// newCredentialChecksum hashes data with a new random salt, so that the hash does not reveal data with
+// little entropy
+func newCredentialChecksum(data map[string]interface{}, updatedAt *time.Time) (*credentialChecksum, error) {
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	checksum := &credentialChecksum{Salt: hex.EncodeToString(salt), UpdatedAt: updatedAt}
+	hash, err := checksum.hash(data)
+	if err != nil {
+		return nil, err
+	}
+	checksum.Hash = hash
+	return checksum, nil
+}
+
+// hash returns the hash of data with the salt of the checksum. Data is encoded as JSON, which sorts object
+// keys, so equal data has the same hash.
+func (c *credentialChecksum) hash(data map[string]interface{}) (string, error) {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return "", err
+	}
+
+	salt, err := hex.DecodeString(c.Salt)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(append(salt, encoded...))
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// matches reports whether data is the data the checksum was created from
+func (c *credentialChecksum) matches(data map[string]interface{}) bool {
+	hash, err := c.hash(data)
+	return err == nil && hash == c.Hash
+}
+
+// modifiedOutsideTerraform reports whether n8n updated credential after Terraform last applied its data
+func (c *credentialChecksum) modifiedOutsideTerraform(credential *client.Credential) bool {
+	return c.UpdatedAt != nil && credential.UpdatedAt != nil && credential.UpdatedAt.After(*c.UpdatedAt)
+}
+
+// setCredentialChecksum records checksum in private state
+func setCredentialChecksum(ctx context.Context, private privateStateSetter, checksum *credentialChecksum) diag.Diagnostics {
+	value, err := json.Marshal(checksum)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Internal Error", fmt.Sprintf("Unable to encode credential checksum, got error: %s", err))
+		return diags
+	}
+	return private.SetKey(ctx, credentialChecksumKey, value)
+}
+
+// getCredentialChecksum returns the checksum recorded by setCredentialChecksum, or nil if none was recorded,
+// e.g. for imported credentials
+func getCredentialChecksum(ctx context.Context, private privateStateGetter) (*credentialChecksum, diag.Diagnostics) {
+	value, diags := private.GetKey(ctx, credentialChecksumKey)
+	if diags.HasError() || len(value) == 0 {
+		return nil, diags
+	}
+
+	var checksum credentialChecksum
+	if err := json.Unmarshal(value, &checksum); err != nil || checksum.Hash == "" {
+		// An unreadable checksum only disables the detection of changes made outside Terraform
+		return nil, diags
+	}
+	return &checksum, diags
+}
+
+// recordAppliedCredentialData records the checksum of the data sent to n8n and the time n8n updated the
+// credential with it
+func recordAppliedCredentialData(ctx context.Context, private privateStateSetter, data map[string]interface{},
+	credential *client.Credential) diag.Diagnostics {
+	checksum, err := newCredentialChecksum(data, credential.UpdatedAt)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Internal Error", fmt.Sprintf("Unable to create credential checksum, got error: %s", err))
+		return diags
+	}
+	return setCredentialChecksum(ctx, private, checksum)
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+)
+
+func TestCredentialChecksum(t *testing.T) {
+	ctx := context.Background()
+	private := testPrivateState{}
+	appliedAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	data := map[string]interface{}{"user": "admin", "password": "secret"}
+
+	checksum, diags := getCredentialChecksum(ctx, private)
+	if diags.HasError() || checksum != nil {
+		t.Fatalf("Expected no checksum before one was recorded, got %v, %v", checksum, diags)
+	}
+
+	credential := &client.Credential{ID: "c1", UpdatedAt: &appliedAt}
+	if diags := recordAppliedCredentialData(ctx, private, data, credential); diags.HasError() {
+		t.Fatalf("recordAppliedCredentialData() diagnostics: %v", diags)
+	}
+	if strings.Contains(string(private[credentialChecksumKey]), "secret") {
+		t.Error("Expected the private state not to contain the credential data")
+	}
+
+	checksum, diags = getCredentialChecksum(ctx, private)
+	if diags.HasError() || checksum == nil {
+		t.Fatalf("Expected the recorded checksum, got %v", diags)
+	}
+	if !checksum.matches(map[string]interface{}{"password": "secret", "user": "admin"}) {
+		t.Error("Expected the applied data to match")
+	}
+	if checksum.matches(map[string]interface{}{"user": "admin", "password": "rotated"}) {
+		t.Error("Expected other data not to match")
+	}
+
+	// The same data is hashed with a new salt each time it is applied
+	other, err := newCredentialChecksum(data, nil)
+	if err != nil || other.Salt == checksum.Salt || other.Hash == checksum.Hash {
+		t.Errorf("Expected a new salt and hash, got %v, %v", other, err)
+	}
+
+	if checksum.modifiedOutsideTerraform(credential) {
+		t.Error("Expected the credential as applied not to be modified")
+	}
+	updatedAt := appliedAt.Add(time.Minute)
+	if !checksum.modifiedOutsideTerraform(&client.Credential{ID: "c1", UpdatedAt: &updatedAt}) {
+		t.Error("Expected a later update in n8n to be detected")
+	}
+
+	// A checksum in an unknown format is ignored
+	private[credentialChecksumKey] = []byte(`"abc"`)
+	if checksum, _ := getCredentialChecksum(ctx, private); checksum != nil {
+		t.Errorf("Expected an unreadable checksum to be ignored, got %v", checksum)
+	}
+}
@@ -4,16 +4,19 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"os"
 	"slices"
 	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 
 	"github.com/devops247-online/terraform-provider-n8n/internal/client"
 )
@@ -21,6 +24,14 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &CredentialResource{}
 var _ resource.ResourceWithImportState = &CredentialResource{}
+var _ resource.ResourceWithIdentity = &CredentialResource{}
+var _ resource.ResourceWithModifyPlan = &CredentialResource{}
+var _ resource.ResourceWithValidateConfig = &CredentialResource{}
+var _ resource.ResourceWithUpgradeState = &CredentialResource{}
+
+// credentialSchemaVersion is the version of the credential resource schema. Version 1 no longer reads
+// node_access from n8n; UpgradeState migrates states of earlier versions.
+const credentialSchemaVersion = 1
 
 func NewCredentialResource() resource.Resource {
 	return &CredentialResource{}
@@ -28,18 +39,34 @@ func NewCredentialResource() resource.Resource {
 
 // CredentialResource defines the resource implementation.
 type CredentialResource struct {
-	client *client.Client
+	client client.N8nAPI
 }
 
 // CredentialResourceModel describes the resource data model.
 type CredentialResourceModel struct {
-	ID         types.String `tfsdk:"id"`
-	Name       types.String `tfsdk:"name"`
-	Type       types.String `tfsdk:"type"`
-	Data       types.String `tfsdk:"data"`
-	NodeAccess types.List   `tfsdk:"node_access"`
-	CreatedAt  types.String `tfsdk:"created_at"`
-	UpdatedAt  types.String `tfsdk:"updated_at"`
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Type               types.String `tfsdk:"type"`
+	Data               types.String `tfsdk:"data"`
+	DataWO             types.String `tfsdk:"data_wo"`
+	DataWOVersion      types.Int64  `tfsdk:"data_wo_version"`
+	DataFrom           types.Object `tfsdk:"data_from"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+	ForceDestroy       types.Bool   `tfsdk:"force_destroy"`
+	Verify             types.Bool   `tfsdk:"verify"`
+	EnforceUniqueName  types.Bool   `tfsdk:"enforce_unique_name"`
+	NodeAccess         types.List   `tfsdk:"node_access"`
+	ProjectID          types.String `tfsdk:"project_id"`
+	SharedWithProjects types.Set    `tfsdk:"shared_with_project_ids"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+	UpdatedAt          types.String `tfsdk:"updated_at"`
+}
+
+// CredentialDataFromModel describes the data_from block, which maps credential data fields to the
+// environment variables and files their values are read from.
+type CredentialDataFromModel struct {
+	Env   types.Map `tfsdk:"env"`
+	Files types.Map `tfsdk:"files"`
 }
 
 // Supported credential types for validation
@@ -109,7 +136,10 @@ func (r *CredentialResource) Metadata(ctx context.Context, req resource.Metadata
 
 func (r *CredentialResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages an n8n credential securely. Credentials store authentication information for services and APIs used by workflows, with proper handling of sensitive data.",
+		Version: credentialSchemaVersion,
+		MarkdownDescription: "Manages an n8n credential securely. Credentials store authentication information for services and APIs used by workflows, with proper handling of sensitive data. " +
+			"Since n8n does not return credential data, a credential updated in n8n after Terraform applied its data is reported " +
+			"as a warning on refresh.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -120,12 +150,14 @@ func (r *CredentialResource) Schema(ctx context.Context, req resource.SchemaRequ
 				},
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the credential. Must be unique within the n8n instance.",
-				Required:            true,
+				MarkdownDescription: "The name of the credential. n8n allows duplicate names; set " +
+					"`enforce_unique_name` to reject them.",
+				Required: true,
 			},
 			"type": schema.StringAttribute{
-				MarkdownDescription: "The type of credential (e.g., 'httpBasicAuth', 'oAuth2Api', 'apiKey'). Determines the required data fields.",
-				Required:            true,
+				MarkdownDescription: "The type of credential (e.g., 'httpBasicAuth', 'oAuth2Api', 'apiKey'). Determines the required data fields. " +
+					"Types of community nodes can be used once their package is installed on the instance.",
+				Required: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -135,10 +167,69 @@ func (r *CredentialResource) Schema(ctx context.Context, req resource.SchemaRequ
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"data_wo": schema.StringAttribute{
+				MarkdownDescription: "Write-only alternative to `data`: JSON string containing the credential configuration " +
+					"data, which is sent to n8n but never stored in the Terraform plan or state. Requires Terraform 1.11 or " +
+					"later. Since changes cannot be detected, increment `data_wo_version` to update the credential data.",
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+			},
+			"data_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of the `data_wo` or `data_from` values. Changing it updates the credential " +
+					"data in n8n.",
+				Optional: true,
+			},
+			"deletion_protection": schema.BoolAttribute{
+				MarkdownDescription: "Whether the provider refuses to delete the credential, e.g. to protect production " +
+					"credentials from an accidental `terraform destroy`. Unlike the `prevent_destroy` lifecycle argument, " +
+					"this also applies when the resource is removed from the configuration. Defaults to false.",
+				Optional: true,
+			},
+			"force_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Whether to delete the credential despite `deletion_protection`. Must be applied " +
+					"before the credential is destroyed. Defaults to false.",
+				Optional: true,
+			},
+			"verify": schema.BoolAttribute{
+				MarkdownDescription: "Whether to test the credential against the service it authenticates with after " +
+					"it is created or updated, failing the apply with the message n8n returns when authentication fails. " +
+					"A credential that fails on create is tainted, and a failed update is attempted again on the next " +
+					"apply. Credential types n8n cannot test only produce a warning. On update, `data_wo` and `data_from` " +
+					"values that differ from the data last applied are sent as well. Requires session authentication. " +
+					"Defaults to false.",
+				Optional: true,
+			},
+			"enforce_unique_name": schema.BoolAttribute{
+				MarkdownDescription: "Whether to fail when another credential with the same name already exists. n8n " +
+					"allows duplicate names, but nodes that refer to credentials by name then use either of them. The " +
+					"existing credentials are checked at plan time and again before the credential is created or " +
+					"renamed. Only credentials visible to the authenticated user are checked. Defaults to false.",
+				Optional: true,
+			},
 			"node_access": schema.ListAttribute{
-				MarkdownDescription: "List of node names that can access this credential. If empty, all nodes can access it.",
-				ElementType:         types.StringType,
-				Optional:            true,
+				MarkdownDescription: "Deprecated: n8n does not restrict credentials to nodes, so the value is kept in " +
+					"state but has no effect. Use `shared_with_project_ids` to control which projects can use the " +
+					"credential.",
+				DeprecationMessage: "n8n does not restrict credentials to nodes, so node_access has no effect. Remove " +
+					"it, and use shared_with_project_ids to control which projects can use the credential.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the project the credential belongs to (Enterprise feature). Defaults to the " +
+					"provider's `default_project_id`; without either, the credential stays in the personal project of " +
+					"the authenticated user. Changing it moves the credential to the new project.",
+				Optional: true,
+				Computed: true,
+			},
+			"shared_with_project_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the projects the credential is shared with, besides the project that " +
+					"owns it, so that their workflows can use it (Enterprise feature). Projects that are not listed " +
+					"lose access, and an empty set unshares the credential. When not set, sharing is not managed. " +
+					"Requires session authentication.",
+				ElementType: types.StringType,
+				Optional:    true,
 			},
 			"created_at": schema.StringAttribute{
 				MarkdownDescription: "Timestamp when the credential was created",
@@ -149,21 +240,48 @@ func (r *CredentialResource) Schema(ctx context.Context, req resource.SchemaRequ
 				Computed:            true,
 			},
 		},
+
+		Blocks: map[string]schema.Block{
+			"data_from": schema.SingleNestedBlock{
+				MarkdownDescription: "Alternative to `data` for Terraform versions without write-only attributes: credential " +
+					"data fields whose values the provider reads from environment variables or files at apply time. Only " +
+					"the variable names and file paths are stored in the state. Since changes to the values cannot be " +
+					"detected, increment `data_wo_version` to update the credential data.",
+				Attributes: map[string]schema.Attribute{
+					"env": schema.MapAttribute{
+						MarkdownDescription: "Names of the environment variables to read, keyed by credential data field",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+					"files": schema.MapAttribute{
+						MarkdownDescription: "Paths of the files to read, keyed by credential data field. A trailing newline " +
+							"is removed from the file content.",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+				},
+			},
+		},
 	}
 }
 
+func (r *CredentialResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("Credential identifier")
+}
+
 func (r *CredentialResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.N8nAPI)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.N8nAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -192,48 +310,34 @@ func (r *CredentialResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	projectID := createProjectID(r.client, data.ProjectID)
+	if projectID != "" && !checkInstanceRequirement(r.client, "n8n_credential", requiresProjects, &resp.Diagnostics) {
+		return
+	}
+
 	// Create credential object
 	credential := &client.Credential{
 		Name: data.Name.ValueString(),
 		Type: data.Type.ValueString(),
 	}
 
-	// Data field is required by n8n API, default to empty object if not provided
-	if !data.Data.IsNull() && data.Data.ValueString() != "" {
-		var credData map[string]interface{}
-		if err := json.Unmarshal([]byte(data.Data.ValueString()), &credData); err != nil {
-			resp.Diagnostics.AddAttributeError(
-				path.Root("data"),
-				"Invalid JSON",
-				fmt.Sprintf("Unable to parse credential data JSON: %s", err),
-			)
-			return
-		}
+	// Write-only data is only available in the configuration
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("data_wo"), &data.DataWO)...)
 
-		// Validate credential data based on type
-		if err := r.validateCredentialData(data.Type.ValueString(), credData); err != nil {
-			resp.Diagnostics.AddAttributeError(
-				path.Root("data"),
-				"Invalid Credential Data",
-				err.Error(),
-			)
-			return
-		}
+	credData, ok := r.credentialData(ctx, &data, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	credential.Data = credData
 
-		credential.Data = credData
-	} else {
-		// Set empty data object if not provided (required by n8n API)
-		credential.Data = make(map[string]interface{})
+	sharedWith := data.SharedWithProjects
+	if !sharedWith.IsNull() && !checkInstanceRequirement(r.client, "n8n_credential", requiresSharing, &resp.Diagnostics) {
+		return
 	}
 
-	// Handle node access
-	if !data.NodeAccess.IsNull() && !data.NodeAccess.IsUnknown() {
-		var nodeAccess []string
-		resp.Diagnostics.Append(data.NodeAccess.ElementsAs(ctx, &nodeAccess, false)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		credential.SharedWith = nodeAccess
+	// Names are checked again, since another credential may have been created since the plan
+	if data.EnforceUniqueName.ValueBool() && !r.checkUniqueName(data.Name.ValueString(), "", &resp.Diagnostics) {
+		return
 	}
 
 	// Create credential via API
@@ -242,10 +346,38 @@ func (r *CredentialResource) Create(ctx context.Context, req resource.CreateRequ
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create credential, got error: %s", err))
 		return
 	}
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, createdCredential.ID)...)
+	resp.Diagnostics.Append(recordAppliedCredentialData(ctx, resp.Private, credData, createdCredential)...)
 
 	// Update model with response data
 	r.updateModelFromCredential(&data, createdCredential)
 
+	// New credentials are created in the personal project and moved afterwards
+	data.ProjectID = types.StringNull()
+	if projectID != "" {
+		if !moveToProject("n8n_credential", createdCredential.ID, projectID, r.client.TransferCredential, &resp.Diagnostics) {
+			// Keep the created credential in state so that it is not orphaned
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+		data.ProjectID = projectIDValue(projectID)
+	}
+
+	// Sharing is left unmanaged in state when it fails, so that it is applied again on the next apply
+	if !sharedWith.IsNull() {
+		data.SharedWithProjects = types.SetNull(types.StringType)
+		if !r.applySharing(ctx, data.ID.ValueString(), sharedWith, &resp.Diagnostics) {
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+		data.SharedWithProjects = sharedWith
+	}
+
+	// A credential that fails verification is kept in state, where Terraform marks it as tainted
+	if data.Verify.ValueBool() {
+		r.verifyCredential(data.ID.ValueString(), credential, &resp.Diagnostics)
+	}
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -263,6 +395,10 @@ func (r *CredentialResource) Read(ctx context.Context, req resource.ReadRequest,
 	// Get credential from API
 	credential, err := r.client.GetCredential(data.ID.ValueString())
 	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read credential, got error: %s", err))
 		return
 	}
@@ -270,6 +406,35 @@ func (r *CredentialResource) Read(ctx context.Context, req resource.ReadRequest,
 	// Update model with response data
 	r.updateModelFromCredential(&data, credential)
 
+	// The project is only refreshed here, since n8n reports the project a credential had before it was
+	// moved when updating it. Not every n8n version reports it.
+	if credential.ProjectID != "" {
+		data.ProjectID = types.StringValue(credential.ProjectID)
+	}
+
+	checksum, diags := getCredentialChecksum(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if checksum != nil && checksum.modifiedOutsideTerraform(credential) {
+		resp.Diagnostics.AddWarning(
+			"Credential Modified Outside Terraform",
+			fmt.Sprintf("n8n reports that credential %s was updated at %s, after Terraform last applied its data. "+
+				"n8n does not return credential data, so its secrets may have been changed. To apply the configured "+
+				"data again, increment data_wo_version or replace the credential.", credential.ID, data.UpdatedAt.ValueString()),
+		)
+	}
+
+	// Sharing is only refreshed when it is managed
+	if !data.SharedWithProjects.IsNull() {
+		projectIDs, err := r.client.GetCredentialSharing(data.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read credential sharing, got error: %s", err))
+			return
+		}
+		data.SharedWithProjects = sharedProjectsValue(projectIDs)
+	}
+
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.ID.ValueString())...)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -284,52 +449,69 @@ func (r *CredentialResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	// Create credential object for update
-	credential := &client.Credential{
-		Name: data.Name.ValueString(),
-		Type: data.Type.ValueString(),
+	var priorProjectID types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("project_id"), &priorProjectID)...)
+	moveProject := !data.ProjectID.IsNull() && data.ProjectID.ValueString() != priorProjectID.ValueString()
+	if moveProject && !checkInstanceRequirement(r.client, "n8n_credential", requiresProjects, &resp.Diagnostics) {
+		return
 	}
 
-	// Data field is required by n8n API, default to empty object if not provided
-	if !data.Data.IsNull() && data.Data.ValueString() != "" {
-		var credData map[string]interface{}
-		if err := json.Unmarshal([]byte(data.Data.ValueString()), &credData); err != nil {
-			resp.Diagnostics.AddAttributeError(
-				path.Root("data"),
-				"Invalid JSON",
-				fmt.Sprintf("Unable to parse credential data JSON: %s", err),
-			)
-			return
-		}
+	var state CredentialResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
-		// Validate credential data based on type
-		if err := r.validateCredentialData(data.Type.ValueString(), credData); err != nil {
-			resp.Diagnostics.AddAttributeError(
-				path.Root("data"),
-				"Invalid Credential Data",
-				err.Error(),
-			)
+	// Removing shared_with_project_ids stops managing the sharing and leaves it as it is
+	share := !data.SharedWithProjects.IsNull() && !data.SharedWithProjects.Equal(state.SharedWithProjects)
+	if share && !checkInstanceRequirement(r.client, "n8n_credential", requiresSharing, &resp.Diagnostics) {
+		return
+	}
+
+	// Write-only data is only available in the configuration
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("data_wo"), &data.DataWO)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only send the fields that changed, so that e.g. the credential data is not sent again on a rename
+	fields := make(map[string]interface{})
+	if !data.Name.Equal(state.Name) {
+		fields["name"] = data.Name.ValueString()
+
+		if data.EnforceUniqueName.ValueBool() &&
+			!r.checkUniqueName(data.Name.ValueString(), data.ID.ValueString(), &resp.Diagnostics) {
 			return
 		}
-
-		credential.Data = credData
-	} else {
-		// Set empty data object if not provided (required by n8n API)
-		credential.Data = make(map[string]interface{})
 	}
 
-	// Handle node access
-	if !data.NodeAccess.IsNull() && !data.NodeAccess.IsUnknown() {
-		var nodeAccess []string
-		resp.Diagnostics.Append(data.NodeAccess.ElementsAs(ctx, &nodeAccess, false)...)
-		if resp.Diagnostics.HasError() {
+	// The credential is tested with its complete data, so the data is also resolved for verification
+	var credData map[string]interface{}
+	if credentialDataChanged(&data, &state) || data.Verify.ValueBool() {
+		var ok bool
+		credData, ok = r.credentialData(ctx, &data, &resp.Diagnostics)
+		if !ok {
 			return
 		}
-		credential.SharedWith = nodeAccess
 	}
 
-	// Update credential via API
-	updatedCredential, err := r.client.UpdateCredential(data.ID.ValueString(), credential)
+	// Data resolved for verification is sent as well if it differs from the data last applied, e.g. because
+	// the values data_from refers to changed
+	checksum, diags := getCredentialChecksum(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	sendData := credentialDataChanged(&data, &state) || (credData != nil && checksum != nil && !checksum.matches(credData))
+
+	if sendData {
+		fields["type"] = data.Type.ValueString()
+		fields["data"] = credData
+	}
+
+	// Update credential via API, or refresh it if only Terraform-side attributes changed
+	var updatedCredential *client.Credential
+	var err error
+	if len(fields) > 0 {
+		updatedCredential, err = r.client.PatchCredential(data.ID.ValueString(), fields)
+	} else {
+		updatedCredential, err = r.client.GetCredential(data.ID.ValueString())
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update credential, got error: %s", err))
 		return
@@ -338,10 +520,153 @@ func (r *CredentialResource) Update(ctx context.Context, req resource.UpdateRequ
 	// Update model with response data
 	r.updateModelFromCredential(&data, updatedCredential)
 
+	// A rename moves the time the credential was last updated, which is not a change outside Terraform
+	// unless there was one before
+	switch {
+	case sendData:
+		resp.Diagnostics.Append(recordAppliedCredentialData(ctx, resp.Private, credData, updatedCredential)...)
+	case checksum != nil && checksum.UpdatedAt != nil && len(fields) > 0 &&
+		checksum.UpdatedAt.Format("2006-01-02T15:04:05Z") == state.UpdatedAt.ValueString():
+		checksum.UpdatedAt = updatedCredential.UpdatedAt
+		resp.Diagnostics.Append(setCredentialChecksum(ctx, resp.Private, checksum)...)
+	}
+
+	if moveProject {
+		if !moveToProject("n8n_credential", data.ID.ValueString(), data.ProjectID.ValueString(), r.client.TransferCredential,
+			&resp.Diagnostics) {
+			data.ProjectID = priorProjectID
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+
+	if share && !r.applySharing(ctx, data.ID.ValueString(), data.SharedWithProjects, &resp.Diagnostics) {
+		data.SharedWithProjects = state.SharedWithProjects
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.ID.ValueString())...)
+
+	// Keep the prior state when verification fails, so that the update is planned and verified again
+	if data.Verify.ValueBool() {
+		credential := &client.Credential{Name: data.Name.ValueString(), Type: data.Type.ValueString(), Data: credData}
+		if !r.verifyCredential(data.ID.ValueString(), credential, &resp.Diagnostics) {
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			return
+		}
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// ValidateConfig checks that the credential data is configured through only one of data, data_wo and data_from
+func (r *CredentialResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse) {
+	var data CredentialResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sources := 0
+	for _, configured := range []bool{!data.Data.IsNull(), !data.DataWO.IsNull(), !data.DataFrom.IsNull()} {
+		if configured {
+			sources++
+		}
+	}
+	if sources > 1 {
+		resp.Diagnostics.AddError("Conflicting Attributes", "Only one of data, data_wo and data_from can be set.")
+	}
+	if !data.DataWOVersion.IsNull() && data.DataWO.IsNull() && data.DataFrom.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("data_wo_version"), "Missing Attribute",
+			"data_wo_version can only be set together with data_wo or data_from.")
+	}
+
+	// Check the type and data before planning, so that invalid credentials do not fail halfway through an apply.
+	// The provider is not configured yet, so types installed by community nodes are checked in ModifyPlan.
+	if data.Type.IsUnknown() {
+		return
+	}
+	if data.Type.ValueString() == "" {
+		resp.Diagnostics.AddAttributeError(path.Root("type"), "Invalid Credential Type", "credential type is required")
+		return
+	}
+
+	r.validateCredentialJSON("data", data.Type.ValueString(), data.Data, &resp.Diagnostics)
+	r.validateCredentialJSON("data_wo", data.Type.ValueString(), data.DataWO, &resp.Diagnostics)
+}
+
+// validateCredentialJSON reports an attribute error if known credential data cannot be parsed or lacks
+// fields its credential type requires
+func (r *CredentialResource) validateCredentialJSON(attribute, credType string, value types.String,
+	diags *diag.Diagnostics) {
+	if value.IsNull() || value.IsUnknown() || value.ValueString() == "" {
+		return
+	}
+
+	var credData map[string]interface{}
+	if err := json.Unmarshal([]byte(value.ValueString()), &credData); err != nil {
+		diags.AddAttributeError(
+			path.Root(attribute),
+			"Invalid JSON",
+			fmt.Sprintf("Unable to parse credential data JSON: %s", err),
+		)
+		return
+	}
+
+	if err := r.validateCredentialData(credType, credData); err != nil {
+		diags.AddAttributeError(
+			path.Root(attribute),
+			"Invalid Credential Data",
+			err.Error(),
+		)
+	}
+}
+
+// ModifyPlan plans the project of credentials that do not configure one, checks that the type is built in or
+// installed on the instance, and checks that names are unique where enforce_unique_name asks for it
+func (r *CredentialResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	planProjectID(ctx, r.client, req, &resp.Plan, &resp.Diagnostics)
+
+	var plan CredentialResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if r.client != nil && !resp.Diagnostics.HasError() && !plan.Type.IsUnknown() {
+		if err := r.validateCredentialType(plan.Type.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("type"), "Invalid Credential Type", err.Error())
+			return
+		}
+	}
+
+	if r.client == nil || resp.Diagnostics.HasError() || !plan.EnforceUniqueName.ValueBool() ||
+		plan.Name.IsUnknown() {
+		return
+	}
+
+	// Existing credentials are only checked when the name is new or the check was just enabled
+	if !req.State.Raw.IsNull() {
+		var state CredentialResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+		if resp.Diagnostics.HasError() || (plan.Name.Equal(state.Name) && state.EnforceUniqueName.ValueBool()) {
+			return
+		}
+	}
+
+	r.checkUniqueName(plan.Name.ValueString(), plan.ID.ValueString(), &resp.Diagnostics)
+}
+
 func (r *CredentialResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var data CredentialResourceModel
 
@@ -352,6 +677,11 @@ func (r *CredentialResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	if !checkDeletionProtection("n8n_credential", data.ID.ValueString(), data.DeletionProtection, data.ForceDestroy,
+		&resp.Diagnostics) {
+		return
+	}
+
 	// Delete credential via API
 	err := r.client.DeleteCredential(data.ID.ValueString())
 	if err != nil {
@@ -360,21 +690,225 @@ func (r *CredentialResource) Delete(ctx context.Context, req resource.DeleteRequ
 	}
 }
 
+// UpgradeState migrates credential state from earlier schema versions
+func (r *CredentialResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// Version 0 read node_access from a field n8n never returns
+		0: {StateUpgrader: r.upgradeStateV0},
+	}
+}
+
+// upgradeStateV0 clears the node_access that version 0 read from n8n, which was always empty, so that it is
+// planned from the configuration once and then kept as configured. The JSON state is rewritten instead of
+// decoded with a prior schema, so attributes that no longer exist are dropped and new ones are null.
+func (r *CredentialResource) upgradeStateV0(ctx context.Context, req resource.UpgradeStateRequest,
+	resp *resource.UpgradeStateResponse) {
+	if req.RawState == nil || len(req.RawState.JSON) == 0 {
+		resp.Diagnostics.AddError("Unable to Upgrade Credential State", "The prior credential state is missing or not in JSON format.")
+		return
+	}
+
+	var rawState map[string]interface{}
+	if err := json.Unmarshal(req.RawState.JSON, &rawState); err != nil {
+		resp.Diagnostics.AddError("Unable to Upgrade Credential State",
+			fmt.Sprintf("Unable to parse the prior credential state, got error: %s", err))
+		return
+	}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	for name := range rawState {
+		if _, ok := schemaResp.Schema.Attributes[name]; !ok {
+			delete(rawState, name)
+		}
+	}
+	delete(rawState, "node_access")
+
+	upgradedJSON, err := json.Marshal(rawState)
+	if err != nil {
+		resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Unable to encode the upgraded credential state, got error: %s", err))
+		return
+	}
+	resp.DynamicValue = &tfprotov6.DynamicValue{JSON: upgradedJSON}
+}
+
 func (r *CredentialResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
-// validateCredentialType validates that the credential type is supported
+// validateCredentialType validates that the credential type is supported. n8n has no API to register credential
+// types; those of community nodes are installed with their package, so types that are not built in are accepted
+// if the instance lists them, which requires session authentication.
 func (r *CredentialResource) validateCredentialType(credType string) error {
 	if credType == "" {
 		return fmt.Errorf("credential type is required")
 	}
 
-	if !slices.Contains(supportedCredentialTypes, credType) {
-		return fmt.Errorf("unsupported credential type: %s. Supported types: %s", credType, strings.Join(supportedCredentialTypes, ", "))
+	if slices.Contains(supportedCredentialTypes, credType) {
+		return nil
 	}
 
-	return nil
+	if r.client != nil {
+		if installed, err := r.client.GetCredentialTypes(); err == nil {
+			for _, credentialType := range installed {
+				if credentialType.Name == credType {
+					return nil
+				}
+			}
+		}
+	}
+
+	return fmt.Errorf("unsupported credential type: %s. Supported types: %s. Credential types of community nodes are "+
+		"accepted once their package is installed on the instance and the provider uses session authentication",
+		credType, strings.Join(supportedCredentialTypes, ", "))
+}
+
+// credentialData parses the credential data from data or data_wo, or resolves it from data_from. The data
+// field is required by the n8n API, so it defaults to an empty object if none of them is set.
+func (r *CredentialResource) credentialData(ctx context.Context, model *CredentialResourceModel,
+	diags *diag.Diagnostics) (map[string]interface{}, bool) {
+	attribute, value := "data", model.Data
+	if !model.DataWO.IsNull() {
+		attribute, value = "data_wo", model.DataWO
+	}
+
+	var credData map[string]interface{}
+	switch {
+	case !model.DataFrom.IsNull():
+		attribute = "data_from"
+
+		var dataFrom CredentialDataFromModel
+		diags.Append(model.DataFrom.As(ctx, &dataFrom, basetypes.ObjectAsOptions{})...)
+		env, files := stringMapElements(dataFrom.Env), stringMapElements(dataFrom.Files)
+		if diags.HasError() {
+			return nil, false
+		}
+
+		resolved, err := resolveCredentialData(env, files)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root(attribute),
+				"Unable to Resolve Credential Data",
+				err.Error(),
+			)
+			return nil, false
+		}
+		credData = resolved
+	case value.IsNull() || value.ValueString() == "":
+		return make(map[string]interface{}), true
+	default:
+		if err := json.Unmarshal([]byte(value.ValueString()), &credData); err != nil {
+			diags.AddAttributeError(
+				path.Root(attribute),
+				"Invalid JSON",
+				fmt.Sprintf("Unable to parse credential data JSON: %s", err),
+			)
+			return nil, false
+		}
+	}
+
+	// Validate credential data based on type
+	if err := r.validateCredentialData(model.Type.ValueString(), credData); err != nil {
+		diags.AddAttributeError(
+			path.Root(attribute),
+			"Invalid Credential Data",
+			err.Error(),
+		)
+		return nil, false
+	}
+
+	return credData, true
+}
+
+// verifyCredential tests a credential with its data and reports whether the service accepted it. Credential
+// types that n8n cannot test are accepted with a warning.
+func (r *CredentialResource) verifyCredential(id string, credential *client.Credential, diags *diag.Diagnostics) bool {
+	result, err := r.client.TestCredential(&client.Credential{
+		ID:   id,
+		Name: credential.Name,
+		Type: credential.Type,
+		Data: credential.Data,
+	})
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to verify credential, got error: %s", err))
+		return false
+	}
+
+	switch {
+	case result.OK():
+		return true
+	case result.NotTestable():
+		diags.AddAttributeWarning(
+			path.Root("verify"),
+			"Credential Not Verified",
+			fmt.Sprintf("n8n cannot test credentials of type %s, so credential %s was not verified.", credential.Type, id),
+		)
+		return true
+	default:
+		diags.AddAttributeError(
+			path.Root("verify"),
+			"Credential Verification Failed",
+			fmt.Sprintf("n8n could not authenticate with credential %s: %s", id, result.Message),
+		)
+		return false
+	}
+}
+
+// checkUniqueName reports whether no credential other than the one with the given ID, empty for credentials
+// that are not created yet, is named name
+func (r *CredentialResource) checkUniqueName(name, id string, diags *diag.Diagnostics) bool {
+	credentials, err := r.client.GetAllCredentials(nil)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list credentials, got error: %s", err))
+		return false
+	}
+
+	for _, credential := range credentials {
+		if credential.Name == name && credential.ID != id {
+			diags.AddAttributeError(
+				path.Root("name"),
+				"Duplicate Credential Name",
+				fmt.Sprintf("Credential %s is already named %q, and enforce_unique_name is set. Choose a different "+
+					"name, or import the existing credential instead.", credential.ID, name),
+			)
+			return false
+		}
+	}
+	return true
+}
+
+// credentialDataChanged reports whether the credential data has to be sent on update. Changes of data_wo
+// and of the values data_from refers to cannot be detected, so they are only sent when data_wo_version changes.
+func credentialDataChanged(plan, state *CredentialResourceModel) bool {
+	return !plan.Data.Equal(state.Data) || !plan.DataWOVersion.Equal(state.DataWOVersion) ||
+		!plan.DataFrom.Equal(state.DataFrom)
+}
+
+// resolveCredentialData reads credential data fields from the given environment variables and files
+func resolveCredentialData(env, files map[string]string) (map[string]interface{}, error) {
+	credData := make(map[string]interface{}, len(env)+len(files))
+
+	for _, field := range sortedKeys(env) {
+		value, ok := os.LookupEnv(env[field])
+		if !ok {
+			return nil, fmt.Errorf("environment variable %s for field %q is not set", env[field], field)
+		}
+		credData[field] = value
+	}
+
+	for _, field := range sortedKeys(files) {
+		if _, ok := credData[field]; ok {
+			return nil, fmt.Errorf("field %q is set from both an environment variable and a file", field)
+		}
+
+		content, err := os.ReadFile(files[field])
+		if err != nil {
+			return nil, fmt.Errorf("unable to read file for field %q: %w", field, err)
+		}
+		credData[field] = strings.TrimRight(string(content), "\r\n")
+	}
+
+	return credData, nil
 }
 
 // validateCredentialData validates the credential data based on type
@@ -456,18 +990,6 @@ func (r *CredentialResource) updateModelFromCredential(model *CredentialResource
 		}
 	}
 
-	// Handle node access / shared with
-	if len(credential.SharedWith) > 0 {
-		nodeAccessValues := make([]attr.Value, len(credential.SharedWith))
-		for i, node := range credential.SharedWith {
-			nodeAccessValues[i] = types.StringValue(node)
-		}
-		model.NodeAccess = types.ListValueMust(types.StringType, nodeAccessValues)
-	} else {
-		// Set as null List when no shared access is configured
-		model.NodeAccess = types.ListNull(types.StringType)
-	}
-
 	if credential.CreatedAt != nil {
 		model.CreatedAt = types.StringValue(credential.CreatedAt.Format("2006-01-02T15:04:05Z"))
 	}
@@ -1,14 +1,27 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"regexp"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+	"github.com/devops247-online/terraform-provider-n8n/internal/client/clientmock"
 )
 
 func TestAccCredentialResource(t *testing.T) {
@@ -75,6 +88,426 @@ func TestAccCredentialResourceWithData(t *testing.T) {
 	})
 }
 
+func TestAccCredentialResourceWriteOnlyData(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckCredentials(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			// Create with write-only credential data
+			{
+				Config: testAccCredentialResourceConfigWriteOnlyData("test-credential-wo", "testpass", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("n8n_credential.test", "name", "test-credential-wo"),
+					resource.TestCheckResourceAttr("n8n_credential.test", "data_wo_version", "1"),
+					resource.TestCheckNoResourceAttr("n8n_credential.test", "data_wo"),
+					resource.TestCheckNoResourceAttr("n8n_credential.test", "data"),
+				),
+			},
+			// Rotate the secret by incrementing the version
+			{
+				Config: testAccCredentialResourceConfigWriteOnlyData("test-credential-wo", "rotatedpass", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("n8n_credential.test", "data_wo_version", "2"),
+					resource.TestCheckNoResourceAttr("n8n_credential.test", "data_wo"),
+				),
+			},
+		},
+	})
+}
+
+func TestCredentialResource_CredentialData(t *testing.T) {
+	t.Setenv("TEST_N8N_CREDENTIAL_USER", "env")
+	t.Setenv("TEST_N8N_CREDENTIAL_PASSWORD", "secret")
+
+	r := &CredentialResource{}
+
+	tests := []struct {
+		name      string
+		model     CredentialResourceModel
+		wantUser  interface{}
+		wantError bool
+	}{
+		{
+			name: "data",
+			model: CredentialResourceModel{
+				Type:   types.StringValue("httpBasicAuth"),
+				Data:   types.StringValue(`{"user": "state", "password": "secret"}`),
+				DataWO: types.StringNull(),
+			},
+			wantUser: "state",
+		},
+		{
+			name: "write-only data",
+			model: CredentialResourceModel{
+				Type:   types.StringValue("httpBasicAuth"),
+				Data:   types.StringNull(),
+				DataWO: types.StringValue(`{"user": "vault", "password": "secret"}`),
+			},
+			wantUser: "vault",
+		},
+		{
+			name: "data from environment",
+			model: CredentialResourceModel{
+				Type:   types.StringValue("httpBasicAuth"),
+				Data:   types.StringNull(),
+				DataWO: types.StringNull(),
+				DataFrom: types.ObjectValueMust(
+					map[string]attr.Type{"env": types.MapType{ElemType: types.StringType}, "files": types.MapType{ElemType: types.StringType}},
+					map[string]attr.Value{
+						"env": types.MapValueMust(types.StringType, map[string]attr.Value{
+							"user":     types.StringValue("TEST_N8N_CREDENTIAL_USER"),
+							"password": types.StringValue("TEST_N8N_CREDENTIAL_PASSWORD"),
+						}),
+						"files": types.MapNull(types.StringType),
+					},
+				),
+			},
+			wantUser: "env",
+		},
+		{
+			name: "no data",
+			model: CredentialResourceModel{
+				Type:   types.StringValue("httpBasicAuth"),
+				Data:   types.StringNull(),
+				DataWO: types.StringNull(),
+			},
+		},
+		{
+			name: "invalid write-only data",
+			model: CredentialResourceModel{
+				Type:   types.StringValue("httpBasicAuth"),
+				Data:   types.StringNull(),
+				DataWO: types.StringValue(`{"user": "vault"}`),
+			},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			credData, ok := r.credentialData(context.Background(), &tt.model, &diags)
+
+			if ok == tt.wantError || diags.HasError() != tt.wantError {
+				t.Fatalf("credentialData() ok = %v, diagnostics = %v", ok, diags)
+			}
+			if !tt.wantError && credData["user"] != tt.wantUser {
+				t.Errorf("Expected user %v, got %v", tt.wantUser, credData["user"])
+			}
+		})
+	}
+}
+
+func TestCredentialResource_ValidateCredentialJSON(t *testing.T) {
+	r := &CredentialResource{}
+
+	tests := []struct {
+		name      string
+		credType  string
+		value     types.String
+		wantError bool
+	}{
+		{name: "valid data", credType: "httpBasicAuth", value: types.StringValue(`{"user": "admin", "password": "secret"}`)},
+		{name: "missing field", credType: "httpBasicAuth", value: types.StringValue(`{"user": "admin"}`), wantError: true},
+		{name: "invalid JSON", credType: "apiKey", value: types.StringValue(`{"apiKey": `), wantError: true},
+		{name: "unknown data", credType: "apiKey", value: types.StringUnknown()},
+		{name: "null data", credType: "apiKey", value: types.StringNull()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			r.validateCredentialJSON("data", tt.credType, tt.value, &diags)
+
+			if diags.HasError() != tt.wantError {
+				t.Errorf("Expected error = %v, got diagnostics: %v", tt.wantError, diags)
+			}
+		})
+	}
+}
+
+func TestCredentialResource_ValidateCredentialType(t *testing.T) {
+	r := &CredentialResource{client: &clientmock.N8nAPI{
+		GetCredentialTypesFunc: func() ([]client.CredentialType, error) {
+			return []client.CredentialType{{Name: "acmeApi", DisplayName: "Acme API"}}, nil
+		},
+	}}
+
+	if err := r.validateCredentialType("httpBasicAuth"); err != nil {
+		t.Errorf("Expected a built-in type to be accepted, got %v", err)
+	}
+	if err := r.validateCredentialType("acmeApi"); err != nil {
+		t.Errorf("Expected a type installed by a community node to be accepted, got %v", err)
+	}
+	if err := r.validateCredentialType("otherApi"); err == nil || !strings.Contains(err.Error(), "unsupported credential type") {
+		t.Errorf("Expected a type that is not installed to be rejected, got %v", err)
+	}
+
+	// Without session authentication, only the built-in types are known
+	r.client = &clientmock.N8nAPI{
+		GetCredentialTypesFunc: func() ([]client.CredentialType, error) {
+			return nil, fmt.Errorf("session authentication required")
+		},
+	}
+	if err := r.validateCredentialType("acmeApi"); err == nil {
+		t.Error("Expected the type to be rejected when the installed types cannot be listed")
+	}
+}
+
+func TestCredentialResource_CommunityType(t *testing.T) {
+	ctx := context.Background()
+
+	// The framework validates the configuration before the provider is configured, so there is no client yet
+	r := &CredentialResource{}
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	newState := func(credType string) tfsdk.State {
+		state := tfsdk.State{Schema: schemaResp.Schema}
+		model := CredentialResourceModel{
+			ID:            types.StringUnknown(),
+			Name:          types.StringValue("Acme"),
+			Type:          types.StringValue(credType),
+			Data:          types.StringValue(`{"apiKey": "acme"}`),
+			DataWO:        types.StringNull(),
+			DataWOVersion: types.Int64Null(),
+			DataFrom: types.ObjectNull(map[string]attr.Type{
+				"env":   types.MapType{ElemType: types.StringType},
+				"files": types.MapType{ElemType: types.StringType},
+			}),
+			DeletionProtection: types.BoolNull(),
+			ForceDestroy:       types.BoolNull(),
+			Verify:             types.BoolNull(),
+			EnforceUniqueName:  types.BoolNull(),
+			NodeAccess:         types.ListNull(types.StringType),
+			ProjectID:          types.StringValue("project-1"),
+			SharedWithProjects: types.SetNull(types.StringType),
+			CreatedAt:          types.StringUnknown(),
+			UpdatedAt:          types.StringUnknown(),
+		}
+		if diags := state.Set(ctx, &model); diags.HasError() {
+			t.Fatalf("Unexpected diagnostics: %v", diags)
+		}
+		return state
+	}
+
+	validateResp := &fwresource.ValidateConfigResponse{}
+	config := tfsdk.Config{Schema: schemaResp.Schema, Raw: newState("acmeApi").Raw}
+	r.ValidateConfig(ctx, fwresource.ValidateConfigRequest{Config: config}, validateResp)
+	if validateResp.Diagnostics.HasError() {
+		t.Errorf("Expected a community type to pass validation without a client, got %v", validateResp.Diagnostics)
+	}
+
+	// Once the provider is configured, the type is checked against the types installed on the instance
+	r.client = &clientmock.N8nAPI{
+		GetCredentialTypesFunc: func() ([]client.CredentialType, error) {
+			return []client.CredentialType{{Name: "acmeApi", DisplayName: "Acme API"}}, nil
+		},
+	}
+	modifyPlan := func(credType string) diag.Diagnostics {
+		plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: newState(credType).Raw}
+		req := fwresource.ModifyPlanRequest{
+			Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: plan.Raw},
+			Plan:   plan,
+			State:  tfsdk.State{Schema: schemaResp.Schema},
+		}
+		req.State.RemoveResource(ctx)
+
+		resp := &fwresource.ModifyPlanResponse{Plan: req.Plan}
+		r.ModifyPlan(ctx, req, resp)
+		return resp.Diagnostics
+	}
+
+	if diags := modifyPlan("acmeApi"); diags.HasError() {
+		t.Errorf("Expected an installed type to be planned, got %v", diags)
+	}
+	if diags := modifyPlan("otherApi"); !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), "otherApi") {
+		t.Errorf("Expected a type that is not installed to be rejected when planning, got %v", diags)
+	}
+}
+
+func TestCredentialResource_VerifyCredential(t *testing.T) {
+	response := `{"data": {"status": "OK", "message": "Connection successful!"}}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(response))
+	}))
+	defer server.Close()
+
+	n8nClient, err := client.NewClient(&client.Config{BaseURL: server.URL, Auth: &client.APIKeyAuth{APIKey: "test-key"}})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	r := &CredentialResource{client: n8nClient}
+	credential := &client.Credential{Name: "OpenAI", Type: "openAiApi", Data: map[string]interface{}{"apiKey": "secret"}}
+
+	var diags diag.Diagnostics
+	if !r.verifyCredential("cred-1", credential, &diags) || diags.HasError() || diags.WarningsCount() > 0 {
+		t.Errorf("Expected the credential to be verified, got %v", diags)
+	}
+
+	response = `{"data": {"status": "Error", "message": "No testing function found for this credential."}}`
+	diags = nil
+	if !r.verifyCredential("cred-1", credential, &diags) || diags.WarningsCount() != 1 {
+		t.Errorf("Expected a warning for a credential type that cannot be tested, got %v", diags)
+	}
+
+	response = `{"data": {"status": "Error", "message": "Incorrect API key provided"}}`
+	diags = nil
+	if r.verifyCredential("cred-1", credential, &diags) || !diags.HasError() {
+		t.Fatal("Expected an error for a credential that fails authentication")
+	}
+	if detail := diags.Errors()[0].Detail(); !strings.Contains(detail, "Incorrect API key provided") {
+		t.Errorf("Expected the message returned by n8n, got %s", detail)
+	}
+}
+
+func TestCredentialResource_ModifyPlanUniqueName(t *testing.T) {
+	ctx := context.Background()
+
+	mock := &clientmock.N8nAPI{
+		GetAllCredentialsFunc: func(options *client.CredentialListOptions) ([]client.Credential, error) {
+			return []client.Credential{{ID: "cred-1", Name: "Stripe"}, {ID: "cred-2", Name: "Slack"}}, nil
+		},
+	}
+	r := &CredentialResource{client: mock}
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	dataFromTypes := map[string]attr.Type{
+		"env":   types.MapType{ElemType: types.StringType},
+		"files": types.MapType{ElemType: types.StringType},
+	}
+	newModel := func(id types.String, name string) CredentialResourceModel {
+		return CredentialResourceModel{
+			ID:                 id,
+			Name:               types.StringValue(name),
+			Type:               types.StringValue("stripeApi"),
+			Data:               types.StringValue(`{"secretKey": "sk_test"}`),
+			DataWO:             types.StringNull(),
+			DataWOVersion:      types.Int64Null(),
+			DataFrom:           types.ObjectNull(dataFromTypes),
+			DeletionProtection: types.BoolNull(),
+			ForceDestroy:       types.BoolNull(),
+			Verify:             types.BoolNull(),
+			EnforceUniqueName:  types.BoolValue(true),
+			NodeAccess:         types.ListNull(types.StringType),
+			ProjectID:          types.StringValue("project-1"),
+			SharedWithProjects: types.SetNull(types.StringType),
+			CreatedAt:          types.StringUnknown(),
+			UpdatedAt:          types.StringUnknown(),
+		}
+	}
+	modifyPlan := func(plan CredentialResourceModel, state *CredentialResourceModel) diag.Diagnostics {
+		req := fwresource.ModifyPlanRequest{
+			Plan:  tfsdk.Plan{Schema: schemaResp.Schema},
+			State: tfsdk.State{Schema: schemaResp.Schema},
+		}
+		req.State.RemoveResource(ctx)
+		diags := req.Plan.Set(ctx, &plan)
+		if state != nil {
+			diags.Append(req.State.Set(ctx, state)...)
+		}
+		if diags.HasError() {
+			t.Fatalf("Unexpected diagnostics: %v", diags)
+		}
+		req.Config = tfsdk.Config{Schema: schemaResp.Schema, Raw: req.Plan.Raw}
+
+		resp := &fwresource.ModifyPlanResponse{Plan: req.Plan}
+		r.ModifyPlan(ctx, req, resp)
+		return resp.Diagnostics
+	}
+
+	// Creating a credential with the name of an existing one fails at plan time
+	diags := modifyPlan(newModel(types.StringUnknown(), "Stripe"), nil)
+	if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), "cred-1") {
+		t.Errorf("Expected an error naming the existing credential, got %v", diags)
+	}
+	if diags := modifyPlan(newModel(types.StringUnknown(), "Stripe Live"), nil); diags.HasError() {
+		t.Errorf("Unexpected diagnostics for a unique name: %v", diags)
+	}
+
+	// A credential does not conflict with itself, but renaming it to the name of another one fails
+	state := newModel(types.StringValue("cred-1"), "Stripe")
+	if diags := modifyPlan(newModel(types.StringValue("cred-1"), "Stripe"), &state); diags.HasError() {
+		t.Errorf("Unexpected diagnostics for an unchanged credential: %v", diags)
+	}
+	if diags := modifyPlan(newModel(types.StringValue("cred-1"), "Slack"), &state); !diags.HasError() {
+		t.Error("Expected an error for a rename to the name of another credential")
+	}
+
+	// Unchanged names are only listed when the check is enabled
+	mock.Calls = nil
+	modifyPlan(newModel(types.StringValue("cred-1"), "Stripe"), &state)
+	if len(mock.Calls) != 0 {
+		t.Errorf("Expected no lookup for an unchanged name, got %v", mock.Calls)
+	}
+}
+
+func TestCredentialDataChanged(t *testing.T) {
+	state := CredentialResourceModel{
+		Data:          types.StringNull(),
+		DataWOVersion: types.Int64Value(1),
+	}
+
+	unchanged := state
+	unchanged.DataWO = types.StringValue(`{"apiKey": "not comparable"}`)
+	if credentialDataChanged(&unchanged, &state) {
+		t.Error("Expected write-only data without a new version to be unchanged")
+	}
+
+	rotated := state
+	rotated.DataWOVersion = types.Int64Value(2)
+	if !credentialDataChanged(&rotated, &state) {
+		t.Error("Expected a new data_wo_version to change the credential data")
+	}
+
+	switched := state
+	switched.Data = types.StringValue(`{"apiKey": "secret"}`)
+	if !credentialDataChanged(&switched, &state) {
+		t.Error("Expected changed data to change the credential data")
+	}
+}
+
+func TestResolveCredentialData(t *testing.T) {
+	t.Setenv("TEST_N8N_CREDENTIAL_USER", "vault-user")
+
+	passwordFile := filepath.Join(t.TempDir(), "password")
+	if err := os.WriteFile(passwordFile, []byte("s3cret\n"), 0o600); err != nil {
+		t.Fatalf("Failed to write password file: %v", err)
+	}
+
+	credData, err := resolveCredentialData(
+		map[string]string{"user": "TEST_N8N_CREDENTIAL_USER"},
+		map[string]string{"password": passwordFile},
+	)
+	if err != nil {
+		t.Fatalf("resolveCredentialData() error = %v", err)
+	}
+	if credData["user"] != "vault-user" || credData["password"] != "s3cret" {
+		t.Errorf("Unexpected credential data: %v", credData)
+	}
+
+	if _, err := resolveCredentialData(map[string]string{"user": "TEST_N8N_CREDENTIAL_MISSING"}, nil); err == nil {
+		t.Error("Expected error for an unset environment variable")
+	}
+	if _, err := resolveCredentialData(nil, map[string]string{"password": filepath.Join(t.TempDir(), "missing")}); err == nil {
+		t.Error("Expected error for a missing file")
+	}
+	if _, err := resolveCredentialData(
+		map[string]string{"user": "TEST_N8N_CREDENTIAL_USER"},
+		map[string]string{"user": passwordFile},
+	); err == nil {
+		t.Error("Expected error for a field set from both sources")
+	}
+}
+
 func TestAccCredentialResourceWithNodeAccess(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheckCredentials(t) },
@@ -270,6 +703,20 @@ resource "n8n_credential" "test" {
 `, name, credType)
 }
 
+func testAccCredentialResourceConfigWriteOnlyData(name, password string, version int) string {
+	return fmt.Sprintf(`
+resource "n8n_credential" "test" {
+  name = "%s"
+  type = "httpBasicAuth"
+  data_wo = jsonencode({
+    user     = "testuser"
+    password = "%s"
+  })
+  data_wo_version = %d
+}
+`, name, password, version)
+}
+
 func testAccCredentialResourceConfigWithNodeAccess(name, credType string) string {
 	return fmt.Sprintf(`
 resource "n8n_credential" "test" {
@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// applySharing shares a credential with exactly the projects in projectIDs
+func (r *CredentialResource) applySharing(ctx context.Context, id string, projectIDs types.Set,
+	diags *diag.Diagnostics) bool {
+	var ids []string
+	diags.Append(projectIDs.ElementsAs(ctx, &ids, false)...)
+	if diags.HasError() {
+		return false
+	}
+
+	if err := r.client.ShareCredential(id, ids); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to share credential, got error: %s", err))
+		return false
+	}
+
+	return true
+}
+
+// sharedProjectsValue returns the IDs of the projects a credential is shared with as a set
+func sharedProjectsValue(projectIDs []string) types.Set {
+	values := make([]attr.Value, 0, len(projectIDs))
+	for _, id := range slices.Compact(slices.Sorted(slices.Values(projectIDs))) {
+		values = append(values, types.StringValue(id))
+	}
+	return types.SetValueMust(types.StringType, values)
+}
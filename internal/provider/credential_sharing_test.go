@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client/clientmock"
+)
+
+func TestCredentialResource_ApplySharing(t *testing.T) {
+	var sharedWith []string
+	mock := &clientmock.N8nAPI{
+		ShareCredentialFunc: func(id string, projectIDs []string) error {
+			sharedWith = projectIDs
+			return nil
+		},
+	}
+	r := &CredentialResource{client: mock}
+
+	projectIDs := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("project-2")})
+	var diags diag.Diagnostics
+	if !r.applySharing(context.Background(), "cred-1", projectIDs, &diags) || diags.HasError() {
+		t.Fatalf("Expected the credential to be shared, got %v", diags)
+	}
+	if !reflect.DeepEqual(sharedWith, []string{"project-2"}) {
+		t.Errorf("Expected the configured projects to be shared, got %v", sharedWith)
+	}
+}
+
+func TestSharedProjectsValue(t *testing.T) {
+	value := sharedProjectsValue([]string{"project-3", "project-2", "project-3"})
+	expected := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("project-2"), types.StringValue("project-3")})
+	if !value.Equal(expected) {
+		t.Errorf("Expected the project IDs, got %v", value)
+	}
+
+	// A credential that is not shared is reported as an empty set, not as unmanaged
+	value = sharedProjectsValue(nil)
+	if value.IsNull() || len(value.Elements()) != 0 {
+		t.Errorf("Expected an empty set, got %v", value)
+	}
+}
+
+func TestCredentialResource_UpgradeStateV0(t *testing.T) {
+	ctx := context.Background()
+	r := &CredentialResource{}
+
+	upgrader, ok := r.UpgradeState(ctx)[0]
+	if !ok {
+		t.Fatal("Expected a state upgrader for version 0")
+	}
+
+	rawState := `{
+		"id": "cred-1",
+		"name": "OpenAI",
+		"type": "openAiApi",
+		"node_access": ["httpRequest"],
+		"project_id": "project-1"
+	}`
+
+	req := fwresource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: []byte(rawState)}}
+	var resp fwresource.UpgradeStateResponse
+	upgrader.StateUpgrader(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	value, err := resp.DynamicValue.Unmarshal(schemaResp.Schema.Type().TerraformType(ctx))
+	if err != nil {
+		t.Fatalf("Expected the upgraded state to match the current schema, got error: %v", err)
+	}
+
+	var data CredentialResourceModel
+	state := tfsdk.State{Raw: value, Schema: schemaResp.Schema}
+	if diags := state.Get(ctx, &data); diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	if data.ID.ValueString() != "cred-1" || data.ProjectID.ValueString() != "project-1" {
+		t.Errorf("Expected the other attributes to be kept, got %+v", data)
+	}
+	if !data.NodeAccess.IsNull() {
+		t.Errorf("Expected node_access to be cleared, got %v", data.NodeAccess)
+	}
+	if !data.SharedWithProjects.IsNull() {
+		t.Errorf("Expected sharing to be unmanaged, got %v", data.SharedWithProjects)
+	}
+
+	req = fwresource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: []byte(`{`)}}
+	resp = fwresource.UpgradeStateResponse{}
+	upgrader.StateUpgrader(ctx, req, &resp)
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected an error for invalid prior state")
+	}
+}
@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CredentialTypesDataSource{}
+
+func NewCredentialTypesDataSource() datasource.DataSource {
+	return &CredentialTypesDataSource{}
+}
+
+// CredentialTypesDataSource defines the data source implementation.
+type CredentialTypesDataSource struct {
+	client client.N8nAPI
+}
+
+// CredentialTypesDataSourceModel describes the data source data model.
+type CredentialTypesDataSourceModel struct {
+	Names           types.List `tfsdk:"names"`
+	CredentialTypes types.Map  `tfsdk:"credential_types"`
+}
+
+// credentialTypeAttrTypes describes the object type of each credential_types entry
+func credentialTypeAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"display_name":      types.StringType,
+		"extends":           types.ListType{ElemType: types.StringType},
+		"documentation_url": types.StringType,
+		"properties":        types.StringType,
+	}
+}
+
+func (d *CredentialTypesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_credential_types"
+}
+
+func (d *CredentialTypesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the credential types installed on the n8n instance, including those of community " +
+			"nodes, with the properties their data consists of. Use it to validate or generate credential data in " +
+			"modules, or to compare the supported types of two instances. Requires session authentication.",
+
+		Attributes: map[string]schema.Attribute{
+			"names": schema.ListAttribute{
+				MarkdownDescription: "Names of the installed credential types, sorted alphabetically",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"credential_types": schema.MapNestedAttribute{
+				MarkdownDescription: "Installed credential types, keyed by name (the `type` of an `n8n_credential`)",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"display_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the credential type shown in the editor",
+							Computed:            true,
+						},
+						"extends": schema.ListAttribute{
+							MarkdownDescription: "Credential types whose properties this type inherits, e.g. 'oAuth2Api'",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"documentation_url": schema.StringAttribute{
+							MarkdownDescription: "Documentation of the credential type",
+							Computed:            true,
+						},
+						"properties": schema.StringAttribute{
+							MarkdownDescription: "JSON array of the properties of the credential data, with their name, " +
+								"type, default value and display options as defined by the credential type",
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CredentialTypesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.N8nAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.N8nAPI, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CredentialTypesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CredentialTypesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	credentialTypes, err := d.client.GetCredentialTypes()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read credential types, got error: %s", err))
+		return
+	}
+
+	if err := data.updateFromCredentialTypes(credentialTypes); err != nil {
+		resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Unable to encode credential types, got error: %s", err))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// updateFromCredentialTypes sets the model from the credential types of the instance
+func (m *CredentialTypesDataSourceModel) updateFromCredentialTypes(credentialTypes []client.CredentialType) error {
+	objectType := types.ObjectType{AttrTypes: credentialTypeAttrTypes()}
+
+	names := make([]string, 0, len(credentialTypes))
+	entries := make(map[string]attr.Value, len(credentialTypes))
+	for _, credentialType := range credentialTypes {
+		// Maps are marshaled with sorted keys, so the properties only change when the type does
+		properties := credentialType.Properties
+		if properties == nil {
+			properties = []map[string]interface{}{}
+		}
+		propertiesJSON, err := json.Marshal(properties)
+		if err != nil {
+			return fmt.Errorf("credential type %s: %w", credentialType.Name, err)
+		}
+
+		extends := make([]attr.Value, len(credentialType.Extends))
+		for i, name := range credentialType.Extends {
+			extends[i] = types.StringValue(name)
+		}
+
+		documentationURL := types.StringNull()
+		if credentialType.DocumentationURL != "" {
+			documentationURL = types.StringValue(credentialType.DocumentationURL)
+		}
+
+		names = append(names, credentialType.Name)
+		entries[credentialType.Name] = types.ObjectValueMust(objectType.AttrTypes, map[string]attr.Value{
+			"display_name":      types.StringValue(credentialType.DisplayName),
+			"extends":           types.ListValueMust(types.StringType, extends),
+			"documentation_url": documentationURL,
+			"properties":        types.StringValue(string(propertiesJSON)),
+		})
+	}
+	sort.Strings(names)
+
+	nameValues := make([]attr.Value, len(names))
+	for i, name := range names {
+		nameValues[i] = types.StringValue(name)
+	}
+
+	m.Names = types.ListValueMust(types.StringType, nameValues)
+	m.CredentialTypes = types.MapValueMust(objectType, entries)
+	return nil
+}
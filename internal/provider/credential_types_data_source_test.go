@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+)
+
+func TestAccCredentialTypesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "n8n_credential_types" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.n8n_credential_types.test", "names.0"),
+					resource.TestCheckResourceAttr("data.n8n_credential_types.test",
+						"credential_types.httpBasicAuth.display_name", "Basic Auth"),
+				),
+			},
+		},
+	})
+}
+
+func TestCredentialTypesDataSourceModel_UpdateFromCredentialTypes(t *testing.T) {
+	var model CredentialTypesDataSourceModel
+	err := model.updateFromCredentialTypes([]client.CredentialType{
+		{
+			Name:        "slackApi",
+			DisplayName: "Slack API",
+			Properties:  []map[string]interface{}{{"type": "string", "name": "accessToken"}},
+		},
+		{
+			Name:             "githubOAuth2Api",
+			DisplayName:      "GitHub OAuth2 API",
+			Extends:          []string{"oAuth2Api"},
+			DocumentationURL: "github",
+		},
+	})
+	if err != nil {
+		t.Fatalf("updateFromCredentialTypes() error = %v", err)
+	}
+
+	names := model.Names.Elements()
+	if len(names) != 2 || names[0].(types.String).ValueString() != "githubOAuth2Api" {
+		t.Errorf("Expected sorted names, got %v", names)
+	}
+
+	slack := model.CredentialTypes.Elements()["slackApi"].(types.Object).Attributes()
+	if got := slack["properties"].(types.String).ValueString(); got != `[{"name":"accessToken","type":"string"}]` {
+		t.Errorf("Expected canonical properties JSON, got %s", got)
+	}
+	if !slack["documentation_url"].IsNull() {
+		t.Errorf("Expected no documentation URL, got %v", slack["documentation_url"])
+	}
+
+	github := model.CredentialTypes.Elements()["githubOAuth2Api"].(types.Object).Attributes()
+	if got := github["properties"].(types.String).ValueString(); got != "[]" {
+		t.Errorf("Expected empty properties, got %s", got)
+	}
+	if extends := github["extends"].(types.List).Elements(); len(extends) != 1 {
+		t.Errorf("Expected one extended type, got %v", extends)
+	}
+}
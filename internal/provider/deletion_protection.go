@@ -0,0 +1,24 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// checkDeletionProtection reports whether a workflow or credential may be destroyed. Unlike the
+// prevent_destroy lifecycle argument, deletion_protection is kept in state, so it also applies when the
+// resource is removed from the configuration or destroyed from another working copy.
+func checkDeletionProtection(typeName, id string, protection, force types.Bool, diags *diag.Diagnostics) bool {
+	if !protection.ValueBool() || force.ValueBool() {
+		return true
+	}
+
+	diags.AddError(
+		"Deletion Protection Enabled",
+		fmt.Sprintf("Cannot destroy %s %s because deletion_protection is enabled. Apply force_destroy = true or "+
+			"deletion_protection = false first to allow destroying it.", typeName, id),
+	)
+	return false
+}
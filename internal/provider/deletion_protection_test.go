@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCheckDeletionProtection(t *testing.T) {
+	tests := []struct {
+		name       string
+		protection types.Bool
+		force      types.Bool
+		allowed    bool
+	}{
+		{"unset", types.BoolNull(), types.BoolNull(), true},
+		{"disabled", types.BoolValue(false), types.BoolValue(false), true},
+		{"protected", types.BoolValue(true), types.BoolNull(), false},
+		{"protected but forced", types.BoolValue(true), types.BoolValue(true), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			allowed := checkDeletionProtection("n8n_workflow", "wf-1", tt.protection, tt.force, &diags)
+
+			if allowed != tt.allowed {
+				t.Errorf("Expected allowed = %t, got %t", tt.allowed, allowed)
+			}
+			if diags.HasError() == tt.allowed {
+				t.Errorf("Expected an error only when destroying is refused, got %v", diags)
+			}
+		})
+	}
+}
@@ -0,0 +1,234 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ExecutionSettingsResource{}
+var _ resource.ResourceWithImportState = &ExecutionSettingsResource{}
+
+func NewExecutionSettingsResource() resource.Resource {
+	return &ExecutionSettingsResource{}
+}
+
+// ExecutionSettingsResource defines the resource implementation.
+type ExecutionSettingsResource struct {
+	client client.N8nAPI
+}
+
+// ExecutionSettingsResourceModel describes the resource data model.
+type ExecutionSettingsResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	PruneExecutions types.Bool   `tfsdk:"prune_executions"`
+	PruneMaxAge     types.Int64  `tfsdk:"prune_max_age"`
+	PruneMaxCount   types.Int64  `tfsdk:"prune_max_count"`
+	PruneExisting   types.Bool   `tfsdk:"prune_existing"`
+}
+
+func (r *ExecutionSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest,
+	resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_execution_settings"
+}
+
+func (r *ExecutionSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reports the retention of n8n execution data, i.e. whether and when old executions are " +
+			"pruned, and can prune the existing executions right away. n8n reads the retention from its environment " +
+			"(`EXECUTIONS_DATA_PRUNE`, `EXECUTIONS_DATA_MAX_AGE` and `EXECUTIONS_DATA_PRUNE_MAX_COUNT`), so it cannot be " +
+			"changed through the API.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Execution settings identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"prune_executions": schema.BoolAttribute{
+				MarkdownDescription: "Whether old execution data is deleted automatically (`EXECUTIONS_DATA_PRUNE`)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"prune_max_age": schema.Int64Attribute{
+				MarkdownDescription: "Age in hours after which execution data is pruned (`EXECUTIONS_DATA_MAX_AGE`)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"prune_max_count": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of executions kept before the oldest are pruned, 0 meaning no limit " +
+					"(`EXECUTIONS_DATA_PRUNE_MAX_COUNT`)",
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"prune_existing": schema.BoolAttribute{
+				MarkdownDescription: "Whether to delete executions older than `prune_max_age` right away on apply instead of " +
+					"waiting for the next scheduled pruning. Only takes effect when the instance prunes executions. Requires " +
+					"session authentication. Defaults to false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *ExecutionSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.N8nAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.N8nAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ExecutionSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ExecutionSettingsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Execution settings are part of the instance settings singleton and set through the environment, so
+	// creating them only reads them
+	settings, err := r.client.GetInstanceSettings()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read execution settings, got error: %s", err))
+		return
+	}
+
+	data.updateFromSettings(settings)
+
+	if !r.pruneExisting(&data, &resp.Diagnostics) {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ExecutionSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ExecutionSettingsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.GetInstanceSettings()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read execution settings, got error: %s", err))
+		return
+	}
+
+	data.updateFromSettings(settings)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ExecutionSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ExecutionSettingsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.GetInstanceSettings()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read execution settings, got error: %s", err))
+		return
+	}
+
+	data.updateFromSettings(settings)
+
+	if !r.pruneExisting(&data, &resp.Diagnostics) {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ExecutionSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Execution settings come from the environment of the instance, so there is nothing to delete
+	resp.Diagnostics.AddWarning(
+		"Execution Settings Not Reset",
+		"Execution settings cannot be deleted from n8n. The resource has been removed from Terraform state, but the settings "+
+			"remain in n8n with their current values.",
+	)
+}
+
+func (r *ExecutionSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse) {
+	// Execution settings are a singleton, so we use a fixed ID
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), "execution_settings")...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("prune_existing"), false)...)
+}
+
+// pruneExisting deletes the executions that are older than the maximum age of the instance if requested and
+// reports whether this succeeded
+func (r *ExecutionSettingsResource) pruneExisting(data *ExecutionSettingsResourceModel, diags *diag.Diagnostics) bool {
+	if !data.PruneExisting.ValueBool() || !data.PruneExecutions.ValueBool() || data.PruneMaxAge.IsNull() {
+		return true
+	}
+
+	deleteBefore := time.Now().UTC().Add(-time.Duration(data.PruneMaxAge.ValueInt64()) * time.Hour)
+	if err := r.client.DeleteExecutions(&client.ExecutionDeleteFilter{DeleteBefore: &deleteBefore}); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to prune existing executions, got error: %s", err))
+		return false
+	}
+
+	return true
+}
+
+// updateFromSettings sets the model from the execution settings of the instance
+func (m *ExecutionSettingsResourceModel) updateFromSettings(settings *client.InstanceSettings) {
+	m.ID = types.StringValue("execution_settings") // Execution settings are a singleton
+
+	m.PruneExecutions = types.BoolPointerValue(settings.ExecutionsDataPruning)
+	m.PruneMaxAge = int64PointerValue(settings.ExecutionsDataMaxAge)
+	m.PruneMaxCount = int64PointerValue(settings.ExecutionsDataPruneMaxCount)
+}
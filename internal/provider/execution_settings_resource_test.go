@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+)
+
+func TestAccExecutionSettingsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccExecutionSettingsResourceConfig(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("n8n_execution_settings.test", "id", "execution_settings"),
+					resource.TestCheckResourceAttrSet("n8n_execution_settings.test", "prune_executions"),
+					resource.TestCheckResourceAttr("n8n_execution_settings.test", "prune_existing", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "n8n_execution_settings.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccExecutionSettingsResourceConfig(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("n8n_execution_settings.test", "prune_existing", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestExecutionSettingsResourceModel_UpdateFromSettings(t *testing.T) {
+	pruning := false
+	maxAge := 336
+	model := &ExecutionSettingsResourceModel{
+		PruneExecutions: types.BoolValue(true),
+		PruneMaxAge:     types.Int64Unknown(),
+		PruneMaxCount:   types.Int64Value(1000),
+	}
+
+	model.updateFromSettings(&client.InstanceSettings{
+		ExecutionsDataPruning: &pruning,
+		ExecutionsDataMaxAge:  &maxAge,
+	})
+
+	if model.ID.ValueString() != "execution_settings" {
+		t.Errorf("Expected singleton ID 'execution_settings', got %q", model.ID.ValueString())
+	}
+	if model.PruneExecutions.ValueBool() || model.PruneMaxAge.ValueInt64() != 336 {
+		t.Error("Expected returned settings to be stored in the model")
+	}
+	if !model.PruneMaxCount.IsNull() {
+		t.Error("Expected prune_max_count missing from the response to become null instead of keeping its prior value")
+	}
+}
+
+func testAccExecutionSettingsResourceConfig(pruneExisting bool) string {
+	return fmt.Sprintf(`
+resource "n8n_execution_settings" "test" {
+  prune_existing = %t
+}
+`, pruneExisting)
+}
@@ -0,0 +1,244 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FolderResource{}
+var _ resource.ResourceWithImportState = &FolderResource{}
+
+func NewFolderResource() resource.Resource {
+	return &FolderResource{}
+}
+
+// FolderResource defines the resource implementation.
+type FolderResource struct {
+	client client.N8nAPI
+}
+
+// FolderResourceModel describes the resource data model.
+type FolderResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	ProjectID      types.String `tfsdk:"project_id"`
+	ParentFolderID types.String `tfsdk:"parent_folder_id"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+	UpdatedAt      types.String `tfsdk:"updated_at"`
+}
+
+func (r *FolderResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_folder"
+}
+
+func (r *FolderResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a folder that organizes the workflows of an n8n project. Place workflows in a " +
+			"folder with the `folder_id` attribute of `n8n_workflow`. Folders are only available on the internal API " +
+			"and require session authentication.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Folder identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the folder",
+				Required:            true,
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the project the folder belongs to. Use the ID of the personal project of " +
+					"the authenticated user for folders outside of team projects. Changing it recreates the folder.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"parent_folder_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the folder this folder is nested in, in the same project. Without it, the " +
+					"folder is at the top level of the project. Changing it moves the folder.",
+				Optional: true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the folder was created",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the folder was last updated",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *FolderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.N8nAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.N8nAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *FolderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FolderResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create folder via API
+	folder, err := r.client.CreateFolder(data.ProjectID.ValueString(), data.Name.ValueString(),
+		data.ParentFolderID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create folder, got error: %s", err))
+		return
+	}
+
+	// Update model with response data
+	r.updateModelFromFolder(&data, folder)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FolderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FolderResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get folder from API
+	folder, err := r.client.GetFolder(data.ProjectID.ValueString(), data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read folder, got error: %s", err))
+		return
+	}
+
+	// Update model with response data. The parent folder is only refreshed here, since n8n does not
+	// return it when creating a folder.
+	r.updateModelFromFolder(&data, folder)
+	data.ParentFolderID = types.StringNull()
+	if parentID := folder.ParentID(); parentID != "" {
+		data.ParentFolderID = types.StringValue(parentID)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FolderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data FolderResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A folder without a parent is moved to the top level of the project
+	parentFolderID := data.ParentFolderID.ValueString()
+	if parentFolderID == "" {
+		parentFolderID = client.ProjectRootFolderID
+	}
+
+	// Update folder via API
+	folder, err := r.client.UpdateFolder(data.ProjectID.ValueString(), data.ID.ValueString(), data.Name.ValueString(),
+		parentFolderID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update folder, got error: %s", err))
+		return
+	}
+
+	// Update model with response data
+	r.updateModelFromFolder(&data, folder)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FolderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FolderResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete folder via API
+	err := r.client.DeleteFolder(data.ProjectID.ValueString(), data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete folder, got error: %s", err))
+		return
+	}
+}
+
+func (r *FolderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Folders are only addressable within their project, so the import ID is "project_id:folder_id"
+	projectID, folderID, ok := strings.Cut(req.ID, ":")
+	if !ok || projectID == "" || folderID == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected an import ID in the format project_id:folder_id, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), folderID)...)
+}
+
+// Helper function to update model from API response
+func (r *FolderResource) updateModelFromFolder(model *FolderResourceModel, folder *client.Folder) {
+	model.ID = types.StringValue(folder.ID)
+	model.Name = types.StringValue(folder.Name)
+
+	model.CreatedAt = types.StringNull()
+	if folder.CreatedAt != nil {
+		model.CreatedAt = types.StringValue(folder.CreatedAt.Format("2006-01-02T15:04:05Z"))
+	}
+
+	model.UpdatedAt = types.StringNull()
+	if folder.UpdatedAt != nil {
+		model.UpdatedAt = types.StringValue(folder.UpdatedAt.Format("2006-01-02T15:04:05Z"))
+	}
+}
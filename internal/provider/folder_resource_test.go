@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+	"github.com/devops247-online/terraform-provider-n8n/internal/client/clientmock"
+)
+
+func TestFolderResource_CRUD(t *testing.T) {
+	ctx := context.Background()
+	createdAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	var updatedParent string
+	mock := &clientmock.N8nAPI{
+		CreateFolderFunc: func(projectID, name, parentFolderID string) (*client.Folder, error) {
+			if projectID != "proj-1" || name != "Billing" || parentFolderID != "" {
+				t.Errorf("Unexpected folder to create: %s, %s, %s", projectID, name, parentFolderID)
+			}
+			return &client.Folder{ID: "folder-1", Name: name, CreatedAt: &createdAt}, nil
+		},
+		UpdateFolderFunc: func(projectID, id, name, parentFolderID string) (*client.Folder, error) {
+			updatedParent = parentFolderID
+			return &client.Folder{ID: id, Name: name, CreatedAt: &createdAt}, nil
+		},
+		DeleteFolderFunc: func(projectID, id string) error {
+			return errors.New("folder is not empty")
+		},
+	}
+
+	r := &FolderResource{}
+	r.Configure(ctx, fwresource.ConfigureRequest{ProviderData: mock}, &fwresource.ConfigureResponse{})
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	model := FolderResourceModel{
+		ID:             types.StringUnknown(),
+		Name:           types.StringValue("Billing"),
+		ProjectID:      types.StringValue("proj-1"),
+		ParentFolderID: types.StringNull(),
+		CreatedAt:      types.StringUnknown(),
+		UpdatedAt:      types.StringUnknown(),
+	}
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+	if diags := plan.Set(ctx, &model); diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	createResp := &fwresource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, fwresource.CreateRequest{Plan: plan}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", createResp.Diagnostics)
+	}
+
+	var created FolderResourceModel
+	createResp.State.Get(ctx, &created)
+	if created.ID.ValueString() != "folder-1" || created.CreatedAt.ValueString() != "2025-01-02T03:04:05Z" ||
+		!created.UpdatedAt.IsNull() {
+		t.Errorf("Expected the created folder in state, got %+v", created)
+	}
+
+	// A folder without a parent is moved to the top level of the project
+	updateResp := &fwresource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Update(ctx, fwresource.UpdateRequest{Plan: tfsdk.Plan(createResp.State)}, updateResp)
+	if updateResp.Diagnostics.HasError() || updatedParent != client.ProjectRootFolderID {
+		t.Errorf("Expected the folder to be moved to the project root, got %q, %v", updatedParent,
+			updateResp.Diagnostics)
+	}
+
+	deleteResp := &fwresource.DeleteResponse{}
+	r.Delete(ctx, fwresource.DeleteRequest{State: createResp.State}, deleteResp)
+	if !deleteResp.Diagnostics.HasError() ||
+		!strings.Contains(deleteResp.Diagnostics[0].Detail(), "folder is not empty") {
+		t.Errorf("Expected the client error to be reported, got %v", deleteResp.Diagnostics)
+	}
+
+	expected := []string{"CreateFolder", "UpdateFolder", "DeleteFolder"}
+	if !reflect.DeepEqual(mock.Calls, expected) {
+		t.Errorf("Expected calls %v, got %v", expected, mock.Calls)
+	}
+}
+
+func TestAccFolderResource(t *testing.T) {
+	projectName := acctest.RandomWithPrefix("tf-test-project")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckEnterprise(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFolderResourceConfig(projectName, "Billing", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("n8n_folder.test", "name", "Billing"),
+					resource.TestCheckNoResourceAttr("n8n_folder.test", "parent_folder_id"),
+					resource.TestCheckResourceAttrSet("n8n_folder.test", "id"),
+					resource.TestCheckResourceAttrPair("n8n_workflow.test", "folder_id", "n8n_folder.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "n8n_folder.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					folder := s.RootModule().Resources["n8n_folder.test"].Primary
+					return fmt.Sprintf("%s:%s", folder.Attributes["project_id"], folder.ID), nil
+				},
+			},
+			// Update and Read testing (rename and move into another folder)
+			{
+				Config: testAccFolderResourceConfig(projectName, "Invoices", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("n8n_folder.test", "name", "Invoices"),
+					resource.TestCheckResourceAttrPair("n8n_folder.test", "parent_folder_id", "n8n_folder.parent", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccFolderResourceConfig(projectName, folderName string, nested bool) string {
+	parent := ""
+	if nested {
+		parent = "parent_folder_id = n8n_folder.parent.id"
+	}
+
+	return fmt.Sprintf(`
+resource "n8n_project" "test" {
+  name = %[1]q
+}
+
+resource "n8n_folder" "parent" {
+  name       = "Finance"
+  project_id = n8n_project.test.id
+}
+
+resource "n8n_folder" "test" {
+  name       = %[2]q
+  project_id = n8n_project.test.id
+  %[3]s
+}
+
+resource "n8n_workflow" "test" {
+  name       = "%[1]s-workflow"
+  project_id = n8n_project.test.id
+  folder_id  = n8n_folder.test.id
+  nodes = jsonencode({
+    "Start" = {
+      type       = "n8n-nodes-base.start"
+      position   = [240, 300]
+      parameters = {}
+    }
+  })
+  connections = jsonencode({})
+}
+`, projectName, folderName, parent)
+}
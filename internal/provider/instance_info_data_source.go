@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &InstanceInfoDataSource{}
+
+func NewInstanceInfoDataSource() datasource.DataSource {
+	return &InstanceInfoDataSource{}
+}
+
+// InstanceInfoDataSource defines the data source implementation.
+type InstanceInfoDataSource struct {
+	client client.N8nAPI
+}
+
+// InstanceInfoDataSourceModel describes the data source data model.
+type InstanceInfoDataSourceModel struct {
+	Version          types.String `tfsdk:"version"`
+	PlanName         types.String `tfsdk:"plan_name"`
+	Features         types.Map    `tfsdk:"features"`
+	PublicAPIEnabled types.Bool   `tfsdk:"public_api_enabled"`
+}
+
+func (d *InstanceInfoDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_instance_info"
+}
+
+func (d *InstanceInfoDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches the version, license plan and enabled Enterprise features of the n8n instance, " +
+			"e.g. to create Enterprise-only resources with `count` only where the license allows them.",
+
+		Attributes: map[string]schema.Attribute{
+			"version": schema.StringAttribute{
+				MarkdownDescription: "Version of n8n running on the instance (e.g., '1.64.2')",
+				Computed:            true,
+			},
+			"plan_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the license plan (e.g., 'Community', 'Enterprise')",
+				Computed:            true,
+			},
+			"features": schema.MapAttribute{
+				MarkdownDescription: "Enterprise features supported by the instance (e.g., 'ldap', 'projects', 'variables'), " +
+					"mapped to whether the license enables them",
+				ElementType: types.BoolType,
+				Computed:    true,
+			},
+			"public_api_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the public API (`/api/v1`) is enabled on the instance",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *InstanceInfoDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.N8nAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.N8nAPI, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *InstanceInfoDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data InstanceInfoDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	info, err := d.client.GetInstanceInfo()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read instance info, got error: %s", err))
+		return
+	}
+
+	data.updateFromInstanceInfo(info)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// updateFromInstanceInfo sets the model from the detected instance information
+func (m *InstanceInfoDataSourceModel) updateFromInstanceInfo(info *client.InstanceInfo) {
+	m.Version = types.StringValue(info.Version)
+	m.PlanName = types.StringValue(info.PlanName)
+	m.PublicAPIEnabled = types.BoolValue(info.PublicAPIEnabled)
+
+	features := make(map[string]attr.Value, len(info.Features))
+	for name, enabled := range info.Features {
+		features[name] = types.BoolValue(enabled)
+	}
+	m.Features = types.MapValueMust(types.BoolType, features)
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+)
+
+func TestAccInstanceInfoDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "n8n_instance_info" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.n8n_instance_info.test", "version"),
+					resource.TestCheckResourceAttrSet("data.n8n_instance_info.test", "plan_name"),
+					resource.TestCheckResourceAttrSet("data.n8n_instance_info.test", "public_api_enabled"),
+				),
+			},
+		},
+	})
+}
+
+func TestInstanceInfoDataSourceModel_UpdateFromInstanceInfo(t *testing.T) {
+	var model InstanceInfoDataSourceModel
+	model.updateFromInstanceInfo(&client.InstanceInfo{
+		Version:          "1.64.2",
+		PlanName:         "Enterprise",
+		PublicAPIEnabled: true,
+		Features:         map[string]bool{"ldap": true, "variables": false},
+	})
+
+	if model.Version.ValueString() != "1.64.2" {
+		t.Errorf("Expected version '1.64.2', got %q", model.Version.ValueString())
+	}
+	if model.PlanName.ValueString() != "Enterprise" {
+		t.Errorf("Expected plan 'Enterprise', got %q", model.PlanName.ValueString())
+	}
+	if !model.PublicAPIEnabled.ValueBool() {
+		t.Error("Expected public_api_enabled to be true")
+	}
+
+	features := model.Features.Elements()
+	if len(features) != 2 {
+		t.Fatalf("Expected 2 features, got %d", len(features))
+	}
+	if !features["ldap"].(types.Bool).ValueBool() || features["variables"].(types.Bool).ValueBool() {
+		t.Errorf("Unexpected features: %v", features)
+	}
+}
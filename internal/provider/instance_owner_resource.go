@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &InstanceOwnerResource{}
+
+func NewInstanceOwnerResource() resource.Resource {
+	return &InstanceOwnerResource{}
+}
+
+// InstanceOwnerResource defines the resource implementation.
+type InstanceOwnerResource struct {
+	client client.N8nAPI
+}
+
+// InstanceOwnerResourceModel describes the resource data model.
+type InstanceOwnerResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Email     types.String `tfsdk:"email"`
+	FirstName types.String `tfsdk:"first_name"`
+	LastName  types.String `tfsdk:"last_name"`
+	Password  types.String `tfsdk:"password"`
+}
+
+func (r *InstanceOwnerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_instance_owner"
+}
+
+func (r *InstanceOwnerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sets up the owner account of a fresh n8n instance. This performs the one-time owner setup " +
+			"that n8n otherwise asks for in the browser on first launch, so a new instance can be bootstrapped by Terraform " +
+			"before any other resource is created. The owner can only be set up once; changing any attribute requires " +
+			"resetting user management on the instance.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Owner user identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email address of the instance owner",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"first_name": schema.StringAttribute{
+				MarkdownDescription: "First name of the instance owner",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"last_name": schema.StringAttribute{
+				MarkdownDescription: "Last name of the instance owner",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Password of the instance owner. n8n requires at least 8 characters, including a number " +
+					"and an uppercase letter.",
+				Required:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *InstanceOwnerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.N8nAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.N8nAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *InstanceOwnerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data InstanceOwnerResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setUp, err := r.client.IsOwnerSetUp()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read instance setup status, got error: %s", err))
+		return
+	}
+
+	if setUp {
+		resp.Diagnostics.AddError(
+			"Instance Owner Already Set Up",
+			"The owner of this n8n instance has already been set up, and n8n only allows the owner setup once. "+
+				"Remove the n8n_instance_owner resource from the configuration, or reset user management on the instance "+
+				"(n8n user-management:reset) to set up a new owner.",
+		)
+		return
+	}
+
+	owner, err := r.client.SetupOwner(&client.OwnerSetupRequest{
+		Email:     data.Email.ValueString(),
+		FirstName: data.FirstName.ValueString(),
+		LastName:  data.LastName.ValueString(),
+		Password:  data.Password.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set up instance owner, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(owner.ID)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InstanceOwnerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data InstanceOwnerResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	setUp, err := r.client.IsOwnerSetUp()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read instance setup status, got error: %s", err))
+		return
+	}
+
+	// The instance was reset or replaced, so the owner has to be set up again
+	if !setUp {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InstanceOwnerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All attributes require replacement, so in-place updates never happen
+	resp.Diagnostics.AddError(
+		"Update Not Supported",
+		"The instance owner cannot be updated in place. Changing the owner requires replacing the resource.",
+	)
+}
+
+func (r *InstanceOwnerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The owner account cannot be removed through the API
+	resp.Diagnostics.AddWarning(
+		"Instance Owner Not Deleted",
+		"The n8n instance owner cannot be deleted through the API. The resource has been removed from Terraform state, "+
+			"but the owner account remains in n8n. To set up a new owner, reset user management on the instance.",
+	)
+}
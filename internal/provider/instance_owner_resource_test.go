@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccInstanceOwnerResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckFreshInstance(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccInstanceOwnerResourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("n8n_instance_owner.test", "first_name", "Terraform"),
+					resource.TestCheckResourceAttr("n8n_instance_owner.test", "last_name", "Owner"),
+					resource.TestCheckResourceAttrSet("n8n_instance_owner.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccInstanceOwnerResource_AlreadySetUp(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccInstanceOwnerResourceConfig(),
+				ExpectError: regexp.MustCompile("Instance Owner Already Set Up"),
+			},
+		},
+	})
+}
+
+// testAccPreCheckFreshInstance skips tests that need an n8n instance whose owner is not set up yet
+func testAccPreCheckFreshInstance(t *testing.T) {
+	testAccPreCheck(t)
+
+	if os.Getenv("N8N_TEST_FRESH_INSTANCE") != "true" {
+		t.Skip("Skipping owner setup test: N8N_TEST_FRESH_INSTANCE must be set to true for a fresh n8n instance")
+	}
+}
+
+func testAccInstanceOwnerResourceConfig() string {
+	return `
+resource "n8n_instance_owner" "test" {
+  email      = "terraform-owner@example.com"
+  first_name = "Terraform"
+  last_name  = "Owner"
+  password   = "TerraformOwner1"
+}
+`
+}
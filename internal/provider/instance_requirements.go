@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+)
+
+// instanceRequirement describes what an n8n instance must support for a resource or data source to work
+type instanceRequirement struct {
+	Feature     string // Enterprise feature that must be licensed
+	FeatureName string // Human-readable name of the feature
+	MinVersion  string // Minimum n8n version, if any
+}
+
+var (
+	requiresProjects = instanceRequirement{
+		Feature:     client.FeatureProjects,
+		FeatureName: "team projects",
+		MinVersion:  "1.40.0",
+	}
+	requiresLDAP = instanceRequirement{
+		Feature:     client.FeatureLDAP,
+		FeatureName: "LDAP",
+	}
+	requiresLogStreaming = instanceRequirement{
+		Feature:     client.FeatureLogStreaming,
+		FeatureName: "log streaming",
+	}
+	requiresSharing = instanceRequirement{
+		Feature:     client.FeatureSharing,
+		FeatureName: "sharing",
+	}
+	requiresVariables = instanceRequirement{
+		Feature:     client.FeatureVariables,
+		FeatureName: "variables",
+	}
+	requiresWorkflowHistory = instanceRequirement{
+		Feature:     client.FeatureWorkflowHistory,
+		FeatureName: "workflow history",
+	}
+)
+
+// instanceRequirementProblem explains why the n8n instance does not meet a requirement of typeName, or
+// returns an empty summary if it does. Requirements are assumed to be met when the instance cannot be
+// probed, so that the API reports its own error instead.
+func instanceRequirementProblem(c client.N8nAPI, typeName string, req instanceRequirement) (string, string) {
+	info, err := c.GetInstanceInfo()
+	if err != nil {
+		return "", ""
+	}
+
+	version := info.Version
+	if version == "" {
+		version = "unknown"
+	}
+
+	if req.MinVersion != "" && !info.VersionAtLeast(req.MinVersion) {
+		return "Unsupported n8n Version", fmt.Sprintf("%s requires n8n %s or later for %s, but the instance runs "+
+			"n8n %s. Upgrade n8n to use %s.", typeName, req.MinVersion, req.FeatureName, version, typeName)
+	}
+
+	if !info.KnowsFeature(req.Feature) {
+		return "Unsupported n8n Version", fmt.Sprintf("%s requires %s, which n8n %s does not support. Upgrade n8n "+
+			"to use %s.", typeName, req.FeatureName, version, typeName)
+	}
+
+	if !info.HasFeature(req.Feature) {
+		return "Enterprise License Required", fmt.Sprintf("%s requires %s, which is not included in the license "+
+			"of the n8n instance (detected %s plan, n8n %s). Activate a license that includes %s to use %s, or "+
+			"only create it on licensed instances, e.g. with count = var.enterprise ? 1 : 0.",
+			typeName, req.FeatureName, info.PlanName, version, req.FeatureName, typeName)
+	}
+
+	return "", ""
+}
+
+// checkInstanceRequirement adds an error if the n8n instance does not meet a requirement of typeName and
+// reports whether it does
+func checkInstanceRequirement(c client.N8nAPI, typeName string, req instanceRequirement, diags *diag.Diagnostics) bool {
+	summary, detail := instanceRequirementProblem(c, typeName, req)
+	if summary == "" {
+		return true
+	}
+
+	diags.AddError(summary, detail)
+	return false
+}
@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+)
+
+func TestInstanceRequirementProblem(t *testing.T) {
+	tests := []struct {
+		name        string
+		settings    string
+		status      int
+		wantSummary string
+	}{
+		{
+			name:     "licensed",
+			settings: `{"data": {"versionCli": "1.64.0", "enterprise": {"ldap": true, "projects": {"team": {"limit": -1}}}}}`,
+			status:   http.StatusOK,
+		},
+		{
+			name:        "not licensed",
+			settings:    `{"data": {"versionCli": "1.64.0", "enterprise": {"ldap": false, "projects": {"team": {"limit": 0}}}}}`,
+			status:      http.StatusOK,
+			wantSummary: "Enterprise License Required",
+		},
+		{
+			name:        "version too old",
+			settings:    `{"data": {"versionCli": "1.30.0", "enterprise": {"ldap": true}}}`,
+			status:      http.StatusOK,
+			wantSummary: "Unsupported n8n Version",
+		},
+		{
+			name:     "probe failed",
+			settings: `{"message": "not found"}`,
+			status:   http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.settings))
+			}))
+			defer server.Close()
+
+			c, err := client.NewClient(&client.Config{
+				BaseURL: server.URL,
+				Auth:    &client.APIKeyAuth{APIKey: "test-key"},
+			})
+			if err != nil {
+				t.Fatalf("NewClient() error = %v", err)
+			}
+
+			summary, detail := instanceRequirementProblem(c, "n8n_project", requiresProjects)
+			if summary != tt.wantSummary {
+				t.Errorf("Expected summary %q, got %q (%s)", tt.wantSummary, summary, detail)
+			}
+		})
+	}
+}
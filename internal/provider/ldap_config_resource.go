@@ -26,7 +26,7 @@ func NewLDAPConfigResource() resource.Resource {
 
 // LDAPConfigResource defines the resource implementation.
 type LDAPConfigResource struct {
-	client *client.Client
+	client client.N8nAPI
 }
 
 // LDAPConfigResourceModel describes the resource data model.
@@ -143,12 +143,12 @@ func (r *LDAPConfigResource) Configure(ctx context.Context, req resource.Configu
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.N8nAPI)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.N8nAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -167,6 +167,10 @@ func (r *LDAPConfigResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	if !checkInstanceRequirement(r.client, "n8n_ldap_config", requiresLDAP, &resp.Diagnostics) {
+		return
+	}
+
 	// Create LDAP config object
 	config := &client.LDAPConfig{
 		ServerURL:              data.ServerURL.ValueString(),
@@ -232,6 +236,10 @@ func (r *LDAPConfigResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	if !checkInstanceRequirement(r.client, "n8n_ldap_config", requiresLDAP, &resp.Diagnostics) {
+		return
+	}
+
 	// Create LDAP config object for update
 	config := &client.LDAPConfig{
 		ServerURL:              data.ServerURL.ValueString(),
@@ -289,7 +297,8 @@ func (r *LDAPConfigResource) updateModelFromLDAPConfig(model *LDAPConfigResource
 	model.UserEmailAttribute = types.StringValue(config.UserEmailAttribute)
 	model.UserFirstNameAttribute = types.StringValue(config.UserFirstNameAttribute)
 	model.UserLastNameAttribute = types.StringValue(config.UserLastNameAttribute)
-	model.GroupSearchBase = types.StringValue(config.GroupSearchBase)
+	// The group search base is optional without a default, so n8n leaves it out once it is cleared
+	model.GroupSearchBase = optionalStringValue(model.GroupSearchBase, config.GroupSearchBase)
 	model.GroupSearchFilter = types.StringValue(config.GroupSearchFilter)
 	model.TLSEnabled = types.BoolValue(config.TLSEnabled)
 	// Don't update ca_certificate from response for security
@@ -3,7 +3,10 @@ package provider
 import (
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
 )
 
 func TestAccLDAPConfigResource(t *testing.T) {
@@ -46,6 +49,33 @@ func TestAccLDAPConfigResource(t *testing.T) {
 	})
 }
 
+func TestLDAPConfigResource_UpdateModelFromLDAPConfig(t *testing.T) {
+	r := &LDAPConfigResource{}
+
+	model := &LDAPConfigResourceModel{
+		BindPassword:    types.StringValue("secret"),
+		GroupSearchBase: types.StringValue("ou=groups,dc=example,dc=com"),
+		SearchBase:      types.StringValue("ou=users,dc=example,dc=com"),
+	}
+
+	// The group search base was cleared in n8n
+	r.updateModelFromLDAPConfig(model, &client.LDAPConfig{
+		ServerURL:  "ldap://ldap.example.com:389",
+		BindDN:     "cn=admin,dc=example,dc=com",
+		SearchBase: "ou=people,dc=example,dc=com",
+	})
+
+	if !model.GroupSearchBase.IsNull() {
+		t.Errorf("Expected the cleared group search base to become null, got %v", model.GroupSearchBase)
+	}
+	if model.SearchBase.ValueString() != "ou=people,dc=example,dc=com" {
+		t.Errorf("Expected the search base of the instance, got %v", model.SearchBase)
+	}
+	if model.BindPassword.ValueString() != "secret" {
+		t.Error("Expected the bind password not to be read from the response")
+	}
+}
+
 func TestAccLDAPConfigResource_WithTLS(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheckEnterprise(t) },
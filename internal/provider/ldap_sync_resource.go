@@ -0,0 +1,199 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &LDAPSyncResource{}
+
+func NewLDAPSyncResource() resource.Resource {
+	return &LDAPSyncResource{}
+}
+
+// LDAPSyncResource defines the resource implementation.
+type LDAPSyncResource struct {
+	client client.N8nAPI
+}
+
+// LDAPSyncResourceModel describes the resource data model.
+type LDAPSyncResourceModel struct {
+	LDAPSyncRunModel
+	RunMode  types.String `tfsdk:"run_mode"`
+	Triggers types.Map    `tfsdk:"triggers"`
+}
+
+func (r *LDAPSyncResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ldap_sync"
+}
+
+func (r *LDAPSyncResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Triggers an LDAP synchronization run in n8n Enterprise. The sync runs when the resource is " +
+			"created and again whenever `triggers` or `run_mode` change, e.g. to force a sync after updating " +
+			"`n8n_ldap_config`. Creation fails if the sync run fails.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the synchronization run",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"run_mode": schema.StringAttribute{
+				MarkdownDescription: "Mode of the run: 'live' applies the changes, 'dry' only reports them. Defaults to 'live'.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(client.LDAPSyncModeLive),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringOneOf(client.LDAPSyncModeLive, client.LDAPSyncModeDry),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that trigger a new sync run when changed",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Outcome of the run (e.g., 'success')",
+				Computed:            true,
+			},
+			"started_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the run started",
+				Computed:            true,
+			},
+			"ended_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the run ended",
+				Computed:            true,
+			},
+			"scanned": schema.Int64Attribute{
+				MarkdownDescription: "Number of LDAP entries scanned",
+				Computed:            true,
+			},
+			"created": schema.Int64Attribute{
+				MarkdownDescription: "Number of n8n users created",
+				Computed:            true,
+			},
+			"updated": schema.Int64Attribute{
+				MarkdownDescription: "Number of n8n users updated",
+				Computed:            true,
+			},
+			"disabled": schema.Int64Attribute{
+				MarkdownDescription: "Number of n8n users disabled",
+				Computed:            true,
+			},
+			"error": schema.StringAttribute{
+				MarkdownDescription: "Error message of a failed run",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *LDAPSyncResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.N8nAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.N8nAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *LDAPSyncResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data LDAPSyncResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !checkInstanceRequirement(r.client, "n8n_ldap_sync", requiresLDAP, &resp.Diagnostics) {
+		return
+	}
+
+	if err := r.client.SyncLDAP(data.RunMode.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to sync LDAP, got error: %s", err))
+		return
+	}
+
+	run, err := r.client.GetLastLDAPSyncRun()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read LDAP sync status, got error: %s", err))
+		return
+	}
+
+	if run == nil {
+		resp.Diagnostics.AddError("LDAP Sync Failed", "The LDAP sync was triggered, but n8n did not record a sync run.")
+		return
+	}
+
+	if run.Status == "error" {
+		resp.Diagnostics.AddError("LDAP Sync Failed", fmt.Sprintf("LDAP sync run %d failed: %s", run.ID, run.Error))
+		return
+	}
+
+	data.updateFromRun(run)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LDAPSyncResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data LDAPSyncResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A completed sync run does not change, so the state is kept as is
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LDAPSyncResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All configurable attributes require replacement, so in-place updates never happen
+	resp.Diagnostics.AddError(
+		"Update Not Supported",
+		"An LDAP sync run cannot be updated in place. Changing the run requires replacing the resource.",
+	)
+}
+
+func (r *LDAPSyncResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// A sync run cannot be undone; removing the resource only removes it from Terraform state
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+)
+
+func TestAccLDAPSyncResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckEnterprise(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccLDAPSyncResourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("n8n_ldap_sync.test", "run_mode", "dry"),
+					resource.TestCheckResourceAttr("n8n_ldap_sync.test", "status", "success"),
+					resource.TestCheckResourceAttrSet("n8n_ldap_sync.test", "id"),
+					resource.TestCheckResourceAttrPair("data.n8n_ldap_sync_status.test", "id", "n8n_ldap_sync.test", "id"),
+					resource.TestCheckResourceAttr("data.n8n_ldap_sync_status.test", "run_mode", "dry"),
+				),
+			},
+		},
+	})
+}
+
+func TestLDAPSyncRunModel_UpdateFromRun(t *testing.T) {
+	startedAt := time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC)
+
+	var model LDAPSyncRunModel
+	model.updateFromRun(&client.LDAPSyncRun{
+		ID:        7,
+		StartedAt: &startedAt,
+		Scanned:   12,
+		Created:   3,
+		Status:    "success",
+	})
+
+	if model.ID.ValueString() != "7" {
+		t.Errorf("Expected ID '7', got %q", model.ID.ValueString())
+	}
+	if model.StartedAt.ValueString() != "2024-01-02T10:00:00Z" {
+		t.Errorf("Expected started_at '2024-01-02T10:00:00Z', got %q", model.StartedAt.ValueString())
+	}
+	if !model.EndedAt.IsNull() || !model.Error.IsNull() {
+		t.Error("Expected missing ended_at and error to be null")
+	}
+	if model.Scanned.ValueInt64() != 12 || model.Created.ValueInt64() != 3 {
+		t.Errorf("Unexpected counters: scanned=%d created=%d", model.Scanned.ValueInt64(), model.Created.ValueInt64())
+	}
+
+	// LDAP was never synchronized
+	model.updateFromRun(nil)
+	if !model.ID.IsNull() || !model.Status.IsNull() || !model.Scanned.IsNull() {
+		t.Error("Expected all values to be null without a sync run")
+	}
+}
+
+func testAccLDAPSyncResourceConfig() string {
+	return testAccLDAPConfigResourceConfig() + `
+resource "n8n_ldap_sync" "test" {
+  run_mode = "dry"
+
+  triggers = {
+    server_url = n8n_ldap_config.test.server_url
+  }
+}
+
+data "n8n_ldap_sync_status" "test" {
+  depends_on = [n8n_ldap_sync.test]
+}
+`
+}
@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &LDAPSyncStatusDataSource{}
+
+func NewLDAPSyncStatusDataSource() datasource.DataSource {
+	return &LDAPSyncStatusDataSource{}
+}
+
+// LDAPSyncStatusDataSource defines the data source implementation.
+type LDAPSyncStatusDataSource struct {
+	client client.N8nAPI
+}
+
+// LDAPSyncRunModel describes the result of an LDAP synchronization run. It is shared by
+// the n8n_ldap_sync resource and the n8n_ldap_sync_status data source.
+type LDAPSyncRunModel struct {
+	ID        types.String `tfsdk:"id"`
+	Status    types.String `tfsdk:"status"`
+	StartedAt types.String `tfsdk:"started_at"`
+	EndedAt   types.String `tfsdk:"ended_at"`
+	Scanned   types.Int64  `tfsdk:"scanned"`
+	Created   types.Int64  `tfsdk:"created"`
+	Updated   types.Int64  `tfsdk:"updated"`
+	Disabled  types.Int64  `tfsdk:"disabled"`
+	Error     types.String `tfsdk:"error"`
+}
+
+// LDAPSyncStatusDataSourceModel describes the data source data model.
+type LDAPSyncStatusDataSourceModel struct {
+	LDAPSyncRunModel
+	RunMode types.String `tfsdk:"run_mode"`
+}
+
+func (d *LDAPSyncStatusDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ldap_sync_status"
+}
+
+func (d *LDAPSyncStatusDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches the result of the most recent LDAP synchronization run (n8n Enterprise). " +
+			"All attributes are null if LDAP has never been synchronized.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the synchronization run",
+				Computed:            true,
+			},
+			"run_mode": schema.StringAttribute{
+				MarkdownDescription: "Mode of the run ('live' or 'dry')",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Outcome of the run (e.g., 'success', 'error')",
+				Computed:            true,
+			},
+			"started_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the run started",
+				Computed:            true,
+			},
+			"ended_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the run ended",
+				Computed:            true,
+			},
+			"scanned": schema.Int64Attribute{
+				MarkdownDescription: "Number of LDAP entries scanned",
+				Computed:            true,
+			},
+			"created": schema.Int64Attribute{
+				MarkdownDescription: "Number of n8n users created",
+				Computed:            true,
+			},
+			"updated": schema.Int64Attribute{
+				MarkdownDescription: "Number of n8n users updated",
+				Computed:            true,
+			},
+			"disabled": schema.Int64Attribute{
+				MarkdownDescription: "Number of n8n users disabled",
+				Computed:            true,
+			},
+			"error": schema.StringAttribute{
+				MarkdownDescription: "Error message of a failed run",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *LDAPSyncStatusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.N8nAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.N8nAPI, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *LDAPSyncStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data LDAPSyncStatusDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !checkInstanceRequirement(d.client, "n8n_ldap_sync_status", requiresLDAP, &resp.Diagnostics) {
+		return
+	}
+
+	run, err := d.client.GetLastLDAPSyncRun()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read LDAP sync status, got error: %s", err))
+		return
+	}
+
+	data.updateFromRun(run)
+	if run != nil {
+		data.RunMode = types.StringValue(run.RunMode)
+	} else {
+		data.RunMode = types.StringNull()
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// updateFromRun sets the model from a synchronization run; a nil run sets all values to null
+func (m *LDAPSyncRunModel) updateFromRun(run *client.LDAPSyncRun) {
+	if run == nil {
+		*m = LDAPSyncRunModel{
+			ID:        types.StringNull(),
+			Status:    types.StringNull(),
+			StartedAt: types.StringNull(),
+			EndedAt:   types.StringNull(),
+			Scanned:   types.Int64Null(),
+			Created:   types.Int64Null(),
+			Updated:   types.Int64Null(),
+			Disabled:  types.Int64Null(),
+			Error:     types.StringNull(),
+		}
+		return
+	}
+
+	m.ID = types.StringValue(strconv.Itoa(run.ID))
+	m.Status = types.StringValue(run.Status)
+	m.Scanned = types.Int64Value(int64(run.Scanned))
+	m.Created = types.Int64Value(int64(run.Created))
+	m.Updated = types.Int64Value(int64(run.Updated))
+	m.Disabled = types.Int64Value(int64(run.Disabled))
+
+	m.StartedAt = types.StringNull()
+	if run.StartedAt != nil {
+		m.StartedAt = types.StringValue(run.StartedAt.Format("2006-01-02T15:04:05Z"))
+	}
+
+	m.EndedAt = types.StringNull()
+	if run.EndedAt != nil {
+		m.EndedAt = types.StringValue(run.EndedAt.Format("2006-01-02T15:04:05Z"))
+	}
+
+	m.Error = types.StringNull()
+	if run.Error != "" {
+		m.Error = types.StringValue(run.Error)
+	}
+}
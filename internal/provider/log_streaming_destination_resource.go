@@ -0,0 +1,531 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &LogStreamingDestinationResource{}
+var _ resource.ResourceWithImportState = &LogStreamingDestinationResource{}
+var _ resource.ResourceWithValidateConfig = &LogStreamingDestinationResource{}
+
+// Defaults n8n uses for syslog destinations
+const (
+	defaultSyslogPort     = 514
+	defaultSyslogProtocol = "udp"
+	defaultSyslogFacility = 16 // local0
+	defaultSyslogAppName  = "n8n"
+)
+
+func NewLogStreamingDestinationResource() resource.Resource {
+	return &LogStreamingDestinationResource{}
+}
+
+// LogStreamingDestinationResource defines the resource implementation.
+type LogStreamingDestinationResource struct {
+	client client.N8nAPI
+}
+
+// LogStreamingDestinationResourceModel describes the resource data model.
+type LogStreamingDestinationResourceModel struct {
+	ID                     types.String `tfsdk:"id"`
+	Label                  types.String `tfsdk:"label"`
+	Enabled                types.Bool   `tfsdk:"enabled"`
+	SubscribedEvents       types.Set    `tfsdk:"subscribed_events"`
+	AnonymizeAuditMessages types.Bool   `tfsdk:"anonymize_audit_messages"`
+	Webhook                types.Object `tfsdk:"webhook"`
+	Sentry                 types.Object `tfsdk:"sentry"`
+	Syslog                 types.Object `tfsdk:"syslog"`
+}
+
+// logStreamingWebhookModel describes the webhook attribute
+type logStreamingWebhookModel struct {
+	URL     types.String `tfsdk:"url"`
+	Method  types.String `tfsdk:"method"`
+	Headers types.Map    `tfsdk:"headers"`
+}
+
+// logStreamingSentryModel describes the sentry attribute
+type logStreamingSentryModel struct {
+	DSN types.String `tfsdk:"dsn"`
+}
+
+// logStreamingSyslogModel describes the syslog attribute
+type logStreamingSyslogModel struct {
+	Host     types.String `tfsdk:"host"`
+	Port     types.Int64  `tfsdk:"port"`
+	Protocol types.String `tfsdk:"protocol"`
+	Facility types.Int64  `tfsdk:"facility"`
+	AppName  types.String `tfsdk:"app_name"`
+}
+
+func logStreamingWebhookAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"url":     types.StringType,
+		"method":  types.StringType,
+		"headers": types.MapType{ElemType: types.StringType},
+	}
+}
+
+func logStreamingSentryAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"dsn": types.StringType,
+	}
+}
+
+func logStreamingSyslogAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"host":     types.StringType,
+		"port":     types.Int64Type,
+		"protocol": types.StringType,
+		"facility": types.Int64Type,
+		"app_name": types.StringType,
+	}
+}
+
+func (r *LogStreamingDestinationResource) Metadata(ctx context.Context, req resource.MetadataRequest,
+	resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_log_streaming_destination"
+}
+
+func (r *LogStreamingDestinationResource) Schema(ctx context.Context, req resource.SchemaRequest,
+	resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a destination n8n streams its event log to, e.g. for audit or monitoring. " +
+			"Exactly one of `webhook`, `sentry` or `syslog` configures the destination. Log streaming is an " +
+			"n8n Enterprise feature, only available on the internal API, and requires session authentication.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Destination identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"label": schema.StringAttribute{
+				MarkdownDescription: "Name of the destination shown in n8n",
+				Required:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether events are sent to the destination. Defaults to true.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"subscribed_events": schema.SetAttribute{
+				MarkdownDescription: "Events sent to the destination, either single events such as " +
+					"`n8n.workflow.failed` or groups such as `n8n.audit`, `n8n.workflow` and `n8n.node`",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"anonymize_audit_messages": schema.BoolAttribute{
+				MarkdownDescription: "Whether personal data such as user names and emails is removed from audit " +
+					"events. Defaults to false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"webhook": schema.SingleNestedAttribute{
+				MarkdownDescription: "Sends each event as an HTTP request",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"url": schema.StringAttribute{
+						MarkdownDescription: "URL the events are sent to",
+						Required:            true,
+					},
+					"method": schema.StringAttribute{
+						MarkdownDescription: "HTTP method of the requests. Defaults to POST.",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString("POST"),
+					},
+					"headers": schema.MapAttribute{
+						MarkdownDescription: "Headers sent with each request, e.g. to authenticate",
+						ElementType:         types.StringType,
+						Optional:            true,
+						Sensitive:           true,
+					},
+				},
+			},
+			"sentry": schema.SingleNestedAttribute{
+				MarkdownDescription: "Sends events to Sentry",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"dsn": schema.StringAttribute{
+						MarkdownDescription: "DSN of the Sentry project",
+						Required:            true,
+						Sensitive:           true,
+					},
+				},
+			},
+			"syslog": schema.SingleNestedAttribute{
+				MarkdownDescription: "Sends events to a syslog server",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"host": schema.StringAttribute{
+						MarkdownDescription: "Host name or address of the syslog server",
+						Required:            true,
+					},
+					"port": schema.Int64Attribute{
+						MarkdownDescription: fmt.Sprintf("Port of the syslog server. Defaults to %d.", defaultSyslogPort),
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(defaultSyslogPort),
+						Validators:          []validator.Int64{int64Between(1, 65535)},
+					},
+					"protocol": schema.StringAttribute{
+						MarkdownDescription: fmt.Sprintf("Transport protocol: 'udp', 'tcp' or 'tls'. Defaults to '%s'.",
+							defaultSyslogProtocol),
+						Optional:   true,
+						Computed:   true,
+						Default:    stringdefault.StaticString(defaultSyslogProtocol),
+						Validators: []validator.String{stringOneOf("udp", "tcp", "tls")},
+					},
+					"facility": schema.Int64Attribute{
+						MarkdownDescription: fmt.Sprintf("Syslog facility code, between 0 and 23. Defaults to %d "+
+							"(local0).", defaultSyslogFacility),
+						Optional:   true,
+						Computed:   true,
+						Default:    int64default.StaticInt64(defaultSyslogFacility),
+						Validators: []validator.Int64{int64Between(0, 23)},
+					},
+					"app_name": schema.StringAttribute{
+						MarkdownDescription: fmt.Sprintf("Application name of the messages. Defaults to '%s'.",
+							defaultSyslogAppName),
+						Optional: true,
+						Computed: true,
+						Default:  stringdefault.StaticString(defaultSyslogAppName),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *LogStreamingDestinationResource) Configure(ctx context.Context, req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.N8nAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.N8nAPI, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig checks that exactly one destination type is configured
+func (r *LogStreamingDestinationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse) {
+	var data LogStreamingDestinationResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configured := 0
+	for _, value := range []types.Object{data.Webhook, data.Sentry, data.Syslog} {
+		if value.IsUnknown() {
+			return
+		}
+		if !value.IsNull() {
+			configured++
+		}
+	}
+
+	if configured != 1 {
+		resp.Diagnostics.AddError("Invalid Destination Type",
+			fmt.Sprintf("Exactly one of webhook, sentry or syslog must be set, got %d.", configured))
+	}
+}
+
+func (r *LogStreamingDestinationResource) Create(ctx context.Context, req resource.CreateRequest,
+	resp *resource.CreateResponse) {
+	var data LogStreamingDestinationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !checkInstanceRequirement(r.client, "n8n_log_streaming_destination", requiresLogStreaming, &resp.Diagnostics) {
+		return
+	}
+
+	destination, ok := destinationFromModel(ctx, &data, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	// Create destination via API; n8n assigns its ID
+	destination.ID = ""
+	saved, err := r.client.SaveLogStreamingDestination(destination)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create log streaming destination, got error: %s", err))
+		return
+	}
+
+	// Update model with response data
+	resp.Diagnostics.Append(updateModelFromDestination(ctx, &data, saved)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LogStreamingDestinationResource) Read(ctx context.Context, req resource.ReadRequest,
+	resp *resource.ReadResponse) {
+	var data LogStreamingDestinationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get destination from API
+	destination, err := r.client.GetLogStreamingDestination(data.ID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read log streaming destination, got error: %s", err))
+		return
+	}
+
+	// Update model with response data
+	resp.Diagnostics.Append(updateModelFromDestination(ctx, &data, destination)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LogStreamingDestinationResource) Update(ctx context.Context, req resource.UpdateRequest,
+	resp *resource.UpdateResponse) {
+	var data LogStreamingDestinationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !checkInstanceRequirement(r.client, "n8n_log_streaming_destination", requiresLogStreaming, &resp.Diagnostics) {
+		return
+	}
+
+	destination, ok := destinationFromModel(ctx, &data, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	// Saving a destination with the ID of an existing one replaces it
+	saved, err := r.client.SaveLogStreamingDestination(destination)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update log streaming destination, got error: %s", err))
+		return
+	}
+
+	// Update model with response data
+	resp.Diagnostics.Append(updateModelFromDestination(ctx, &data, saved)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LogStreamingDestinationResource) Delete(ctx context.Context, req resource.DeleteRequest,
+	resp *resource.DeleteResponse) {
+	var data LogStreamingDestinationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete destination via API
+	err := r.client.DeleteLogStreamingDestination(data.ID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete log streaming destination, got error: %s", err))
+		return
+	}
+}
+
+func (r *LogStreamingDestinationResource) ImportState(ctx context.Context, req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// destinationFromModel builds the destination sent to the API from the model
+func destinationFromModel(ctx context.Context, model *LogStreamingDestinationResourceModel,
+	diags *diag.Diagnostics) (*client.LogStreamingDestination, bool) {
+	destination := &client.LogStreamingDestination{
+		ID:                     model.ID.ValueString(),
+		Label:                  model.Label.ValueString(),
+		Enabled:                model.Enabled.ValueBool(),
+		AnonymizeAuditMessages: model.AnonymizeAuditMessages.ValueBool(),
+		SubscribedEvents:       []string{},
+	}
+
+	diags.Append(model.SubscribedEvents.ElementsAs(ctx, &destination.SubscribedEvents, false)...)
+	slices.Sort(destination.SubscribedEvents)
+
+	switch {
+	case !model.Webhook.IsNull():
+		var webhook logStreamingWebhookModel
+		diags.Append(model.Webhook.As(ctx, &webhook, basetypes.ObjectAsOptions{})...)
+
+		var headers map[string]string
+		diags.Append(webhook.Headers.ElementsAs(ctx, &headers, false)...)
+
+		destination.Type = client.LogStreamingTypeWebhook
+		destination.URL = webhook.URL.ValueString()
+		destination.Method = webhook.Method.ValueString()
+		if len(headers) > 0 {
+			destination.SendHeaders = true
+			destination.HeaderParameters = &client.LogStreamingParameters{}
+			for _, name := range sortedKeys(headers) {
+				destination.HeaderParameters.Parameters = append(destination.HeaderParameters.Parameters,
+					client.LogStreamingParameter{Name: name, Value: headers[name]})
+			}
+		}
+	case !model.Sentry.IsNull():
+		var sentry logStreamingSentryModel
+		diags.Append(model.Sentry.As(ctx, &sentry, basetypes.ObjectAsOptions{})...)
+
+		destination.Type = client.LogStreamingTypeSentry
+		destination.DSN = sentry.DSN.ValueString()
+	case !model.Syslog.IsNull():
+		var syslog logStreamingSyslogModel
+		diags.Append(model.Syslog.As(ctx, &syslog, basetypes.ObjectAsOptions{})...)
+
+		facility := int(syslog.Facility.ValueInt64())
+		destination.Type = client.LogStreamingTypeSyslog
+		destination.Host = syslog.Host.ValueString()
+		destination.Port = int(syslog.Port.ValueInt64())
+		destination.Protocol = syslog.Protocol.ValueString()
+		destination.Facility = &facility
+		destination.AppName = syslog.AppName.ValueString()
+	default:
+		diags.AddError("Invalid Destination Type", "Exactly one of webhook, sentry or syslog must be set.")
+	}
+
+	return destination, !diags.HasError()
+}
+
+// updateModelFromDestination updates the model from an API response. Only the attribute of the type of
+// the destination is set; n8n falls back to its defaults for settings it does not return.
+func updateModelFromDestination(ctx context.Context, model *LogStreamingDestinationResourceModel,
+	destination *client.LogStreamingDestination) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	model.ID = types.StringValue(destination.ID)
+	model.Label = types.StringValue(destination.Label)
+	model.Enabled = types.BoolValue(destination.Enabled)
+	model.AnonymizeAuditMessages = types.BoolValue(destination.AnonymizeAuditMessages)
+
+	events, d := types.SetValueFrom(ctx, types.StringType, append([]string{}, destination.SubscribedEvents...))
+	diags.Append(d...)
+	model.SubscribedEvents = events
+
+	model.Webhook = types.ObjectNull(logStreamingWebhookAttrTypes())
+	model.Sentry = types.ObjectNull(logStreamingSentryAttrTypes())
+	model.Syslog = types.ObjectNull(logStreamingSyslogAttrTypes())
+
+	switch destination.Type {
+	case client.LogStreamingTypeWebhook:
+		method := destination.Method
+		if method == "" {
+			method = "POST"
+		}
+
+		// Keep headers unset if none are configured or n8n no longer sends them
+		headers := types.MapNull(types.StringType)
+		if destination.SendHeaders && destination.HeaderParameters != nil &&
+			len(destination.HeaderParameters.Parameters) > 0 {
+			values := make(map[string]attr.Value, len(destination.HeaderParameters.Parameters))
+			for _, parameter := range destination.HeaderParameters.Parameters {
+				values[parameter.Name] = types.StringValue(parameter.Value)
+			}
+			headers = types.MapValueMust(types.StringType, values)
+		}
+
+		model.Webhook, d = types.ObjectValueFrom(ctx, logStreamingWebhookAttrTypes(), logStreamingWebhookModel{
+			URL:     types.StringValue(destination.URL),
+			Method:  types.StringValue(method),
+			Headers: headers,
+		})
+		diags.Append(d...)
+	case client.LogStreamingTypeSentry:
+		model.Sentry, d = types.ObjectValueFrom(ctx, logStreamingSentryAttrTypes(), logStreamingSentryModel{
+			DSN: types.StringValue(destination.DSN),
+		})
+		diags.Append(d...)
+	case client.LogStreamingTypeSyslog:
+		syslog := logStreamingSyslogModel{
+			Host:     types.StringValue(destination.Host),
+			Port:     types.Int64Value(defaultSyslogPort),
+			Protocol: types.StringValue(defaultSyslogProtocol),
+			Facility: types.Int64Value(defaultSyslogFacility),
+			AppName:  types.StringValue(defaultSyslogAppName),
+		}
+		if destination.Port != 0 {
+			syslog.Port = types.Int64Value(int64(destination.Port))
+		}
+		if destination.Protocol != "" {
+			syslog.Protocol = types.StringValue(destination.Protocol)
+		}
+		if destination.Facility != nil {
+			syslog.Facility = types.Int64Value(int64(*destination.Facility))
+		}
+		if destination.AppName != "" {
+			syslog.AppName = types.StringValue(destination.AppName)
+		}
+
+		model.Syslog, d = types.ObjectValueFrom(ctx, logStreamingSyslogAttrTypes(), syslog)
+		diags.Append(d...)
+	default:
+		diags.AddError("Unsupported Destination Type",
+			fmt.Sprintf("Log streaming destination %s has type %q, which the provider does not support.",
+				destination.ID, destination.Type))
+	}
+
+	return diags
+}
@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+	"github.com/devops247-online/terraform-provider-n8n/internal/client/clientmock"
+)
+
+func TestLogStreamingDestinationResource_Create(t *testing.T) {
+	ctx := context.Background()
+
+	var saved *client.LogStreamingDestination
+	mock := &clientmock.N8nAPI{
+		GetInstanceInfoFunc: func() (*client.InstanceInfo, error) {
+			return &client.InstanceInfo{Features: map[string]bool{client.FeatureLogStreaming: true}}, nil
+		},
+		SaveLogStreamingDestinationFunc: func(destination *client.LogStreamingDestination) (*client.LogStreamingDestination, error) {
+			saved = destination
+			result := *destination
+			result.ID = "dest-1"
+			return &result, nil
+		},
+	}
+	r := &LogStreamingDestinationResource{client: mock}
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	model := LogStreamingDestinationResourceModel{
+		ID:                     types.StringUnknown(),
+		Label:                  types.StringValue("Audit"),
+		Enabled:                types.BoolValue(true),
+		SubscribedEvents:       types.SetValueMust(types.StringType, []attr.Value{types.StringValue("n8n.audit")}),
+		AnonymizeAuditMessages: types.BoolValue(false),
+		Webhook: types.ObjectValueMust(logStreamingWebhookAttrTypes(), map[string]attr.Value{
+			"url":    types.StringValue("https://logs.example.com/n8n"),
+			"method": types.StringValue("POST"),
+			"headers": types.MapValueMust(types.StringType, map[string]attr.Value{
+				"X-Token":     types.StringValue("secret"),
+				"Content-Tag": types.StringValue("n8n"),
+			}),
+		}),
+		Sentry: types.ObjectNull(logStreamingSentryAttrTypes()),
+		Syslog: types.ObjectNull(logStreamingSyslogAttrTypes()),
+	}
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+	if diags := plan.Set(ctx, &model); diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	resp := &fwresource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, fwresource.CreateRequest{Plan: plan}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if saved.Type != client.LogStreamingTypeWebhook || !saved.SendHeaders || saved.ID != "" {
+		t.Errorf("Expected a new webhook destination that sends headers, got %+v", saved)
+	}
+	expectedHeaders := []client.LogStreamingParameter{{Name: "Content-Tag", Value: "n8n"}, {Name: "X-Token", Value: "secret"}}
+	if !reflect.DeepEqual(saved.HeaderParameters.Parameters, expectedHeaders) {
+		t.Errorf("Expected the headers sorted by name, got %v", saved.HeaderParameters.Parameters)
+	}
+
+	var created LogStreamingDestinationResourceModel
+	resp.State.Get(ctx, &created)
+	if created.ID.ValueString() != "dest-1" || !created.Webhook.Equal(model.Webhook) || !created.Syslog.IsNull() {
+		t.Errorf("Expected the created webhook destination, got %+v", created)
+	}
+}
+
+func TestUpdateModelFromDestination_Syslog(t *testing.T) {
+	ctx := context.Background()
+
+	// n8n leaves out settings that have their default value
+	var model LogStreamingDestinationResourceModel
+	diags := updateModelFromDestination(ctx, &model, &client.LogStreamingDestination{
+		ID:               "dest-2",
+		Type:             client.LogStreamingTypeSyslog,
+		Label:            "SIEM",
+		SubscribedEvents: []string{"n8n.workflow", "n8n.audit"},
+		Host:             "siem.example.com",
+		Protocol:         "tls",
+	})
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	expected := types.ObjectValueMust(logStreamingSyslogAttrTypes(), map[string]attr.Value{
+		"host":     types.StringValue("siem.example.com"),
+		"port":     types.Int64Value(514),
+		"protocol": types.StringValue("tls"),
+		"facility": types.Int64Value(16),
+		"app_name": types.StringValue("n8n"),
+	})
+	if !model.Syslog.Equal(expected) || !model.Webhook.IsNull() || len(model.SubscribedEvents.Elements()) != 2 {
+		t.Errorf("Expected the syslog destination with defaults, got %+v", model)
+	}
+
+	diags = updateModelFromDestination(ctx, &model, &client.LogStreamingDestination{ID: "dest-3", Type: "$$Unknown"})
+	if !diags.HasError() {
+		t.Error("Expected an error for an unsupported destination type")
+	}
+}
+
+func TestUpdateModelFromDestination_Webhook(t *testing.T) {
+	ctx := context.Background()
+
+	// Headers that n8n keeps but no longer sends are not part of the destination
+	var model LogStreamingDestinationResourceModel
+	diags := updateModelFromDestination(ctx, &model, &client.LogStreamingDestination{
+		ID:               "dest-2",
+		Type:             client.LogStreamingTypeWebhook,
+		URL:              "https://logs.example.com",
+		SendHeaders:      false,
+		HeaderParameters: &client.LogStreamingParameters{Parameters: []client.LogStreamingParameter{{Name: "X-Token", Value: "old"}}},
+	})
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	var webhook logStreamingWebhookModel
+	if diags := model.Webhook.As(ctx, &webhook, basetypes.ObjectAsOptions{}); diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+	if webhook.URL.ValueString() != "https://logs.example.com" || !webhook.Headers.IsNull() || !model.Syslog.IsNull() {
+		t.Errorf("Expected a webhook destination without headers, got %+v", model)
+	}
+}
@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NodeTypesDataSource{}
+
+func NewNodeTypesDataSource() datasource.DataSource {
+	return &NodeTypesDataSource{}
+}
+
+// NodeTypesDataSource defines the data source implementation.
+type NodeTypesDataSource struct {
+	client client.N8nAPI
+}
+
+// NodeTypesDataSourceModel describes the data source data model.
+type NodeTypesDataSourceModel struct {
+	Names     types.List `tfsdk:"names"`
+	NodeTypes types.Map  `tfsdk:"node_types"`
+}
+
+// nodeTypeAttrTypes describes the object type of each node_types entry
+func nodeTypeAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"display_name":    types.StringType,
+		"versions":        types.ListType{ElemType: types.Float64Type},
+		"latest_version":  types.Float64Type,
+		"default_version": types.Float64Type,
+		"package":         types.StringType,
+		"community":       types.BoolType,
+	}
+}
+
+func (d *NodeTypesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node_types"
+}
+
+func (d *NodeTypesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the node types installed on the n8n instance with their versions, e.g. to check " +
+			"before deploying workflows that an instance has the nodes and node versions they use, or that a " +
+			"community node is installed. Requires session authentication.",
+
+		Attributes: map[string]schema.Attribute{
+			"names": schema.ListAttribute{
+				MarkdownDescription: "Names of the installed node types, sorted alphabetically",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"node_types": schema.MapNestedAttribute{
+				MarkdownDescription: "Installed node types, keyed by name (the `type` of a workflow node, e.g. " +
+					"'n8n-nodes-base.slack')",
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"display_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the node type shown in the editor",
+							Computed:            true,
+						},
+						"versions": schema.ListAttribute{
+							MarkdownDescription: "Versions of the node type that workflow nodes can use (the `typeVersion` " +
+								"of a node), sorted in ascending order",
+							ElementType: types.Float64Type,
+							Computed:    true,
+						},
+						"latest_version": schema.Float64Attribute{
+							MarkdownDescription: "Highest available version of the node type",
+							Computed:            true,
+						},
+						"default_version": schema.Float64Attribute{
+							MarkdownDescription: "Version the editor uses for new nodes of this type",
+							Computed:            true,
+						},
+						"package": schema.StringAttribute{
+							MarkdownDescription: "npm package that provides the node type, e.g. 'n8n-nodes-base'",
+							Computed:            true,
+						},
+						"community": schema.BoolAttribute{
+							MarkdownDescription: "Whether the node type belongs to a community package rather than to n8n",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *NodeTypesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.N8nAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.N8nAPI, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *NodeTypesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data NodeTypesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nodeTypes, err := d.client.GetNodeTypes()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read node types, got error: %s", err))
+		return
+	}
+
+	data.updateFromNodeTypes(nodeTypes)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// updateFromNodeTypes sets the model from the node types of the instance, which are sorted by name
+func (m *NodeTypesDataSourceModel) updateFromNodeTypes(nodeTypes []client.NodeType) {
+	objectType := types.ObjectType{AttrTypes: nodeTypeAttrTypes()}
+
+	names := make([]attr.Value, 0, len(nodeTypes))
+	entries := make(map[string]attr.Value, len(nodeTypes))
+	for _, nodeType := range nodeTypes {
+		versions := make([]attr.Value, len(nodeType.Versions))
+		for i, version := range nodeType.Versions {
+			versions[i] = types.Float64Value(version)
+		}
+
+		// Node types without versioning are used at their only version
+		defaultVersion := nodeType.DefaultVersion
+		if defaultVersion == 0 {
+			defaultVersion = nodeType.LatestVersion()
+		}
+
+		names = append(names, types.StringValue(nodeType.Name))
+		entries[nodeType.Name] = types.ObjectValueMust(objectType.AttrTypes, map[string]attr.Value{
+			"display_name":    types.StringValue(nodeType.DisplayName),
+			"versions":        types.ListValueMust(types.Float64Type, versions),
+			"latest_version":  types.Float64Value(nodeType.LatestVersion()),
+			"default_version": types.Float64Value(defaultVersion),
+			"package":         types.StringValue(nodeType.Package()),
+			"community":       types.BoolValue(nodeType.IsCommunity()),
+		})
+	}
+
+	m.Names = types.ListValueMust(types.StringType, names)
+	m.NodeTypes = types.MapValueMust(objectType, entries)
+}
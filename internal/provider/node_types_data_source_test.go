@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+)
+
+func TestAccNodeTypesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "n8n_node_types" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.n8n_node_types.test", "names.0"),
+					resource.TestCheckResourceAttr("data.n8n_node_types.test",
+						"node_types.n8n-nodes-base.webhook.package", "n8n-nodes-base"),
+					resource.TestCheckResourceAttr("data.n8n_node_types.test",
+						"node_types.n8n-nodes-base.webhook.community", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestNodeTypesDataSourceModel_UpdateFromNodeTypes(t *testing.T) {
+	var model NodeTypesDataSourceModel
+	model.updateFromNodeTypes([]client.NodeType{
+		{Name: "@acme/n8n-nodes-crm.contact", DisplayName: "Contact", Versions: []float64{1}},
+		{Name: "n8n-nodes-base.slack", DisplayName: "Slack", Versions: []float64{1, 2, 2.2}, DefaultVersion: 2},
+	})
+
+	if names := model.Names.Elements(); len(names) != 2 {
+		t.Fatalf("Expected 2 names, got %v", names)
+	}
+
+	slack := model.NodeTypes.Elements()["n8n-nodes-base.slack"].(types.Object).Attributes()
+	if slack["latest_version"].(types.Float64).ValueFloat64() != 2.2 {
+		t.Errorf("Expected latest version 2.2, got %v", slack["latest_version"])
+	}
+	if slack["default_version"].(types.Float64).ValueFloat64() != 2 {
+		t.Errorf("Expected default version 2, got %v", slack["default_version"])
+	}
+	if slack["community"].(types.Bool).ValueBool() || slack["package"].(types.String).ValueString() != "n8n-nodes-base" {
+		t.Errorf("Expected a built-in node, got %v", slack)
+	}
+	if versions := slack["versions"].(types.List).Elements(); len(versions) != 3 {
+		t.Errorf("Expected 3 versions, got %v", versions)
+	}
+
+	contact := model.NodeTypes.Elements()["@acme/n8n-nodes-crm.contact"].(types.Object).Attributes()
+	if !contact["community"].(types.Bool).ValueBool() {
+		t.Error("Expected a community node")
+	}
+	if contact["default_version"].(types.Float64).ValueFloat64() != 1 {
+		t.Errorf("Expected the only version as default, got %v", contact["default_version"])
+	}
+}
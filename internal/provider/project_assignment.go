@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+)
+
+// planProjectID sets the planned project_id of a workflow or credential when it is not configured:
+// existing resources stay in their project and new ones go to the default project of the provider.
+// Without it, an unconfigured project_id would be unknown in every plan.
+func planProjectID(ctx context.Context, c client.N8nAPI, req resource.ModifyPlanRequest, plan *tfsdk.Plan,
+	diags *diag.Diagnostics) {
+	var configured types.String
+	diags.Append(req.Config.GetAttribute(ctx, path.Root("project_id"), &configured)...)
+	if diags.HasError() || !configured.IsNull() {
+		return
+	}
+
+	projectID := types.StringNull()
+	if !req.State.Raw.IsNull() {
+		diags.Append(req.State.GetAttribute(ctx, path.Root("project_id"), &projectID)...)
+	} else if c != nil && c.DefaultProjectID() != "" {
+		projectID = types.StringValue(c.DefaultProjectID())
+	}
+
+	diags.Append(plan.SetAttribute(ctx, path.Root("project_id"), projectID)...)
+}
+
+// createProjectID returns the project a new workflow or credential is moved to, or an empty string if it
+// stays in the personal project of the authenticated user
+func createProjectID(c client.N8nAPI, planned types.String) string {
+	if planned.IsUnknown() {
+		return c.DefaultProjectID()
+	}
+	return planned.ValueString()
+}
+
+// moveToProject moves a workflow or credential to projectID using transfer and reports whether this
+// succeeded
+func moveToProject(typeName, id, projectID string, transfer func(id, projectID string) error,
+	diags *diag.Diagnostics) bool {
+	if err := transfer(id, projectID); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to move %s to project %s, got error: %s", typeName, projectID, err))
+		return false
+	}
+
+	return true
+}
+
+// projectIDValue converts a project ID into the project_id attribute value
+func projectIDValue(projectID string) types.String {
+	if projectID == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(projectID)
+}
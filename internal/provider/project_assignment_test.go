@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+)
+
+func TestCreateProjectID(t *testing.T) {
+	c, err := client.NewClient(&client.Config{
+		BaseURL:          "http://localhost:5678",
+		Auth:             &client.APIKeyAuth{APIKey: "test-key"},
+		DefaultProjectID: "team-default",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		planned types.String
+		want    string
+	}{
+		{name: "configured project", planned: types.StringValue("team-a"), want: "team-a"},
+		{name: "planned without project", planned: types.StringNull(), want: ""},
+		{name: "unknown uses provider default", planned: types.StringUnknown(), want: "team-default"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := createProjectID(c, tt.planned); got != tt.want {
+				t.Errorf("createProjectID() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMoveToProject(t *testing.T) {
+	var moved string
+	transfer := func(id, projectID string) error {
+		if projectID == "missing" {
+			return errors.New("project not found")
+		}
+		moved = id + "->" + projectID
+		return nil
+	}
+
+	var diags diag.Diagnostics
+	if !moveToProject("n8n_workflow", "wf-1", "team-a", transfer, &diags) || moved != "wf-1->team-a" {
+		t.Errorf("Expected workflow to be moved, got %q and %v", moved, diags)
+	}
+
+	if moveToProject("n8n_workflow", "wf-1", "missing", transfer, &diags) || !diags.HasError() {
+		t.Error("Expected a failed move to add an error")
+	}
+
+	if !projectIDValue("").IsNull() || projectIDValue("team-a").ValueString() != "team-a" {
+		t.Error("Expected an empty project ID to become null")
+	}
+}
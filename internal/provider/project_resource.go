@@ -8,8 +8,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/devops247-online/terraform-provider-n8n/internal/client"
@@ -18,6 +21,8 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ProjectResource{}
 var _ resource.ResourceWithImportState = &ProjectResource{}
+var _ resource.ResourceWithModifyPlan = &ProjectResource{}
+var _ resource.ResourceWithValidateConfig = &ProjectResource{}
 
 func NewProjectResource() resource.Resource {
 	return &ProjectResource{}
@@ -25,7 +30,7 @@ func NewProjectResource() resource.Resource {
 
 // ProjectResource defines the resource implementation.
 type ProjectResource struct {
-	client *client.Client
+	client client.N8nAPI
 }
 
 // ProjectResourceModel describes the resource data model.
@@ -40,15 +45,26 @@ type ProjectResourceModel struct {
 	MemberCount types.Int64  `tfsdk:"member_count"`
 	CreatedAt   types.String `tfsdk:"created_at"`
 	UpdatedAt   types.String `tfsdk:"updated_at"`
+
+	ForceDestroy        types.Bool   `tfsdk:"force_destroy"`
+	OnDestroy           types.String `tfsdk:"on_destroy"`
+	TransferToProjectID types.String `tfsdk:"transfer_to_project_id"`
 }
 
+// Ways force_destroy handles the workflows and credentials of a project
+const (
+	projectOnDestroyTransfer = "transfer"
+	projectOnDestroyDelete   = "delete"
+)
+
 func (r *ProjectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_project"
 }
 
 func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages an n8n project. Projects provide workspace isolation and team collaboration features in n8n Enterprise.",
+		MarkdownDescription: "Manages an n8n project. Projects provide workspace isolation and team collaboration features in n8n Enterprise. " +
+			"Planning a new project fails if the license of the instance does not include projects.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -67,9 +83,10 @@ func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Optional:            true,
 			},
 			"settings": schema.StringAttribute{
-				MarkdownDescription: "JSON string containing project-specific settings",
-				Optional:            true,
-				Computed:            true,
+				MarkdownDescription: "JSON string containing project-specific settings. Leave it unset when the settings " +
+					"are managed with `n8n_project_settings`.",
+				Optional: true,
+				Computed: true,
 			},
 			"icon": schema.StringAttribute{
 				MarkdownDescription: "Project icon identifier",
@@ -95,6 +112,30 @@ func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest
 				MarkdownDescription: "Timestamp when the project was last updated",
 				Computed:            true,
 			},
+			"force_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Whether to destroy the project even if it contains workflows or credentials, which " +
+					"are then handled as set by `on_destroy`. Must be applied before the project is destroyed. Requires " +
+					"session authentication. Defaults to false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"on_destroy": schema.StringAttribute{
+				MarkdownDescription: "What happens to the workflows and credentials of the project when it is destroyed " +
+					"with `force_destroy`: 'transfer' moves them to `transfer_to_project_id`, and 'delete' deletes them. " +
+					"Defaults to 'transfer'.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(projectOnDestroyTransfer),
+				Validators: []validator.String{
+					stringOneOf(projectOnDestroyTransfer, projectOnDestroyDelete),
+				},
+			},
+			"transfer_to_project_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the project the workflows and credentials are moved to when `on_destroy` is " +
+					"'transfer'. Defaults to the personal project of the authenticated user.",
+				Optional: true,
+			},
 		},
 	}
 }
@@ -105,12 +146,12 @@ func (r *ProjectResource) Configure(ctx context.Context, req resource.ConfigureR
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.N8nAPI)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.N8nAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -129,6 +170,10 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	if !checkInstanceRequirement(r.client, "n8n_project", requiresProjects, &resp.Diagnostics) {
+		return
+	}
+
 	// Create project object
 	project := &client.Project{
 		Name:        data.Name.ValueString(),
@@ -178,6 +223,10 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 	// Get project from API
 	project, err := r.client.GetProject(data.ID.ValueString())
 	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project, got error: %s", err))
 		return
 	}
@@ -199,6 +248,10 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	if !checkInstanceRequirement(r.client, "n8n_project", requiresProjects, &resp.Diagnostics) {
+		return
+	}
+
 	// Create project object for update
 	project := &client.Project{
 		Name:        data.Name.ValueString(),
@@ -222,12 +275,18 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	// Update project via API
-	updatedProject, err := r.client.UpdateProject(data.ID.ValueString(), project)
-	if err != nil {
+	if err := r.client.UpdateProject(data.ID.ValueString(), project); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update project, got error: %s", err))
 		return
 	}
 
+	// The update does not return the project, so it is read again
+	updatedProject, err := r.client.GetProject(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read updated project, got error: %s", err))
+		return
+	}
+
 	// Update model with response data
 	r.updateModelFromProject(&data, updatedProject)
 
@@ -245,14 +304,69 @@ func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
-	// Delete project via API
-	err := r.client.DeleteProject(data.ID.ValueString())
-	if err != nil {
+	// Without force_destroy, deleting a project that still contains workflows or credentials fails
+	if !data.ForceDestroy.ValueBool() {
+		if err := r.client.DeleteProject(data.ID.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete project, got error: %s", err))
+		}
+		return
+	}
+
+	// The workflows and credentials are moved to the personal project unless another one is configured
+
+	transferTo := ""
+	if data.OnDestroy.ValueString() != projectOnDestroyDelete {
+		transferTo = data.TransferToProjectID.ValueString()
+		if transferTo == "" {
+			personal, err := r.client.GetPersonalProject()
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read personal project, got error: %s", err))
+				return
+			}
+			transferTo = personal.ID
+		}
+	}
+
+	if err := r.client.DeleteProjectWithContent(data.ID.ValueString(), transferTo); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete project, got error: %s", err))
 		return
 	}
 }
 
+// ValidateConfig checks that transfer_to_project_id is only set when the content of the project is transferred
+func (r *ProjectResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse) {
+	var data ProjectResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.TransferToProjectID.IsNull() && data.OnDestroy.ValueString() == projectOnDestroyDelete {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("transfer_to_project_id"),
+			"Conflicting Configuration",
+			"transfer_to_project_id cannot be set when on_destroy is 'delete', since the workflows and credentials "+
+				"of the project are then deleted.",
+		)
+	}
+}
+
+// ModifyPlan checks at plan time that the instance supports projects, so that creating a project on an
+// instance without a license for them fails before anything is applied
+func (r *ProjectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse) {
+	// Existing projects were created on a licensed instance, and there is nothing to check on destroy
+	if !req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	checkInstanceRequirement(r.client, "n8n_project", requiresProjects, &resp.Diagnostics)
+}
+
 func (r *ProjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
@@ -267,11 +381,9 @@ func (r *ProjectResource) updateModelFromProject(model *ProjectResourceModel, pr
 	model.OwnerID = types.StringValue(project.OwnerID)
 	model.MemberCount = types.Int64Value(int64(project.MemberCount))
 
-	// Convert settings to JSON string
-	if project.Settings != nil {
-		if settingsJSON, err := json.Marshal(project.Settings); err == nil {
-			model.Settings = types.StringValue(string(settingsJSON))
-		}
+	// Convert settings to JSON string. Settings removed in n8n are read as an empty object.
+	if settingsJSON, err := json.Marshal(emptyIfNil(project.Settings)); err == nil {
+		model.Settings = types.StringValue(string(settingsJSON))
 	}
 
 	if project.CreatedAt != nil {
@@ -1,13 +1,190 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"strings"
 	"testing"
 
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+	"github.com/devops247-online/terraform-provider-n8n/internal/client/clientmock"
 )
 
+func TestProjectResource_ModifyPlan(t *testing.T) {
+	ctx := context.Background()
+
+	mock := &clientmock.N8nAPI{
+		GetInstanceInfoFunc: func() (*client.InstanceInfo, error) {
+			return &client.InstanceInfo{
+				Version:  "1.45.0",
+				PlanName: "Community",
+				Features: map[string]bool{client.FeatureProjects: false},
+			}, nil
+		},
+	}
+	r := &ProjectResource{client: mock}
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	model := ProjectResourceModel{
+		ID:          types.StringUnknown(),
+		Name:        types.StringValue("Billing"),
+		Description: types.StringNull(),
+		Settings:    types.StringNull(),
+		Icon:        types.StringNull(),
+		Color:       types.StringNull(),
+		OwnerID:     types.StringUnknown(),
+		MemberCount: types.Int64Unknown(),
+		CreatedAt:   types.StringUnknown(),
+		UpdatedAt:   types.StringUnknown(),
+	}
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+	if diags := plan.Set(ctx, &model); diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	// Existing projects are not checked again
+	resp := &fwresource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, fwresource.ModifyPlanRequest{
+		Plan:  plan,
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: plan.Raw.Copy()},
+	}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Expected no check for an existing project, got %v", resp.Diagnostics)
+	}
+
+	// Creating a project on an unlicensed instance fails at plan time
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	state.RemoveResource(ctx)
+	resp = &fwresource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, fwresource.ModifyPlanRequest{Plan: plan, State: state}, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Expected an error for an instance without a projects license")
+	}
+	detail := resp.Diagnostics.Errors()[0].Detail()
+	if !strings.Contains(detail, "detected Community plan, n8n 1.45.0") || !strings.Contains(detail, "count = var.enterprise ? 1 : 0") {
+		t.Errorf("Expected the detected plan and version and the count pattern, got %q", detail)
+	}
+}
+
+func TestProjectResource_Delete(t *testing.T) {
+	ctx := context.Background()
+
+	var deleted, deletedWithContent []string
+	mock := &clientmock.N8nAPI{
+		DeleteProjectFunc: func(id string) error {
+			deleted = append(deleted, id)
+			return nil
+		},
+		DeleteProjectWithContentFunc: func(id, transferToProjectID string) error {
+			deletedWithContent = append(deletedWithContent, id+"->"+transferToProjectID)
+			return nil
+		},
+		GetPersonalProjectFunc: func() (*client.Project, error) {
+			return &client.Project{ID: "personal-1"}, nil
+		},
+	}
+	r := &ProjectResource{client: mock}
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	tests := []struct {
+		forceDestroy bool
+		onDestroy    string
+		transferTo   types.String
+	}{
+		{forceDestroy: false, onDestroy: projectOnDestroyTransfer, transferTo: types.StringNull()},
+		{forceDestroy: true, onDestroy: projectOnDestroyTransfer, transferTo: types.StringNull()},
+		{forceDestroy: true, onDestroy: projectOnDestroyTransfer, transferTo: types.StringValue("proj-archive")},
+		{forceDestroy: true, onDestroy: projectOnDestroyDelete, transferTo: types.StringNull()},
+	}
+	for i, tt := range tests {
+		model := ProjectResourceModel{
+			ID:                  types.StringValue(fmt.Sprintf("proj-%d", i)),
+			Name:                types.StringValue("Billing"),
+			ForceDestroy:        types.BoolValue(tt.forceDestroy),
+			OnDestroy:           types.StringValue(tt.onDestroy),
+			TransferToProjectID: tt.transferTo,
+		}
+		state := tfsdk.State{Schema: schemaResp.Schema}
+		if diags := state.Set(ctx, &model); diags.HasError() {
+			t.Fatalf("Unexpected diagnostics: %v", diags)
+		}
+
+		resp := &fwresource.DeleteResponse{State: state}
+		r.Delete(ctx, fwresource.DeleteRequest{State: state}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("Unexpected diagnostics: %v", resp.Diagnostics)
+		}
+	}
+
+	if len(deleted) != 1 || deleted[0] != "proj-0" {
+		t.Errorf("Expected only the project without force_destroy to be deleted directly, got %v", deleted)
+	}
+	expected := []string{"proj-1->personal-1", "proj-2->proj-archive", "proj-3->"}
+	if strings.Join(deletedWithContent, ",") != strings.Join(expected, ",") {
+		t.Errorf("Expected the content to be transferred or deleted, got %v", deletedWithContent)
+	}
+}
+
+func TestProjectResource_Read(t *testing.T) {
+	ctx := context.Background()
+
+	mock := &clientmock.N8nAPI{
+		GetProjectFunc: func(id string) (*client.Project, error) {
+			if id == "proj-deleted" {
+				return nil, &client.APIError{Code: http.StatusNotFound, Message: "Not Found"}
+			}
+			return &client.Project{ID: id, Name: "Billing"}, nil
+		},
+	}
+	r := &ProjectResource{client: mock}
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	for _, id := range []string{"proj-1", "proj-deleted"} {
+		model := ProjectResourceModel{
+			ID:       types.StringValue(id),
+			Name:     types.StringValue("Billing"),
+			Settings: types.StringValue(`{"executionTimeout":600}`),
+		}
+		state := tfsdk.State{Schema: schemaResp.Schema}
+		if diags := state.Set(ctx, &model); diags.HasError() {
+			t.Fatalf("Unexpected diagnostics: %v", diags)
+		}
+
+		resp := &fwresource.ReadResponse{State: state}
+		r.Read(ctx, fwresource.ReadRequest{State: state}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("Unexpected diagnostics: %v", resp.Diagnostics)
+		}
+
+		if id == "proj-deleted" {
+			if !resp.State.Raw.IsNull() {
+				t.Error("Expected the deleted project to be removed from state")
+			}
+			continue
+		}
+
+		// Settings removed in n8n show up as a change
+		var read ProjectResourceModel
+		resp.State.Get(ctx, &read)
+		if read.Settings.ValueString() != "{}" {
+			t.Errorf("Expected the settings in n8n, got %s", read.Settings.ValueString())
+		}
+	}
+}
+
 func TestAccProjectResource(t *testing.T) {
 	projectName := acctest.RandomWithPrefix("tf-test-project")
 	projectDescription := "Test project description"
@@ -0,0 +1,267 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ProjectSettingsResource{}
+var _ resource.ResourceWithImportState = &ProjectSettingsResource{}
+var _ resource.ResourceWithValidateConfig = &ProjectSettingsResource{}
+
+func NewProjectSettingsResource() resource.Resource {
+	return &ProjectSettingsResource{}
+}
+
+// ProjectSettingsResource defines the resource implementation.
+type ProjectSettingsResource struct {
+	client client.N8nAPI
+}
+
+// ProjectSettingsResourceModel describes the resource data model.
+type ProjectSettingsResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	ProjectID types.String `tfsdk:"project_id"`
+	Settings  types.String `tfsdk:"settings"`
+}
+
+func (r *ProjectSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest,
+	resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_settings"
+}
+
+func (r *ProjectSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the settings of an n8n project as a whole, so that the baseline of each team's " +
+			"project is reproducible. There is one settings resource per project. The settings are shared with the " +
+			"`settings` attribute of `n8n_project`, so leave that attribute unset when using this resource.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the project settings, which is the ID of the project",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the project. Changing it manages the settings of the new project instead.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"settings": schema.StringAttribute{
+				MarkdownDescription: "JSON object of the project settings. Settings that are not listed are removed from " +
+					"the project. The settings of a project cannot be emptied, so at least one setting is required.",
+				Required: true,
+			},
+		},
+	}
+}
+
+func (r *ProjectSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.N8nAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.N8nAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ProjectSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ProjectSettingsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !checkInstanceRequirement(r.client, "n8n_project_settings", requiresProjects, &resp.Diagnostics) {
+		return
+	}
+
+	// Project settings are part of the project, so we use update
+	project, ok := r.applySettings(&data, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	r.updateModelFromProject(&data, project, &resp.Diagnostics)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProjectSettingsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project, err := r.client.GetProject(data.ProjectID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project, got error: %s", err))
+		return
+	}
+
+	r.updateModelFromProject(&data, project, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ProjectSettingsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project, ok := r.applySettings(&data, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	r.updateModelFromProject(&data, project, &resp.Diagnostics)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The settings belong to the project, which keeps them until it is deleted
+	resp.Diagnostics.AddWarning(
+		"Project Settings Not Reset",
+		"Project settings cannot be deleted from n8n. The resource has been removed from Terraform state, but the "+
+			"project keeps its current settings.",
+	)
+}
+
+func (r *ProjectSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("project_id"), req, resp)
+}
+
+// ValidateConfig checks that settings is a JSON object with at least one setting
+func (r *ProjectSettingsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse) {
+	var data ProjectSettingsResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Settings.IsNull() || data.Settings.IsUnknown() {
+		return
+	}
+
+	var settings map[string]interface{}
+	if err := json.Unmarshal([]byte(data.Settings.ValueString()), &settings); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("settings"),
+			"Invalid JSON",
+			fmt.Sprintf("Unable to parse settings as a JSON object: %s", err),
+		)
+		return
+	}
+
+	// An empty object is not sent, so the project would keep its previous settings
+	if len(settings) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("settings"),
+			"Missing Project Settings",
+			"settings must hold at least one setting, since the settings of a project cannot be emptied.",
+		)
+	}
+}
+
+// applySettings replaces the settings of the project with the planned ones and returns the updated project.
+// The project is updated as a whole, so its other attributes are sent as they are in n8n.
+func (r *ProjectSettingsResource) applySettings(data *ProjectSettingsResourceModel,
+	diags *diag.Diagnostics) (*client.Project, bool) {
+	var settings map[string]interface{}
+	if err := json.Unmarshal([]byte(data.Settings.ValueString()), &settings); err != nil {
+		diags.AddAttributeError(path.Root("settings"), "Invalid JSON",
+			fmt.Sprintf("Unable to parse settings as a JSON object: %s", err))
+		return nil, false
+	}
+
+	current, err := r.client.GetProject(data.ProjectID.ValueString())
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read project, got error: %s", err))
+		return nil, false
+	}
+
+	err = r.client.UpdateProject(current.ID, &client.Project{
+		Name:        current.Name,
+		Description: current.Description,
+		Icon:        current.Icon,
+		Color:       current.Color,
+		Settings:    settings,
+	})
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update project settings, got error: %s", err))
+		return nil, false
+	}
+
+	// The update does not return the project, so it is read again
+	project, err := r.client.GetProject(current.ID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read updated project, got error: %s", err))
+		return nil, false
+	}
+
+	return project, true
+}
+
+// Helper function to update model from API response. The settings are identified by the project ID, and
+// the configured settings are kept if they hold the same JSON.
+func (r *ProjectSettingsResource) updateModelFromProject(model *ProjectSettingsResourceModel, project *client.Project,
+	diags *diag.Diagnostics) {
+	model.ProjectID = types.StringValue(project.ID)
+	model.ID = model.ProjectID
+
+	settings, err := workflowJSONValue(model.Settings, emptyIfNil(project.Settings))
+	if err != nil {
+		diags.AddError("Internal Error", fmt.Sprintf("Unable to encode project settings, got error: %s", err))
+		return
+	}
+	model.Settings = settings
+}
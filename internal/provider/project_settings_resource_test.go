@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+	"github.com/devops247-online/terraform-provider-n8n/internal/client/clientmock"
+)
+
+func TestProjectSettingsResource_CRUD(t *testing.T) {
+	ctx := context.Background()
+
+	project := &client.Project{ID: "p1", Name: "Billing", Icon: "folder", Settings: map[string]interface{}{"legacy": true}}
+	var updated *client.Project
+	mock := &clientmock.N8nAPI{
+		GetProjectFunc: func(id string) (*client.Project, error) {
+			return project, nil
+		},
+		UpdateProjectFunc: func(id string, p *client.Project) error {
+			updated = p
+			result := *p
+			result.ID = id
+			project = &result
+			return nil
+		},
+	}
+
+	r := &ProjectSettingsResource{}
+	r.Configure(ctx, fwresource.ConfigureRequest{ProviderData: mock}, &fwresource.ConfigureResponse{})
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	model := ProjectSettingsResourceModel{
+		ID:        types.StringUnknown(),
+		ProjectID: types.StringValue("p1"),
+		Settings:  types.StringValue(`{"executionTimeout": 600}`),
+	}
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+	if diags := plan.Set(ctx, &model); diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	createResp := &fwresource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, fwresource.CreateRequest{Plan: plan}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", createResp.Diagnostics)
+	}
+
+	// The settings are replaced and the rest of the project is sent as it is
+	expected := &client.Project{Name: "Billing", Icon: "folder", Settings: map[string]interface{}{"executionTimeout": float64(600)}}
+	if !reflect.DeepEqual(updated, expected) {
+		t.Errorf("Expected project update %+v, got %+v", expected, updated)
+	}
+
+	var created ProjectSettingsResourceModel
+	createResp.State.Get(ctx, &created)
+	if created.ID.ValueString() != "p1" || created.Settings.ValueString() != `{"executionTimeout": 600}` {
+		t.Errorf("Expected the configured settings in state, got %+v", created)
+	}
+
+	// Settings changed in n8n show up as drift
+	project = &client.Project{ID: "p1", Name: "Billing", Settings: map[string]interface{}{"executionTimeout": 60}}
+	readResp := &fwresource.ReadResponse{State: createResp.State}
+	r.Read(ctx, fwresource.ReadRequest{State: createResp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", readResp.Diagnostics)
+	}
+
+	var read ProjectSettingsResourceModel
+	readResp.State.Get(ctx, &read)
+	if read.Settings.ValueString() != "{\n  \"executionTimeout\": 60\n}" {
+		t.Errorf("Expected the settings in n8n, got %s", read.Settings.ValueString())
+	}
+
+	// Destroying the resource leaves the settings in place
+	deleteResp := &fwresource.DeleteResponse{}
+	r.Delete(ctx, fwresource.DeleteRequest{State: readResp.State}, deleteResp)
+	if deleteResp.Diagnostics.HasError() || deleteResp.Diagnostics.WarningsCount() != 1 {
+		t.Errorf("Expected a warning, got %v", deleteResp.Diagnostics)
+	}
+
+	calls := []string{"GetInstanceInfo", "GetProject", "UpdateProject", "GetProject", "GetProject"}
+	if !reflect.DeepEqual(mock.Calls, calls) {
+		t.Errorf("Expected calls %v, got %v", calls, mock.Calls)
+	}
+}
+
+func TestProjectSettingsResource_ValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	r := &ProjectSettingsResource{}
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	tests := []struct {
+		settings  string
+		wantError bool
+	}{
+		{settings: `{"executionTimeout": 600}`},
+		{settings: `{}`, wantError: true},
+		{settings: `[1]`, wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.settings, func(t *testing.T) {
+			config := tfsdk.Config{Schema: schemaResp.Schema}
+			state := tfsdk.State{Schema: schemaResp.Schema}
+			model := ProjectSettingsResourceModel{
+				ID:        types.StringNull(),
+				ProjectID: types.StringValue("p1"),
+				Settings:  types.StringValue(tt.settings),
+			}
+			if diags := state.Set(ctx, &model); diags.HasError() {
+				t.Fatalf("Unexpected diagnostics: %v", diags)
+			}
+			config.Raw = state.Raw
+
+			resp := &fwresource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, fwresource.ValidateConfigRequest{Config: config}, resp)
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Errorf("ValidateConfig() error = %v, want %v", resp.Diagnostics, tt.wantError)
+			}
+		})
+	}
+}
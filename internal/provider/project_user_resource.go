@@ -25,7 +25,7 @@ func NewProjectUserResource() resource.Resource {
 
 // ProjectUserResource defines the resource implementation.
 type ProjectUserResource struct {
-	client *client.Client
+	client client.N8nAPI
 }
 
 // ProjectUserResourceModel describes the resource data model.
@@ -70,14 +70,19 @@ func (r *ProjectUserResource) Schema(ctx context.Context, req resource.SchemaReq
 				},
 			},
 			"role": schema.StringAttribute{
-				MarkdownDescription: "The role of the user in the project (admin, editor, viewer)",
-				Optional:            true,
-				Computed:            true,
-				Default:             stringdefault.StaticString("viewer"),
+				MarkdownDescription: "The role of the user in the project (admin, editor, viewer). The scoped names of " +
+					"newer n8n versions, e.g. 'project:editor', are accepted as well. Changing it updates the " +
+					"membership in place.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("viewer"),
 			},
 			"added_at": schema.StringAttribute{
 				MarkdownDescription: "Timestamp when the user was added to the project",
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 		},
 	}
@@ -90,12 +95,12 @@ func (r *ProjectUserResource) Configure(ctx context.Context, req resource.Config
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.N8nAPI)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.",
+			fmt.Sprintf("Expected client.N8nAPI, got: %T. Please report this issue to the provider developers.",
 				req.ProviderData),
 		)
 
@@ -115,6 +120,10 @@ func (r *ProjectUserResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	if !checkInstanceRequirement(r.client, "n8n_project_user", requiresProjects, &resp.Diagnostics) {
+		return
+	}
+
 	// Create project user object
 	projectUser := &client.ProjectUser{
 		ProjectID: data.ProjectID.ValueString(),
@@ -148,7 +157,7 @@ func (r *ProjectUserResource) Read(ctx context.Context, req resource.ReadRequest
 
 	// Get project users from API
 	projectUsers, err := r.client.GetProjectUsers(data.ProjectID.ValueString())
-	if err != nil {
+	if err != nil && !client.IsNotFound(err) {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project users, got error: %s", err))
 		return
 	}
@@ -162,9 +171,9 @@ func (r *ProjectUserResource) Read(ctx context.Context, req resource.ReadRequest
 		}
 	}
 
+	// A user removed from the project, or a deleted project, is no longer a member
 	if foundUser == nil {
-		resp.Diagnostics.AddError("Not Found",
-			fmt.Sprintf("User %s not found in project %s", data.UserID.ValueString(), data.ProjectID.ValueString()))
+		resp.State.RemoveResource(ctx)
 		return
 	}
 
@@ -185,6 +194,10 @@ func (r *ProjectUserResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	if !checkInstanceRequirement(r.client, "n8n_project_user", requiresProjects, &resp.Diagnostics) {
+		return
+	}
+
 	// Create project user object for update
 	projectUser := &client.ProjectUser{
 		ProjectID: data.ProjectID.ValueString(),
@@ -232,15 +245,23 @@ func (r *ProjectUserResource) ImportState(ctx context.Context, req resource.Impo
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
-// Helper function to update model from API response
+// Helper function to update model from API response. The configured project and user are kept, since n8n
+// may identify the user by ID where the configuration uses the email, which would otherwise force a
+// replacement on the next plan.
 func (r *ProjectUserResource) updateModelFromProjectUser(model *ProjectUserResourceModel,
 	projectUser *client.ProjectUser) {
-	model.ID = types.StringValue(fmt.Sprintf("%s:%s", projectUser.ProjectID, projectUser.UserID))
-	model.ProjectID = types.StringValue(projectUser.ProjectID)
-	model.UserID = types.StringValue(projectUser.UserID)
-	model.Role = types.StringValue(projectUser.Role)
+	if model.ProjectID.IsNull() || model.ProjectID.IsUnknown() || model.ProjectID.ValueString() == "" {
+		model.ProjectID = types.StringValue(projectUser.ProjectID)
+	}
+	if model.UserID.IsNull() || model.UserID.IsUnknown() || model.UserID.ValueString() == "" {
+		model.UserID = types.StringValue(projectUser.UserID)
+	}
+	model.ID = types.StringValue(fmt.Sprintf("%s:%s", model.ProjectID.ValueString(), model.UserID.ValueString()))
+	model.Role = roleValue(model.Role, projectUser.Role, client.NormalizeProjectRole)
 
 	if projectUser.AddedAt != nil {
 		model.AddedAt = types.StringValue(projectUser.AddedAt.Format("2006-01-02T15:04:05Z"))
+	} else if model.AddedAt.IsUnknown() {
+		model.AddedAt = types.StringNull()
 	}
 }
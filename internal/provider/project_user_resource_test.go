@@ -1,13 +1,98 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+	"github.com/devops247-online/terraform-provider-n8n/internal/client/clientmock"
 )
 
+func TestProjectUserResource_UpdateRole(t *testing.T) {
+	ctx := context.Background()
+
+	mock := &clientmock.N8nAPI{
+		UpdateProjectUserFunc: func(projectID, userID string, projectUser *client.ProjectUser) (*client.ProjectUser, error) {
+			if projectID != "proj-1" || userID != "jane@example.com" || projectUser.Role != "admin" {
+				t.Errorf("Unexpected update: %s, %s, %+v", projectID, userID, projectUser)
+			}
+			// n8n identifies the user by ID and uses the scoped role name
+			return &client.ProjectUser{ProjectID: projectID, UserID: "user-1", Role: "project:admin"}, nil
+		},
+	}
+	r := &ProjectUserResource{client: mock}
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	model := ProjectUserResourceModel{
+		ID:        types.StringValue("proj-1:jane@example.com"),
+		ProjectID: types.StringValue("proj-1"),
+		UserID:    types.StringValue("jane@example.com"),
+		Role:      types.StringValue("admin"),
+		AddedAt:   types.StringValue("2025-01-02T03:04:05Z"),
+	}
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+	if diags := plan.Set(ctx, &model); diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	resp := &fwresource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Update(ctx, fwresource.UpdateRequest{Plan: plan}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var updated ProjectUserResourceModel
+	resp.State.Get(ctx, &updated)
+	if updated.UserID.ValueString() != "jane@example.com" || updated.ID.ValueString() != "proj-1:jane@example.com" {
+		t.Errorf("Expected the configured user to be kept, got %+v", updated)
+	}
+	if updated.Role.ValueString() != "admin" || updated.AddedAt.ValueString() != "2025-01-02T03:04:05Z" {
+		t.Errorf("Expected the configured role and the prior added_at, got %+v", updated)
+	}
+}
+
+func TestProjectUserResource_Read(t *testing.T) {
+	ctx := context.Background()
+
+	mock := &clientmock.N8nAPI{
+		GetProjectUsersFunc: func(projectID string) ([]client.ProjectUser, error) {
+			return []client.ProjectUser{{ProjectID: projectID, UserID: "user-2", Role: "project:editor"}}, nil
+		},
+	}
+	r := &ProjectUserResource{client: mock}
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	model := ProjectUserResourceModel{
+		ID:        types.StringValue("proj-1:user-1"),
+		ProjectID: types.StringValue("proj-1"),
+		UserID:    types.StringValue("user-1"),
+		Role:      types.StringValue("project:viewer"),
+		AddedAt:   types.StringNull(),
+	}
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	if diags := state.Set(ctx, &model); diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	// A user removed from the project in n8n is removed from state, so that it is added again
+	resp := &fwresource.ReadResponse{State: state}
+	r.Read(ctx, fwresource.ReadRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() || !resp.State.Raw.IsNull() {
+		t.Errorf("Expected the membership to be removed from state, got %v", resp.Diagnostics)
+	}
+}
+
 func TestAccProjectUserResource(t *testing.T) {
 	projectName := acctest.RandomWithPrefix("tf-test-project")
 	userEmail := fmt.Sprintf("test-%s@example.com", acctest.RandString(8))
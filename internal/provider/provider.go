@@ -1,15 +1,25 @@
 package provider
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/devops247-online/terraform-provider-n8n/internal/client"
@@ -29,13 +39,61 @@ type N8nProvider struct {
 
 // N8nProviderModel describes the provider data model.
 type N8nProviderModel struct {
-	BaseURL            types.String `tfsdk:"base_url"`
-	APIKey             types.String `tfsdk:"api_key"`
-	Email              types.String `tfsdk:"email"`
-	Password           types.String `tfsdk:"password"`
-	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	BaseURL             types.String `tfsdk:"base_url"`
+	APIKey              types.String `tfsdk:"api_key"`
+	APIKeyFile          types.String `tfsdk:"api_key_file"`
+	APIKeyCommand       types.String `tfsdk:"api_key_command"`
+	Email               types.String `tfsdk:"email"`
+	Password            types.String `tfsdk:"password"`
+	ProxyAuthUsername   types.String `tfsdk:"proxy_auth_username"`
+	ProxyAuthPassword   types.String `tfsdk:"proxy_auth_password"`
+	BearerToken         types.String `tfsdk:"bearer_token"`
+	OAuthTokenURL       types.String `tfsdk:"oauth_token_url"`
+	OAuthClientID       types.String `tfsdk:"oauth_client_id"`
+	OAuthClientSecret   types.String `tfsdk:"oauth_client_secret"`
+	OAuthScopes         types.List   `tfsdk:"oauth_scopes"`
+	InsecureSkipVerify  types.Bool   `tfsdk:"insecure_skip_verify"`
+	WebhookURL          types.String `tfsdk:"webhook_url"`
+	MaxIdleConns        types.Int64  `tfsdk:"max_idle_conns"`
+	IdleConnTimeout     types.Int64  `tfsdk:"idle_conn_timeout"`
+	Parallelism         types.Int64  `tfsdk:"parallelism"`
+	DisableHTTP2        types.Bool   `tfsdk:"disable_http2"`
+	CacheTTL            types.Int64  `tfsdk:"cache_ttl"`
+	CircuitThreshold    types.Int64  `tfsdk:"circuit_breaker_threshold"`
+	CircuitCoolDown     types.Int64  `tfsdk:"circuit_breaker_cool_down"`
+	ExtraHeaders        types.Map    `tfsdk:"extra_headers"`
+	HTTPProxy           types.String `tfsdk:"http_proxy"`
+	HTTPSProxy          types.String `tfsdk:"https_proxy"`
+	NoProxy             types.String `tfsdk:"no_proxy"`
+	ClientCertPEM       types.String `tfsdk:"client_cert_pem"`
+	ClientKeyPEM        types.String `tfsdk:"client_key_pem"`
+	ClientCertFile      types.String `tfsdk:"client_cert_file"`
+	ClientKeyFile       types.String `tfsdk:"client_key_file"`
+	CACertPEM           types.String `tfsdk:"ca_cert_pem"`
+	CACertFile          types.String `tfsdk:"ca_cert_file"`
+	SessionAuth         types.Bool   `tfsdk:"session_auth"`
+	CookieFile          types.String `tfsdk:"cookie_file"`
+	APIMode             types.String `tfsdk:"api_mode"`
+	DefaultProjectID    types.String `tfsdk:"default_project_id"`
+	WorkflowNamePattern types.String `tfsdk:"workflow_name_pattern"`
+	ValidateConnection  types.Bool   `tfsdk:"validate_connection"`
+	StartupWait         types.Int64  `tfsdk:"startup_wait"`
+	MaxResponseSizeMB   types.Int64  `tfsdk:"max_response_size_mb"`
+	CompressRequests    types.Bool   `tfsdk:"compress_requests"`
+	RefreshCache        types.Bool   `tfsdk:"refresh_cache"`
 }
 
+// defaultCircuitThreshold is how many consecutive requests may fail with a 5xx status or a connection
+// error before requests are paused, when circuit_breaker_threshold is not set
+const defaultCircuitThreshold = 5
+
+// requestStatsInterval is how often the aggregate request timing is logged at most. The summary lists the
+// endpoints that took the most time, which shows the resources that dominate a long apply.
+const requestStatsInterval = time.Minute
+
+// apiKeyCommandTimeout is how long api_key_command may run before it is killed
+const apiKeyCommandTimeout = 30 * time.Second
+
 func (p *N8nProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = "n8n"
 	resp.Version = p.version
@@ -59,6 +117,19 @@ func (p *N8nProvider) Schema(ctx context.Context, req provider.SchemaRequest, re
 				Optional:  true,
 				Sensitive: true,
 			},
+			"api_key_file": schema.StringAttribute{
+				MarkdownDescription: "Path of a file containing the API key, e.g. a short-lived key mounted by a CI " +
+					"system. Leading and trailing whitespace is ignored. Can be set via the `N8N_API_KEY_FILE` " +
+					"environment variable. Conflicts with `api_key` and `api_key_command`.",
+				Optional: true,
+			},
+			"api_key_command": schema.StringAttribute{
+				MarkdownDescription: "Command that prints the API key, run by the shell (`sh -c`, or `cmd /C` on Windows) " +
+					"when the provider is configured, e.g. to fetch the key from a secret manager. Leading and trailing " +
+					"whitespace of its output is ignored; it must finish within 30 seconds. Can be set via the " +
+					"`N8N_API_KEY_COMMAND` environment variable. Conflicts with `api_key` and `api_key_file`.",
+				Optional: true,
+			},
 			"email": schema.StringAttribute{
 				MarkdownDescription: "Email for basic authentication with n8n. Can be set via the " +
 					"`N8N_EMAIL` environment variable. Alternative to api_key.",
@@ -70,9 +141,247 @@ func (p *N8nProvider) Schema(ctx context.Context, req provider.SchemaRequest, re
 				Optional:  true,
 				Sensitive: true,
 			},
+			"proxy_auth_username": schema.StringAttribute{
+				MarkdownDescription: "Username for Basic authentication with a reverse proxy in front of n8n. It is " +
+					"sent with every request in addition to the authentication with n8n, i.e. the API key or the session, " +
+					"which cannot be Basic authentication then. Can be set via the `N8N_PROXY_AUTH_USERNAME` environment variable.",
+				Optional: true,
+			},
+			"proxy_auth_password": schema.StringAttribute{
+				MarkdownDescription: "Password for Basic authentication with a reverse proxy in front of n8n. Can be set " +
+					"via the `N8N_PROXY_AUTH_PASSWORD` environment variable.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"bearer_token": schema.StringAttribute{
+				MarkdownDescription: "Token sent as `Authorization: Bearer` with every request, for a reverse proxy in front " +
+					"of n8n that authenticates with OIDC. It is sent in addition to the authentication with n8n, i.e. the API " +
+					"key or the session, which cannot be Basic authentication then. Can be set via the `N8N_BEARER_TOKEN` " +
+					"environment variable. Conflicts with `oauth_token_url` and the proxy_auth attributes.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"oauth_token_url": schema.StringAttribute{
+				MarkdownDescription: "Token endpoint of an OAuth 2.0 or OIDC provider to obtain the bearer token from with " +
+					"the client credentials grant instead of configuring `bearer_token`. The token is renewed shortly before " +
+					"it expires. Can be set via the `N8N_OAUTH_TOKEN_URL` environment variable.",
+				Optional: true,
+			},
+			"oauth_client_id": schema.StringAttribute{
+				MarkdownDescription: "Client ID for the client credentials grant. Can be set via the `N8N_OAUTH_CLIENT_ID` " +
+					"environment variable.",
+				Optional: true,
+			},
+			"oauth_client_secret": schema.StringAttribute{
+				MarkdownDescription: "Client secret for the client credentials grant. Can be set via the " +
+					"`N8N_OAUTH_CLIENT_SECRET` environment variable.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"oauth_scopes": schema.ListAttribute{
+				MarkdownDescription: "Scopes requested with the client credentials grant. Can be set via the " +
+					"`N8N_OAUTH_SCOPES` environment variable, separated by spaces.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
 			"insecure_skip_verify": schema.BoolAttribute{
 				MarkdownDescription: "Skip TLS certificate verification. Can be set via the " +
-					"`N8N_INSECURE_SKIP_VERIFY` environment variable. Defaults to false.",
+					"`N8N_INSECURE_SKIP_VERIFY` environment variable. Defaults to false. " +
+					"For instances using a private CA, set `ca_cert_pem` or `ca_cert_file` instead.",
+				Optional: true,
+			},
+			"webhook_url": schema.StringAttribute{
+				MarkdownDescription: "Public base URL that n8n serves webhooks under, if it differs from `base_url` " +
+					"(the `WEBHOOK_URL` setting of the n8n instance). Used to build webhook URLs. Can be set via the " +
+					"`N8N_WEBHOOK_URL` environment variable.",
+				Optional: true,
+			},
+			"max_idle_conns": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of idle keep-alive connections kept open, both to the n8n instance " +
+					"and in total. Raise this for large configurations applied with high parallelism. Defaults to 32 " +
+					"connections to the instance and 100 in total.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64AtLeast(1),
+				},
+			},
+			"parallelism": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of requests this provider sends to n8n at once, whatever the " +
+					"`-parallelism` of Terraform, for small instances that fail under concurrent writes. Each provider " +
+					"alias has its own limit. Waiting for a free slot counts towards the 30 second timeout of each request. " +
+					"Defaults to no limit.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64AtLeast(1),
+				},
+			},
+			"idle_conn_timeout": schema.Int64Attribute{
+				MarkdownDescription: "Seconds an idle keep-alive connection is kept open before it is closed. Defaults to 90.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64AtLeast(1),
+				},
+			},
+			"disable_http2": schema.BoolAttribute{
+				MarkdownDescription: "Disable HTTP/2 and use HTTP/1.1 for all requests. Defaults to false.",
+				Optional:            true,
+			},
+			"cache_ttl": schema.Int64Attribute{
+				MarkdownDescription: "Seconds that API read responses are cached in memory during a single Terraform run, " +
+					"so repeated lookups don't re-fetch the same data. Any write clears the cache, but changes made " +
+					"outside of this provider are not seen until a cached response expires. Defaults to 0, which " +
+					"disables caching.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64AtLeast(0),
+				},
+			},
+			"circuit_breaker_threshold": schema.Int64Attribute{
+				MarkdownDescription: "Number of consecutive requests that may fail with a 5xx status or a connection error " +
+					"before further requests fail immediately, so that an unavailable instance fails the run quickly " +
+					"instead of every resource using up its retries. Set to 0 to disable. Defaults to 5.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64AtLeast(0),
+				},
+			},
+			"circuit_breaker_cool_down": schema.Int64Attribute{
+				MarkdownDescription: "Seconds requests are paused once `circuit_breaker_threshold` is reached. A single " +
+					"request then checks whether the instance is back; if it fails, the pause doubles, up to 5 minutes. " +
+					"Defaults to 30.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64AtLeast(1),
+				},
+			},
+			"extra_headers": schema.MapAttribute{
+				MarkdownDescription: "Additional HTTP headers sent with every request, e.g. for an authenticating " +
+					"reverse proxy in front of n8n. Requests also carry a `User-Agent` of `terraform-provider-n8n/<version>` " +
+					"and a unique `X-Request-Id`, which is logged with the request so that it can be found in the logs of " +
+					"n8n or a proxy. Both can be replaced here.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"http_proxy": schema.StringAttribute{
+				MarkdownDescription: "Proxy URL for HTTP requests. When none of `http_proxy`, `https_proxy` and " +
+					"`no_proxy` are set, the `HTTP_PROXY`, `HTTPS_PROXY` and `NO_PROXY` environment variables are used.",
+				Optional: true,
+			},
+			"https_proxy": schema.StringAttribute{
+				MarkdownDescription: "Proxy URL for HTTPS requests.",
+				Optional:            true,
+			},
+			"no_proxy": schema.StringAttribute{
+				MarkdownDescription: "Comma-separated list of hosts that bypass the proxy.",
+				Optional:            true,
+			},
+			"client_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM-encoded client certificate for instances that require mutual TLS. " +
+					"Conflicts with `client_cert_file`.",
+				Optional: true,
+			},
+			"client_key_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM-encoded private key for `client_cert_pem`. Conflicts with `client_key_file`.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"client_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a PEM-encoded client certificate for instances that require mutual TLS.",
+				Optional:            true,
+			},
+			"client_key_file": schema.StringAttribute{
+				MarkdownDescription: "Path to the PEM-encoded private key for `client_cert_file`.",
+				Optional:            true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM-encoded CA certificates trusted in addition to the system roots, e.g. for an " +
+					"internal CA. Prefer this over `insecure_skip_verify`. Conflicts with `ca_cert_file`.",
+				Optional: true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a PEM-encoded CA bundle trusted in addition to the system roots. " +
+					"Can be set via the `N8N_CA_CERT_FILE` environment variable.",
+				Optional: true,
+			},
+			"session_auth": schema.BoolAttribute{
+				MarkdownDescription: "Authenticate with an n8n browser session instead of the public API key. The provider " +
+					"logs in with `email` and `password` and logs in again when the session expires. " +
+					"Can be set via the `N8N_USE_SESSION_AUTH` environment variable. Defaults to false.",
+				Optional: true,
+			},
+			"compress_requests": schema.BoolAttribute{
+				MarkdownDescription: "Compress request bodies larger than 1 KiB with gzip, e.g. to upload large workflows " +
+					"faster over slow links. Responses are always requested compressed. Leave it disabled behind proxies " +
+					"that do not pass compressed request bodies through to n8n. Defaults to false.",
+				Optional: true,
+			},
+			"refresh_cache": schema.BoolAttribute{
+				MarkdownDescription: "Refresh `n8n_workflow` and `n8n_credential` resources from one paginated listing " +
+					"of all workflows and credentials instead of a request per resource, which speeds up plans of large " +
+					"configurations considerably. Each listing is fetched on the first refresh of its resource type; " +
+					"resources missing from it are read one by one. Once the provider writes anything, reads are sent " +
+					"one by one again. Only applies to the public API. Can be set via the `N8N_REFRESH_CACHE` " +
+					"environment variable. Defaults to false.",
+				Optional: true,
+			},
+			"cookie_file": schema.StringAttribute{
+				MarkdownDescription: "Netscape format cookie file for session authentication. An existing session is " +
+					"reused from this file, and sessions created by logging in are saved to it. Can be set via the " +
+					"`N8N_COOKIE_FILE` environment variable.",
+				Optional: true,
+			},
+			"api_mode": schema.StringAttribute{
+				MarkdownDescription: "Which n8n API requests are sent to: 'public' uses the public API (`/api/v1`) for " +
+					"every endpoint it has, 'internal' uses the internal API (`/rest`) behind the editor wherever it has " +
+					"the endpoint, and 'auto' routes each endpoint to the API that has it, preferring the internal API " +
+					"with `session_auth` and the public API otherwise. Endpoints only the internal API has, e.g. LDAP, " +
+					"are sent there in every mode. The internal API requires `session_auth`. " +
+					"Can be set via the `N8N_API_MODE` environment variable. Defaults to 'public'.",
+				Optional: true,
+				Validators: []validator.String{
+					stringOneOf("public", "internal", "auto"),
+				},
+			},
+			"default_project_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the project (Enterprise feature) that workflows and credentials created by " +
+					"this provider are moved to unless they set `project_id`, e.g. to use one provider alias per team. " +
+					"Can be set via the `N8N_DEFAULT_PROJECT_ID` environment variable.",
+				Optional: true,
+			},
+			"workflow_name_pattern": schema.StringAttribute{
+				MarkdownDescription: "Regular expression (RE2 syntax) that the names of workflows managed by `n8n_workflow` " +
+					"and `n8n_workflow_bundle` must match, e.g. `^[a-z]+ - .+$` to enforce a team prefix. The pattern matches " +
+					"anywhere in the name unless it is anchored with `^` and `$`. Names are checked at plan time when a " +
+					"workflow is created or renamed, so workflows that predate the convention can still be changed. " +
+					"Can be set via the `N8N_WORKFLOW_NAME_PATTERN` environment variable.",
+				Optional: true,
+			},
+			"startup_wait": schema.Int64Attribute{
+				MarkdownDescription: "Seconds the first request waits for the instance to start, for instances provisioned " +
+					"in the same apply, e.g. by a `helm_release`. Until then, the readiness endpoint of the instance is " +
+					"polled, and refused connections and 5xx responses are retried. With `validate_connection`, the " +
+					"connection check waits as well. Defaults to 0, which does not wait.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64AtLeast(0),
+				},
+			},
+			"max_response_size_mb": schema.Int64Attribute{
+				MarkdownDescription: "Largest API response in MiB that the provider reads, so that e.g. a workflow with " +
+					"megabytes of pinned data fails with an error instead of exhausting the memory of a constrained CI " +
+					"runner. Large responses to writes are decoded while they are read, and logged request and response " +
+					"bodies are truncated. Defaults to 64.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64AtLeast(1),
+				},
+			},
+			"validate_connection": schema.BoolAttribute{
+				MarkdownDescription: "Check when the provider is configured that the instance is reachable and accepts " +
+					"the credentials, by listing a single workflow, so that an unreachable instance fails with one clear " +
+					"error instead of an error per resource. Can be set via the `N8N_VALIDATE_CONNECTION` environment " +
+					"variable. Defaults to false.",
 				Optional: true,
 			},
 		},
@@ -91,16 +400,29 @@ func (p *N8nProvider) Configure(ctx context.Context, req provider.ConfigureReque
 	// Configuration values
 	baseURL := os.Getenv("N8N_BASE_URL")
 	apiKey := os.Getenv("N8N_API_KEY")
+	apiKeyFile := os.Getenv("N8N_API_KEY_FILE")
+	apiKeyCommand := os.Getenv("N8N_API_KEY_COMMAND")
 	email := os.Getenv("N8N_EMAIL")
 	password := os.Getenv("N8N_PASSWORD")
+	proxyAuthUsername := os.Getenv("N8N_PROXY_AUTH_USERNAME")
+	proxyAuthPassword := os.Getenv("N8N_PROXY_AUTH_PASSWORD")
+	bearerToken := os.Getenv("N8N_BEARER_TOKEN")
+	oauthTokenURL := os.Getenv("N8N_OAUTH_TOKEN_URL")
+	oauthClientID := os.Getenv("N8N_OAUTH_CLIENT_ID")
+	oauthClientSecret := os.Getenv("N8N_OAUTH_CLIENT_SECRET")
+	oauthScopes := strings.Fields(os.Getenv("N8N_OAUTH_SCOPES"))
 	insecureSkipVerify := os.Getenv("N8N_INSECURE_SKIP_VERIFY") == "true"
+	webhookURL := os.Getenv("N8N_WEBHOOK_URL")
 
 	if !data.BaseURL.IsNull() {
 		baseURL = data.BaseURL.ValueString()
 	}
 
-	if !data.APIKey.IsNull() {
+	// An API key source in the configuration replaces the sources in the environment
+	if !data.APIKey.IsNull() || !data.APIKeyFile.IsNull() || !data.APIKeyCommand.IsNull() {
 		apiKey = data.APIKey.ValueString()
+		apiKeyFile = data.APIKeyFile.ValueString()
+		apiKeyCommand = data.APIKeyCommand.ValueString()
 	}
 
 	if !data.Email.IsNull() {
@@ -111,10 +433,45 @@ func (p *N8nProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		password = data.Password.ValueString()
 	}
 
+	if !data.ProxyAuthUsername.IsNull() {
+		proxyAuthUsername = data.ProxyAuthUsername.ValueString()
+	}
+
+	if !data.ProxyAuthPassword.IsNull() {
+		proxyAuthPassword = data.ProxyAuthPassword.ValueString()
+	}
+
+	if !data.BearerToken.IsNull() {
+		bearerToken = data.BearerToken.ValueString()
+	}
+
+	if !data.OAuthTokenURL.IsNull() {
+		oauthTokenURL = data.OAuthTokenURL.ValueString()
+	}
+
+	if !data.OAuthClientID.IsNull() {
+		oauthClientID = data.OAuthClientID.ValueString()
+	}
+
+	if !data.OAuthClientSecret.IsNull() {
+		oauthClientSecret = data.OAuthClientSecret.ValueString()
+	}
+
+	if !data.OAuthScopes.IsNull() && !data.OAuthScopes.IsUnknown() {
+		resp.Diagnostics.Append(data.OAuthScopes.ElementsAs(ctx, &oauthScopes, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	if !data.InsecureSkipVerify.IsNull() {
 		insecureSkipVerify = data.InsecureSkipVerify.ValueBool()
 	}
 
+	if !data.WebhookURL.IsNull() {
+		webhookURL = data.WebhookURL.ValueString()
+	}
+
 	// If practitioner-provided configuration is missing, add errors.
 	if baseURL == "" {
 		resp.Diagnostics.AddAttributeError(
@@ -126,17 +483,61 @@ func (p *N8nProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		)
 	}
 
-	// Check for session-based authentication from CI environment
+	apiKey, err := resolveAPIKey(ctx, apiKey, apiKeyFile, apiKeyCommand)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("api_key"), "Invalid n8n API Key", err.Error())
+		return
+	}
+
+	// Check for session-based authentication
 	useSessionAuth := os.Getenv("N8N_USE_SESSION_AUTH") == "true"
 	cookieFile := os.Getenv("N8N_COOKIE_FILE")
 
+	if !data.SessionAuth.IsNull() {
+		useSessionAuth = data.SessionAuth.ValueBool()
+	}
+
+	if !data.CookieFile.IsNull() {
+		cookieFile = data.CookieFile.ValueString()
+	}
+
+	apiMode := os.Getenv("N8N_API_MODE")
+	if !data.APIMode.IsNull() {
+		apiMode = data.APIMode.ValueString()
+	}
+
+	defaultProjectID := os.Getenv("N8N_DEFAULT_PROJECT_ID")
+	if !data.DefaultProjectID.IsNull() {
+		defaultProjectID = data.DefaultProjectID.ValueString()
+	}
+
+	refreshCache := os.Getenv("N8N_REFRESH_CACHE") == "true"
+	if !data.RefreshCache.IsNull() {
+		refreshCache = data.RefreshCache.ValueBool()
+	}
+
+	workflowNamePattern := os.Getenv("N8N_WORKFLOW_NAME_PATTERN")
+	if !data.WorkflowNamePattern.IsNull() {
+		workflowNamePattern = data.WorkflowNamePattern.ValueString()
+	}
+	if _, err := regexp.Compile(workflowNamePattern); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("workflow_name_pattern"),
+			"Invalid Workflow Name Pattern",
+			fmt.Sprintf("Unable to parse workflow_name_pattern as a regular expression: %s", err),
+		)
+		return
+	}
+
 	// Create n8n client with appropriate authentication method
 	var authMethod client.AuthMethod
 
-	if useSessionAuth && cookieFile != "" {
-		// Use session-based authentication for CI environments
+	if useSessionAuth && (cookieFile != "" || (email != "" && password != "")) {
+		// Use session-based authentication, logging in with email and password when available
 		authMethod = &client.SessionAuth{
 			CookieFile: cookieFile,
+			Email:      email,
+			Password:   password,
 		}
 	} else if apiKey != "" {
 		authMethod = &client.APIKeyAuth{APIKey: apiKey}
@@ -147,16 +548,145 @@ func (p *N8nProvider) Configure(ctx context.Context, req provider.ConfigureReque
 			path.Root("api_key"),
 			"Missing n8n Authentication",
 			"The provider cannot create the n8n API client as there is missing authentication information. "+
-				"Either set the api_key attribute in the provider configuration or use the N8N_API_KEY environment variable, "+
+				"Either set the api_key, api_key_file or api_key_command attribute in the provider configuration or use the "+
+				"N8N_API_KEY, N8N_API_KEY_FILE or N8N_API_KEY_COMMAND environment variable, "+
 				"or provide both email and password for basic authentication via the N8N_EMAIL and N8N_PASSWORD environment variables.",
 		)
 		return
 	}
 
+	// Basic authentication with a reverse proxy is applied before the authentication with n8n
+	if proxyAuthUsername != "" || proxyAuthPassword != "" {
+		if _, ok := authMethod.(*client.BasicAuth); ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("proxy_auth_username"),
+				"Conflicting n8n Authentication",
+				"Basic authentication with a reverse proxy cannot be combined with basic authentication with n8n, since "+
+					"both use the Authorization header. Authenticate with n8n with an API key or with session_auth instead.",
+			)
+			return
+		}
+		proxyAuth := &client.BasicAuth{Email: proxyAuthUsername, Password: proxyAuthPassword}
+		authMethod = client.CompositeAuth{proxyAuth, authMethod}
+	}
+
+	// A bearer token for a reverse proxy is applied the same way, either configured or from the token endpoint
+	if bearerToken != "" || oauthTokenURL != "" {
+		_, basicAuth := authMethod.(*client.BasicAuth)
+		switch {
+		case bearerToken != "" && oauthTokenURL != "":
+			resp.Diagnostics.AddAttributeError(
+				path.Root("bearer_token"),
+				"Conflicting n8n Authentication",
+				"Only one of bearer_token and oauth_token_url may be set.",
+			)
+			return
+		case basicAuth || proxyAuthUsername != "" || proxyAuthPassword != "":
+			resp.Diagnostics.AddAttributeError(
+				path.Root("bearer_token"),
+				"Conflicting n8n Authentication",
+				"A bearer token cannot be combined with basic authentication with n8n or with a reverse proxy, since "+
+					"both use the Authorization header. Authenticate with n8n with an API key or with session_auth instead.",
+			)
+			return
+		case oauthTokenURL != "" && oauthClientID == "":
+			resp.Diagnostics.AddAttributeError(
+				path.Root("oauth_client_id"),
+				"Missing OAuth Client ID",
+				"The client credentials grant requires oauth_client_id (or the N8N_OAUTH_CLIENT_ID environment variable) "+
+					"when oauth_token_url is set.",
+			)
+			return
+		}
+		bearerAuth := &client.BearerTokenAuth{
+			Token:        bearerToken,
+			TokenURL:     oauthTokenURL,
+			ClientID:     oauthClientID,
+			ClientSecret: oauthClientSecret,
+			Scopes:       oauthScopes,
+		}
+		authMethod = client.CompositeAuth{bearerAuth, authMethod}
+	}
+
+	// Connection pooling settings; zero values fall back to client defaults
+	transportConfig := client.TransportConfig{
+		MaxIdleConns:        int(data.MaxIdleConns.ValueInt64()),
+		MaxIdleConnsPerHost: int(data.MaxIdleConns.ValueInt64()),
+		IdleConnTimeout:     time.Duration(data.IdleConnTimeout.ValueInt64()) * time.Second,
+		DisableHTTP2:        data.DisableHTTP2.ValueBool(),
+		HTTPProxy:           data.HTTPProxy.ValueString(),
+		HTTPSProxy:          data.HTTPSProxy.ValueString(),
+		NoProxy:             data.NoProxy.ValueString(),
+	}
+
+	clientCertPEM, err := readPEMAttribute(data.ClientCertPEM, data.ClientCertFile)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("client_cert_file"), "Invalid Client Certificate", err.Error())
+		return
+	}
+
+	clientKeyPEM, err := readPEMAttribute(data.ClientKeyPEM, data.ClientKeyFile)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("client_key_file"), "Invalid Client Key", err.Error())
+		return
+	}
+
+	caCertFile := data.CACertFile
+	if caCertFile.IsNull() && data.CACertPEM.IsNull() {
+		if envFile := os.Getenv("N8N_CA_CERT_FILE"); envFile != "" {
+			caCertFile = types.StringValue(envFile)
+		}
+	}
+
+	caCertPEM, err := readPEMAttribute(data.CACertPEM, caCertFile)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("ca_cert_file"), "Invalid CA Certificate", err.Error())
+		return
+	}
+
+	var extraHeaders map[string]string
+	if !data.ExtraHeaders.IsNull() && !data.ExtraHeaders.IsUnknown() {
+		resp.Diagnostics.Append(data.ExtraHeaders.ElementsAs(ctx, &extraHeaders, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var cacheTTL time.Duration
+	if !data.CacheTTL.IsNull() {
+		cacheTTL = time.Duration(data.CacheTTL.ValueInt64()) * time.Second
+	}
+
+	circuitBreaker := client.CircuitBreakerConfig{
+		FailureThreshold: defaultCircuitThreshold,
+		CoolDown:         time.Duration(data.CircuitCoolDown.ValueInt64()) * time.Second,
+	}
+	if !data.CircuitThreshold.IsNull() {
+		circuitBreaker.FailureThreshold = int(data.CircuitThreshold.ValueInt64())
+	}
+
 	clientConfig := &client.Config{
-		BaseURL:            baseURL,
-		Auth:               authMethod,
-		InsecureSkipVerify: insecureSkipVerify,
+		BaseURL:             baseURL,
+		Auth:                authMethod,
+		InsecureSkipVerify:  insecureSkipVerify,
+		WebhookURL:          webhookURL,
+		Transport:           transportConfig,
+		CacheTTL:            cacheTTL,
+		Headers:             extraHeaders,
+		ClientCertPEM:       clientCertPEM,
+		ClientKeyPEM:        clientKeyPEM,
+		CACertPEM:           caCertPEM,
+		APIMode:             client.APIMode(apiMode),
+		DefaultProjectID:    defaultProjectID,
+		WorkflowNamePattern: workflowNamePattern,
+		Instrumentation:     client.NewRequestStats(nil, requestStatsInterval),
+		CircuitBreaker:      circuitBreaker,
+		StartupWait:         time.Duration(data.StartupWait.ValueInt64()) * time.Second,
+		MaxResponseSize:     data.MaxResponseSizeMB.ValueInt64() << 20,
+		CompressRequests:    data.CompressRequests.ValueBool(),
+		RefreshCache:        refreshCache,
+		Parallelism:         int(data.Parallelism.ValueInt64()),
+		UserAgent:           fmt.Sprintf("%s/%s", client.DefaultUserAgent, p.version),
 	}
 
 	n8nClient, err := client.NewClient(clientConfig)
@@ -170,26 +700,166 @@ func (p *N8nProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		return
 	}
 
+	validateConnection := os.Getenv("N8N_VALIDATE_CONNECTION") == "true"
+	if !data.ValidateConnection.IsNull() {
+		validateConnection = data.ValidateConnection.ValueBool()
+	}
+
+	if validateConnection {
+		if err := n8nClient.Ping(); err != nil {
+			addConnectionError(&resp.Diagnostics, baseURL, err)
+			return
+		}
+	}
+
+	// Probe the instance version and license up front so resources can report unsupported features
+	// clearly. A failed probe is not an error here; it is retried when a resource needs the result.
+	// An instance that is still starting is not probed, so that configuring the provider does not wait.
+	if clientConfig.StartupWait == 0 {
+		_, _ = n8nClient.GetInstanceInfo()
+	}
+
 	// Make the n8n client available during DataSource and Resource
 	// type Configure methods.
 	resp.DataSourceData = n8nClient
 	resp.ResourceData = n8nClient
 }
 
+// readPEMAttribute returns PEM content given inline or as a file path; setting both is an error
+func readPEMAttribute(pemValue, fileValue types.String) (string, error) {
+	if !pemValue.IsNull() && pemValue.ValueString() != "" {
+		if !fileValue.IsNull() && fileValue.ValueString() != "" {
+			return "", fmt.Errorf("only one of the inline PEM value and the file path may be set")
+		}
+		return pemValue.ValueString(), nil
+	}
+
+	if fileValue.IsNull() || fileValue.ValueString() == "" {
+		return "", nil
+	}
+
+	content, err := os.ReadFile(filepath.Clean(fileValue.ValueString()))
+	if err != nil {
+		return "", fmt.Errorf("unable to read %s: %w", fileValue.ValueString(), err)
+	}
+
+	return string(content), nil
+}
+
+// addConnectionError adds an error explaining why the n8n instance at baseURL failed the connection check
+func addConnectionError(diags *diag.Diagnostics, baseURL string, err error) {
+	if client.IsUnauthorized(err) {
+		diags.AddError("n8n Authentication Failed", fmt.Sprintf("n8n at %s rejected the configured credentials: %s. "+
+			"Check the API key, or the email and password, of the provider configuration.", baseURL, err))
+		return
+	}
+
+	diags.AddError("Unable to Reach n8n", fmt.Sprintf("cannot reach n8n at %s: %s. Check base_url and that the "+
+		"instance is running and reachable from where Terraform runs.", baseURL, err))
+}
+
+// resolveAPIKey returns the API key given inline, read from keyFile or printed by keyCommand. At most one
+// of them may be set.
+func resolveAPIKey(ctx context.Context, apiKey, keyFile, keyCommand string) (string, error) {
+	sources := 0
+	for _, source := range []string{apiKey, keyFile, keyCommand} {
+		if source != "" {
+			sources++
+		}
+	}
+	if sources > 1 {
+		return "", fmt.Errorf("only one of api_key, api_key_file and api_key_command may be set")
+	}
+
+	switch {
+	case keyFile != "":
+		content, err := os.ReadFile(filepath.Clean(keyFile))
+		if err != nil {
+			return "", fmt.Errorf("unable to read api_key_file %s: %w", keyFile, err)
+		}
+		key := strings.TrimSpace(string(content))
+		if key == "" {
+			return "", fmt.Errorf("api_key_file %s is empty", keyFile)
+		}
+		return key, nil
+	case keyCommand != "":
+		return runAPIKeyCommand(ctx, keyCommand)
+	default:
+		return apiKey, nil
+	}
+}
+
+// runAPIKeyCommand runs a command by the shell and returns its output as the API key
+func runAPIKeyCommand(ctx context.Context, command string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, apiKeyCommandTimeout)
+	defer cancel()
+
+	shell, flag := "sh", "-c"
+	if runtime.GOOS == "windows" {
+		shell, flag = "cmd", "/C"
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, shell, flag, command) // #nosec G204 - The command is configured by the practitioner
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return "", fmt.Errorf("api_key_command did not finish within %s", apiKeyCommandTimeout)
+		}
+		if output := strings.TrimSpace(stderr.String()); output != "" {
+			return "", fmt.Errorf("api_key_command failed: %w: %s", err, output)
+		}
+		return "", fmt.Errorf("api_key_command failed: %w", err)
+	}
+
+	key := strings.TrimSpace(stdout.String())
+	if key == "" {
+		return "", fmt.Errorf("api_key_command printed no API key")
+	}
+	return key, nil
+}
+
 func (p *N8nProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewWorkflowResource,
 		NewCredentialResource,
 		NewUserResource,
+		NewUsersResource,
 		NewProjectResource,
 		NewProjectUserResource,
+		NewProjectSettingsResource,
 		NewLDAPConfigResource,
+		NewInstanceOwnerResource,
+		NewSettingsResource,
+		NewLDAPSyncResource,
+		NewWorkflowExecutionResource,
+		NewExecutionSettingsResource,
+		NewWorkflowBundleResource,
+		NewFolderResource,
+		NewLogStreamingDestinationResource,
+		NewWorkflowActivationResource,
 	}
 }
 
 func (p *N8nProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewUserDataSource,
+		NewWebhookDataSource,
+		NewLDAPSyncStatusDataSource,
+		NewWorkflowVersionsDataSource,
+		NewWorkflowDependenciesDataSource,
+		NewInstanceInfoDataSource,
+		NewWorkflowExportDataSource,
+		NewCredentialTypesDataSource,
+		NewNodeTypesDataSource,
+		NewAuditDataSource,
+		NewSecurityAuditDataSource,
+		NewVariablesDataSource,
+		NewWorkflowsDataSource,
+		NewTagDataSource,
+		NewTagsDataSource,
 	}
 }
 
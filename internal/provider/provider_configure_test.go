@@ -2,10 +2,16 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"runtime"
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -317,13 +323,228 @@ func TestProvider_Configure_InvalidConfiguration(t *testing.T) {
 	}
 }
 
+func TestResolveAPIKey(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "api-key")
+	if err := os.WriteFile(keyFile, []byte("file-key\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	emptyFile := filepath.Join(t.TempDir(), "empty")
+	if err := os.WriteFile(emptyFile, []byte("\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		apiKey    string
+		keyFile   string
+		command   string
+		expected  string
+		errorText string
+		shell     bool
+	}{
+		{name: "inline key", apiKey: "inline-key", expected: "inline-key"},
+		{name: "no key", expected: ""},
+		{name: "key file", keyFile: keyFile, expected: "file-key"},
+		{name: "missing key file", keyFile: filepath.Join(t.TempDir(), "missing"), errorText: "unable to read api_key_file"},
+		{name: "empty key file", keyFile: emptyFile, errorText: "is empty"},
+		{name: "command", command: "echo ' command-key '", expected: "command-key", shell: true},
+		{name: "failing command", command: "echo denied >&2; exit 3", errorText: "exit status 3: denied", shell: true},
+		{name: "silent command", command: "true", errorText: "printed no API key", shell: true},
+		{name: "conflicting sources", apiKey: "inline-key", keyFile: keyFile, errorText: "only one of"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.shell && runtime.GOOS == "windows" {
+				t.Skip("The commands are written for sh")
+			}
+
+			key, err := resolveAPIKey(context.Background(), tt.apiKey, tt.keyFile, tt.command)
+			if tt.errorText != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errorText) {
+					t.Fatalf("Expected error containing %q, got %v", tt.errorText, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if key != tt.expected {
+				t.Errorf("Expected key %q, got %q", tt.expected, key)
+			}
+		})
+	}
+}
+
+func TestProvider_Configure_APIKeyFile(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "api-key")
+	if err := os.WriteFile(keyFile, []byte("file-key"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	// A key source in the configuration replaces the key in the environment
+	t.Setenv("N8N_API_KEY", "env-key")
+
+	resp := configureProvider(t, N8nProviderModel{
+		BaseURL:    types.StringValue("https://n8n.example.com"),
+		APIKeyFile: types.StringValue(keyFile),
+	})
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected configuration error: %v", resp.Diagnostics.Errors())
+	}
+	if resp.ResourceData == nil {
+		t.Error("Expected client data to be configured")
+	}
+}
+
+func TestProvider_Configure_ProxyAuth(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		username, _, ok := r.BasicAuth()
+		if !ok || username != "proxy" || r.Header.Get("X-N8N-API-KEY") != "api-key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"message": "unauthorized"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data": []}`))
+	}))
+	defer server.Close()
+
+	resp := configureProvider(t, N8nProviderModel{
+		BaseURL:            types.StringValue(server.URL),
+		APIKey:             types.StringValue("api-key"),
+		ProxyAuthUsername:  types.StringValue("proxy"),
+		ProxyAuthPassword:  types.StringValue("proxy-secret"),
+		ValidateConnection: types.BoolValue(true),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected configuration error: %v", resp.Diagnostics.Errors())
+	}
+
+	// Basic authentication with n8n would replace the credentials for the proxy
+	resp = configureProvider(t, N8nProviderModel{
+		BaseURL:           types.StringValue(server.URL),
+		Email:             types.StringValue("owner@example.com"),
+		Password:          types.StringValue("secret"),
+		ProxyAuthUsername: types.StringValue("proxy"),
+		ProxyAuthPassword: types.StringValue("proxy-secret"),
+	})
+	if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Conflicting n8n Authentication" {
+		t.Fatalf("Expected conflicting Basic authentication to fail the configuration, got %v", resp.Diagnostics)
+	}
+}
+
+func TestProvider_Configure_BearerToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/token" {
+			_, _ = w.Write([]byte(`{"access_token": "oidc-token", "expires_in": 300}`))
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer oidc-token" || r.Header.Get("X-N8N-API-KEY") != "api-key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"message": "unauthorized"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data": []}`))
+	}))
+	defer server.Close()
+
+	resp := configureProvider(t, N8nProviderModel{
+		BaseURL:            types.StringValue(server.URL),
+		APIKey:             types.StringValue("api-key"),
+		OAuthTokenURL:      types.StringValue(server.URL + "/token"),
+		OAuthClientID:      types.StringValue("terraform"),
+		OAuthClientSecret:  types.StringValue("secret"),
+		ValidateConnection: types.BoolValue(true),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected configuration error: %v", resp.Diagnostics.Errors())
+	}
+
+	resp = configureProvider(t, N8nProviderModel{
+		BaseURL:           types.StringValue(server.URL),
+		APIKey:            types.StringValue("api-key"),
+		BearerToken:       types.StringValue("oidc-token"),
+		ProxyAuthUsername: types.StringValue("proxy"),
+	})
+	if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Conflicting n8n Authentication" {
+		t.Fatalf("Expected a bearer token with proxy credentials to fail the configuration, got %v", resp.Diagnostics)
+	}
+}
+
+func TestProvider_Configure_ValidateConnection(t *testing.T) {
+	validKey := "valid-key"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/api/v1/workflows" && r.Header.Get("X-N8N-API-KEY") != validKey {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"message": "unauthorized"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data": []}`))
+	}))
+	defer server.Close()
+
+	resp := configureProvider(t, N8nProviderModel{
+		BaseURL:            types.StringValue(server.URL),
+		APIKey:             types.StringValue(validKey),
+		ValidateConnection: types.BoolValue(true),
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected configuration error: %v", resp.Diagnostics.Errors())
+	}
+
+	resp = configureProvider(t, N8nProviderModel{
+		BaseURL:            types.StringValue(server.URL),
+		APIKey:             types.StringValue("revoked-key"),
+		ValidateConnection: types.BoolValue(true),
+	})
+	if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "n8n Authentication Failed" {
+		t.Fatalf("Expected the rejected credentials to fail the configuration, got %v", resp.Diagnostics)
+	}
+	if resp.ResourceData != nil {
+		t.Error("Expected no client to be configured")
+	}
+}
+
+func TestAddConnectionError(t *testing.T) {
+	var diags diag.Diagnostics
+	addConnectionError(&diags, "https://n8n.example.com", errors.New("dial tcp: connection refused"))
+
+	if diags.Errors()[0].Summary() != "Unable to Reach n8n" {
+		t.Errorf("Expected a connection error, got %v", diags)
+	}
+	if !strings.HasPrefix(diags.Errors()[0].Detail(), "cannot reach n8n at https://n8n.example.com: dial tcp") {
+		t.Errorf("Expected the URL and the cause in the detail, got %q", diags.Errors()[0].Detail())
+	}
+}
+
 // Helper functions for testing
 
+// configureProvider configures the provider with a configuration
+func configureProvider(t *testing.T, model N8nProviderModel) *provider.ConfigureResponse {
+	t.Helper()
+
+	p := &N8nProvider{}
+	var schemaResp provider.SchemaResponse
+	p.Schema(context.Background(), provider.SchemaRequest{}, &schemaResp)
+
+	config := createTerraformConfig(t, model)
+	config.Schema = schemaResp.Schema
+
+	resp := &provider.ConfigureResponse{}
+	p.Configure(context.Background(), provider.ConfigureRequest{Config: config}, resp)
+	return resp
+}
+
 func setupTestEnvironment(envVars map[string]string) map[string]string {
 	originalEnvs := make(map[string]string)
 
 	// Store original values
-	testEnvKeys := []string{"N8N_BASE_URL", "N8N_API_KEY", "N8N_EMAIL", "N8N_PASSWORD", "N8N_INSECURE_SKIP_VERIFY", "N8N_USE_SESSION_AUTH", "N8N_COOKIE_FILE"}
+	testEnvKeys := []string{"N8N_BASE_URL", "N8N_API_KEY", "N8N_EMAIL", "N8N_PASSWORD", "N8N_INSECURE_SKIP_VERIFY", "N8N_USE_SESSION_AUTH", "N8N_COOKIE_FILE",
+		"N8N_API_MODE", "N8N_DEFAULT_PROJECT_ID"}
 	for _, key := range testEnvKeys {
 		originalEnvs[key] = os.Getenv(key)
 		os.Unsetenv(key)
@@ -353,18 +574,92 @@ func createTerraformConfig(t *testing.T, model N8nProviderModel) tfsdk.Config {
 	// Create the tftypes object representation
 	configValue := tftypes.NewValue(tftypes.Object{
 		AttributeTypes: map[string]tftypes.Type{
-			"base_url":             tftypes.String,
-			"api_key":              tftypes.String,
-			"email":                tftypes.String,
-			"password":             tftypes.String,
-			"insecure_skip_verify": tftypes.Bool,
+			"base_url":                  tftypes.String,
+			"api_key":                   tftypes.String,
+			"api_key_file":              tftypes.String,
+			"api_key_command":           tftypes.String,
+			"email":                     tftypes.String,
+			"password":                  tftypes.String,
+			"proxy_auth_username":       tftypes.String,
+			"proxy_auth_password":       tftypes.String,
+			"bearer_token":              tftypes.String,
+			"oauth_token_url":           tftypes.String,
+			"oauth_client_id":           tftypes.String,
+			"oauth_client_secret":       tftypes.String,
+			"oauth_scopes":              tftypes.List{ElementType: tftypes.String},
+			"insecure_skip_verify":      tftypes.Bool,
+			"webhook_url":               tftypes.String,
+			"max_idle_conns":            tftypes.Number,
+			"idle_conn_timeout":         tftypes.Number,
+			"parallelism":               tftypes.Number,
+			"disable_http2":             tftypes.Bool,
+			"cache_ttl":                 tftypes.Number,
+			"circuit_breaker_threshold": tftypes.Number,
+			"circuit_breaker_cool_down": tftypes.Number,
+			"extra_headers":             tftypes.Map{ElementType: tftypes.String},
+			"http_proxy":                tftypes.String,
+			"https_proxy":               tftypes.String,
+			"no_proxy":                  tftypes.String,
+			"client_cert_pem":           tftypes.String,
+			"client_key_pem":            tftypes.String,
+			"client_cert_file":          tftypes.String,
+			"client_key_file":           tftypes.String,
+			"ca_cert_pem":               tftypes.String,
+			"ca_cert_file":              tftypes.String,
+			"session_auth":              tftypes.Bool,
+			"cookie_file":               tftypes.String,
+			"api_mode":                  tftypes.String,
+			"default_project_id":        tftypes.String,
+			"workflow_name_pattern":     tftypes.String,
+			"validate_connection":       tftypes.Bool,
+			"startup_wait":              tftypes.Number,
+			"max_response_size_mb":      tftypes.Number,
+			"compress_requests":         tftypes.Bool,
+			"refresh_cache":             tftypes.Bool,
 		},
 	}, map[string]tftypes.Value{
-		"base_url":             convertStringToTFValue(model.BaseURL),
-		"api_key":              convertStringToTFValue(model.APIKey),
-		"email":                convertStringToTFValue(model.Email),
-		"password":             convertStringToTFValue(model.Password),
-		"insecure_skip_verify": convertBoolToTFValue(model.InsecureSkipVerify),
+		"base_url":                  convertStringToTFValue(model.BaseURL),
+		"api_key":                   convertStringToTFValue(model.APIKey),
+		"api_key_file":              convertStringToTFValue(model.APIKeyFile),
+		"api_key_command":           convertStringToTFValue(model.APIKeyCommand),
+		"email":                     convertStringToTFValue(model.Email),
+		"password":                  convertStringToTFValue(model.Password),
+		"proxy_auth_username":       convertStringToTFValue(model.ProxyAuthUsername),
+		"proxy_auth_password":       convertStringToTFValue(model.ProxyAuthPassword),
+		"bearer_token":              convertStringToTFValue(model.BearerToken),
+		"oauth_token_url":           convertStringToTFValue(model.OAuthTokenURL),
+		"oauth_client_id":           convertStringToTFValue(model.OAuthClientID),
+		"oauth_client_secret":       convertStringToTFValue(model.OAuthClientSecret),
+		"oauth_scopes":              tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+		"insecure_skip_verify":      convertBoolToTFValue(model.InsecureSkipVerify),
+		"webhook_url":               convertStringToTFValue(model.WebhookURL),
+		"max_idle_conns":            convertInt64ToTFValue(model.MaxIdleConns),
+		"idle_conn_timeout":         convertInt64ToTFValue(model.IdleConnTimeout),
+		"parallelism":               convertInt64ToTFValue(model.Parallelism),
+		"disable_http2":             convertBoolToTFValue(model.DisableHTTP2),
+		"cache_ttl":                 convertInt64ToTFValue(model.CacheTTL),
+		"circuit_breaker_threshold": convertInt64ToTFValue(model.CircuitThreshold),
+		"circuit_breaker_cool_down": convertInt64ToTFValue(model.CircuitCoolDown),
+		"extra_headers":             tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+		"http_proxy":                convertStringToTFValue(model.HTTPProxy),
+		"https_proxy":               convertStringToTFValue(model.HTTPSProxy),
+		"no_proxy":                  convertStringToTFValue(model.NoProxy),
+		"client_cert_pem":           convertStringToTFValue(model.ClientCertPEM),
+		"client_key_pem":            convertStringToTFValue(model.ClientKeyPEM),
+		"client_cert_file":          convertStringToTFValue(model.ClientCertFile),
+		"client_key_file":           convertStringToTFValue(model.ClientKeyFile),
+		"ca_cert_pem":               convertStringToTFValue(model.CACertPEM),
+		"ca_cert_file":              convertStringToTFValue(model.CACertFile),
+		"session_auth":              convertBoolToTFValue(model.SessionAuth),
+		"cookie_file":               convertStringToTFValue(model.CookieFile),
+		"api_mode":                  convertStringToTFValue(model.APIMode),
+		"default_project_id":        convertStringToTFValue(model.DefaultProjectID),
+		"workflow_name_pattern":     convertStringToTFValue(model.WorkflowNamePattern),
+		"validate_connection":       convertBoolToTFValue(model.ValidateConnection),
+		"startup_wait":              convertInt64ToTFValue(model.StartupWait),
+		"max_response_size_mb":      convertInt64ToTFValue(model.MaxResponseSizeMB),
+		"compress_requests":         convertBoolToTFValue(model.CompressRequests),
+		"refresh_cache":             convertBoolToTFValue(model.RefreshCache),
 	})
 
 	config := tfsdk.Config{
@@ -393,3 +688,13 @@ func convertBoolToTFValue(attr types.Bool) tftypes.Value {
 	}
 	return tftypes.NewValue(tftypes.Bool, attr.ValueBool())
 }
+
+func convertInt64ToTFValue(attr types.Int64) tftypes.Value {
+	if attr.IsNull() {
+		return tftypes.NewValue(tftypes.Number, nil)
+	}
+	if attr.IsUnknown() {
+		return tftypes.NewValue(tftypes.Number, tftypes.UnknownValue)
+	}
+	return tftypes.NewValue(tftypes.Number, attr.ValueInt64())
+}
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/provider"
@@ -97,7 +98,14 @@ func TestProvider_Schema(t *testing.T) {
 		t.Error("Expected MarkdownDescription to be non-empty")
 	}
 
-	expectedAttrs := []string{"base_url", "api_key", "email", "password", "insecure_skip_verify"}
+	expectedAttrs := []string{
+		"base_url", "api_key", "email", "password", "insecure_skip_verify", "webhook_url",
+		"max_idle_conns", "idle_conn_timeout", "disable_http2", "cache_ttl",
+		"extra_headers", "http_proxy", "https_proxy", "no_proxy",
+		"client_cert_pem", "client_key_pem", "client_cert_file", "client_key_file",
+		"ca_cert_pem", "ca_cert_file", "session_auth", "cookie_file",
+		"api_mode", "default_project_id",
+	}
 	for _, attr := range expectedAttrs {
 		if _, exists := resp.Schema.Attributes[attr]; !exists {
 			t.Errorf("Expected attribute %q to exist in schema", attr)
@@ -112,6 +120,14 @@ func TestProvider_Schema(t *testing.T) {
 	if !resp.Schema.Attributes["password"].IsSensitive() {
 		t.Error("Expected password to be marked as sensitive")
 	}
+
+	if !resp.Schema.Attributes["extra_headers"].IsSensitive() {
+		t.Error("Expected extra_headers to be marked as sensitive")
+	}
+
+	if !resp.Schema.Attributes["client_key_pem"].IsSensitive() {
+		t.Error("Expected client_key_pem to be marked as sensitive")
+	}
 }
 
 func TestProvider_Configure_EnvironmentVariableHandling(t *testing.T) {
@@ -226,7 +242,10 @@ func TestProvider_Resources(t *testing.T) {
 
 	resources := p.Resources(ctx)
 
-	expectedCount := 6 // workflow, credential, user, project, project_user, ldap_config
+	// workflow, credential, user, users, project, project_user, project_settings, ldap_config, instance_owner,
+	// settings, ldap_sync, workflow_execution, execution_settings, workflow_bundle, folder,
+	// log_streaming_destination, workflow_activation
+	expectedCount := 17
 	if len(resources) != expectedCount {
 		t.Errorf("Expected %d resources, got %d", expectedCount, len(resources))
 	}
@@ -246,7 +265,9 @@ func TestProvider_DataSources(t *testing.T) {
 
 	dataSources := p.DataSources(ctx)
 
-	expectedCount := 1 // user data source
+	// user, webhook, ldap_sync_status, workflow_versions, instance_info, workflow_export, credential_types,
+	// node_types, audit, security_audit, variables, workflows, tag, tags, workflow_dependencies
+	expectedCount := 15
 	if len(dataSources) != expectedCount {
 		t.Errorf("Expected %d data sources, got %d", expectedCount, len(dataSources))
 	}
@@ -298,3 +319,36 @@ func TestN8nProviderModel(t *testing.T) {
 		t.Error("InsecureSkipVerify not set correctly")
 	}
 }
+
+func TestReadPEMAttribute(t *testing.T) {
+	pemFile := filepath.Join(t.TempDir(), "client.pem")
+	if err := os.WriteFile(pemFile, []byte("-----BEGIN CERTIFICATE-----\nfile\n"), 0600); err != nil {
+		t.Fatalf("Failed to write PEM file: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		pemValue types.String
+		file     types.String
+		expected string
+		wantErr  bool
+	}{
+		{name: "neither set", pemValue: types.StringNull(), file: types.StringNull(), expected: ""},
+		{name: "inline PEM", pemValue: types.StringValue("inline"), file: types.StringNull(), expected: "inline"},
+		{name: "file path", pemValue: types.StringNull(), file: types.StringValue(pemFile), expected: "-----BEGIN CERTIFICATE-----\nfile\n"},
+		{name: "both set", pemValue: types.StringValue("inline"), file: types.StringValue(pemFile), wantErr: true},
+		{name: "missing file", pemValue: types.StringNull(), file: types.StringValue(filepath.Join(t.TempDir(), "missing.pem")), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := readPEMAttribute(tt.pemValue, tt.file)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("readPEMAttribute() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.expected {
+				t.Errorf("readPEMAttribute() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
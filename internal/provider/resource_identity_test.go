@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSetIDIdentity(t *testing.T) {
+	ctx := context.Background()
+
+	var schemaResp resource.IdentitySchemaResponse
+	NewWorkflowResource().(resource.ResourceWithIdentity).IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &schemaResp)
+
+	identity := &tfsdk.ResourceIdentity{
+		Schema: schemaResp.IdentitySchema,
+		Raw:    tftypes.NewValue(schemaResp.IdentitySchema.Type().TerraformType(ctx), nil),
+	}
+
+	if diags := setIDIdentity(ctx, identity, "wf-1"); diags.HasError() {
+		t.Fatalf("setIDIdentity() diagnostics = %v", diags)
+	}
+
+	var id types.String
+	if diags := identity.GetAttribute(ctx, path.Root("id"), &id); diags.HasError() {
+		t.Fatalf("GetAttribute() diagnostics = %v", diags)
+	}
+	if id.ValueString() != "wf-1" {
+		t.Errorf("Expected identity ID wf-1, got %q", id.ValueString())
+	}
+
+	// Terraform versions without resource identity do not provide one
+	if diags := setIDIdentity(ctx, nil, "wf-1"); diags.HasError() {
+		t.Errorf("Expected no diagnostics without an identity, got %v", diags)
+	}
+}
@@ -0,0 +1,249 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SecurityAuditDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &SecurityAuditDataSource{}
+
+func NewSecurityAuditDataSource() datasource.DataSource {
+	return &SecurityAuditDataSource{}
+}
+
+// SecurityAuditDataSource defines the data source implementation.
+type SecurityAuditDataSource struct {
+	client client.N8nAPI
+}
+
+// SecurityAuditDataSourceModel describes the data source data model.
+type SecurityAuditDataSourceModel struct {
+	Categories            types.List   `tfsdk:"categories"`
+	DaysAbandonedWorkflow types.Int64  `tfsdk:"days_abandoned_workflow"`
+	MaxFindings           types.Map    `tfsdk:"max_findings"`
+	WarnOnly              types.Bool   `tfsdk:"warn_only"`
+	Findings              types.Map    `tfsdk:"findings"`
+	Violations            types.List   `tfsdk:"violations"`
+	Passed                types.Bool   `tfsdk:"passed"`
+	Report                types.String `tfsdk:"report"`
+}
+
+func (d *SecurityAuditDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_security_audit"
+}
+
+func (d *SecurityAuditDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Runs a security audit of the n8n instance and fails the Terraform run when a risk " +
+			"category has more findings than allowed, e.g. credentials unused for longer than " +
+			"`days_abandoned_workflow` or unvetted community nodes. Since data sources are read on every plan, this " +
+			"gates each pipeline run on the audit. Use `n8n_audit` to only read the report.",
+
+		Attributes: map[string]schema.Attribute{
+			"categories": schema.ListAttribute{
+				MarkdownDescription: "Risk categories to audit: " + strings.Join(client.AuditCategories, ", ") +
+					". Defaults to all categories.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					stringListOneOf(client.AuditCategories...),
+				},
+			},
+			"days_abandoned_workflow": schema.Int64Attribute{
+				MarkdownDescription: "Number of days without executions after which a workflow is considered abandoned, " +
+					"which also applies to the credentials it uses. n8n defaults to 90 days.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64AtLeast(1),
+				},
+			},
+			"max_findings": schema.MapAttribute{
+				MarkdownDescription: "Maximum number of findings allowed per risk category, e.g. `{ credentials = 0 }`. " +
+					"A finding is each workflow, node or credential a section of the report lists, or the section " +
+					"itself if it lists none. Categories that are not listed are audited but not checked.",
+				ElementType: types.Int64Type,
+				Required:    true,
+			},
+			"warn_only": schema.BoolAttribute{
+				MarkdownDescription: "Whether to report exceeded thresholds as warnings instead of failing the run. " +
+					"Defaults to false.",
+				Optional: true,
+			},
+			"findings": schema.MapAttribute{
+				MarkdownDescription: "Number of findings of each audited risk category",
+				ElementType:         types.Int64Type,
+				Computed:            true,
+			},
+			"violations": schema.ListAttribute{
+				MarkdownDescription: "Risk categories whose findings exceed `max_findings`, with their findings",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"passed": schema.BoolAttribute{
+				MarkdownDescription: "Whether no risk category exceeds `max_findings`",
+				Computed:            true,
+			},
+			"report": schema.StringAttribute{
+				MarkdownDescription: "JSON string containing the complete audit report as returned by n8n, keyed by " +
+					"report title. An empty object if there are no findings.",
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *SecurityAuditDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.N8nAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.N8nAPI, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// ValidateConfig checks that max_findings only limits risk categories that are audited
+func (d *SecurityAuditDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest,
+	resp *datasource.ValidateConfigResponse) {
+	var data SecurityAuditDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.MaxFindings.IsUnknown() || data.Categories.IsUnknown() {
+		return
+	}
+
+	audited := client.AuditCategories
+	if len(data.Categories.Elements()) > 0 {
+		audited = nil
+		resp.Diagnostics.Append(data.Categories.ElementsAs(ctx, &audited, false)...)
+	}
+
+	for _, category := range sortedKeys(data.MaxFindings.Elements()) {
+		switch {
+		case !slices.Contains(client.AuditCategories, category):
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_findings").AtMapKey(category),
+				"Invalid Risk Category",
+				fmt.Sprintf("%q is not a risk category of the audit, must be one of: %s", category,
+					strings.Join(client.AuditCategories, ", ")),
+			)
+		case !slices.Contains(audited, category):
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_findings").AtMapKey(category),
+				"Risk Category Not Audited",
+				fmt.Sprintf("max_findings limits the %s category, which categories leaves out of the audit.", category),
+			)
+		}
+	}
+}
+
+func (d *SecurityAuditDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SecurityAuditDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	options := auditOptions(ctx, data.Categories, data.DaysAbandonedWorkflow, &resp.Diagnostics)
+	maxFindings := make(map[string]int64)
+	resp.Diagnostics.Append(data.MaxFindings.ElementsAs(ctx, &maxFindings, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	audit, err := d.client.RunAudit(options)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to run audit, got error: %s", err))
+		return
+	}
+
+	audited := options.Categories
+	if len(audited) == 0 {
+		audited = client.AuditCategories
+	}
+	findings := auditFindings(audit, audited)
+	violations := auditViolations(findings, maxFindings)
+
+	findingValues := make(map[string]attr.Value, len(findings))
+	for category, count := range findings {
+		findingValues[category] = types.Int64Value(int64(count))
+	}
+	violationValues := make([]attr.Value, 0, len(violations))
+	for _, violation := range violations {
+		violationValues = append(violationValues, types.StringValue(violation))
+	}
+
+	data.Findings = types.MapValueMust(types.Int64Type, findingValues)
+	data.Violations = types.ListValueMust(types.StringType, violationValues)
+	data.Passed = types.BoolValue(len(violations) == 0)
+	data.Report = types.StringValue(string(audit.Raw))
+
+	if len(violations) > 0 {
+		summary, detail := "Security Audit Failed", fmt.Sprintf("The security audit of the n8n instance found more "+
+			"risks than max_findings allows:\n  - %s", strings.Join(violations, "\n  - "))
+		if !data.WarnOnly.ValueBool() {
+			resp.Diagnostics.AddError(summary, detail)
+			return
+		}
+		resp.Diagnostics.AddWarning(summary, detail)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// auditFindings returns the number of findings of each audited risk category
+func auditFindings(audit *client.Audit, categories []string) map[string]int {
+	findings := make(map[string]int, len(categories))
+	for _, category := range categories {
+		findings[category] = 0
+	}
+	for _, report := range audit.Reports {
+		findings[report.Risk] += report.FindingCount()
+	}
+	return findings
+}
+
+// auditViolations describes the risk categories whose findings exceed their maximum, sorted by category
+func auditViolations(findings map[string]int, maxFindings map[string]int64) []string {
+	var violations []string
+	for _, category := range sortedKeys(maxFindings) {
+		if count := findings[category]; int64(count) > maxFindings[category] {
+			violations = append(violations, fmt.Sprintf("%s: %d findings, at most %d allowed", category, count,
+				maxFindings[category]))
+		}
+	}
+	return violations
+}
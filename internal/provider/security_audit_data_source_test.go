@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+)
+
+func TestAuditFindings(t *testing.T) {
+	audit := &client.Audit{Reports: []client.AuditReport{
+		{
+			Title: "Credentials Risk Report",
+			Risk:  "credentials",
+			Sections: []client.AuditSection{
+				{Title: "Credentials not used in any workflow", Location: []map[string]interface{}{{"id": "1"}, {"id": "2"}}},
+				{Title: "Credentials not used in recently executed workflows", Location: []map[string]interface{}{{"id": "3"}}},
+			},
+		},
+		{
+			Title:    "Instance Risk Report",
+			Risk:     "instance",
+			Sections: []client.AuditSection{{Title: "Outdated instance"}},
+		},
+	}}
+
+	findings := auditFindings(audit, []string{"credentials", "nodes", "instance"})
+	expected := map[string]int{"credentials": 3, "nodes": 0, "instance": 1}
+	if !reflect.DeepEqual(findings, expected) {
+		t.Fatalf("Expected findings %v, got %v", expected, findings)
+	}
+
+	violations := auditViolations(findings, map[string]int64{"nodes": 0, "instance": 1, "credentials": 2})
+	if !reflect.DeepEqual(violations, []string{"credentials: 3 findings, at most 2 allowed"}) {
+		t.Errorf("Expected only the credentials category to exceed its maximum, got %v", violations)
+	}
+
+	if violations := auditViolations(findings, map[string]int64{"credentials": 3}); len(violations) != 0 {
+		t.Errorf("Expected no violations at the maximum, got %v", violations)
+	}
+}
@@ -0,0 +1,338 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SettingsResource{}
+var _ resource.ResourceWithImportState = &SettingsResource{}
+
+func NewSettingsResource() resource.Resource {
+	return &SettingsResource{}
+}
+
+// SettingsResource defines the resource implementation.
+type SettingsResource struct {
+	client client.N8nAPI
+}
+
+// SettingsResourceModel describes the resource data model.
+type SettingsResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	PruneExecutions  types.Bool   `tfsdk:"prune_executions"`
+	PruneMaxAge      types.Int64  `tfsdk:"prune_max_age"`
+	PruneMaxCount    types.Int64  `tfsdk:"prune_max_count"`
+	TelemetryEnabled types.Bool   `tfsdk:"telemetry_enabled"`
+	DismissedBanners types.List   `tfsdk:"dismissed_banners"`
+	MFAEnforced      types.Bool   `tfsdk:"mfa_enforced"`
+}
+
+func (r *SettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_settings"
+}
+
+func (r *SettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages instance-level n8n settings, i.e. the dismissed banners and MFA enforcement, and " +
+			"reports the execution data pruning and telemetry of the instance. n8n reads pruning and telemetry from its " +
+			"environment (`EXECUTIONS_DATA_PRUNE`, `EXECUTIONS_DATA_MAX_AGE`, `EXECUTIONS_DATA_PRUNE_MAX_COUNT` and " +
+			"`N8N_DIAGNOSTICS_ENABLED`), so they cannot be changed through the API. Settings that are not configured " +
+			"keep their current value on the instance.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Settings identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"prune_executions": schema.BoolAttribute{
+				MarkdownDescription: "Whether old execution data is deleted automatically (`EXECUTIONS_DATA_PRUNE`)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"prune_max_age": schema.Int64Attribute{
+				MarkdownDescription: "Age in hours after which execution data is pruned (`EXECUTIONS_DATA_MAX_AGE`)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"prune_max_count": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of executions kept before the oldest are pruned, 0 meaning no limit " +
+					"(`EXECUTIONS_DATA_PRUNE_MAX_COUNT`)",
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"telemetry_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether anonymous usage telemetry is sent to n8n (`N8N_DIAGNOSTICS_ENABLED`)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dismissed_banners": schema.ListAttribute{
+				MarkdownDescription: "Banners dismissed for all users of the instance (e.g., V1, TRIAL, NON_PRODUCTION_LICENSE). " +
+					"n8n has no API for custom announcement banners, so the built-in banners can only be dismissed, and a " +
+					"dismissed banner cannot be shown again. Dismissing banners requires session authentication as the owner.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"mfa_enforced": schema.BoolAttribute{
+				MarkdownDescription: "Whether all users must set up multi-factor authentication before they can use n8n. " +
+					"Changing it requires session authentication as the owner or an admin.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.N8nAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.N8nAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SettingsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Settings are a singleton, so creating them applies the configuration to the existing settings
+	r.applySettings(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.applyMFAEnforcement(&data, types.BoolNull(), &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SettingsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get settings from API
+	settings, err := r.client.GetInstanceSettings()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read settings, got error: %s", err))
+		return
+	}
+
+	// Update model with response data
+	r.updateModelFromSettings(&data, settings)
+	r.readMFAEnforcement(&data, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SettingsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state SettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.applySettings(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.applyMFAEnforcement(&data, state.MFAEnforced, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Instance settings cannot be deleted, only changed
+	resp.Diagnostics.AddWarning(
+		"Settings Not Reset",
+		"Instance settings cannot be deleted from n8n. The resource has been removed from Terraform state, but the settings remain in n8n with their current values.",
+	)
+}
+
+func (r *SettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Settings are a singleton, so we use a fixed ID
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), "settings")...)
+}
+
+// applySettings dismisses the configured banners that the instance still shows and stores the resulting
+// settings of the instance in the model
+func (r *SettingsResource) applySettings(ctx context.Context, model *SettingsResourceModel, diags *diag.Diagnostics) {
+	settings, err := r.client.GetInstanceSettings()
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read settings, got error: %s", err))
+		return
+	}
+
+	if !model.DismissedBanners.IsNull() && !model.DismissedBanners.IsUnknown() {
+		banners := []string{}
+		diags.Append(model.DismissedBanners.ElementsAs(ctx, &banners, false)...)
+		if diags.HasError() {
+			return
+		}
+
+		for _, banner := range banners {
+			if slices.Contains(settings.BannersDismissed, banner) {
+				continue
+			}
+			if err := r.client.DismissBanner(banner); err != nil {
+				diags.AddError("Client Error", fmt.Sprintf("Unable to dismiss banner %s, got error: %s", banner, err))
+				return
+			}
+			settings.BannersDismissed = append(settings.BannersDismissed, banner)
+		}
+	}
+
+	r.updateModelFromSettings(model, settings)
+}
+
+// Helper function to update model from API response
+func (r *SettingsResource) updateModelFromSettings(model *SettingsResourceModel, settings *client.InstanceSettings) {
+	model.ID = types.StringValue("settings") // Settings are a singleton
+
+	model.PruneExecutions = types.BoolPointerValue(settings.ExecutionsDataPruning)
+	model.PruneMaxAge = int64PointerValue(settings.ExecutionsDataMaxAge)
+	model.PruneMaxCount = int64PointerValue(settings.ExecutionsDataPruneMaxCount)
+	model.TelemetryEnabled = types.BoolPointerValue(settings.DiagnosticsEnabled)
+
+	// The instance reports every dismissed banner, so a configured list is kept as long as all of its banners
+	// are dismissed
+	if !model.DismissedBanners.IsNull() && !model.DismissedBanners.IsUnknown() {
+		banners := make([]types.String, 0, len(model.DismissedBanners.Elements()))
+		model.DismissedBanners.ElementsAs(context.Background(), &banners, false)
+		if allBannersDismissed(banners, settings.BannersDismissed) {
+			return
+		}
+	}
+
+	bannerValues := make([]attr.Value, len(settings.BannersDismissed))
+	for i, banner := range settings.BannersDismissed {
+		bannerValues[i] = types.StringValue(banner)
+	}
+	model.DismissedBanners = types.ListValueMust(types.StringType, bannerValues)
+}
+
+// applyMFAEnforcement enforces or lifts MFA when the configured mfa_enforced differs from prior, and stores
+// the enforcement of the instance in the model. Enforcement is left alone when mfa_enforced is not set, so
+// that the other settings can be managed without session authentication.
+func (r *SettingsResource) applyMFAEnforcement(model *SettingsResourceModel, prior types.Bool,
+	diags *diag.Diagnostics) {
+	if !model.MFAEnforced.IsNull() && !model.MFAEnforced.IsUnknown() && !model.MFAEnforced.Equal(prior) {
+		if err := r.client.EnforceMFA(model.MFAEnforced.ValueBool()); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to set MFA enforcement, got error: %s", err))
+			return
+		}
+	}
+
+	r.readMFAEnforcement(model, diags)
+}
+
+// readMFAEnforcement stores whether the instance enforces MFA in the model
+func (r *SettingsResource) readMFAEnforcement(model *SettingsResourceModel, diags *diag.Diagnostics) {
+	enforced, err := r.client.IsMFAEnforced()
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read MFA enforcement, got error: %s", err))
+		return
+	}
+	model.MFAEnforced = types.BoolValue(enforced)
+}
+
+// allBannersDismissed reports whether every banner of banners is in dismissed
+func allBannersDismissed(banners []types.String, dismissed []string) bool {
+	for _, banner := range banners {
+		if !slices.Contains(dismissed, banner.ValueString()) {
+			return false
+		}
+	}
+	return true
+}
+
+// int64PointerValue converts an optional int reported by the API into a Terraform value
+func int64PointerValue(value *int) types.Int64 {
+	if value == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*value))
+}
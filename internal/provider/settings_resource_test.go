@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+	"github.com/devops247-online/terraform-provider-n8n/internal/client/clientmock"
+)
+
+func TestAccSettingsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSettingsResourceConfig(`["V1"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("n8n_settings.test", "id", "settings"),
+					resource.TestCheckResourceAttrSet("n8n_settings.test", "prune_executions"),
+					resource.TestCheckResourceAttr("n8n_settings.test", "dismissed_banners.#", "1"),
+					resource.TestCheckResourceAttr("n8n_settings.test", "dismissed_banners.0", "V1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "n8n_settings.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"dismissed_banners"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccSettingsResourceConfig(`["V1", "NON_PRODUCTION_LICENSE"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("n8n_settings.test", "dismissed_banners.#", "2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestSettingsResource_ApplySettings(t *testing.T) {
+	var dismissed []string
+	mock := &clientmock.N8nAPI{
+		GetInstanceSettingsFunc: func() (*client.InstanceSettings, error) {
+			return &client.InstanceSettings{BannersDismissed: []string{"V1"}}, nil
+		},
+		DismissBannerFunc: func(banner string) error {
+			dismissed = append(dismissed, banner)
+			return nil
+		},
+	}
+	r := &SettingsResource{client: mock}
+
+	model := &SettingsResourceModel{
+		DismissedBanners: types.ListValueMust(types.StringType, []attr.Value{
+			types.StringValue("NON_PRODUCTION_LICENSE"),
+			types.StringValue("V1"),
+		}),
+	}
+
+	var diags diag.Diagnostics
+	r.applySettings(context.Background(), model, &diags)
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	if !reflect.DeepEqual(dismissed, []string{"NON_PRODUCTION_LICENSE"}) {
+		t.Errorf("Expected only the banner that is still shown to be dismissed, got %v", dismissed)
+	}
+	if len(model.DismissedBanners.Elements()) != 2 || model.DismissedBanners.Elements()[0] != types.StringValue("NON_PRODUCTION_LICENSE") {
+		t.Errorf("Expected the configured banners to be kept, got %v", model.DismissedBanners)
+	}
+}
+
+func TestSettingsResource_UpdateModelFromSettings(t *testing.T) {
+	r := &SettingsResource{}
+
+	pruning := true
+	maxAge := 336
+	model := &SettingsResourceModel{
+		PruneExecutions:  types.BoolUnknown(),
+		PruneMaxAge:      types.Int64Unknown(),
+		PruneMaxCount:    types.Int64Value(1000),
+		TelemetryEnabled: types.BoolValue(true),
+		DismissedBanners: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("V1")}),
+	}
+
+	r.updateModelFromSettings(model, &client.InstanceSettings{
+		ExecutionsDataPruning: &pruning,
+		ExecutionsDataMaxAge:  &maxAge,
+		BannersDismissed:      []string{"TRIAL"},
+	})
+
+	if model.ID.ValueString() != "settings" {
+		t.Errorf("Expected singleton ID 'settings', got %q", model.ID.ValueString())
+	}
+	if !model.PruneExecutions.ValueBool() || model.PruneMaxAge.ValueInt64() != 336 {
+		t.Error("Expected returned settings to be stored in the model")
+	}
+	if !model.PruneMaxCount.IsNull() || !model.TelemetryEnabled.IsNull() {
+		t.Error("Expected settings missing from the response to become null instead of keeping prior values")
+	}
+	expected := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("TRIAL")})
+	if !model.DismissedBanners.Equal(expected) {
+		t.Errorf("Expected the dismissed banners of the instance after drift, got %v", model.DismissedBanners)
+	}
+}
+
+func TestSettingsResource_ApplyMFAEnforcement(t *testing.T) {
+	enforced := false
+	mock := &clientmock.N8nAPI{
+		EnforceMFAFunc: func(enforce bool) error {
+			enforced = enforce
+			return nil
+		},
+		IsMFAEnforcedFunc: func() (bool, error) {
+			return enforced, nil
+		},
+	}
+	r := &SettingsResource{client: mock}
+
+	tests := []struct {
+		name     string
+		planned  types.Bool
+		prior    types.Bool
+		expected []string
+	}{
+		{name: "not configured", planned: types.BoolNull(), prior: types.BoolNull(), expected: []string{"IsMFAEnforced"}},
+		{name: "enforced", planned: types.BoolValue(true), prior: types.BoolNull(), expected: []string{"EnforceMFA", "IsMFAEnforced"}},
+		{name: "unchanged", planned: types.BoolValue(true), prior: types.BoolValue(true), expected: []string{"IsMFAEnforced"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock.Calls = nil
+			model := &SettingsResourceModel{MFAEnforced: tt.planned}
+
+			var diags diag.Diagnostics
+			r.applyMFAEnforcement(model, tt.prior, &diags)
+			if diags.HasError() {
+				t.Fatalf("Unexpected diagnostics: %v", diags)
+			}
+			if !reflect.DeepEqual(mock.Calls, tt.expected) {
+				t.Errorf("Expected calls %v, got %v", tt.expected, mock.Calls)
+			}
+			if !model.MFAEnforced.Equal(types.BoolValue(enforced)) {
+				t.Errorf("Expected the enforcement of the instance, got %v", model.MFAEnforced)
+			}
+		})
+	}
+}
+
+func testAccSettingsResourceConfig(banners string) string {
+	return fmt.Sprintf(`
+resource "n8n_settings" "test" {
+  dismissed_banners = %s
+}
+`, banners)
+}
@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &TagDataSource{}
+
+func NewTagDataSource() datasource.DataSource {
+	return &TagDataSource{}
+}
+
+// TagDataSource defines the data source implementation.
+type TagDataSource struct {
+	client client.N8nAPI
+}
+
+// TagDataSourceModel describes the data source data model.
+type TagDataSourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	UsageCount types.Int64  `tfsdk:"usage_count"`
+	CreatedAt  types.String `tfsdk:"created_at"`
+	UpdatedAt  types.String `tfsdk:"updated_at"`
+}
+
+func (d *TagDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tag"
+}
+
+func (d *TagDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up an existing n8n tag by name, e.g. to reference a tag another team manages.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Tag identifier",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the tag",
+				Required:            true,
+			},
+			"usage_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of workflows with the tag. Only reported through the internal API, " +
+					"e.g. with session authentication; null otherwise.",
+				Computed: true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the tag was created",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the tag was last updated",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *TagDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.N8nAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.N8nAPI, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *TagDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TagDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags, err := d.client.GetAllTags()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list tags, got error: %s", err))
+		return
+	}
+
+	name := data.Name.ValueString()
+	var found *client.Tag
+	for i := range tags {
+		if tags[i].Name == name {
+			found = &tags[i]
+			break
+		}
+	}
+
+	if found == nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "Tag Not Found", fmt.Sprintf("No tag found with name: %s", name))
+		return
+	}
+
+	data.ID = types.StringValue(found.ID)
+	data.UsageCount = tagUsageCount(found)
+	data.CreatedAt = tagTimestamp(found.CreatedAt)
+	data.UpdatedAt = tagTimestamp(found.UpdatedAt)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+	"github.com/devops247-online/terraform-provider-n8n/internal/client/clientmock"
+)
+
+func TestTagDataSource_Read(t *testing.T) {
+	ctx := context.Background()
+	usageCount := 4
+
+	d := &TagDataSource{client: &clientmock.N8nAPI{
+		GetAllTagsFunc: func() ([]client.Tag, error) {
+			return []client.Tag{{ID: "t1", Name: "Prod"}, {ID: "t2", Name: "prod", UsageCount: &usageCount}}, nil
+		},
+	}}
+
+	var schemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+
+	read := func(name string) *datasource.ReadResponse {
+		config := TagDataSourceModel{
+			ID:         types.StringNull(),
+			Name:       types.StringValue(name),
+			UsageCount: types.Int64Null(),
+			CreatedAt:  types.StringNull(),
+			UpdatedAt:  types.StringNull(),
+		}
+		state := tfsdk.State{Schema: schemaResp.Schema}
+		if diags := state.Set(ctx, &config); diags.HasError() {
+			t.Fatalf("Unexpected diagnostics: %v", diags)
+		}
+
+		resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+		d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: state.Raw}}, resp)
+		return resp
+	}
+
+	// Names are matched exactly
+	resp := read("prod")
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	var result TagDataSourceModel
+	resp.State.Get(ctx, &result)
+	if result.ID.ValueString() != "t2" || result.UsageCount.ValueInt64() != 4 || !result.CreatedAt.IsNull() {
+		t.Errorf("Expected the tag named prod, got %+v", result)
+	}
+
+	resp = read("billing")
+	if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), "billing") {
+		t.Errorf("Expected an error for a missing tag, got %v", resp.Diagnostics)
+	}
+}
@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &TagsDataSource{}
+
+func NewTagsDataSource() datasource.DataSource {
+	return &TagsDataSource{}
+}
+
+// TagsDataSource defines the data source implementation.
+type TagsDataSource struct {
+	client client.N8nAPI
+}
+
+// TagsDataSourceModel describes the data source data model.
+type TagsDataSourceModel struct {
+	Tags types.List `tfsdk:"tags"`
+}
+
+// tagAttrTypes describes the object type of each tags entry
+func tagAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":          types.StringType,
+		"name":        types.StringType,
+		"usage_count": types.Int64Type,
+		"created_at":  types.StringType,
+		"updated_at":  types.StringType,
+	}
+}
+
+func (d *TagsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tags"
+}
+
+func (d *TagsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists every tag of the n8n instance, following pagination.",
+
+		Attributes: map[string]schema.Attribute{
+			"tags": schema.ListNestedAttribute{
+				MarkdownDescription: "Tags of the instance, in the order n8n returns them",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Tag identifier",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the tag",
+							Computed:            true,
+						},
+						"usage_count": schema.Int64Attribute{
+							MarkdownDescription: "Number of workflows with the tag. Only reported through the internal " +
+								"API, e.g. with session authentication; null otherwise.",
+							Computed: true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "Timestamp when the tag was created",
+							Computed:            true,
+						},
+						"updated_at": schema.StringAttribute{
+							MarkdownDescription: "Timestamp when the tag was last updated",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *TagsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.N8nAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.N8nAPI, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *TagsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TagsDataSourceModel
+
+	tags, err := d.client.GetAllTags()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list tags, got error: %s", err))
+		return
+	}
+
+	values := make([]attr.Value, 0, len(tags))
+	for i := range tags {
+		values = append(values, types.ObjectValueMust(tagAttrTypes(), map[string]attr.Value{
+			"id":          types.StringValue(tags[i].ID),
+			"name":        types.StringValue(tags[i].Name),
+			"usage_count": tagUsageCount(&tags[i]),
+			"created_at":  tagTimestamp(tags[i].CreatedAt),
+			"updated_at":  tagTimestamp(tags[i].UpdatedAt),
+		}))
+	}
+	data.Tags = types.ListValueMust(types.ObjectType{AttrTypes: tagAttrTypes()}, values)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// tagUsageCount returns the usage count of a tag, or null if n8n did not report it
+func tagUsageCount(tag *client.Tag) types.Int64 {
+	if tag.UsageCount == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*tag.UsageCount))
+}
+
+// tagTimestamp formats a timestamp of a tag, or returns null if n8n did not report it
+func tagTimestamp(timestamp *time.Time) types.String {
+	if timestamp == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(timestamp.Format("2006-01-02T15:04:05Z"))
+}
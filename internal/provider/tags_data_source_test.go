@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+	"github.com/devops247-online/terraform-provider-n8n/internal/client/clientmock"
+)
+
+func TestTagsDataSource_Read(t *testing.T) {
+	ctx := context.Background()
+	createdAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	d := &TagsDataSource{client: &clientmock.N8nAPI{
+		GetAllTagsFunc: func() ([]client.Tag, error) {
+			return []client.Tag{{ID: "t1", Name: "billing", CreatedAt: &createdAt}, {ID: "t2", Name: "prod"}}, nil
+		},
+	}}
+
+	var schemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(ctx, datasource.ReadRequest{}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var result TagsDataSourceModel
+	resp.State.Get(ctx, &result)
+	if len(result.Tags.Elements()) != 2 {
+		t.Fatalf("Expected 2 tags, got %v", result.Tags)
+	}
+
+	tag := result.Tags.Elements()[0].(types.Object).Attributes()
+	if tag["name"].(types.String).ValueString() != "billing" ||
+		tag["created_at"].(types.String).ValueString() != "2025-01-02T03:04:05Z" || !tag["usage_count"].IsNull() {
+		t.Errorf("Expected the first tag without a usage count, got %v", tag)
+	}
+}
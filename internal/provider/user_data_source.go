@@ -21,21 +21,22 @@ func NewUserDataSource() datasource.DataSource {
 
 // UserDataSource defines the data source implementation.
 type UserDataSource struct {
-	client *client.Client
+	client client.N8nAPI
 }
 
 // UserDataSourceModel describes the data source data model.
 type UserDataSourceModel struct {
-	ID        types.String `tfsdk:"id"`
-	Email     types.String `tfsdk:"email"`
-	FirstName types.String `tfsdk:"first_name"`
-	LastName  types.String `tfsdk:"last_name"`
-	Role      types.String `tfsdk:"role"`
-	IsOwner   types.Bool   `tfsdk:"is_owner"`
-	IsPending types.Bool   `tfsdk:"is_pending"`
-	Settings  types.Object `tfsdk:"settings"`
-	CreatedAt types.String `tfsdk:"created_at"`
-	UpdatedAt types.String `tfsdk:"updated_at"`
+	ID         types.String `tfsdk:"id"`
+	Email      types.String `tfsdk:"email"`
+	FirstName  types.String `tfsdk:"first_name"`
+	LastName   types.String `tfsdk:"last_name"`
+	Role       types.String `tfsdk:"role"`
+	IsOwner    types.Bool   `tfsdk:"is_owner"`
+	IsPending  types.Bool   `tfsdk:"is_pending"`
+	MFAEnabled types.Bool   `tfsdk:"mfa_enabled"`
+	Settings   types.Object `tfsdk:"settings"`
+	CreatedAt  types.String `tfsdk:"created_at"`
+	UpdatedAt  types.String `tfsdk:"updated_at"`
 }
 
 func (d *UserDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest,
@@ -78,6 +79,10 @@ func (d *UserDataSource) Schema(ctx context.Context, req datasource.SchemaReques
 				MarkdownDescription: "Whether the user invitation is pending",
 				Computed:            true,
 			},
+			"mfa_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user has set up multi-factor authentication",
+				Computed:            true,
+			},
 			"settings": schema.SingleNestedAttribute{
 				MarkdownDescription: "User-specific settings",
 				Computed:            true,
@@ -111,12 +116,12 @@ func (d *UserDataSource) Configure(ctx context.Context, req datasource.Configure
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.N8nAPI)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.",
+			fmt.Sprintf("Expected client.N8nAPI, got: %T. Please report this issue to the provider developers.",
 				req.ProviderData),
 		)
 
@@ -157,7 +162,7 @@ func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		}
 	} else {
 		// Look up user by email - we need to list users and find the one with matching email
-		users, err := d.client.GetUsers(nil)
+		users, err := d.client.GetAllUsers(&client.UserListOptions{Limit: client.MaxPageSize})
 		if err != nil {
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list users, got error: %s", err))
 			return
@@ -165,7 +170,7 @@ func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 
 		emailToFind := data.Email.ValueString()
 		var foundUser *client.User
-		for _, u := range users.Data {
+		for _, u := range users {
 			if u.Email == emailToFind {
 				foundUser = &u
 				break
@@ -205,6 +210,7 @@ func (d *UserDataSource) updateModelFromUser(model *UserDataSourceModel, user *c
 
 	model.IsOwner = types.BoolValue(user.IsOwner)
 	model.IsPending = types.BoolValue(user.IsPending)
+	model.MFAEnabled = types.BoolValue(user.MFAEnabled)
 
 	// Handle settings
 	if user.Settings.Theme != "" || user.Settings.AllowSSOManualLogin {
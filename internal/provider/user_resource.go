@@ -3,8 +3,11 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -19,6 +22,8 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &UserResource{}
 var _ resource.ResourceWithImportState = &UserResource{}
+var _ resource.ResourceWithValidateConfig = &UserResource{}
+var _ resource.ResourceWithModifyPlan = &UserResource{}
 
 func NewUserResource() resource.Resource {
 	return &UserResource{}
@@ -26,22 +31,26 @@ func NewUserResource() resource.Resource {
 
 // UserResource defines the resource implementation.
 type UserResource struct {
-	client *client.Client
+	client client.N8nAPI
 }
 
 // UserResourceModel describes the resource data model.
 type UserResourceModel struct {
-	ID        types.String `tfsdk:"id"`
-	Email     types.String `tfsdk:"email"`
-	FirstName types.String `tfsdk:"first_name"`
-	LastName  types.String `tfsdk:"last_name"`
-	Role      types.String `tfsdk:"role"`
-	Password  types.String `tfsdk:"password"`
-	IsOwner   types.Bool   `tfsdk:"is_owner"`
-	IsPending types.Bool   `tfsdk:"is_pending"`
-	Settings  types.Object `tfsdk:"settings"`
-	CreatedAt types.String `tfsdk:"created_at"`
-	UpdatedAt types.String `tfsdk:"updated_at"`
+	ID                 types.String `tfsdk:"id"`
+	Email              types.String `tfsdk:"email"`
+	FirstName          types.String `tfsdk:"first_name"`
+	LastName           types.String `tfsdk:"last_name"`
+	Role               types.String `tfsdk:"role"`
+	Password           types.String `tfsdk:"password"`
+	PasswordWO         types.String `tfsdk:"password_wo"`
+	PasswordVersion    types.Int64  `tfsdk:"password_version"`
+	IsOwner            types.Bool   `tfsdk:"is_owner"`
+	IsPending          types.Bool   `tfsdk:"is_pending"`
+	MFAEnabled         types.Bool   `tfsdk:"mfa_enabled"`
+	Settings           types.Object `tfsdk:"settings"`
+	OnDeleteTransferTo types.String `tfsdk:"on_delete_transfer_to"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+	UpdatedAt          types.String `tfsdk:"updated_at"`
 }
 
 func (r *UserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -74,18 +83,31 @@ func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Optional:            true,
 			},
 			"role": schema.StringAttribute{
-				MarkdownDescription: "User role (e.g., 'admin', 'member', 'editor'). If not specified, " +
-					"defaults to the instance default role.",
+				MarkdownDescription: "Global role of the user (e.g., 'global:admin', 'global:member'), which must be " +
+					"one of the roles of the instance. The names of n8n versions before 1.0, e.g. 'admin', are accepted " +
+					"and kept as configured. If not specified, defaults to the instance default role.",
 				Optional: true,
 				Computed: true,
 			},
 			"password": schema.StringAttribute{
-				MarkdownDescription: "User password. This is sensitive data and will not be stored in the state after creation.",
-				Optional:            true,
-				Sensitive:           true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
-				},
+				MarkdownDescription: "User password. It is stored in the state as sensitive data; use `password_wo` to keep " +
+					"it out of the state. Changing it sets the new password, which requires session authentication.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"password_wo": schema.StringAttribute{
+				MarkdownDescription: "Write-only alternative to `password`, which is never stored in the Terraform plan or " +
+					"state. Requires Terraform 1.11 or later. The password is only set when the user is created or " +
+					"`password_version` changes, so rotating it is always deliberate. Setting the password of an existing " +
+					"user requires session authentication.",
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+			},
+			"password_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of the `password_wo` value. Changing it sets the user's password to the " +
+					"current `password_wo`.",
+				Optional: true,
 			},
 			"is_owner": schema.BoolAttribute{
 				MarkdownDescription: "Whether the user is an owner of the n8n instance",
@@ -95,6 +117,12 @@ func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				MarkdownDescription: "Whether the user invitation is pending",
 				Computed:            true,
 			},
+			"mfa_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user has set up multi-factor authentication. Use `mfa_enforced` of " +
+					"`n8n_settings` to require it for all users. n8n has no API to disable MFA for another user; run " +
+					"`n8n mfa:disable --email=<email>` on the instance instead, e.g. when the user lost their device.",
+				Computed: true,
+			},
 			"settings": schema.SingleNestedAttribute{
 				MarkdownDescription: "User-specific settings",
 				Optional:            true,
@@ -110,6 +138,12 @@ func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 					},
 				},
 			},
+			"on_delete_transfer_to": schema.StringAttribute{
+				MarkdownDescription: "ID of the project, e.g. the personal project of another user, that the workflows " +
+					"and credentials the user owns are moved to when the user is destroyed. If not specified, n8n deletes " +
+					"them with the user. It must be applied before the user is destroyed to take effect.",
+				Optional: true,
+			},
 			"created_at": schema.StringAttribute{
 				MarkdownDescription: "Timestamp when the user was created",
 				Computed:            true,
@@ -128,12 +162,12 @@ func (r *UserResource) Configure(ctx context.Context, req resource.ConfigureRequ
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.N8nAPI)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.",
+			fmt.Sprintf("Expected client.N8nAPI, got: %T. Please report this issue to the provider developers.",
 				req.ProviderData),
 		)
 
@@ -153,14 +187,24 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	// Write-only password is only available in the configuration
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &data.PasswordWO)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create user request object
 	createReq := &client.CreateUserRequest{
 		Email:     data.Email.ValueString(),
 		FirstName: data.FirstName.ValueString(),
 		LastName:  data.LastName.ValueString(),
-		Role:      data.Role.ValueString(),
+		Role:      client.NormalizeGlobalRole(data.Role.ValueString()),
 		Password:  data.Password.ValueString(),
 	}
+	if !data.PasswordWO.IsNull() {
+		createReq.Password = data.PasswordWO.ValueString()
+	}
 
 	// Create user via API
 	createdUser, err := r.client.CreateUser(createReq)
@@ -179,8 +223,6 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 	// Update model with complete user data
 	r.updateModelFromUser(&data, completeUser)
 
-	// Keep password in state (it's marked as sensitive, so it's secure)
-
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -198,6 +240,10 @@ func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	// Get user from API
 	user, err := r.client.GetUser(data.ID.ValueString())
 	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user, got error: %s", err))
 		return
 	}
@@ -230,7 +276,7 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		Email:     data.Email.ValueString(),
 		FirstName: data.FirstName.ValueString(),
 		LastName:  data.LastName.ValueString(),
-		Role:      data.Role.ValueString(),
+		Role:      client.NormalizeGlobalRole(data.Role.ValueString()),
 	}
 
 	// Handle settings if provided
@@ -243,6 +289,14 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		user.Settings = settings
 	}
 
+	var state UserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &data.PasswordWO)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update user via API
 	updatedUser, err := r.client.UpdateUser(data.ID.ValueString(), user)
 	if err != nil {
@@ -253,13 +307,70 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	// Update model with response data
 	r.updateModelFromUser(&data, updatedUser)
 
-	// Clear the password from state for security (it's not returned by the API)
-	data.Password = types.StringNull()
+	if password, ok := changedPassword(&data, &state); ok {
+		if err := r.client.SetUserPassword(data.ID.ValueString(), password); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set user password, got error: %s", err))
+
+			// Keep the previous password in state, so that setting it is retried on the next apply
+			data.Password = state.Password
+			data.PasswordVersion = state.PasswordVersion
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// ValidateConfig checks that the password is configured through only one of password and password_wo
+func (r *UserResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse) {
+	var data UserResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Password.IsNull() && !data.PasswordWO.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("password_wo"), "Conflicting Attributes",
+			"Only one of password and password_wo can be set.")
+	}
+	if !data.PasswordVersion.IsNull() && data.PasswordWO.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("password_version"), "Missing Attribute",
+			"password_version can only be set together with password_wo.")
+	}
+}
+
+// ModifyPlan checks that the configured role is a global role of the instance. Without session
+// authentication the roles cannot be listed, so the built-in roles are assumed.
+func (r *UserResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var role types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("role"), &role)...)
+
+	if resp.Diagnostics.HasError() || role.IsNull() || role.IsUnknown() {
+		return
+	}
+
+	roles := client.GlobalRoles
+	if r.client != nil {
+		if instanceRoles, err := r.client.GetGlobalRoles(); err == nil {
+			roles = instanceRoles
+		}
+	}
+
+	checkUserRole(role.ValueString(), roles, &resp.Diagnostics)
+}
+
 func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var data UserResourceModel
 
@@ -270,8 +381,13 @@ func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	// Delete user via API
-	err := r.client.DeleteUser(data.ID.ValueString())
+	// Delete user via API, moving the workflows and credentials they own if requested
+	var err error
+	if transferTo := data.OnDeleteTransferTo.ValueString(); transferTo != "" {
+		err = r.client.DeleteUserWithTransfer(data.ID.ValueString(), transferTo)
+	} else {
+		err = r.client.DeleteUser(data.ID.ValueString())
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete user, got error: %s", err))
 		return
@@ -283,25 +399,74 @@ func (r *UserResource) ImportState(ctx context.Context, req resource.ImportState
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+// changedPassword returns the password to set on update: the write-only password when its version changed,
+// or the password when it changed. Removing a password does not change it in n8n.
+func changedPassword(plan, state *UserResourceModel) (string, bool) {
+	if !plan.PasswordWO.IsNull() {
+		if plan.PasswordVersion.Equal(state.PasswordVersion) {
+			return "", false
+		}
+		return plan.PasswordWO.ValueString(), true
+	}
+
+	if plan.Password.IsNull() || plan.Password.Equal(state.Password) {
+		return "", false
+	}
+	return plan.Password.ValueString(), true
+}
+
+// checkUserRole reports an error if a role, in the naming of any n8n version, is not one of roles or is the
+// owner role, which cannot be assigned
+func checkUserRole(role string, roles []string, diags *diag.Diagnostics) {
+	normalized := client.NormalizeGlobalRole(role)
+
+	switch {
+	case normalized == client.GlobalRoleOwner:
+		diags.AddAttributeError(path.Root("role"), "Invalid Role",
+			"The owner role cannot be assigned: an n8n instance has a single owner, which is set up with n8n_instance_owner.")
+	case !slices.Contains(roles, normalized):
+		assignable := slices.DeleteFunc(slices.Clone(roles), func(r string) bool { return r == client.GlobalRoleOwner })
+		diags.AddAttributeError(path.Root("role"), "Invalid Role",
+			fmt.Sprintf("%q is not a global role of the n8n instance, must be one of: %s", role,
+				strings.Join(assignable, ", ")))
+	}
+}
+
+// roleValue returns the value of a role attribute for a role returned by n8n. The prior value is kept if
+// normalize maps both to the same role, e.g. admin and global:admin, which differ between n8n versions, so
+// that it does not show up as a change.
+func roleValue(prior types.String, role string, normalize func(string) string) types.String {
+	if !prior.IsNull() && !prior.IsUnknown() && normalize(prior.ValueString()) == normalize(role) {
+		return prior
+	}
+	return types.StringValue(role)
+}
+
+// optionalStringValue converts a string n8n returns empty when unset into the value of an optional
+// attribute, keeping an empty prior value
+func optionalStringValue(prior types.String, value string) types.String {
+	if value == "" && (prior.IsNull() || prior.IsUnknown() || prior.ValueString() != "") {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
 // Helper function to update model from API response
 func (r *UserResource) updateModelFromUser(model *UserResourceModel, user *client.User) {
 	model.ID = types.StringValue(user.ID)
 	model.Email = types.StringValue(user.Email)
 
-	if user.FirstName != "" {
-		model.FirstName = types.StringValue(user.FirstName)
-	}
-
-	if user.LastName != "" {
-		model.LastName = types.StringValue(user.LastName)
-	}
+	// Names cleared in n8n are no longer set
+	model.FirstName = optionalStringValue(model.FirstName, user.FirstName)
+	model.LastName = optionalStringValue(model.LastName, user.LastName)
 
 	if user.Role != "" {
-		model.Role = types.StringValue(user.Role)
+		model.Role = roleValue(model.Role, user.Role, client.NormalizeGlobalRole)
 	}
 
 	model.IsOwner = types.BoolValue(user.IsOwner)
 	model.IsPending = types.BoolValue(user.IsPending)
+	model.MFAEnabled = types.BoolValue(user.MFAEnabled)
 
 	// Handle settings (always set to ensure known value)
 	settingsAttrs := map[string]attr.Value{
@@ -1,10 +1,21 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+	"github.com/devops247-online/terraform-provider-n8n/internal/client/clientmock"
 )
 
 func TestAccUserResource(t *testing.T) {
@@ -68,6 +79,220 @@ func TestAccUserResourceWithPassword(t *testing.T) {
 	})
 }
 
+func TestChangedPassword(t *testing.T) {
+	tests := []struct {
+		name     string
+		plan     UserResourceModel
+		state    UserResourceModel
+		want     string
+		wantOkay bool
+	}{
+		{
+			name:  "unchanged password",
+			plan:  UserResourceModel{Password: types.StringValue("secret"), PasswordWO: types.StringNull()},
+			state: UserResourceModel{Password: types.StringValue("secret")},
+		},
+		{
+			name:     "changed password",
+			plan:     UserResourceModel{Password: types.StringValue("rotated"), PasswordWO: types.StringNull()},
+			state:    UserResourceModel{Password: types.StringValue("secret")},
+			want:     "rotated",
+			wantOkay: true,
+		},
+		{
+			name:  "removed password",
+			plan:  UserResourceModel{Password: types.StringNull(), PasswordWO: types.StringNull()},
+			state: UserResourceModel{Password: types.StringValue("secret")},
+		},
+		{
+			name: "write-only password with unchanged version",
+			plan: UserResourceModel{
+				Password: types.StringNull(), PasswordWO: types.StringValue("rotated"), PasswordVersion: types.Int64Value(1),
+			},
+			state: UserResourceModel{Password: types.StringNull(), PasswordVersion: types.Int64Value(1)},
+		},
+		{
+			name: "write-only password with new version",
+			plan: UserResourceModel{
+				Password: types.StringNull(), PasswordWO: types.StringValue("rotated"), PasswordVersion: types.Int64Value(2),
+			},
+			state:    UserResourceModel{Password: types.StringNull(), PasswordVersion: types.Int64Value(1)},
+			want:     "rotated",
+			wantOkay: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := changedPassword(&tt.plan, &tt.state)
+			if got != tt.want || ok != tt.wantOkay {
+				t.Errorf("changedPassword() = %q, %v, want %q, %v", got, ok, tt.want, tt.wantOkay)
+			}
+		})
+	}
+}
+
+func TestCheckUserRole(t *testing.T) {
+	roles := []string{"global:owner", "global:admin", "global:member", "global:auditor"}
+
+	tests := []struct {
+		role     string
+		expected string
+	}{
+		{role: "global:admin"},
+		{role: "member"},
+		{role: "global:auditor"},
+		{role: "global:owner", expected: "The owner role cannot be assigned"},
+		{role: "owner", expected: "The owner role cannot be assigned"},
+		{role: "editor", expected: `"editor" is not a global role of the n8n instance, must be one of: ` +
+			"global:admin, global:member, global:auditor"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.role, func(t *testing.T) {
+			var diags diag.Diagnostics
+			checkUserRole(tt.role, roles, &diags)
+
+			if tt.expected == "" {
+				if diags.HasError() {
+					t.Errorf("Unexpected diagnostics: %v", diags)
+				}
+				return
+			}
+			if !diags.HasError() || !strings.Contains(diags[0].Detail(), tt.expected) {
+				t.Errorf("Expected error containing %q, got %v", tt.expected, diags)
+			}
+		})
+	}
+}
+
+func TestRoleValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		prior    types.String
+		role     string
+		expected types.String
+	}{
+		{"legacy name is kept", types.StringValue("admin"), "global:admin", types.StringValue("admin")},
+		{"scoped name is kept", types.StringValue("global:admin"), "global:admin", types.StringValue("global:admin")},
+		{"changed role", types.StringValue("admin"), "global:member", types.StringValue("global:member")},
+		{"unknown prior", types.StringUnknown(), "global:member", types.StringValue("global:member")},
+		{"null prior", types.StringNull(), "global:member", types.StringValue("global:member")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := roleValue(tt.prior, tt.role, client.NormalizeGlobalRole); !got.Equal(tt.expected) {
+				t.Errorf("Expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestUserResource_Delete(t *testing.T) {
+	ctx := context.Background()
+
+	var deleted, deletedWithTransfer []string
+	mock := &clientmock.N8nAPI{
+		DeleteUserFunc: func(id string) error {
+			deleted = append(deleted, id)
+			return nil
+		},
+		DeleteUserWithTransferFunc: func(id, transferToProjectID string) error {
+			deletedWithTransfer = append(deletedWithTransfer, id+"->"+transferToProjectID)
+			return nil
+		},
+	}
+	r := &UserResource{client: mock}
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	for id, transferTo := range map[string]types.String{"u1": types.StringNull(), "u2": types.StringValue("personal-2")} {
+		model := UserResourceModel{
+			ID:                 types.StringValue(id),
+			Email:              types.StringValue(id + "@example.com"),
+			Settings:           types.ObjectNull(map[string]attr.Type{"theme": types.StringType, "allow_sso_manual_login": types.BoolType}),
+			OnDeleteTransferTo: transferTo,
+		}
+		state := tfsdk.State{Schema: schemaResp.Schema}
+		if diags := state.Set(ctx, &model); diags.HasError() {
+			t.Fatalf("Unexpected diagnostics: %v", diags)
+		}
+
+		resp := &fwresource.DeleteResponse{State: state}
+		r.Delete(ctx, fwresource.DeleteRequest{State: state}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("Unexpected diagnostics: %v", resp.Diagnostics)
+		}
+	}
+
+	if strings.Join(deleted, ",") != "u1" {
+		t.Errorf("Expected only the user without on_delete_transfer_to to be deleted directly, got %v", deleted)
+	}
+	if strings.Join(deletedWithTransfer, ",") != "u2->personal-2" {
+		t.Errorf("Expected the workflows and credentials of the user to be transferred, got %v", deletedWithTransfer)
+	}
+}
+
+func TestUserResource_Read(t *testing.T) {
+	ctx := context.Background()
+
+	users := map[string]*client.User{
+		"u1": {ID: "u1", Email: "jane@example.com", FirstName: "Jane", Role: "global:member", MFAEnabled: true},
+	}
+	mock := &clientmock.N8nAPI{
+		GetUserFunc: func(id string) (*client.User, error) {
+			if user, ok := users[id]; ok {
+				return user, nil
+			}
+			return nil, &client.APIError{Code: http.StatusNotFound, Message: "Not Found"}
+		},
+	}
+	r := &UserResource{client: mock}
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	for _, id := range []string{"u1", "u2"} {
+		model := UserResourceModel{
+			ID:        types.StringValue(id),
+			Email:     types.StringValue("jane@example.com"),
+			FirstName: types.StringValue("Jane"),
+			LastName:  types.StringValue("Doe"),
+			Role:      types.StringValue("global:member"),
+			Settings:  types.ObjectNull(map[string]attr.Type{"theme": types.StringType, "allow_sso_manual_login": types.BoolType}),
+		}
+		state := tfsdk.State{Schema: schemaResp.Schema}
+		if diags := state.Set(ctx, &model); diags.HasError() {
+			t.Fatalf("Unexpected diagnostics: %v", diags)
+		}
+
+		resp := &fwresource.ReadResponse{State: state}
+		r.Read(ctx, fwresource.ReadRequest{State: state}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("Unexpected diagnostics: %v", resp.Diagnostics)
+		}
+
+		if id == "u2" {
+			if !resp.State.Raw.IsNull() {
+				t.Error("Expected the deleted user to be removed from state")
+			}
+			continue
+		}
+
+		// A last name cleared in n8n is no longer set
+		var read UserResourceModel
+		resp.State.Get(ctx, &read)
+		if read.FirstName.ValueString() != "Jane" || !read.LastName.IsNull() {
+			t.Errorf("Expected the names in n8n, got %q and %q", read.FirstName.ValueString(), read.LastName.ValueString())
+		}
+		if !read.MFAEnabled.ValueBool() {
+			t.Error("Expected the user to have MFA enabled")
+		}
+	}
+}
+
 func TestAccUserResourceWithSettings(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
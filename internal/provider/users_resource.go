@@ -0,0 +1,403 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &UsersResource{}
+var _ resource.ResourceWithImportState = &UsersResource{}
+
+func NewUsersResource() resource.Resource {
+	return &UsersResource{}
+}
+
+// UsersResource defines the resource implementation.
+type UsersResource struct {
+	client client.N8nAPI
+}
+
+// UsersResourceModel describes the resource data model.
+type UsersResourceModel struct {
+	ID    types.String `tfsdk:"id"`
+	Users types.Map    `tfsdk:"users"`
+}
+
+// UsersResourceUserModel describes a single user entry keyed by email.
+type UsersResourceUserModel struct {
+	ID        types.String `tfsdk:"id"`
+	Role      types.String `tfsdk:"role"`
+	IsPending types.Bool   `tfsdk:"is_pending"`
+}
+
+// usersResourceUserAttrTypes returns the attribute types of a single user entry
+func usersResourceUserAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":         types.StringType,
+		"role":       types.StringType,
+		"is_pending": types.BoolType,
+	}
+}
+
+func (r *UsersResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (r *UsersResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a collection of n8n users. All new users are invited with a single API call, " +
+			"which makes provisioning large teams much faster than one `n8n_user` resource per person.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "User collection identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"users": schema.MapNestedAttribute{
+				MarkdownDescription: "Users to invite, keyed by email address",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "User identifier",
+							Computed:            true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"role": schema.StringAttribute{
+							MarkdownDescription: "User role (e.g., 'global:member', 'global:admin'). Defaults to 'global:member'.",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("global:member"),
+						},
+						"is_pending": schema.BoolAttribute{
+							MarkdownDescription: "Whether the user invitation is pending",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *UsersResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.N8nAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.N8nAPI, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *UsersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data UsersResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planned := make(map[string]UsersResourceUserModel)
+	resp.Diagnostics.Append(data.Users.ElementsAs(ctx, &planned, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Invite all users with a single API call
+	created, ok := r.inviteUsers(planned, &resp.Diagnostics)
+
+	data.ID = types.StringValue(usersCollectionID(sortedUserEmails(planned)))
+	resp.Diagnostics.Append(r.setUsers(ctx, &data, created)...)
+
+	// Save data into Terraform state even on partial failure so invited users are tracked
+	if len(created) > 0 || ok {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
+}
+
+func (r *UsersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UsersResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current := make(map[string]UsersResourceUserModel)
+	resp.Diagnostics.Append(data.Users.ElementsAs(ctx, &current, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// List all users once instead of fetching each user individually
+	users, err := r.client.GetAllUsers(&client.UserListOptions{IncludeRole: true, Limit: client.MaxPageSize})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list users, got error: %s", err))
+		return
+	}
+
+	byEmail := make(map[string]client.User, len(users))
+	for _, user := range users {
+		byEmail[strings.ToLower(user.Email)] = user
+	}
+
+	refreshed := make(map[string]UsersResourceUserModel, len(current))
+	for email, entry := range current {
+		user, found := byEmail[strings.ToLower(email)]
+		if !found {
+			// User was removed outside of Terraform, drop it so it is re-invited
+			continue
+		}
+
+		entry.ID = types.StringValue(user.ID)
+		entry.IsPending = types.BoolValue(user.IsPending)
+		// Keep the prior role if n8n leaves it out of the listed user
+		if user.Role != "" {
+			entry.Role = roleValue(entry.Role, user.Role, client.NormalizeGlobalRole)
+		}
+		refreshed[email] = entry
+	}
+
+	if len(refreshed) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(r.setUsers(ctx, &data, refreshed)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UsersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data UsersResourceModel
+	var state UsersResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planned := make(map[string]UsersResourceUserModel)
+	resp.Diagnostics.Append(data.Users.ElementsAs(ctx, &planned, false)...)
+	current := make(map[string]UsersResourceUserModel)
+	resp.Diagnostics.Append(state.Users.ElementsAs(ctx, &current, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result := make(map[string]UsersResourceUserModel, len(planned))
+
+	// Remove users that are no longer configured
+	for email, entry := range current {
+		if _, keep := planned[email]; keep {
+			continue
+		}
+
+		if err := r.client.DeleteUser(entry.ID.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Client Error",
+				fmt.Sprintf("Unable to delete user %s, got error: %s", email, err))
+			result[email] = entry
+		}
+	}
+
+	// Update roles of users that already exist
+	toInvite := make(map[string]UsersResourceUserModel)
+	for email, entry := range planned {
+		existing, exists := current[email]
+		if !exists {
+			toInvite[email] = entry
+			continue
+		}
+
+		entry.ID = existing.ID
+		entry.IsPending = existing.IsPending
+
+		if role := client.NormalizeGlobalRole(entry.Role.ValueString()); role != client.NormalizeGlobalRole(existing.Role.ValueString()) {
+			if err := r.client.ChangeUserRole(existing.ID.ValueString(), role); err != nil {
+				resp.Diagnostics.AddError("Client Error",
+					fmt.Sprintf("Unable to update role for user %s, got error: %s", email, err))
+				entry.Role = existing.Role
+			}
+		}
+
+		result[email] = entry
+	}
+
+	// Invite new users with a single API call
+	if len(toInvite) > 0 {
+		created, _ := r.inviteUsers(toInvite, &resp.Diagnostics)
+		for email, entry := range created {
+			result[email] = entry
+		}
+	}
+
+	data.ID = state.ID
+	resp.Diagnostics.Append(r.setUsers(ctx, &data, result)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UsersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data UsersResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current := make(map[string]UsersResourceUserModel)
+	resp.Diagnostics.Append(data.Users.ElementsAs(ctx, &current, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete users via API
+	for _, email := range sortedUserEmails(current) {
+		if err := r.client.DeleteUser(current[email].ID.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Client Error",
+				fmt.Sprintf("Unable to delete user %s, got error: %s", email, err))
+		}
+	}
+}
+
+func (r *UsersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import ID is a comma-separated list of user emails
+	users := make(map[string]UsersResourceUserModel)
+	for _, email := range strings.Split(req.ID, ",") {
+		email = strings.TrimSpace(email)
+		if email == "" {
+			continue
+		}
+		users[email] = UsersResourceUserModel{
+			ID:        types.StringNull(),
+			Role:      types.StringNull(),
+			IsPending: types.BoolNull(),
+		}
+	}
+
+	if len(users) == 0 {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			"Expected a comma-separated list of user emails, e.g. \"alice@example.com,bob@example.com\".",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), usersCollectionID(sortedUserEmails(users)))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("users"), users)...)
+}
+
+// inviteUsers invites the given users in one batch request and returns the successfully created entries.
+// Per-user failures are reported as diagnostics; the returned bool is false if any invite failed.
+func (r *UsersResource) inviteUsers(users map[string]UsersResourceUserModel,
+	diags *diag.Diagnostics) (map[string]UsersResourceUserModel, bool) {
+	emails := sortedUserEmails(users)
+
+	userReqs := make([]*client.CreateUserRequest, len(emails))
+	for i, email := range emails {
+		userReqs[i] = &client.CreateUserRequest{
+			Email: email,
+			Role:  client.NormalizeGlobalRole(users[email].Role.ValueString()),
+		}
+	}
+
+	results, err := r.client.CreateUsers(userReqs)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to invite users, got error: %s", err))
+		return nil, false
+	}
+
+	resultsByEmail := make(map[string]client.CreateUserResult, len(results))
+	for _, result := range results {
+		resultsByEmail[strings.ToLower(result.User.Email)] = result
+	}
+
+	created := make(map[string]UsersResourceUserModel, len(emails))
+	ok := true
+	for _, email := range emails {
+		result, found := resultsByEmail[strings.ToLower(email)]
+		switch {
+		case !found:
+			diags.AddError("Client Error", fmt.Sprintf("No invitation result returned for user %s", email))
+			ok = false
+		case result.Error != "" || result.User.ID == "":
+			diags.AddError("Client Error", fmt.Sprintf("Unable to invite user %s, got error: %s", email, result.Error))
+			ok = false
+		default:
+			entry := users[email]
+			entry.ID = types.StringValue(result.User.ID)
+			entry.IsPending = types.BoolValue(true)
+			created[email] = entry
+		}
+	}
+
+	return created, ok
+}
+
+// setUsers stores the given user entries on the model
+func (r *UsersResource) setUsers(ctx context.Context, model *UsersResourceModel,
+	users map[string]UsersResourceUserModel) diag.Diagnostics {
+	value, diags := types.MapValueFrom(ctx, types.ObjectType{AttrTypes: usersResourceUserAttrTypes()}, users)
+	model.Users = value
+	return diags
+}
+
+// sortedUserEmails returns the emails of the given users in a deterministic order
+func sortedUserEmails(users map[string]UsersResourceUserModel) []string {
+	emails := make([]string, 0, len(users))
+	for email := range users {
+		emails = append(emails, email)
+	}
+	sort.Strings(emails)
+	return emails
+}
+
+// usersCollectionID derives a stable identifier from the initial set of user emails
+func usersCollectionID(emails []string) string {
+	hash := sha256.Sum256([]byte(strings.ToLower(strings.Join(emails, ","))))
+	return fmt.Sprintf("users-%x", hash[:8])
+}
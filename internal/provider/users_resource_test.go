@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+	"github.com/devops247-online/terraform-provider-n8n/internal/client/clientmock"
+)
+
+func TestAccUsersResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccUsersResourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("n8n_users.test", "id"),
+					resource.TestCheckResourceAttr("n8n_users.test", "users.%", "2"),
+					resource.TestCheckResourceAttr("n8n_users.test", "users.bulk-one@example.com.role", "global:member"),
+					resource.TestCheckResourceAttr("n8n_users.test", "users.bulk-two@example.com.role", "global:admin"),
+					resource.TestCheckResourceAttrSet("n8n_users.test", "users.bulk-one@example.com.id"),
+					resource.TestCheckResourceAttrSet("n8n_users.test", "users.bulk-two@example.com.id"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccUsersResourceConfigUpdated(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("n8n_users.test", "users.%", "2"),
+					resource.TestCheckResourceAttr("n8n_users.test", "users.bulk-one@example.com.role", "global:member"),
+					resource.TestCheckResourceAttr("n8n_users.test", "users.bulk-three@example.com.role", "global:member"),
+					resource.TestCheckResourceAttrSet("n8n_users.test", "users.bulk-three@example.com.id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccUsersResourceConfig() string {
+	return `
+resource "n8n_users" "test" {
+  users = {
+    "bulk-one@example.com" = {}
+    "bulk-two@example.com" = {
+      role = "global:admin"
+    }
+  }
+}
+`
+}
+
+func testAccUsersResourceConfigUpdated() string {
+	return `
+resource "n8n_users" "test" {
+  users = {
+    "bulk-one@example.com"   = {}
+    "bulk-three@example.com" = {
+      role = "global:member"
+    }
+  }
+}
+`
+}
+
+func TestUsersResource_ReadKeepsRoleMissingFromList(t *testing.T) {
+	ctx := context.Background()
+
+	var options *client.UserListOptions
+	mock := &clientmock.N8nAPI{
+		GetAllUsersFunc: func(opts *client.UserListOptions) ([]client.User, error) {
+			options = opts
+			return []client.User{{ID: "user-1", Email: "alice@example.com", IsPending: true}}, nil
+		},
+	}
+	r := &UsersResource{client: mock}
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	model := UsersResourceModel{ID: types.StringValue("users-1")}
+	if diags := r.setUsers(ctx, &model, map[string]UsersResourceUserModel{
+		"alice@example.com": {ID: types.StringValue("user-1"), Role: types.StringValue("global:admin"), IsPending: types.BoolValue(true)},
+	}); diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	if diags := state.Set(ctx, &model); diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	resp := &fwresource.ReadResponse{State: state}
+	r.Read(ctx, fwresource.ReadRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if options == nil || !options.IncludeRole {
+		t.Error("Expected the roles of the listed users to be requested")
+	}
+
+	var refreshed UsersResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &refreshed)...)
+	users := map[string]UsersResourceUserModel{}
+	resp.Diagnostics.Append(refreshed.Users.ElementsAs(ctx, &users, false)...)
+	if role := users["alice@example.com"].Role; role.ValueString() != "global:admin" {
+		t.Errorf("Expected the prior role to be kept when n8n leaves it out, got %v", role)
+	}
+}
+
+func TestUsersResource_UpdateChangesRole(t *testing.T) {
+	ctx := context.Background()
+
+	var changedID, changedRole string
+	mock := &clientmock.N8nAPI{
+		ChangeUserRoleFunc: func(id, role string) error {
+			changedID, changedRole = id, role
+			return nil
+		},
+	}
+	r := &UsersResource{client: mock}
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	newState := func(role string) tfsdk.State {
+		model := UsersResourceModel{ID: types.StringValue("users-1")}
+		if diags := r.setUsers(ctx, &model, map[string]UsersResourceUserModel{
+			"alice@example.com": {ID: types.StringValue("user-1"), Role: types.StringValue(role), IsPending: types.BoolValue(false)},
+		}); diags.HasError() {
+			t.Fatalf("Unexpected diagnostics: %v", diags)
+		}
+		state := tfsdk.State{Schema: schemaResp.Schema}
+		if diags := state.Set(ctx, &model); diags.HasError() {
+			t.Fatalf("Unexpected diagnostics: %v", diags)
+		}
+		return state
+	}
+
+	state := newState("global:member")
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: newState("global:admin").Raw}
+	resp := &fwresource.UpdateResponse{State: state}
+	r.Update(ctx, fwresource.UpdateRequest{Plan: plan, State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if changedID != "user-1" || changedRole != "global:admin" {
+		t.Errorf("Expected the role of user-1 to be changed to global:admin, got %q for %q", changedRole, changedID)
+	}
+	if calls := []string{"ChangeUserRole"}; !reflect.DeepEqual(mock.Calls, calls) {
+		t.Errorf("Expected calls %v, got %v", calls, mock.Calls)
+	}
+}
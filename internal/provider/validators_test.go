@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestStringOneOfValidator(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     types.String
+		wantError bool
+	}{
+		{name: "allowed value", value: types.StringValue("any"), wantError: false},
+		{name: "disallowed value", value: types.StringValue("everyone"), wantError: true},
+		{name: "null value", value: types.StringNull(), wantError: false},
+		{name: "unknown value", value: types.StringUnknown(), wantError: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("test"), ConfigValue: tt.value}
+			resp := &validator.StringResponse{}
+
+			stringOneOf("any", "none").ValidateString(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Errorf("Expected error = %v, got diagnostics: %v", tt.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestStringListOneOfValidator(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     types.List
+		wantError bool
+	}{
+		{name: "allowed values", value: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("nodes")}), wantError: false},
+		{name: "disallowed value", value: types.ListValueMust(types.StringType, []attr.Value{
+			types.StringValue("nodes"), types.StringValue("network"),
+		}), wantError: true},
+		{name: "unknown element", value: types.ListValueMust(types.StringType, []attr.Value{types.StringUnknown()}), wantError: false},
+		{name: "null value", value: types.ListNull(types.StringType), wantError: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.ListRequest{Path: path.Root("test"), ConfigValue: tt.value}
+			resp := &validator.ListResponse{}
+
+			stringListOneOf("nodes", "database").ValidateList(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Errorf("Expected error = %v, got diagnostics: %v", tt.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestTimezoneValidator(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     types.String
+		wantError bool
+	}{
+		{name: "valid time zone", value: types.StringValue("Europe/Berlin"), wantError: false},
+		{name: "UTC", value: types.StringValue("UTC"), wantError: false},
+		{name: "invalid time zone", value: types.StringValue("Mars/Olympus"), wantError: true},
+		{name: "empty string", value: types.StringValue(""), wantError: true},
+		{name: "null value", value: types.StringNull(), wantError: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("timezone"), ConfigValue: tt.value}
+			resp := &validator.StringResponse{}
+
+			validTimezone().ValidateString(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Errorf("Expected error = %v, got diagnostics: %v", tt.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestInt64AtLeastValidator(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     types.Int64
+		wantError bool
+	}{
+		{name: "above minimum", value: types.Int64Value(3600), wantError: false},
+		{name: "equal to minimum", value: types.Int64Value(-1), wantError: false},
+		{name: "below minimum", value: types.Int64Value(-5), wantError: true},
+		{name: "null value", value: types.Int64Null(), wantError: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.Int64Request{Path: path.Root("execution_timeout"), ConfigValue: tt.value}
+			resp := &validator.Int64Response{}
+
+			int64AtLeast(-1).ValidateInt64(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Errorf("Expected error = %v, got diagnostics: %v", tt.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestInt64BetweenValidator(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     types.Int64
+		wantError bool
+	}{
+		{name: "within range", value: types.Int64Value(100), wantError: false},
+		{name: "equal to maximum", value: types.Int64Value(250), wantError: false},
+		{name: "below minimum", value: types.Int64Value(0), wantError: true},
+		{name: "above maximum", value: types.Int64Value(251), wantError: true},
+		{name: "null value", value: types.Int64Null(), wantError: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.Int64Request{Path: path.Root("page_size"), ConfigValue: tt.value}
+			resp := &validator.Int64Response{}
+
+			int64Between(1, 250).ValidateInt64(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Errorf("Expected error = %v, got diagnostics: %v", tt.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
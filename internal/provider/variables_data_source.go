@@ -0,0 +1,203 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &VariablesDataSource{}
+
+func NewVariablesDataSource() datasource.DataSource {
+	return &VariablesDataSource{}
+}
+
+// VariablesDataSource defines the data source implementation.
+type VariablesDataSource struct {
+	client client.N8nAPI
+}
+
+// VariablesDataSourceModel describes the data source data model.
+type VariablesDataSourceModel struct {
+	ProjectID     types.String `tfsdk:"project_id"`
+	IncludeValues types.Bool   `tfsdk:"include_values"`
+	RequiredKeys  types.List   `tfsdk:"required_keys"`
+	Keys          types.List   `tfsdk:"keys"`
+	Values        types.Map    `tfsdk:"values"`
+}
+
+func (d *VariablesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_variables"
+}
+
+func (d *VariablesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the variables workflows can read as `$vars`. Without `project_id` the global " +
+			"variables are listed; with it, the variables available to the workflows of the project, i.e. the " +
+			"global variables and those scoped to the project. Set `required_keys` to fail the plan when variables " +
+			"a workflow uses are missing, before the workflow is activated. Requires a license that includes variables.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Project whose variables to list in addition to the global variables",
+				Optional:            true,
+			},
+			"include_values": schema.BoolAttribute{
+				MarkdownDescription: "Whether to read the values of the variables into `values`. Defaults to `false`, " +
+					"which only lists their keys.",
+				Optional: true,
+			},
+			"required_keys": schema.ListAttribute{
+				MarkdownDescription: "Keys of variables that must exist. Reading the data source fails if any of them " +
+					"is missing.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"keys": schema.ListAttribute{
+				MarkdownDescription: "Keys of the variables, sorted alphabetically",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"values": schema.MapAttribute{
+				MarkdownDescription: "Values of the variables, keyed by key. A project variable takes precedence over a " +
+					"global variable with the same key. Null unless `include_values` is `true`.",
+				ElementType: types.StringType,
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func (d *VariablesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.N8nAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.N8nAPI, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *VariablesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VariablesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !checkInstanceRequirement(d.client, "n8n_variables", requiresVariables, &resp.Diagnostics) {
+		return
+	}
+
+	variables, err := d.client.GetAllVariables()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read variables, got error: %s", err))
+		return
+	}
+
+	values := scopedVariables(variables, data.ProjectID.ValueString())
+
+	var requiredKeys []string
+	resp.Diagnostics.Append(data.RequiredKeys.ElementsAs(ctx, &requiredKeys, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	checkRequiredVariables(values, requiredKeys, data.ProjectID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.updateFromVariables(values)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// scopedVariables returns the values of the variables available in a project, keyed by key: the global
+// variables and, if projectID is set, those of the project, which take precedence
+func scopedVariables(variables []client.Variable, projectID string) map[string]string {
+	values := make(map[string]string)
+	for _, variable := range variables {
+		if variable.ProjectID == "" {
+			if _, ok := values[variable.Key]; !ok {
+				values[variable.Key] = variable.Value
+			}
+		}
+	}
+	if projectID == "" {
+		return values
+	}
+
+	for _, variable := range variables {
+		if variable.ProjectID == projectID {
+			values[variable.Key] = variable.Value
+		}
+	}
+	return values
+}
+
+// checkRequiredVariables adds an error listing the required keys that are not among the variables
+func checkRequiredVariables(values map[string]string, requiredKeys []string, projectID string, diags *diag.Diagnostics) {
+	var missing []string
+	for _, key := range requiredKeys {
+		if _, ok := values[key]; !ok {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) == 0 {
+		return
+	}
+
+	scope := "globally"
+	if projectID != "" {
+		scope = fmt.Sprintf("globally or in project %s", projectID)
+	}
+	diags.AddError("Missing Variables", fmt.Sprintf("The following variables are not defined %s: %s. Create them in "+
+		"n8n before activating workflows that use them.", scope, strings.Join(missing, ", ")))
+}
+
+// updateFromVariables sets the keys, and the values if requested, from the variables in scope
+func (m *VariablesDataSourceModel) updateFromVariables(values map[string]string) {
+	keys := sortedKeys(values)
+
+	keyValues := make([]attr.Value, len(keys))
+	for i, key := range keys {
+		keyValues[i] = types.StringValue(key)
+	}
+	m.Keys = types.ListValueMust(types.StringType, keyValues)
+
+	if !m.IncludeValues.ValueBool() {
+		m.Values = types.MapNull(types.StringType)
+		return
+	}
+
+	valueMap := make(map[string]attr.Value, len(values))
+	for key, value := range values {
+		valueMap[key] = types.StringValue(value)
+	}
+	m.Values = types.MapValueMust(types.StringType, valueMap)
+}
@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+	"github.com/devops247-online/terraform-provider-n8n/internal/client/clientmock"
+)
+
+var testVariables = []client.Variable{
+	{ID: "v1", Key: "BASE_URL", Value: "https://example.com"},
+	{ID: "v2", Key: "API_TOKEN", Value: "global-token"},
+	{ID: "v3", Key: "API_TOKEN", Value: "billing-token", ProjectID: "proj-1"},
+	{ID: "v4", Key: "TEAM", Value: "billing", ProjectID: "proj-1"},
+	{ID: "v5", Key: "TEAM", Value: "support", ProjectID: "proj-2"},
+}
+
+func TestScopedVariables(t *testing.T) {
+	global := scopedVariables(testVariables, "")
+	expected := map[string]string{"BASE_URL": "https://example.com", "API_TOKEN": "global-token"}
+	if !reflect.DeepEqual(global, expected) {
+		t.Errorf("Expected the global variables, got %v", global)
+	}
+
+	project := scopedVariables(testVariables, "proj-1")
+	expected = map[string]string{"BASE_URL": "https://example.com", "API_TOKEN": "billing-token", "TEAM": "billing"}
+	if !reflect.DeepEqual(project, expected) {
+		t.Errorf("Expected the global and project variables, got %v", project)
+	}
+}
+
+// readVariables reads the variables data source with a configuration and returns its state
+func readVariables(t *testing.T, mock *clientmock.N8nAPI, config VariablesDataSourceModel) (VariablesDataSourceModel,
+	*datasource.ReadResponse) {
+	t.Helper()
+	ctx := context.Background()
+
+	d := &VariablesDataSource{}
+	d.Configure(ctx, datasource.ConfigureRequest{ProviderData: mock}, &datasource.ConfigureResponse{})
+
+	var schemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	if diags := state.Set(ctx, &config); diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: state.Raw}}, resp)
+
+	var result VariablesDataSourceModel
+	if !resp.Diagnostics.HasError() {
+		resp.State.Get(ctx, &result)
+	}
+	return result, resp
+}
+
+func TestVariablesDataSource_Read(t *testing.T) {
+	mock := &clientmock.N8nAPI{
+		GetAllVariablesFunc: func() ([]client.Variable, error) { return testVariables, nil },
+	}
+
+	config := VariablesDataSourceModel{
+		ProjectID:     types.StringValue("proj-1"),
+		IncludeValues: types.BoolNull(),
+		RequiredKeys:  types.ListValueMust(types.StringType, []attr.Value{types.StringValue("API_TOKEN")}),
+		Keys:          types.ListNull(types.StringType),
+		Values:        types.MapNull(types.StringType),
+	}
+	result, resp := readVariables(t, mock, config)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var keys []string
+	result.Keys.ElementsAs(context.Background(), &keys, false)
+	if !reflect.DeepEqual(keys, []string{"API_TOKEN", "BASE_URL", "TEAM"}) {
+		t.Errorf("Expected the sorted keys of the project, got %v", keys)
+	}
+	if !result.Values.IsNull() {
+		t.Errorf("Expected no values unless requested, got %v", result.Values)
+	}
+
+	// Values are only read when requested
+	config.IncludeValues = types.BoolValue(true)
+	result, _ = readVariables(t, mock, config)
+	token := result.Values.Elements()["API_TOKEN"].(types.String).ValueString()
+	if token != "billing-token" {
+		t.Errorf("Expected the project value to take precedence, got %q", token)
+	}
+}
+
+func TestVariablesDataSource_MissingKeys(t *testing.T) {
+	mock := &clientmock.N8nAPI{
+		GetAllVariablesFunc: func() ([]client.Variable, error) { return testVariables, nil },
+	}
+
+	config := VariablesDataSourceModel{
+		ProjectID:     types.StringNull(),
+		IncludeValues: types.BoolNull(),
+		RequiredKeys: types.ListValueMust(types.StringType, []attr.Value{
+			types.StringValue("BASE_URL"), types.StringValue("TEAM"), types.StringValue("REGION"),
+		}),
+		Keys:   types.ListNull(types.StringType),
+		Values: types.MapNull(types.StringType),
+	}
+	_, resp := readVariables(t, mock, config)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Expected an error for the missing variables")
+	}
+	detail := resp.Diagnostics.Errors()[0].Detail()
+	if !strings.Contains(detail, "globally: TEAM, REGION.") {
+		t.Errorf("Expected the missing global variables to be listed, got %q", detail)
+	}
+}
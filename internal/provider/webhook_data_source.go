@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &WebhookDataSource{}
+
+func NewWebhookDataSource() datasource.DataSource {
+	return &WebhookDataSource{}
+}
+
+// WebhookDataSource defines the data source implementation.
+type WebhookDataSource struct {
+	client client.N8nAPI
+}
+
+// WebhookDataSourceModel describes the data source data model.
+type WebhookDataSourceModel struct {
+	WorkflowID    types.String `tfsdk:"workflow_id"`
+	NodeName      types.String `tfsdk:"node_name"`
+	Path          types.String `tfsdk:"path"`
+	HTTPMethod    types.String `tfsdk:"http_method"`
+	ProductionURL types.String `tfsdk:"production_url"`
+	TestURL       types.String `tfsdk:"test_url"`
+}
+
+func (d *WebhookDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook"
+}
+
+func (d *WebhookDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches the URLs of a webhook or form trigger node in an n8n workflow. URLs are built " +
+			"from the provider's `webhook_url` if set, otherwise from `base_url`.",
+
+		Attributes: map[string]schema.Attribute{
+			"workflow_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the workflow containing the webhook node",
+				Required:            true,
+			},
+			"node_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the webhook node within the workflow",
+				Required:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Webhook path of the node",
+				Computed:            true,
+			},
+			"http_method": schema.StringAttribute{
+				MarkdownDescription: "HTTP method the webhook listens for (e.g., 'GET', 'POST')",
+				Computed:            true,
+			},
+			"production_url": schema.StringAttribute{
+				MarkdownDescription: "URL that triggers the active workflow",
+				Computed:            true,
+			},
+			"test_url": schema.StringAttribute{
+				MarkdownDescription: "URL that triggers the workflow while listening for a test event in the editor",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *WebhookDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.N8nAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.N8nAPI, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *WebhookDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data WebhookDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workflow, err := d.client.GetWorkflow(data.WorkflowID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workflow, got error: %s", err))
+		return
+	}
+
+	nodeName := data.NodeName.ValueString()
+	var webhook *client.Webhook
+	for _, w := range d.client.WorkflowWebhooks(workflow) {
+		if w.NodeName == nodeName {
+			webhook = &w
+			break
+		}
+	}
+
+	if webhook == nil {
+		resp.Diagnostics.AddError(
+			"Webhook Not Found",
+			fmt.Sprintf("No webhook or form trigger node named %q found in workflow %s", nodeName, workflow.ID),
+		)
+		return
+	}
+
+	data.Path = types.StringValue(webhook.Path)
+	data.HTTPMethod = types.StringValue(webhook.HTTPMethod)
+	data.ProductionURL = types.StringValue(webhook.ProductionURL)
+	data.TestURL = types.StringValue(webhook.TestURL)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccWebhookDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccWebhookDataSourceConfig("Incoming Order"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.n8n_webhook.test", "path", "tf-acc-webhook-ds"),
+					resource.TestCheckResourceAttr("data.n8n_webhook.test", "http_method", "POST"),
+					resource.TestMatchResourceAttr("data.n8n_webhook.test", "production_url",
+						regexp.MustCompile(`/webhook/tf-acc-webhook-ds$`)),
+					resource.TestMatchResourceAttr("data.n8n_webhook.test", "test_url",
+						regexp.MustCompile(`/webhook-test/tf-acc-webhook-ds$`)),
+				),
+			},
+			// Unknown node
+			{
+				Config:      testAccWebhookDataSourceConfig("Missing Node"),
+				ExpectError: regexp.MustCompile("Webhook Not Found"),
+			},
+		},
+	})
+}
+
+func testAccWebhookDataSourceConfig(nodeName string) string {
+	return fmt.Sprintf(`
+resource "n8n_workflow" "test" {
+  name   = "test-webhook-datasource"
+  active = false
+
+  nodes = jsonencode({
+    "Incoming Order": {
+      "type": "n8n-nodes-base.webhook",
+      "typeVersion": 2,
+      "position": [240, 300],
+      "parameters": {
+        "path": "tf-acc-webhook-ds",
+        "httpMethod": "POST"
+      }
+    }
+  })
+
+  connections = jsonencode({})
+}
+
+data "n8n_webhook" "test" {
+  workflow_id = n8n_workflow.test.id
+  node_name   = "%s"
+}
+`, nodeName)
+}
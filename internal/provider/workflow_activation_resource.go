@@ -0,0 +1,219 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &WorkflowActivationResource{}
+var _ resource.ResourceWithImportState = &WorkflowActivationResource{}
+
+func NewWorkflowActivationResource() resource.Resource {
+	return &WorkflowActivationResource{}
+}
+
+// WorkflowActivationResource defines the resource implementation.
+type WorkflowActivationResource struct {
+	client client.N8nAPI
+}
+
+// WorkflowActivationResourceModel describes the resource data model.
+type WorkflowActivationResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	WorkflowID types.String `tfsdk:"workflow_id"`
+	Active     types.Bool   `tfsdk:"active"`
+}
+
+func (r *WorkflowActivationResource) Metadata(ctx context.Context, req resource.MetadataRequest,
+	resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow_activation"
+}
+
+func (r *WorkflowActivationResource) Schema(ctx context.Context, req resource.SchemaRequest,
+	resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Activates or deactivates an n8n workflow, so that activation can be managed separately " +
+			"from the workflow definition, e.g. by a release pipeline. Destroying it deactivates the workflow. The " +
+			"`n8n_workflow` of the workflow must not manage activation as well: leave its `active` attribute unset " +
+			"and add `active` to its `lifecycle.ignore_changes`, otherwise both resources undo each other's changes.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the activation, which is the ID of the workflow",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workflow_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the workflow to activate. Changing it recreates the activation.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the workflow is active and can be triggered. Defaults to true.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *WorkflowActivationResource) Configure(ctx context.Context, req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.N8nAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.N8nAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *WorkflowActivationResource) Create(ctx context.Context, req resource.CreateRequest,
+	resp *resource.CreateResponse) {
+	var data WorkflowActivationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workflow, ok := r.setActive(data.WorkflowID.ValueString(), data.Active.ValueBool(), &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	// Update model with response data
+	r.updateModelFromWorkflow(&data, workflow)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WorkflowActivationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data WorkflowActivationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get workflow from API
+	workflow, err := r.client.GetWorkflow(data.WorkflowID.ValueString())
+	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workflow, got error: %s", err))
+		return
+	}
+
+	// Update model with response data
+	r.updateModelFromWorkflow(&data, workflow)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WorkflowActivationResource) Update(ctx context.Context, req resource.UpdateRequest,
+	resp *resource.UpdateResponse) {
+	var data WorkflowActivationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workflow, ok := r.setActive(data.WorkflowID.ValueString(), data.Active.ValueBool(), &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	// Update model with response data
+	r.updateModelFromWorkflow(&data, workflow)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WorkflowActivationResource) Delete(ctx context.Context, req resource.DeleteRequest,
+	resp *resource.DeleteResponse) {
+	var data WorkflowActivationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Destroying the activation deactivates the workflow. A workflow deleted already is inactive.
+	if !data.Active.ValueBool() {
+		return
+	}
+	_, err := r.client.DeactivateWorkflow(data.WorkflowID.ValueString())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to deactivate workflow, got error: %s", err))
+		return
+	}
+}
+
+func (r *WorkflowActivationResource) ImportState(ctx context.Context, req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("workflow_id"), req, resp)
+}
+
+// setActive activates or deactivates the workflow with the given ID and returns the updated workflow
+func (r *WorkflowActivationResource) setActive(id string, active bool, diags *diag.Diagnostics) (*client.Workflow, bool) {
+	setActive, action := r.client.DeactivateWorkflow, "deactivate"
+	if active {
+		setActive, action = r.client.ActivateWorkflow, "activate"
+	}
+
+	workflow, err := setActive(id)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to %s workflow, got error: %s", action, err))
+		return nil, false
+	}
+	return workflow, true
+}
+
+// Helper function to update model from API response. The activation is identified by the workflow ID.
+func (r *WorkflowActivationResource) updateModelFromWorkflow(model *WorkflowActivationResourceModel,
+	workflow *client.Workflow) {
+	model.ID = model.WorkflowID
+	model.Active = types.BoolValue(workflow.Active)
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+	"github.com/devops247-online/terraform-provider-n8n/internal/client/clientmock"
+)
+
+func TestWorkflowActivationResource_CRUD(t *testing.T) {
+	ctx := context.Background()
+
+	mock := &clientmock.N8nAPI{
+		ActivateWorkflowFunc: func(id string) (*client.Workflow, error) {
+			return &client.Workflow{ID: id, Active: true}, nil
+		},
+		DeactivateWorkflowFunc: func(id string) (*client.Workflow, error) {
+			return &client.Workflow{ID: id, Active: false}, nil
+		},
+		GetWorkflowFunc: func(id string) (*client.Workflow, error) {
+			return nil, &client.APIError{Code: http.StatusNotFound, Message: "Not Found"}
+		},
+	}
+
+	r := &WorkflowActivationResource{}
+	r.Configure(ctx, fwresource.ConfigureRequest{ProviderData: mock}, &fwresource.ConfigureResponse{})
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	model := WorkflowActivationResourceModel{
+		ID:         types.StringUnknown(),
+		WorkflowID: types.StringValue("wf-1"),
+		Active:     types.BoolValue(true),
+	}
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+	if diags := plan.Set(ctx, &model); diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	createResp := &fwresource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, fwresource.CreateRequest{Plan: plan}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", createResp.Diagnostics)
+	}
+
+	var created WorkflowActivationResourceModel
+	createResp.State.Get(ctx, &created)
+	if created.ID.ValueString() != "wf-1" || !created.Active.ValueBool() {
+		t.Errorf("Expected the active workflow in state, got %+v", created)
+	}
+
+	// Destroying the activation deactivates the workflow
+	deleteResp := &fwresource.DeleteResponse{}
+	r.Delete(ctx, fwresource.DeleteRequest{State: createResp.State}, deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Errorf("Unexpected diagnostics: %v", deleteResp.Diagnostics)
+	}
+
+	// An activation of a deleted workflow is removed from state
+	readResp := &fwresource.ReadResponse{State: createResp.State}
+	r.Read(ctx, fwresource.ReadRequest{State: createResp.State}, readResp)
+	if readResp.Diagnostics.HasError() || !readResp.State.Raw.IsNull() {
+		t.Errorf("Expected the activation to be removed from state, got %v", readResp.Diagnostics)
+	}
+
+	expected := []string{"ActivateWorkflow", "DeactivateWorkflow", "GetWorkflow"}
+	if !reflect.DeepEqual(mock.Calls, expected) {
+		t.Errorf("Expected calls %v, got %v", expected, mock.Calls)
+	}
+}
@@ -0,0 +1,599 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &WorkflowBundleResource{}
+var _ resource.ResourceWithValidateConfig = &WorkflowBundleResource{}
+var _ resource.ResourceWithModifyPlan = &WorkflowBundleResource{}
+
+func NewWorkflowBundleResource() resource.Resource {
+	return &WorkflowBundleResource{}
+}
+
+// WorkflowBundleResource defines the resource implementation.
+type WorkflowBundleResource struct {
+	client client.N8nAPI
+}
+
+// WorkflowBundleResourceModel describes the resource data model.
+type WorkflowBundleResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Directory        types.String `tfsdk:"directory"`
+	Workflows        types.Map    `tfsdk:"workflows"`
+	Prune            types.Bool   `tfsdk:"prune"`
+	ManagePinnedData types.Bool   `tfsdk:"manage_pinned_data"`
+	WorkflowIDs      types.Map    `tfsdk:"workflow_ids"`
+}
+
+// bundleContentKey is the private state key holding the hashes of the workflow content last written by
+// Terraform, keyed like workflows
+const bundleContentKey = "applied_content_hashes"
+
+// bundleWorkflow is a workflow in the n8n export format as found in a bundle
+type bundleWorkflow struct {
+	Name        string                 `json:"name"`
+	Nodes       []interface{}          `json:"nodes"`
+	Connections map[string]interface{} `json:"connections"`
+	Settings    map[string]interface{} `json:"settings"`
+	StaticData  map[string]interface{} `json:"staticData,omitempty"`
+	PinData     map[string]interface{} `json:"pinData,omitempty"`
+}
+
+func (r *WorkflowBundleResource) Metadata(ctx context.Context, req resource.MetadataRequest,
+	resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow_bundle"
+}
+
+func (r *WorkflowBundleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a set of n8n workflows from exported workflow JSON, e.g. to migrate many workflows " +
+			"from a Git repository at once. Workflows are created, updated and pruned so that n8n matches the bundle. " +
+			"Only workflows created by the bundle are ever updated or deleted.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Bundle identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"directory": schema.StringAttribute{
+				MarkdownDescription: "Directory containing exported workflow JSON files (`*.json`). Each file is keyed by " +
+					"its name without the extension. Conflicts with `workflows`.",
+				Optional: true,
+			},
+			"workflows": schema.MapAttribute{
+				MarkdownDescription: "Exported workflow JSON keyed by a stable name. Set from the files when `directory` " +
+					"is used.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+			},
+			"prune": schema.BoolAttribute{
+				MarkdownDescription: "Whether workflows removed from the bundle are deleted from n8n. If false, they are " +
+					"only no longer managed. Defaults to true.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"manage_pinned_data": schema.BoolAttribute{
+				MarkdownDescription: "Whether the pinned data (`pinData`) of the exported workflows is sent to n8n. " +
+					"Pinned data holds sample payloads for testing, so it is left out by default to keep it from " +
+					"being promoted to production. Defaults to false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"workflow_ids": schema.MapAttribute{
+				MarkdownDescription: "IDs of the n8n workflows keyed like `workflows`",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *WorkflowBundleResource) Configure(ctx context.Context, req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.N8nAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.N8nAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig checks that the bundle has exactly one source and that inline workflows are valid
+func (r *WorkflowBundleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse) {
+	var data WorkflowBundleResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Directory.IsNull() && !data.Workflows.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("workflows"), "Conflicting Attributes",
+			"Only one of directory and workflows can be set.")
+		return
+	}
+	if data.Directory.IsNull() && data.Workflows.IsNull() {
+		resp.Diagnostics.AddError("Missing Attribute", "One of directory or workflows must be set.")
+		return
+	}
+
+	if data.Workflows.IsUnknown() || data.Workflows.IsNull() {
+		return
+	}
+
+	for key, value := range data.Workflows.Elements() {
+		content, ok := value.(types.String)
+		if !ok || content.IsUnknown() || content.IsNull() {
+			continue
+		}
+		if _, err := parseBundleWorkflow(key, content.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("workflows").AtMapKey(key), "Invalid Workflow JSON", err.Error())
+		}
+	}
+}
+
+// ModifyPlan loads the workflows from the bundle directory, so that changed files show up in the plan
+func (r *WorkflowBundleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan WorkflowBundleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Directory.IsNull() && !plan.Directory.IsUnknown() {
+		workflows, err := loadBundleDirectory(plan.Directory.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("directory"), "Invalid Bundle Directory", err.Error())
+			return
+		}
+
+		plan.Workflows = stringMapValue(workflows)
+	}
+
+	if r.client != nil {
+		var prior map[string]string
+		if !req.State.Raw.IsNull() {
+			var state WorkflowBundleResourceModel
+			resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+			prior = stringMapElements(state.Workflows)
+		}
+		r.checkWorkflowNames(stringMapElements(plan.Workflows), prior, &resp.Diagnostics)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Workflow IDs only change when workflows are added to or removed from the bundle
+	plan.WorkflowIDs = types.MapUnknown(types.StringType)
+	if !req.State.Raw.IsNull() && !plan.Workflows.IsUnknown() {
+		var state WorkflowBundleResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if sameMapKeys(plan.Workflows, state.WorkflowIDs) {
+			plan.WorkflowIDs = state.WorkflowIDs
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *WorkflowBundleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data WorkflowBundleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue("workflow_bundle")
+	if !data.Directory.IsNull() {
+		data.ID = types.StringValue(data.Directory.ValueString())
+	}
+
+	desired := stringMapElements(data.Workflows)
+	hashes := map[string]string{}
+	applied, ids := r.reconcile(desired, map[string]string{}, map[string]string{}, hashes, true,
+		data.ManagePinnedData.ValueBool(), &resp.Diagnostics)
+
+	// Workflows that were created before an error are kept in state, so that they are not orphaned
+	data.Workflows = stringMapValue(applied)
+	data.WorkflowIDs = stringMapValue(ids)
+	resp.Diagnostics.Append(setBundleContentHashes(ctx, resp.Private, hashes)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WorkflowBundleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data WorkflowBundleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workflows := stringMapElements(data.Workflows)
+	ids := stringMapElements(data.WorkflowIDs)
+	hashes, diags := getBundleContentHashes(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+
+	if !r.refresh(workflows, ids, hashes, &resp.Diagnostics) {
+		return
+	}
+
+	data.Workflows = stringMapValue(workflows)
+	data.WorkflowIDs = stringMapValue(ids)
+	resp.Diagnostics.Append(setBundleContentHashes(ctx, resp.Private, hashes)...)
+
+	// State written before manage_pinned_data existed has it unset
+	if data.ManagePinnedData.IsNull() {
+		data.ManagePinnedData = types.BoolValue(false)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WorkflowBundleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state WorkflowBundleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hashes, diags := getBundleContentHashes(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+
+	desired := stringMapElements(data.Workflows)
+	applied, ids := r.reconcile(desired, stringMapElements(state.Workflows), stringMapElements(state.WorkflowIDs),
+		hashes, data.Prune.ValueBool(), data.ManagePinnedData.ValueBool(), &resp.Diagnostics)
+
+	// Partial progress is saved, so that the next apply continues where this one stopped
+	data.Workflows = stringMapValue(applied)
+	data.WorkflowIDs = stringMapValue(ids)
+	resp.Diagnostics.Append(setBundleContentHashes(ctx, resp.Private, hashes)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WorkflowBundleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data WorkflowBundleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids := stringMapElements(data.WorkflowIDs)
+	for _, key := range sortedKeys(ids) {
+		if err := r.client.DeleteWorkflow(ids[key]); err != nil && !client.IsNotFound(err) {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete workflow %s, got error: %s", ids[key], err))
+		}
+	}
+}
+
+// refresh reads the workflows of the bundle from n8n. Workflows deleted in n8n are dropped, and workflows
+// whose content no longer has the hash Terraform recorded are replaced by their content in n8n. It returns
+// false if a workflow cannot be read.
+func (r *WorkflowBundleResource) refresh(workflows, ids, hashes map[string]string, diags *diag.Diagnostics) bool {
+	for key, id := range ids {
+		workflow, err := r.client.GetWorkflow(id)
+		if err != nil {
+			// Workflows deleted outside Terraform are dropped from state, so that they are created again
+			if client.IsNotFound(err) {
+				delete(ids, key)
+				delete(workflows, key)
+				delete(hashes, key)
+				continue
+			}
+			diags.AddError("Client Error", fmt.Sprintf("Unable to read workflow %s, got error: %s", id, err))
+			return false
+		}
+
+		hash, err := workflowContentHash(workflow)
+		if err != nil {
+			diags.AddError("Internal Error", fmt.Sprintf("Unable to hash workflow %s, got error: %s", id, err))
+			return false
+		}
+
+		// Workflows applied before their content was recorded are taken as they are
+		applied, ok := hashes[key]
+		if !ok {
+			hashes[key] = hash
+			continue
+		}
+
+		// Workflows changed outside Terraform are read as exported from n8n, so that the change shows up
+		// as a difference to the bundle and the next apply updates them again
+		if hash != applied {
+			exported, err := exportBundleWorkflow(workflow)
+			if err != nil {
+				diags.AddError("Internal Error", fmt.Sprintf("Unable to encode workflow %s, got error: %s", id, err))
+				return false
+			}
+			workflows[key] = exported
+		}
+	}
+
+	return true
+}
+
+// reconcile creates, updates and prunes workflows so that n8n matches the desired bundle. It starts from
+// the previously applied workflows and their IDs and returns them as updated by every successful change,
+// stopping at the first error. The hashes of the content n8n stored are recorded in hashes. Pinned data
+// is only sent if managePinnedData is set.
+func (r *WorkflowBundleResource) reconcile(desired, applied, ids, hashes map[string]string, prune, managePinnedData bool,
+	diags *diag.Diagnostics) (map[string]string, map[string]string) {
+	for _, key := range sortedKeys(desired) {
+		content := desired[key]
+		id, exists := ids[key]
+		if exists && applied[key] == content {
+			continue
+		}
+
+		workflow, err := parseBundleWorkflow(key, content)
+		if err != nil {
+			diags.AddAttributeError(path.Root("workflows").AtMapKey(key), "Invalid Workflow JSON", err.Error())
+			return applied, ids
+		}
+		if !managePinnedData {
+			workflow.PinnedData = nil
+		}
+
+		var stored *client.Workflow
+		if exists {
+			if stored, err = r.client.UpdateWorkflow(id, workflow); err != nil {
+				diags.AddError("Client Error", fmt.Sprintf("Unable to update workflow %q, got error: %s", key, err))
+				return applied, ids
+			}
+		} else {
+			if stored, err = r.client.CreateWorkflow(workflow); err != nil {
+				diags.AddError("Client Error", fmt.Sprintf("Unable to create workflow %q, got error: %s", key, err))
+				return applied, ids
+			}
+			ids[key] = stored.ID
+		}
+		applied[key] = content
+
+		if hash, err := workflowContentHash(stored); err == nil {
+			hashes[key] = hash
+		} else {
+			delete(hashes, key)
+		}
+	}
+
+	for _, key := range sortedKeys(ids) {
+		if _, ok := desired[key]; ok {
+			continue
+		}
+
+		if prune {
+			if err := r.client.DeleteWorkflow(ids[key]); err != nil && !client.IsNotFound(err) {
+				diags.AddError("Client Error", fmt.Sprintf("Unable to delete workflow %q, got error: %s", key, err))
+				return applied, ids
+			}
+		}
+		delete(ids, key)
+		delete(applied, key)
+		delete(hashes, key)
+	}
+
+	return applied, ids
+}
+
+// checkWorkflowNames checks the names of the workflows that are added to the bundle or renamed against the
+// workflow_name_pattern of the provider. Workflows that cannot be parsed are reported by ValidateConfig.
+func (r *WorkflowBundleResource) checkWorkflowNames(workflows, prior map[string]string, diags *diag.Diagnostics) {
+	for _, key := range sortedKeys(workflows) {
+		workflow, err := parseBundleWorkflow(key, workflows[key])
+		if err != nil {
+			continue
+		}
+
+		priorName := types.StringNull()
+		if content, ok := prior[key]; ok {
+			if previous, err := parseBundleWorkflow(key, content); err == nil {
+				priorName = types.StringValue(previous.Name)
+			}
+		}
+		checkWorkflowName(r.client, path.Root("workflows").AtMapKey(key), types.StringValue(workflow.Name), priorName, diags)
+	}
+}
+
+// parseBundleWorkflow converts exported workflow JSON into a workflow for the API. The bundle key is used
+// as the name of workflows that do not have one.
+func parseBundleWorkflow(key, content string) (*client.Workflow, error) {
+	var exported bundleWorkflow
+	if err := json.Unmarshal([]byte(content), &exported); err != nil {
+		return nil, fmt.Errorf("unable to parse workflow %q: %w", key, err)
+	}
+
+	workflow := &client.Workflow{
+		Name:        exported.Name,
+		Nodes:       exported.Nodes,
+		Connections: exported.Connections,
+		Settings:    exported.Settings,
+		StaticData:  exported.StaticData,
+		PinnedData:  exported.PinData,
+	}
+	if workflow.Name == "" {
+		workflow.Name = key
+	}
+
+	// Connections and settings are required by the n8n API
+	if workflow.Connections == nil {
+		workflow.Connections = make(map[string]interface{})
+	}
+	if workflow.Settings == nil {
+		workflow.Settings = map[string]interface{}{
+			"executionOrder": "v1",
+		}
+	}
+
+	return workflow, nil
+}
+
+// exportBundleWorkflow encodes the name, nodes, connections and settings of a workflow in the n8n export
+// format, which are the fields of a bundle workflow that are compared for drift
+func exportBundleWorkflow(workflow *client.Workflow) (string, error) {
+	exported, err := json.Marshal(&bundleWorkflow{
+		Name:        workflow.Name,
+		Nodes:       workflow.Nodes,
+		Connections: workflow.Connections,
+		Settings:    workflow.Settings,
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(exported), nil
+}
+
+// setBundleContentHashes records the hashes of the workflow content written by Terraform in private state
+func setBundleContentHashes(ctx context.Context, private privateStateSetter, hashes map[string]string) diag.Diagnostics {
+	value, err := json.Marshal(hashes)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Internal Error", fmt.Sprintf("Unable to encode workflow content hashes, got error: %s", err))
+		return diags
+	}
+	return private.SetKey(ctx, bundleContentKey, value)
+}
+
+// getBundleContentHashes returns the hashes recorded by setBundleContentHashes, or an empty map if none
+// were recorded
+func getBundleContentHashes(ctx context.Context, private privateStateGetter) (map[string]string, diag.Diagnostics) {
+	hashes := map[string]string{}
+	value, diags := private.GetKey(ctx, bundleContentKey)
+	if diags.HasError() || len(value) == 0 {
+		return hashes, diags
+	}
+
+	// Unreadable hashes are recorded again on the next read
+	if err := json.Unmarshal(value, &hashes); err != nil || hashes == nil {
+		return map[string]string{}, diags
+	}
+	return hashes, diags
+}
+
+// loadBundleDirectory reads the workflow JSON files of a bundle directory keyed by file name without extension
+func loadBundleDirectory(dir string) (map[string]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read directory %s: %w", dir, err)
+	}
+
+	workflows := make(map[string]string)
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() || filepath.Ext(entry.Name()) != ".json" {
+			continue
+		}
+
+		content, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("unable to read %s: %w", entry.Name(), err)
+		}
+		workflows[strings.TrimSuffix(entry.Name(), ".json")] = string(content)
+	}
+
+	// An empty directory is most likely a wrong path and would prune every workflow of the bundle
+	if len(workflows) == 0 {
+		return nil, fmt.Errorf("directory %s does not contain any workflow JSON files", dir)
+	}
+
+	return workflows, nil
+}
+
+// stringMapElements returns the known elements of a map of strings as a Go map
+func stringMapElements(value types.Map) map[string]string {
+	result := make(map[string]string, len(value.Elements()))
+	for key, element := range value.Elements() {
+		if s, ok := element.(types.String); ok && !s.IsNull() && !s.IsUnknown() {
+			result[key] = s.ValueString()
+		}
+	}
+	return result
+}
+
+// stringMapValue converts a Go map into a map of strings value
+func stringMapValue(m map[string]string) types.Map {
+	elements := make(map[string]attr.Value, len(m))
+	for key, value := range m {
+		elements[key] = types.StringValue(value)
+	}
+	return types.MapValueMust(types.StringType, elements)
+}
+
+// sameMapKeys reports whether two known maps have the same keys
+func sameMapKeys(a, b types.Map) bool {
+	if a.IsUnknown() || b.IsUnknown() || len(a.Elements()) != len(b.Elements()) {
+		return false
+	}
+	for key := range a.Elements() {
+		if _, ok := b.Elements()[key]; !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,203 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+	"github.com/devops247-online/terraform-provider-n8n/internal/client/clientmock"
+)
+
+func TestParseBundleWorkflow(t *testing.T) {
+	workflow, err := parseBundleWorkflow("orders", `{
+		"id": "ignored",
+		"nodes": [{"name": "Start", "type": "n8n-nodes-base.start"}],
+		"tags": [{"id": "1", "name": "billing"}]
+	}`)
+	if err != nil {
+		t.Fatalf("parseBundleWorkflow() error = %v", err)
+	}
+
+	if workflow.Name != "orders" {
+		t.Errorf("Expected the bundle key as name, got %q", workflow.Name)
+	}
+	if workflow.ID != "" {
+		t.Errorf("Expected the exported ID to be ignored, got %q", workflow.ID)
+	}
+	if len(workflow.Nodes) != 1 || workflow.Connections == nil || workflow.Settings["executionOrder"] != "v1" {
+		t.Errorf("Expected nodes and default connections and settings, got %+v", workflow)
+	}
+
+	if _, err := parseBundleWorkflow("broken", `{"nodes": {}`); err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
+
+func TestLoadBundleDirectory(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"orders.json":  `{"name": "Orders"}`,
+		"billing.json": `{"name": "Billing"}`,
+		"README.md":    "not a workflow",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+
+	workflows, err := loadBundleDirectory(dir)
+	if err != nil {
+		t.Fatalf("loadBundleDirectory() error = %v", err)
+	}
+	if len(workflows) != 2 || workflows["orders"] != `{"name": "Orders"}` || workflows["billing"] == "" {
+		t.Errorf("Expected the two JSON files keyed by name, got %v", workflows)
+	}
+
+	if _, err := loadBundleDirectory(t.TempDir()); err == nil {
+		t.Error("Expected error for a directory without workflow files")
+	}
+	if _, err := loadBundleDirectory(filepath.Join(dir, "missing")); err == nil {
+		t.Error("Expected error for a missing directory")
+	}
+}
+
+func TestWorkflowBundleResource_Reconcile(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == "POST":
+			var workflow map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&workflow)
+			if _, ok := workflow["pinData"]; ok {
+				t.Errorf("Expected pinned data not to be sent unless it is managed, got %v", workflow)
+			}
+			_, _ = w.Write([]byte(fmt.Sprintf(`{"id": "new-%s", "name": %q}`, strings.ToLower(workflow["name"].(string)),
+				workflow["name"])))
+		case r.Method == "PUT":
+			_, _ = w.Write([]byte(`{"id": "wf-billing", "name": "Billing"}`))
+		case r.Method == "DELETE":
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	n8nClient, err := client.NewClient(&client.Config{
+		BaseURL: server.URL,
+		Auth:    &client.APIKeyAuth{APIKey: "test-key"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	r := &WorkflowBundleResource{client: n8nClient}
+
+	desired := map[string]string{
+		"billing": `{"name": "Billing", "nodes": []}`,
+		"orders":  `{"name": "Orders"}`,
+		"support": `{"name": "Support", "pinData": {"Webhook": [{"json": {"id": 1}}]}}`,
+	}
+	applied := map[string]string{
+		"billing": `{"name": "Billing"}`,
+		"orders":  `{"name": "Orders"}`,
+		"legacy":  `{"name": "Legacy"}`,
+	}
+	ids := map[string]string{
+		"billing": "wf-billing",
+		"orders":  "wf-orders",
+		"legacy":  "wf-legacy",
+	}
+
+	hashes := map[string]string{"orders": "orders-hash", "legacy": "legacy-hash"}
+
+	var diags diag.Diagnostics
+	applied, ids = r.reconcile(desired, applied, ids, hashes, true, false, &diags)
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	expectedRequests := []string{
+		"PUT /api/v1/workflows/wf-billing",
+		"POST /api/v1/workflows",
+		"DELETE /api/v1/workflows/wf-legacy",
+	}
+	if strings.Join(requests, ", ") != strings.Join(expectedRequests, ", ") {
+		t.Errorf("Expected requests %v, got %v", expectedRequests, requests)
+	}
+
+	if ids["support"] != "new-support" || ids["orders"] != "wf-orders" || ids["legacy"] != "" {
+		t.Errorf("Unexpected workflow IDs: %v", ids)
+	}
+	if len(applied) != 3 || applied["billing"] != desired["billing"] {
+		t.Errorf("Expected the applied workflows to match the bundle, got %v", applied)
+	}
+	if len(hashes) != 3 || hashes["billing"] == "" || hashes["support"] == "" || hashes["orders"] != "orders-hash" {
+		t.Errorf("Expected the hashes of the written workflows to be recorded, got %v", hashes)
+	}
+}
+
+func TestWorkflowBundleResource_Refresh(t *testing.T) {
+	remote := map[string]*client.Workflow{
+		"wf-orders":  {ID: "wf-orders", Name: "Orders", Nodes: []interface{}{}, Connections: map[string]interface{}{}},
+		"wf-billing": {ID: "wf-billing", Name: "Billing", Nodes: []interface{}{}, Connections: map[string]interface{}{}},
+	}
+	r := &WorkflowBundleResource{client: &clientmock.N8nAPI{
+		GetWorkflowFunc: func(id string) (*client.Workflow, error) {
+			if workflow, ok := remote[id]; ok {
+				return workflow, nil
+			}
+			return nil, &client.APIError{Code: http.StatusNotFound, Message: "not found"}
+		},
+	}}
+
+	ordersHash, _ := workflowContentHash(remote["wf-orders"])
+	billingHash, _ := workflowContentHash(remote["wf-billing"])
+
+	// The billing workflow was edited in n8n after Terraform wrote it
+	remote["wf-billing"] = &client.Workflow{
+		ID:          "wf-billing",
+		Name:        "Billing",
+		Nodes:       []interface{}{map[string]interface{}{"name": "Set", "type": "n8n-nodes-base.set"}},
+		Connections: map[string]interface{}{},
+	}
+
+	workflows := map[string]string{
+		"orders":  `{"name": "Orders"}`,
+		"billing": `{"name": "Billing"}`,
+		"support": `{"name": "Support"}`,
+		"legacy":  `{"name": "Legacy"}`,
+	}
+	ids := map[string]string{"orders": "wf-orders", "billing": "wf-billing", "support": "wf-support"}
+	hashes := map[string]string{"orders": ordersHash, "billing": billingHash, "support": "support-hash"}
+
+	var diags diag.Diagnostics
+	if !r.refresh(workflows, ids, hashes, &diags) || diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	if workflows["orders"] != `{"name": "Orders"}` {
+		t.Errorf("Expected an unchanged workflow to keep its content, got %s", workflows["orders"])
+	}
+	if !strings.Contains(workflows["billing"], `"name":"Set"`) {
+		t.Errorf("Expected a workflow changed in n8n to be read from n8n, got %s", workflows["billing"])
+	}
+	if _, ok := ids["support"]; ok || hashes["support"] != "" {
+		t.Errorf("Expected a workflow deleted in n8n to be dropped, got %v and %v", ids, hashes)
+	}
+	if hashes["billing"] != billingHash {
+		t.Errorf("Expected the hash Terraform wrote to be kept until the next apply, got %s", hashes["billing"])
+	}
+}
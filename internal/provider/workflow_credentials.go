@@ -0,0 +1,285 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+)
+
+// The credential_overrides attribute maps node names to the ID or name of a credential. The credential
+// replaces the credential of its type in the node before the workflow is sent, so that a workflow exported
+// from one instance can be applied to others with different credentials. The nodes attribute keeps the
+// configured credentials; only credentials that differ from the override show up as a change.
+
+// resolveOverrideCredentials returns the credentials the nodes in credential_overrides should use, keyed by
+// node name. An empty or unset attribute needs no lookup.
+func (r *WorkflowResource) resolveOverrideCredentials(ctx context.Context, overrides types.Map,
+	diags *diag.Diagnostics) (map[string]client.Credential, bool) {
+	if overrides.IsNull() || overrides.IsUnknown() {
+		return nil, true
+	}
+
+	var refs map[string]string
+	diags.Append(overrides.ElementsAs(ctx, &refs, false)...)
+	if diags.HasError() {
+		return nil, false
+	}
+
+	credentials, err := resolveCredentialOverrides(r.client, refs)
+	if err != nil {
+		diags.AddAttributeError(path.Root("credential_overrides"), "Unable to Resolve Credential Overrides", err.Error())
+		return nil, false
+	}
+
+	return credentials, true
+}
+
+// resolveCredentialOverrides returns the credential each credential reference refers to, keyed by node
+// name. A reference is the ID of a credential, or otherwise its name, which must then be unique.
+func resolveCredentialOverrides(c client.N8nAPI, refs map[string]string) (map[string]client.Credential, error) {
+	if len(refs) == 0 {
+		return nil, nil
+	}
+
+	existing, err := c.GetAllCredentials(nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list credentials: %w", err)
+	}
+
+	return matchCredentialOverrides(existing, refs)
+}
+
+// matchCredentialOverrides returns the credential of existing each credential reference refers to, keyed
+// by node name
+func matchCredentialOverrides(existing []client.Credential, refs map[string]string) (map[string]client.Credential, error) {
+	byID := make(map[string]client.Credential, len(existing))
+	byName := make(map[string][]client.Credential, len(existing))
+	for _, credential := range existing {
+		byID[credential.ID] = credential
+		byName[credential.Name] = append(byName[credential.Name], credential)
+	}
+
+	credentials := make(map[string]client.Credential, len(refs))
+	var problems []string
+	for _, node := range sortedKeys(refs) {
+		ref := refs[node]
+		if credential, ok := byID[ref]; ok {
+			credentials[node] = credential
+			continue
+		}
+
+		switch named := byName[ref]; len(named) {
+		case 0:
+			problems = append(problems, fmt.Sprintf("%s: no credential has the ID or name %q", node, ref))
+		case 1:
+			credentials[node] = named[0]
+		default:
+			problems = append(problems, fmt.Sprintf("%s: %d credentials are named %q; use the ID of the credential "+
+				"instead", node, len(named), ref))
+		}
+	}
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("the credential overrides do not match the credentials of the instance:\n  - %s",
+			strings.Join(problems, "\n  - "))
+	}
+
+	return credentials, nil
+}
+
+// overrideNodeCredentials sets the credential of its type in each node that has an override. nodes is in
+// the array format of the API.
+func overrideNodeCredentials(nodes []interface{}, credentials map[string]client.Credential) {
+	for _, nodeData := range nodes {
+		node, ok := nodeData.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := node["name"].(string)
+		credential, ok := credentials[name]
+		if !ok {
+			continue
+		}
+
+		// Copy the credentials so that the configured value is not modified
+		configured, _ := node["credentials"].(map[string]interface{})
+		nodeCredentials := make(map[string]interface{}, len(configured)+1)
+		for key, value := range configured {
+			nodeCredentials[key] = value
+		}
+		nodeCredentials[credential.Type] = map[string]interface{}{"id": credential.ID, "name": credential.Name}
+		node["credentials"] = nodeCredentials
+	}
+}
+
+// restoreOverriddenCredentials replaces the credentials that match an override in the nodes read from n8n
+// with the credentials of prior, the configured or stored nodes, so that overrides do not show up as a
+// change. Credentials that no longer match the override, e.g. because they were changed in n8n, are kept.
+func restoreOverriddenCredentials(nodes, prior map[string]interface{}, overrides types.Map) {
+	for name, element := range overrides.Elements() {
+		ref, ok := element.(types.String)
+		if !ok || ref.IsNull() || ref.IsUnknown() {
+			continue
+		}
+		node, ok := nodes[name].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		remote, ok := node["credentials"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		// Copy the credentials so that the workflow read from n8n is not modified
+		nodeCredentials := make(map[string]interface{}, len(remote))
+		for key, value := range remote {
+			nodeCredentials[key] = value
+		}
+		node["credentials"] = nodeCredentials
+
+		priorNode, _ := prior[name].(map[string]interface{})
+		priorCredentials, hasPrior := priorNode["credentials"].(map[string]interface{})
+
+		for credentialType, value := range nodeCredentials {
+			credential, _ := value.(map[string]interface{})
+			id, _ := credential["id"].(string)
+			credentialName, _ := credential["name"].(string)
+			if id != ref.ValueString() && credentialName != ref.ValueString() {
+				continue
+			}
+
+			if priorCredential, ok := priorCredentials[credentialType]; ok {
+				nodeCredentials[credentialType] = priorCredential
+			} else {
+				delete(nodeCredentials, credentialType)
+			}
+		}
+
+		// A credential the override added to a node without credentials leaves no trace
+		if len(nodeCredentials) == 0 && !hasPrior {
+			delete(node, "credentials")
+		}
+	}
+}
+
+// validateCredentialOverrides checks that each node in credential_overrides is one of the workflow nodes
+func validateCredentialOverrides(nodes map[string]interface{}, overrides types.Map) []string {
+	var problems []string
+	for _, name := range sortedKeys(overrides.Elements()) {
+		if _, ok := nodes[name]; !ok {
+			problems = append(problems, fmt.Sprintf("%s is not a node of the workflow", name))
+		}
+	}
+	return problems
+}
+
+// nodeCredentialReference is a credential a node refers to by ID
+type nodeCredentialReference struct {
+	node           string
+	credentialType string
+	id             string
+}
+
+// nodeCredentialReferences returns the credentials the nodes refer to by ID, sorted by node name and
+// credential type. nodes is keyed by node name.
+func nodeCredentialReferences(nodes map[string]interface{}) []nodeCredentialReference {
+	var refs []nodeCredentialReference
+	for _, name := range sortedKeys(nodes) {
+		node, _ := nodes[name].(map[string]interface{})
+		credentials, _ := node["credentials"].(map[string]interface{})
+		for _, credentialType := range sortedKeys(credentials) {
+			credential, _ := credentials[credentialType].(map[string]interface{})
+			if id, _ := credential["id"].(string); id != "" {
+				refs = append(refs, nodeCredentialReference{node: name, credentialType: credentialType, id: id})
+			}
+		}
+	}
+	return refs
+}
+
+// validateCredentialReferences ensures that the credentials the nodes and credential overrides of a
+// workflow refer to exist in n8n, since n8n only reports missing credentials when the workflow is activated
+// or executed. References that are not known yet, e.g. to credentials created in the same apply, are checked
+// in the plan once they are known.
+func (r *WorkflowResource) validateCredentialReferences(ctx context.Context, plan *WorkflowResourceModel,
+	diags *diag.Diagnostics) {
+	overrideRefs := make(map[string]string)
+	for node, element := range plan.CredentialOverrides.Elements() {
+		if ref, ok := element.(types.String); ok && !ref.IsNull() && !ref.IsUnknown() {
+			overrideRefs[node] = ref.ValueString()
+		}
+	}
+	var nodeRefs []nodeCredentialReference
+	if !plan.Nodes.IsNull() && !plan.Nodes.IsUnknown() {
+		nodeRefs = nodeCredentialReferences(decodeNodes(plan.Nodes))
+	}
+	if len(overrideRefs) == 0 && len(nodeRefs) == 0 {
+		return
+	}
+
+	existing, err := r.client.GetAllCredentials(nil)
+	if err != nil {
+		diags.AddWarning("Workflow Credentials Not Checked",
+			fmt.Sprintf("Unable to list credentials to check the credentials of the workflow, got error: %s", err))
+		return
+	}
+
+	overrides, err := matchCredentialOverrides(existing, overrideRefs)
+	if err != nil {
+		diags.AddAttributeError(path.Root("credential_overrides"), "Unable to Resolve Credential Overrides", err.Error())
+		return
+	}
+
+	ids := make(map[string]bool, len(existing))
+	for _, credential := range existing {
+		ids[credential.ID] = true
+	}
+
+	var missing []string
+	for _, ref := range nodeRefs {
+		// An override replaces the credential of its type
+		if credential, ok := overrides[ref.node]; ok && credential.Type == ref.credentialType {
+			continue
+		}
+		if !ids[ref.id] {
+			missing = append(missing, fmt.Sprintf("%s: %s credential %s", ref.node, ref.credentialType, ref.id))
+		}
+	}
+	if len(missing) > 0 {
+		diags.AddAttributeError(
+			path.Root("nodes"),
+			"Unknown Workflow Credentials",
+			fmt.Sprintf("The nodes refer to credentials that do not exist in n8n, so the workflow would fail to "+
+				"activate:\n  - %s\n\nRefer to credentials managed in this configuration by the id attribute of "+
+				"their n8n_credential, so that they are created before the workflow.", strings.Join(missing, "\n  - ")),
+		)
+	}
+}
+
+// workflowCredentialIDs returns the IDs of the credentials the nodes use. nodes is in the array format of
+// the API.
+func workflowCredentialIDs(nodes []interface{}) types.Set {
+	found := make(map[string]bool)
+	for _, nodeData := range nodes {
+		node, _ := nodeData.(map[string]interface{})
+		credentials, _ := node["credentials"].(map[string]interface{})
+		for _, value := range credentials {
+			credential, _ := value.(map[string]interface{})
+			if id, _ := credential["id"].(string); id != "" {
+				found[id] = true
+			}
+		}
+	}
+
+	ids := make([]attr.Value, 0, len(found))
+	for _, id := range sortedKeys(found) {
+		ids = append(ids, types.StringValue(id))
+	}
+	return types.SetValueMust(types.StringType, ids)
+}
@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+	"github.com/devops247-online/terraform-provider-n8n/internal/client/clientmock"
+)
+
+func TestResolveCredentialOverrides(t *testing.T) {
+	mock := &clientmock.N8nAPI{
+		GetAllCredentialsFunc: func(options *client.CredentialListOptions) ([]client.Credential, error) {
+			return []client.Credential{
+				{ID: "c1", Name: "Slack prod", Type: "slackApi"},
+				{ID: "c2", Name: "Postgres", Type: "postgres"},
+				{ID: "c3", Name: "Postgres", Type: "postgres"},
+			}, nil
+		},
+	}
+
+	credentials, err := resolveCredentialOverrides(mock, map[string]string{"Notify": "Slack prod", "Query": "c3"})
+	if err != nil {
+		t.Fatalf("resolveCredentialOverrides() error = %v", err)
+	}
+	if credentials["Notify"].ID != "c1" || credentials["Query"].ID != "c3" {
+		t.Errorf("Expected the credentials named and identified, got %v", credentials)
+	}
+
+	// Unknown and ambiguous names are reported together
+	_, err = resolveCredentialOverrides(mock, map[string]string{"Notify": "Slack dev", "Query": "Postgres"})
+	if err == nil || !strings.Contains(err.Error(), `Notify: no credential has the ID or name "Slack dev"`) ||
+		!strings.Contains(err.Error(), `Query: 2 credentials are named "Postgres"`) {
+		t.Errorf("Expected the unresolved overrides to be listed, got %v", err)
+	}
+
+	// No overrides need no lookup
+	credentials, err = resolveCredentialOverrides(&clientmock.N8nAPI{}, nil)
+	if err != nil || len(credentials) != 0 {
+		t.Errorf("Expected no credentials, got %v, %v", credentials, err)
+	}
+}
+
+func TestOverrideNodeCredentials(t *testing.T) {
+	configured := map[string]interface{}{
+		"slackApi": map[string]interface{}{"id": "dev-1", "name": "Slack dev"},
+		"httpAuth": map[string]interface{}{"id": "h1", "name": "Header"},
+	}
+	nodes := []interface{}{
+		map[string]interface{}{"name": "Notify", "credentials": configured},
+		map[string]interface{}{"name": "Webhook"},
+	}
+
+	overrideNodeCredentials(nodes, map[string]client.Credential{
+		"Notify": {ID: "c1", Name: "Slack prod", Type: "slackApi"},
+	})
+
+	expected := map[string]interface{}{
+		"slackApi": map[string]interface{}{"id": "c1", "name": "Slack prod"},
+		"httpAuth": map[string]interface{}{"id": "h1", "name": "Header"},
+	}
+	if got := nodes[0].(map[string]interface{})["credentials"]; !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected the credential of the type to be replaced, got %v", got)
+	}
+	if _, ok := nodes[1].(map[string]interface{})["credentials"]; ok {
+		t.Error("Expected nodes without an override to be left alone")
+	}
+	if configured["slackApi"].(map[string]interface{})["id"] != "dev-1" {
+		t.Error("Expected the configured credentials not to be modified")
+	}
+}
+
+func TestRestoreOverriddenCredentials(t *testing.T) {
+	overrides := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"Notify":  types.StringValue("Slack prod"),
+		"Query":   types.StringValue("c2"),
+		"Webhook": types.StringValue("c3"),
+	})
+	prior := map[string]interface{}{
+		"Notify": map[string]interface{}{
+			"credentials": map[string]interface{}{"slackApi": map[string]interface{}{"id": "dev-1", "name": "Slack dev"}},
+		},
+		"Query":   map[string]interface{}{"type": "n8n-nodes-base.postgres"},
+		"Webhook": map[string]interface{}{"type": "n8n-nodes-base.webhook"},
+	}
+	remote := map[string]interface{}{"slackApi": map[string]interface{}{"id": "c1", "name": "Slack prod"}}
+	nodes := map[string]interface{}{
+		"Notify": map[string]interface{}{"credentials": remote},
+		"Query": map[string]interface{}{
+			"type":        "n8n-nodes-base.postgres",
+			"credentials": map[string]interface{}{"postgres": map[string]interface{}{"id": "c2", "name": "Postgres"}},
+		},
+		// The credential was changed in n8n since it was overridden
+		"Webhook": map[string]interface{}{
+			"type":        "n8n-nodes-base.webhook",
+			"credentials": map[string]interface{}{"httpBasicAuth": map[string]interface{}{"id": "c4", "name": "Basic"}},
+		},
+	}
+
+	restoreOverriddenCredentials(nodes, prior, overrides)
+
+	if !reflect.DeepEqual(nodes["Notify"], prior["Notify"]) {
+		t.Errorf("Expected the configured credential, got %v", nodes["Notify"])
+	}
+	if !reflect.DeepEqual(nodes["Query"], prior["Query"]) {
+		t.Errorf("Expected the credential added by the override to be removed, got %v", nodes["Query"])
+	}
+	if _, ok := nodes["Webhook"].(map[string]interface{})["credentials"]; !ok {
+		t.Error("Expected a credential that does not match the override to be kept")
+	}
+	if remote["slackApi"].(map[string]interface{})["id"] != "c1" {
+		t.Error("Expected the nodes read from n8n not to be modified")
+	}
+}
+
+func TestWorkflowResource_ValidateCredentialReferences(t *testing.T) {
+	ctx := context.Background()
+	mock := &clientmock.N8nAPI{
+		GetAllCredentialsFunc: func(options *client.CredentialListOptions) ([]client.Credential, error) {
+			return []client.Credential{
+				{ID: "c1", Name: "Slack prod", Type: "slackApi"},
+				{ID: "c2", Name: "Postgres", Type: "postgres"},
+			}, nil
+		},
+	}
+	r := &WorkflowResource{client: mock}
+
+	nodes := types.StringValue(`{
+		"Notify": {"credentials": {"slackApi": {"id": "c9", "name": "Slack dev"}}},
+		"Query": {"credentials": {"postgres": {"id": "c2", "name": "Postgres"}}},
+		"Store": {"credentials": {"postgres": {"id": "c8", "name": "Postgres old"}}}
+	}`)
+
+	// Overridden credentials do not have to exist
+	plan := &WorkflowResourceModel{
+		Nodes: nodes,
+		CredentialOverrides: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"Notify": types.StringValue("Slack prod"),
+		}),
+	}
+	var diags diag.Diagnostics
+	r.validateCredentialReferences(ctx, plan, &diags)
+	if !diags.HasError() {
+		t.Fatal("Expected an error for the missing credential")
+	}
+	detail := diags.Errors()[0].Detail()
+	if !strings.Contains(detail, "Store: postgres credential c8") || strings.Contains(detail, "c9") {
+		t.Errorf("Expected only the credential of Store to be reported, got %s", detail)
+	}
+
+	// Nodes that are not known yet are checked once they are
+	plan.Nodes = types.StringUnknown()
+	diags = nil
+	r.validateCredentialReferences(ctx, plan, &diags)
+	if diags.HasError() {
+		t.Errorf("Unexpected diagnostics: %v", diags)
+	}
+
+	ids := workflowCredentialIDs([]interface{}{
+		map[string]interface{}{"name": "Query", "credentials": map[string]interface{}{"postgres": map[string]interface{}{"id": "c2"}}},
+		map[string]interface{}{"name": "Store", "credentials": map[string]interface{}{"postgres": map[string]interface{}{"id": "c2"}}},
+		map[string]interface{}{"name": "Notify", "credentials": map[string]interface{}{"slackApi": map[string]interface{}{"id": "c1"}}},
+		map[string]interface{}{"name": "Wait"},
+	})
+	expected := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("c1"), types.StringValue("c2")})
+	if !ids.Equal(expected) {
+		t.Errorf("Expected credential IDs %v, got %v", expected, ids)
+	}
+}
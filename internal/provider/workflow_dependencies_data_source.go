@@ -0,0 +1,218 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &WorkflowDependenciesDataSource{}
+
+func NewWorkflowDependenciesDataSource() datasource.DataSource {
+	return &WorkflowDependenciesDataSource{}
+}
+
+// WorkflowDependenciesDataSource defines the data source implementation.
+type WorkflowDependenciesDataSource struct {
+	client client.N8nAPI
+}
+
+// WorkflowDependenciesDataSourceModel describes the data source data model.
+type WorkflowDependenciesDataSourceModel struct {
+	WorkflowID    types.String `tfsdk:"workflow_id"`
+	Credentials   types.Set    `tfsdk:"credentials"`
+	CredentialIDs types.Set    `tfsdk:"credential_ids"`
+	NodeTypes     types.Set    `tfsdk:"node_types"`
+}
+
+// workflowDependencyCredentialAttrTypes describes the object type of each credentials entry
+func workflowDependencyCredentialAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":   types.StringType,
+		"name": types.StringType,
+		"type": types.StringType,
+	}
+}
+
+// workflowCredentialReference is a credential a node of a workflow refers to
+type workflowCredentialReference struct {
+	ID   string
+	Name string
+	Type string
+}
+
+func (d *WorkflowDependenciesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow_dependencies"
+}
+
+func (d *WorkflowDependenciesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the credentials and node types the nodes of an n8n workflow use, e.g. to check in " +
+			"a precondition that every credential a workflow refers to is managed before it is activated.",
+
+		Attributes: map[string]schema.Attribute{
+			"workflow_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the workflow",
+				Required:            true,
+			},
+			"credentials": schema.SetNestedAttribute{
+				MarkdownDescription: "Credentials the nodes refer to",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "ID of the credential; empty for nodes that only refer to it by name",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the credential as stored in the node",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Credential type (e.g., 'slackApi')",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"credential_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the credentials the nodes refer to",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"node_types": schema.SetAttribute{
+				MarkdownDescription: "Types of the nodes (e.g., 'n8n-nodes-base.httpRequest')",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *WorkflowDependenciesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.N8nAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.N8nAPI, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *WorkflowDependenciesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
+	resp *datasource.ReadResponse) {
+	var data WorkflowDependenciesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workflow, err := d.client.GetWorkflow(data.WorkflowID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workflow, got error: %s", err))
+		return
+	}
+
+	credentials, nodeTypes := workflowDependencies(workflow.Nodes)
+
+	credentialValues := make([]attr.Value, 0, len(credentials))
+	var credentialIDs []attr.Value
+	for _, credential := range credentials {
+		credentialValues = append(credentialValues, types.ObjectValueMust(workflowDependencyCredentialAttrTypes(),
+			map[string]attr.Value{
+				"id":   types.StringValue(credential.ID),
+				"name": types.StringValue(credential.Name),
+				"type": types.StringValue(credential.Type),
+			}))
+		if credential.ID != "" {
+			credentialIDs = append(credentialIDs, types.StringValue(credential.ID))
+		}
+	}
+
+	nodeTypeValues := make([]attr.Value, 0, len(nodeTypes))
+	for _, nodeType := range nodeTypes {
+		nodeTypeValues = append(nodeTypeValues, types.StringValue(nodeType))
+	}
+
+	data.Credentials = types.SetValueMust(types.ObjectType{AttrTypes: workflowDependencyCredentialAttrTypes()}, credentialValues)
+	data.CredentialIDs = types.SetValueMust(types.StringType, credentialIDs)
+	data.NodeTypes = types.SetValueMust(types.StringType, nodeTypeValues)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// workflowDependencies returns the distinct credentials the nodes refer to, sorted by type and ID, and the
+// sorted node types. Nodes store their credentials keyed by credential type, each with an ID and a name.
+func workflowDependencies(nodes []interface{}) ([]workflowCredentialReference, []string) {
+	seenCredentials := make(map[workflowCredentialReference]bool)
+	seenTypes := make(map[string]bool)
+	var credentials []workflowCredentialReference
+	var nodeTypes []string
+
+	for _, n := range nodes {
+		node, ok := n.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if nodeType, ok := node["type"].(string); ok && nodeType != "" && !seenTypes[nodeType] {
+			seenTypes[nodeType] = true
+			nodeTypes = append(nodeTypes, nodeType)
+		}
+
+		nodeCredentials, _ := node["credentials"].(map[string]interface{})
+		for credentialType, c := range nodeCredentials {
+			details, ok := c.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			credential := workflowCredentialReference{Type: credentialType}
+			credential.ID, _ = details["id"].(string)
+			credential.Name, _ = details["name"].(string)
+			if credential.ID == "" && credential.Name == "" || seenCredentials[credential] {
+				continue
+			}
+			seenCredentials[credential] = true
+			credentials = append(credentials, credential)
+		}
+	}
+
+	sort.Slice(credentials, func(i, j int) bool {
+		if credentials[i].Type != credentials[j].Type {
+			return credentials[i].Type < credentials[j].Type
+		}
+		if credentials[i].ID != credentials[j].ID {
+			return credentials[i].ID < credentials[j].ID
+		}
+		return credentials[i].Name < credentials[j].Name
+	})
+	sort.Strings(nodeTypes)
+
+	return credentials, nodeTypes
+}
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+	"github.com/devops247-online/terraform-provider-n8n/internal/client/clientmock"
+)
+
+func testWorkflowWithDependencies() *client.Workflow {
+	slack := map[string]interface{}{"slackApi": map[string]interface{}{"id": "c2", "name": "Slack"}}
+	return &client.Workflow{
+		ID: "wf-1",
+		Nodes: []interface{}{
+			map[string]interface{}{"name": "Webhook", "type": "n8n-nodes-base.webhook"},
+			map[string]interface{}{"name": "Notify", "type": "n8n-nodes-base.slack", "credentials": slack},
+			map[string]interface{}{"name": "Alert", "type": "n8n-nodes-base.slack", "credentials": slack},
+			map[string]interface{}{
+				"name": "Query",
+				"type": "n8n-nodes-base.postgres",
+				"credentials": map[string]interface{}{
+					"postgres": map[string]interface{}{"id": "c1", "name": "Postgres"},
+				},
+			},
+			// Workflows imported without credential IDs refer to credentials by name
+			map[string]interface{}{
+				"name":        "Fetch",
+				"type":        "n8n-nodes-base.httpRequest",
+				"credentials": map[string]interface{}{"httpHeaderAuth": map[string]interface{}{"name": "API token"}},
+			},
+		},
+	}
+}
+
+func TestWorkflowDependencies(t *testing.T) {
+	credentials, nodeTypes := workflowDependencies(testWorkflowWithDependencies().Nodes)
+
+	expectedCredentials := []workflowCredentialReference{
+		{Name: "API token", Type: "httpHeaderAuth"},
+		{ID: "c1", Name: "Postgres", Type: "postgres"},
+		{ID: "c2", Name: "Slack", Type: "slackApi"},
+	}
+	if !reflect.DeepEqual(credentials, expectedCredentials) {
+		t.Errorf("Expected each credential once, got %v", credentials)
+	}
+
+	expectedTypes := []string{"n8n-nodes-base.httpRequest", "n8n-nodes-base.postgres", "n8n-nodes-base.slack", "n8n-nodes-base.webhook"}
+	if !reflect.DeepEqual(nodeTypes, expectedTypes) {
+		t.Errorf("Expected each node type once, got %v", nodeTypes)
+	}
+}
+
+func TestWorkflowDependenciesDataSource_Read(t *testing.T) {
+	ctx := context.Background()
+	mock := &clientmock.N8nAPI{
+		GetWorkflowFunc: func(id string) (*client.Workflow, error) {
+			return testWorkflowWithDependencies(), nil
+		},
+	}
+
+	d := &WorkflowDependenciesDataSource{}
+	d.Configure(ctx, datasource.ConfigureRequest{ProviderData: mock}, &datasource.ConfigureResponse{})
+
+	var schemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+
+	config := WorkflowDependenciesDataSourceModel{
+		WorkflowID:    types.StringValue("wf-1"),
+		Credentials:   types.SetNull(types.ObjectType{AttrTypes: workflowDependencyCredentialAttrTypes()}),
+		CredentialIDs: types.SetNull(types.StringType),
+		NodeTypes:     types.SetNull(types.StringType),
+	}
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	if diags := state.Set(ctx, &config); diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: state.Raw}}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var result WorkflowDependenciesDataSourceModel
+	resp.State.Get(ctx, &result)
+
+	var credentialIDs []string
+	resp.Diagnostics.Append(result.CredentialIDs.ElementsAs(ctx, &credentialIDs, false)...)
+	if !reflect.DeepEqual(credentialIDs, []string{"c1", "c2"}) {
+		t.Errorf("Expected the IDs of the credentials that have one, got %v", credentialIDs)
+	}
+	if len(result.Credentials.Elements()) != 3 || len(result.NodeTypes.Elements()) != 4 {
+		t.Errorf("Expected 3 credentials and 4 node types, got %v and %v", result.Credentials, result.NodeTypes)
+	}
+}
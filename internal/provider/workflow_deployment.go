@@ -0,0 +1,227 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+)
+
+// Strategies n8n_workflow deploys changes to active workflows with
+const (
+	workflowDeploymentInPlace   = "in_place"
+	workflowDeploymentBlueGreen = "blue_green"
+)
+
+// workflowCheckTimeout is the default time in seconds to wait for verification and check executions
+const workflowCheckTimeout = 300
+
+// WorkflowPostUpdateCheckModel describes the post_update_check block, a manual execution that must succeed
+// after the workflow is updated in place
+type WorkflowPostUpdateCheckModel struct {
+	PinData types.String `tfsdk:"pin_data"`
+	Timeout types.Int64  `tfsdk:"timeout"`
+}
+
+// workflowPostUpdateCheckAttrTypes describes the object type of the post_update_check block
+func workflowPostUpdateCheckAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"pin_data": types.StringType,
+		"timeout":  types.Int64Type,
+	}
+}
+
+// blueGreenDeployment reports whether applying plan deploys the changes to a new workflow that replaces the
+// one in state. This is the case with the blue_green strategy when the nodes, connections or settings of a
+// workflow that is and stays active change.
+func blueGreenDeployment(plan, state *WorkflowResourceModel) bool {
+	if plan.DeploymentStrategy.ValueString() != workflowDeploymentBlueGreen || !state.Active.ValueBool() ||
+		!plan.Active.ValueBool() || state.Archived.ValueBool() || plan.Archived.ValueBool() {
+		return false
+	}
+
+	for _, values := range [][2]attr.Value{
+		{plan.Nodes, state.Nodes},
+		{plan.Connections, state.Connections},
+		{plan.Settings, state.Settings},
+		{plan.CredentialOverrides, state.CredentialOverrides},
+		{plan.ParameterOverrides, state.ParameterOverrides},
+		{plan.ErrorWorkflowID, state.ErrorWorkflowID},
+		{plan.Timezone, state.Timezone},
+		{plan.ExecutionTimeout, state.ExecutionTimeout},
+		{plan.SaveExecutionProgress, state.SaveExecutionProgress},
+		{plan.SaveManualExecutions, state.SaveManualExecutions},
+		{plan.CallerPolicy, state.CallerPolicy},
+	} {
+		if !values[0].Equal(values[1]) {
+			return true
+		}
+	}
+	return false
+}
+
+// deployBlueGreen creates a new workflow from workflow, places it like the model, switches activation over
+// from the workflow with the ID activeID and archives that one once the new workflow is verified. Webhook
+// paths can only be registered by one active workflow, so the active workflow is deactivated right before
+// the new one is activated. If the new workflow cannot be set up, activated or verified, it is deleted
+// again and the previous workflow stays active. On success, the model describes the new workflow.
+func (r *WorkflowResource) deployBlueGreen(ctx context.Context, model *WorkflowResourceModel, activeID string,
+	workflow *client.Workflow, diags *diag.Diagnostics) (*client.Workflow, bool) {
+	workflow.Active = false
+	created, err := r.client.CreateWorkflow(workflow)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to create workflow, got error: %s", err))
+		return nil, false
+	}
+
+	rollback := func(reactivate bool) {
+		if err := r.client.DeleteWorkflow(created.ID); err != nil {
+			diags.AddWarning("Workflow Not Cleaned Up", fmt.Sprintf("Unable to delete workflow %s created by the "+
+				"failed deployment, got error: %s", created.ID, err))
+		}
+		if !reactivate {
+			return
+		}
+		if _, err := r.client.ActivateWorkflow(activeID); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to reactivate workflow %s after the failed deployment, "+
+				"got error: %s", activeID, err))
+		}
+	}
+
+	// The new workflow is set up like the active one before activation is switched over
+	projectID := createProjectID(r.client, model.ProjectID)
+	if projectID != "" && !moveToProject("n8n_workflow", created.ID, projectID, r.client.TransferWorkflow, diags) {
+		rollback(false)
+		return nil, false
+	}
+	if !model.FolderID.IsNull() && !r.moveToFolder(created.ID, model.FolderID.ValueString(), diags) {
+		rollback(false)
+		return nil, false
+	}
+	tags, ok := r.applyTags(ctx, created.ID, model.Tags, model.CreateMissingTags.ValueBool(), diags)
+	if !ok {
+		rollback(false)
+		return nil, false
+	}
+
+	if _, err := r.client.DeactivateWorkflow(activeID); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to deactivate workflow, got error: %s", err))
+		rollback(false)
+		return nil, false
+	}
+	activated, err := r.client.ActivateWorkflow(created.ID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to activate workflow, got error: %s", err))
+		rollback(true)
+		return nil, false
+	}
+
+	if !model.VerificationPayload.IsNull() && !r.verifyDeployment(created.ID, model, diags) {
+		rollback(true)
+		return nil, false
+	}
+
+	// The previous workflow is kept archived, so that it can be restored in n8n
+	if _, err := r.client.ArchiveWorkflow(activeID); err != nil {
+		diags.AddWarning("Previous Workflow Not Archived", fmt.Sprintf("Workflow %s was replaced by workflow %s and "+
+			"deactivated, but could not be archived, got error: %s", activeID, created.ID, err))
+	}
+
+	folderID := model.FolderID
+	r.updateModelFromWorkflow(model, activated)
+	model.ProjectID = projectIDValue(projectID)
+	model.FolderID = folderID
+	model.Tags = tags
+	return activated, true
+}
+
+// verifyDeployment runs the workflow with the verification payload and reports whether the execution succeeded
+func (r *WorkflowResource) verifyDeployment(id string, model *WorkflowResourceModel, diags *diag.Diagnostics) bool {
+	var payload map[string]interface{}
+	if err := json.Unmarshal([]byte(model.VerificationPayload.ValueString()), &payload); err != nil {
+		diags.AddError("Invalid Verification Payload JSON",
+			fmt.Sprintf("Unable to parse verification_payload as a JSON object: %s", err))
+		return false
+	}
+
+	executionID, err := r.client.RunWorkflow(id, payload)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to run workflow, got error: %s", err))
+		return false
+	}
+
+	status, ok := r.waitForExecutionStatus(executionID, model.VerificationTimeout, diags)
+	if ok && status != client.ExecutionStatusSuccess {
+		diags.AddError("Workflow Verification Failed", fmt.Sprintf("Execution %s of the new workflow %s finished with "+
+			"status '%s', so the deployment was rolled back.", executionID, id, status))
+		return false
+	}
+	return ok
+}
+
+// checkWorkflowUpdate runs the updated workflow with the pinned data of the post update check and reports
+// whether the execution succeeded
+func (r *WorkflowResource) checkWorkflowUpdate(id string, check *WorkflowPostUpdateCheckModel, diags *diag.Diagnostics) bool {
+	var pinData map[string]interface{}
+	if !check.PinData.IsNull() {
+		if err := json.Unmarshal([]byte(check.PinData.ValueString()), &pinData); err != nil {
+			diags.AddError("Invalid Pin Data JSON",
+				fmt.Sprintf("Unable to parse post_update_check.pin_data as a JSON object: %s", err))
+			return false
+		}
+	}
+
+	executionID, err := r.client.RunWorkflowWithPinData(id, pinData)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to run workflow, got error: %s", err))
+		return false
+	}
+
+	status, ok := r.waitForExecutionStatus(executionID, check.Timeout, diags)
+	if ok && status != client.ExecutionStatusSuccess {
+		diags.AddError("Post Update Check Failed", fmt.Sprintf("Execution %s of workflow %s finished with status '%s', "+
+			"so the update was rolled back.", executionID, id, status))
+		return false
+	}
+	return ok
+}
+
+// waitForExecutionStatus waits up to timeout seconds, or workflowCheckTimeout if it is not set, for an
+// execution to finish and returns its status
+func (r *WorkflowResource) waitForExecutionStatus(executionID string, timeout types.Int64,
+	diags *diag.Diagnostics) (string, bool) {
+	seconds := int64(workflowCheckTimeout)
+	if !timeout.IsNull() && !timeout.IsUnknown() {
+		seconds = timeout.ValueInt64()
+	}
+
+	execution, err := r.client.WaitForExecution(executionID, time.Duration(seconds)*time.Second)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to wait for execution %s, got error: %s", executionID, err))
+		return "", false
+	}
+	return executionStatus(execution), true
+}
+
+// revertWorkflowUpdate restores the fields an update changed to their values in before, the workflow as it
+// was in n8n before the update, and returns the restored workflow
+func (r *WorkflowResource) revertWorkflowUpdate(id string, before, updated *client.Workflow,
+	changes map[string]interface{}) (*client.Workflow, error) {
+	revert, err := client.WorkflowChanges(updated, before)
+	if err != nil {
+		return nil, err
+	}
+	for field := range revert {
+		if _, ok := changes[field]; !ok {
+			delete(revert, field)
+		}
+	}
+
+	return r.client.PatchWorkflow(id, revert)
+}
@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+	"github.com/devops247-online/terraform-provider-n8n/internal/client/clientmock"
+)
+
+func TestBlueGreenDeployment(t *testing.T) {
+	state := WorkflowResourceModel{
+		Name:                types.StringValue("Orders"),
+		Active:              types.BoolValue(true),
+		Nodes:               types.StringValue(`{"Webhook": {"type": "n8n-nodes-base.webhook"}}`),
+		DeploymentStrategy:  types.StringValue(workflowDeploymentBlueGreen),
+		CredentialOverrides: types.MapNull(types.StringType),
+		ParameterOverrides:  types.MapNull(types.StringType),
+	}
+
+	tests := []struct {
+		name   string
+		modify func(plan *WorkflowResourceModel)
+		want   bool
+	}{
+		{name: "no change", modify: func(plan *WorkflowResourceModel) {}},
+		{name: "rename", modify: func(plan *WorkflowResourceModel) { plan.Name = types.StringValue("Order Sync") }},
+		{
+			name:   "changed nodes",
+			modify: func(plan *WorkflowResourceModel) { plan.Nodes = types.StringValue(`{}`) },
+			want:   true,
+		},
+		{
+			name:   "unknown nodes",
+			modify: func(plan *WorkflowResourceModel) { plan.Nodes = types.StringUnknown() },
+			want:   true,
+		},
+		{
+			name:   "changed timezone",
+			modify: func(plan *WorkflowResourceModel) { plan.Timezone = types.StringValue("Europe/Berlin") },
+			want:   true,
+		},
+		{
+			name: "in place strategy",
+			modify: func(plan *WorkflowResourceModel) {
+				plan.Nodes = types.StringValue(`{}`)
+				plan.DeploymentStrategy = types.StringValue(workflowDeploymentInPlace)
+			},
+		},
+		{
+			name: "deactivation",
+			modify: func(plan *WorkflowResourceModel) {
+				plan.Nodes = types.StringValue(`{}`)
+				plan.Active = types.BoolValue(false)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := state
+			tt.modify(&plan)
+			if got := blueGreenDeployment(&plan, &state); got != tt.want {
+				t.Errorf("blueGreenDeployment() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWorkflowResource_DeployBlueGreen(t *testing.T) {
+	ctx := context.Background()
+
+	newMock := func(status string) *clientmock.N8nAPI {
+		return &clientmock.N8nAPI{
+			CreateWorkflowFunc: func(workflow *client.Workflow) (*client.Workflow, error) {
+				if workflow.Active {
+					t.Error("Expected the new workflow to be created inactive")
+				}
+				created := *workflow
+				created.ID = "wf-green"
+				return &created, nil
+			},
+			GetAllTagsFunc: func() ([]client.Tag, error) {
+				return []client.Tag{{ID: "tag-1", Name: "billing"}}, nil
+			},
+			UpdateWorkflowTagsFunc: func(workflowID string, tagIDs []string) ([]client.Tag, error) {
+				return []client.Tag{{ID: "tag-1", Name: "billing"}}, nil
+			},
+			DeactivateWorkflowFunc: func(id string) (*client.Workflow, error) {
+				return &client.Workflow{ID: id}, nil
+			},
+			ActivateWorkflowFunc: func(id string) (*client.Workflow, error) {
+				return &client.Workflow{ID: id, Name: "Orders", Active: true}, nil
+			},
+			RunWorkflowFunc: func(id string, payload map[string]interface{}) (string, error) {
+				if id != "wf-green" || payload["order"] != "test" {
+					t.Errorf("Unexpected verification run of %s with %v", id, payload)
+				}
+				return "exec-1", nil
+			},
+			WaitForExecutionFunc: func(id string, timeout time.Duration) (*client.Execution, error) {
+				return &client.Execution{Status: status}, nil
+			},
+			ArchiveWorkflowFunc: func(id string) (*client.Workflow, error) {
+				return &client.Workflow{ID: id, IsArchived: true}, nil
+			},
+			DeleteWorkflowFunc: func(id string) error {
+				return nil
+			},
+		}
+	}
+	newModel := func() *WorkflowResourceModel {
+		return &WorkflowResourceModel{
+			ID:                  types.StringValue("wf-blue"),
+			Tags:                types.ListValueMust(types.StringType, []attr.Value{types.StringValue("billing")}),
+			VerificationPayload: types.StringValue(`{"order": "test"}`),
+			VerificationTimeout: types.Int64Value(60),
+		}
+	}
+
+	mock := newMock(client.ExecutionStatusSuccess)
+	r := &WorkflowResource{client: mock}
+	model := newModel()
+	var diags diag.Diagnostics
+	if _, ok := r.deployBlueGreen(ctx, model, "wf-blue", &client.Workflow{Name: "Orders", Active: true}, &diags); !ok {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+	if model.ID.ValueString() != "wf-green" || !model.Active.ValueBool() || len(model.Tags.Elements()) != 1 {
+		t.Errorf("Expected the model to describe the new workflow, got %+v", model)
+	}
+	expected := []string{"CreateWorkflow", "GetAllTags", "UpdateWorkflowTags", "DeactivateWorkflow",
+		"ActivateWorkflow", "RunWorkflow", "WaitForExecution", "ArchiveWorkflow", "WorkflowWebhooks"}
+	if !reflect.DeepEqual(mock.Calls, expected) {
+		t.Errorf("Expected calls %v, got %v", expected, mock.Calls)
+	}
+
+	// A failed verification deletes the new workflow and reactivates the previous one
+	mock = newMock(client.ExecutionStatusError)
+	r = &WorkflowResource{client: mock}
+	model = newModel()
+	diags = nil
+	if _, ok := r.deployBlueGreen(ctx, model, "wf-blue", &client.Workflow{Name: "Orders"}, &diags); ok {
+		t.Fatal("Expected the deployment to fail")
+	}
+	if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), "rolled back") {
+		t.Errorf("Expected the failed verification to be reported, got %v", diags)
+	}
+	if model.ID.ValueString() != "wf-blue" {
+		t.Errorf("Expected the model to keep the previous workflow, got %s", model.ID.ValueString())
+	}
+	expected = []string{"CreateWorkflow", "GetAllTags", "UpdateWorkflowTags", "DeactivateWorkflow",
+		"ActivateWorkflow", "RunWorkflow", "WaitForExecution", "DeleteWorkflow", "ActivateWorkflow"}
+	if !reflect.DeepEqual(mock.Calls, expected) {
+		t.Errorf("Expected calls %v, got %v", expected, mock.Calls)
+	}
+}
+
+func TestWorkflowResource_PostUpdateCheck(t *testing.T) {
+	var pinned map[string]interface{}
+	var reverted map[string]interface{}
+	mock := &clientmock.N8nAPI{
+		RunWorkflowWithPinDataFunc: func(id string, pinData map[string]interface{}) (string, error) {
+			pinned = pinData
+			return "exec-1", nil
+		},
+		WaitForExecutionFunc: func(id string, timeout time.Duration) (*client.Execution, error) {
+			if timeout != 30*time.Second {
+				t.Errorf("Expected the timeout of the check, got %s", timeout)
+			}
+			return &client.Execution{Status: client.ExecutionStatusError}, nil
+		},
+		PatchWorkflowFunc: func(id string, fields map[string]interface{}) (*client.Workflow, error) {
+			reverted = fields
+			return &client.Workflow{ID: id, Name: "Orders"}, nil
+		},
+	}
+	r := &WorkflowResource{client: mock}
+
+	check := &WorkflowPostUpdateCheckModel{
+		PinData: types.StringValue(`{"Webhook": [{"json": {"order_id": 1}}]}`),
+		Timeout: types.Int64Value(30),
+	}
+	var diags diag.Diagnostics
+	if r.checkWorkflowUpdate("wf-1", check, &diags) {
+		t.Fatal("Expected the failed execution to fail the check")
+	}
+	if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), "rolled back") {
+		t.Errorf("Expected the failed check to be reported, got %v", diags)
+	}
+	if _, ok := pinned["Webhook"]; !ok {
+		t.Errorf("Expected the pin data of the check, got %v", pinned)
+	}
+
+	// Only the fields the update changed are restored
+	before := &client.Workflow{ID: "wf-1", Name: "Orders", Nodes: []interface{}{map[string]interface{}{"name": "Webhook"}}}
+	updated := &client.Workflow{ID: "wf-1", Name: "Orders", Nodes: []interface{}{}, VersionID: "v2"}
+	changes := map[string]interface{}{"nodes": []interface{}{}}
+	if _, err := r.revertWorkflowUpdate("wf-1", before, updated, changes); err != nil {
+		t.Fatalf("revertWorkflowUpdate() error = %v", err)
+	}
+	expected := map[string]interface{}{"nodes": []interface{}{map[string]interface{}{"name": "Webhook"}}}
+	if !reflect.DeepEqual(reverted, expected) {
+		t.Errorf("Expected the nodes before the update to be restored, got %v", reverted)
+	}
+}
@@ -0,0 +1,257 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &WorkflowExecutionResource{}
+
+func NewWorkflowExecutionResource() resource.Resource {
+	return &WorkflowExecutionResource{}
+}
+
+// WorkflowExecutionResource defines the resource implementation.
+type WorkflowExecutionResource struct {
+	client client.N8nAPI
+}
+
+// WorkflowExecutionResourceModel describes the resource data model.
+type WorkflowExecutionResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	WorkflowID        types.String `tfsdk:"workflow_id"`
+	Payload           types.String `tfsdk:"payload"`
+	Triggers          types.Map    `tfsdk:"triggers"`
+	WaitForCompletion types.Bool   `tfsdk:"wait_for_completion"`
+	Timeout           types.Int64  `tfsdk:"timeout"`
+	Status            types.String `tfsdk:"status"`
+	StartedAt         types.String `tfsdk:"started_at"`
+	FinishedAt        types.String `tfsdk:"finished_at"`
+}
+
+func (r *WorkflowExecutionResource) Metadata(ctx context.Context, req resource.MetadataRequest,
+	resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow_execution"
+}
+
+func (r *WorkflowExecutionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Runs an n8n workflow, e.g. to seed data or run a migration workflow after deploying it. " +
+			"The workflow runs when the resource is created and again whenever `workflow_id`, `payload` or `triggers` " +
+			"change. Manual runs use the internal REST API and therefore require session authentication. Waiting for " +
+			"completion requires n8n to save manual executions.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the execution",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workflow_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the workflow to run",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"payload": schema.StringAttribute{
+				MarkdownDescription: "JSON object passed to the workflow as the output of its trigger node",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that trigger a new execution when changed",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				MarkdownDescription: "Whether to wait for the execution to finish and fail if it does not succeed. " +
+					"Defaults to true.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"timeout": schema.Int64Attribute{
+				MarkdownDescription: "Maximum time in seconds to wait for the execution to finish. Defaults to 300.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(300),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64AtLeast(1),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Outcome of the execution (e.g., 'success'), or 'running' when not waiting for completion",
+				Computed:            true,
+			},
+			"started_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the execution started",
+				Computed:            true,
+			},
+			"finished_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the execution finished",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *WorkflowExecutionResource) Configure(ctx context.Context, req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.N8nAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.N8nAPI, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *WorkflowExecutionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data WorkflowExecutionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var payload map[string]interface{}
+	if !data.Payload.IsNull() && data.Payload.ValueString() != "" {
+		if err := json.Unmarshal([]byte(data.Payload.ValueString()), &payload); err != nil {
+			resp.Diagnostics.AddError("Invalid Payload JSON", fmt.Sprintf("Unable to parse payload as a JSON object: %s", err))
+			return
+		}
+	}
+
+	executionID, err := r.client.RunWorkflow(data.WorkflowID.ValueString(), payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to run workflow, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(executionID)
+
+	if !data.WaitForCompletion.ValueBool() {
+		data.updateFromExecution(&client.Execution{Status: client.ExecutionStatusRunning})
+
+		// Save data into Terraform state
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	timeout := time.Duration(data.Timeout.ValueInt64()) * time.Second
+	execution, err := r.client.WaitForExecution(executionID, timeout)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to wait for execution %s, got error: %s", executionID, err))
+		return
+	}
+
+	data.updateFromExecution(execution)
+
+	if data.Status.ValueString() != client.ExecutionStatusSuccess {
+		resp.Diagnostics.AddError("Workflow Execution Failed", fmt.Sprintf("Execution %s of workflow %s finished with status '%s'.",
+			executionID, data.WorkflowID.ValueString(), data.Status.ValueString()))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WorkflowExecutionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data WorkflowExecutionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// n8n may prune old executions, so the state of a past run is kept as is
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WorkflowExecutionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All configurable attributes require replacement, so in-place updates never happen
+	resp.Diagnostics.AddError(
+		"Update Not Supported",
+		"A workflow execution cannot be updated in place. Changing the execution requires replacing the resource.",
+	)
+}
+
+func (r *WorkflowExecutionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// An execution cannot be undone; removing the resource only removes it from Terraform state
+}
+
+// updateFromExecution sets the computed attributes from an execution
+func (m *WorkflowExecutionResourceModel) updateFromExecution(execution *client.Execution) {
+	m.Status = types.StringValue(executionStatus(execution))
+
+	m.StartedAt = types.StringNull()
+	if execution.StartedAt != nil {
+		m.StartedAt = types.StringValue(execution.StartedAt.Format("2006-01-02T15:04:05Z"))
+	}
+
+	m.FinishedAt = types.StringNull()
+	if execution.StoppedAt != nil {
+		m.FinishedAt = types.StringValue(execution.StoppedAt.Format("2006-01-02T15:04:05Z"))
+	}
+}
+
+// executionStatus returns the status of a finished execution
+func executionStatus(execution *client.Execution) string {
+	if execution.Status != "" {
+		return execution.Status
+	}
+
+	// Older n8n versions only report whether the execution finished successfully
+	if execution.Finished {
+		return client.ExecutionStatusSuccess
+	}
+	return client.ExecutionStatusError
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+)
+
+func TestWorkflowExecutionResourceModel_UpdateFromExecution(t *testing.T) {
+	startedAt := time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC)
+	stoppedAt := startedAt.Add(time.Minute)
+
+	var model WorkflowExecutionResourceModel
+	model.updateFromExecution(&client.Execution{
+		Status:    client.ExecutionStatusSuccess,
+		StartedAt: &startedAt,
+		StoppedAt: &stoppedAt,
+	})
+
+	if model.Status.ValueString() != "success" {
+		t.Errorf("Expected status 'success', got %q", model.Status.ValueString())
+	}
+	if model.StartedAt.ValueString() != "2024-01-02T10:00:00Z" {
+		t.Errorf("Expected started_at '2024-01-02T10:00:00Z', got %q", model.StartedAt.ValueString())
+	}
+	if model.FinishedAt.ValueString() != "2024-01-02T10:01:00Z" {
+		t.Errorf("Expected finished_at '2024-01-02T10:01:00Z', got %q", model.FinishedAt.ValueString())
+	}
+
+	// Older n8n versions do not report a status
+	model.updateFromExecution(&client.Execution{Finished: false, StoppedAt: &stoppedAt})
+	if model.Status.ValueString() != "error" {
+		t.Errorf("Expected status 'error' for an unfinished stopped execution, got %q", model.Status.ValueString())
+	}
+	if !model.StartedAt.IsNull() {
+		t.Error("Expected missing started_at to be null")
+	}
+
+	model.updateFromExecution(&client.Execution{Finished: true})
+	if model.Status.ValueString() != "success" {
+		t.Errorf("Expected status 'success' for a finished execution, got %q", model.Status.ValueString())
+	}
+}
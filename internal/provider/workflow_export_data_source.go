@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &WorkflowExportDataSource{}
+
+func NewWorkflowExportDataSource() datasource.DataSource {
+	return &WorkflowExportDataSource{}
+}
+
+// WorkflowExportDataSource defines the data source implementation.
+type WorkflowExportDataSource struct {
+	client client.N8nAPI
+}
+
+// WorkflowExportDataSourceModel describes the data source data model.
+type WorkflowExportDataSourceModel struct {
+	WorkflowID       types.String `tfsdk:"workflow_id"`
+	StripIDs         types.Bool   `tfsdk:"strip_ids"`
+	StripCredentials types.Bool   `tfsdk:"strip_credentials"`
+	Name             types.String `tfsdk:"name"`
+	VersionID        types.String `tfsdk:"version_id"`
+	Archived         types.Bool   `tfsdk:"archived"`
+	JSON             types.String `tfsdk:"json"`
+}
+
+func (d *WorkflowExportDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow_export"
+}
+
+func (d *WorkflowExportDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Exports an n8n workflow as JSON in the format of the n8n editor export, e.g. to back up " +
+			"workflows to object storage. The JSON is canonical: keys are sorted and indentation is stable, so the " +
+			"export only changes when the workflow does.",
+
+		Attributes: map[string]schema.Attribute{
+			"workflow_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the workflow to export",
+				Required:            true,
+			},
+			"strip_ids": schema.BoolAttribute{
+				MarkdownDescription: "Whether to remove the workflow ID, version ID and node IDs from the export, e.g. to " +
+					"import it into another instance. Defaults to false.",
+				Optional: true,
+			},
+			"strip_credentials": schema.BoolAttribute{
+				MarkdownDescription: "Whether to remove the credential references of nodes from the export. Defaults to false.",
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the workflow",
+				Computed:            true,
+			},
+			"version_id": schema.StringAttribute{
+				MarkdownDescription: "Version identifier of the exported workflow",
+				Computed:            true,
+			},
+			"archived": schema.BoolAttribute{
+				MarkdownDescription: "Whether the workflow is archived. The export does not record it, so restoring an " +
+					"archived workflow from the export brings it back as a live workflow.",
+				Computed: true,
+			},
+			"json": schema.StringAttribute{
+				MarkdownDescription: "Exported workflow JSON",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *WorkflowExportDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.N8nAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.N8nAPI, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *WorkflowExportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data WorkflowExportDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workflow, err := d.client.GetWorkflow(data.WorkflowID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workflow, got error: %s", err))
+		return
+	}
+
+	export, err := exportWorkflowJSON(workflow, data.StripIDs.ValueBool(), data.StripCredentials.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Unable to encode workflow export, got error: %s", err))
+		return
+	}
+
+	data.Name = types.StringValue(workflow.Name)
+	data.VersionID = types.StringValue(workflow.VersionID)
+	data.Archived = types.BoolValue(workflow.IsArchived)
+	data.JSON = types.StringValue(export)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// exportWorkflowJSON renders a workflow in the n8n export format, optionally without identifiers or
+// credential references. Maps are marshaled with sorted keys, so the output is canonical.
+func exportWorkflowJSON(workflow *client.Workflow, stripIDs, stripCredentials bool) (string, error) {
+	nodes := make([]interface{}, 0, len(workflow.Nodes))
+	for _, n := range workflow.Nodes {
+		node, ok := n.(map[string]interface{})
+		if !ok {
+			nodes = append(nodes, n)
+			continue
+		}
+
+		// Copy the node so that the workflow itself is not modified
+		exported := make(map[string]interface{}, len(node))
+		for key, value := range node {
+			exported[key] = value
+		}
+		if stripIDs {
+			delete(exported, "id")
+		}
+		if stripCredentials {
+			delete(exported, "credentials")
+		}
+		nodes = append(nodes, exported)
+	}
+
+	export := map[string]interface{}{
+		"name":        workflow.Name,
+		"active":      workflow.Active,
+		"nodes":       nodes,
+		"connections": emptyIfNil(workflow.Connections),
+		"settings":    emptyIfNil(workflow.Settings),
+		"staticData":  workflow.StaticData,
+		"pinData":     emptyIfNil(workflow.PinnedData),
+		"tags":        workflow.Tags,
+	}
+	if workflow.Tags == nil {
+		export["tags"] = []string{}
+	}
+	if !stripIDs {
+		export["id"] = workflow.ID
+		export["versionId"] = workflow.VersionID
+	}
+
+	result, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(result), nil
+}
+
+// emptyIfNil returns an empty map for a nil map, so that it is exported as {} rather than null
+func emptyIfNil(m map[string]interface{}) map[string]interface{} {
+	if m == nil {
+		return map[string]interface{}{}
+	}
+	return m
+}
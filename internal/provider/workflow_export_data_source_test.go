@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+)
+
+func testWorkflowForExport() *client.Workflow {
+	return &client.Workflow{
+		ID:        "wf-1",
+		Name:      "Backup",
+		VersionID: "v1",
+		Nodes: []interface{}{
+			map[string]interface{}{
+				"id":          "node-1",
+				"name":        "HTTP Request",
+				"type":        "n8n-nodes-base.httpRequest",
+				"credentials": map[string]interface{}{"httpHeaderAuth": map[string]interface{}{"id": "cred-1"}},
+			},
+		},
+		Connections: map[string]interface{}{},
+	}
+}
+
+func TestExportWorkflowJSON(t *testing.T) {
+	workflow := testWorkflowForExport()
+
+	export, err := exportWorkflowJSON(workflow, false, false)
+	if err != nil {
+		t.Fatalf("exportWorkflowJSON() error = %v", err)
+	}
+
+	var parsed map[string]interface{}
+	if err := json.Unmarshal([]byte(export), &parsed); err != nil {
+		t.Fatalf("Export is not valid JSON: %v", err)
+	}
+	if parsed["id"] != "wf-1" || parsed["versionId"] != "v1" || parsed["name"] != "Backup" {
+		t.Errorf("Expected workflow identity in the export, got %v", parsed)
+	}
+	if _, ok := parsed["pinData"].(map[string]interface{}); !ok {
+		t.Errorf("Expected empty pinData object, got %v", parsed["pinData"])
+	}
+	if !strings.Contains(export, `"credentials"`) {
+		t.Error("Expected node credentials to be kept")
+	}
+
+	// The export is canonical
+	again, err := exportWorkflowJSON(workflow, false, false)
+	if err != nil {
+		t.Fatalf("exportWorkflowJSON() error = %v", err)
+	}
+	if again != export {
+		t.Error("Expected repeated exports to be identical")
+	}
+}
+
+func TestExportWorkflowJSON_Strip(t *testing.T) {
+	workflow := testWorkflowForExport()
+
+	export, err := exportWorkflowJSON(workflow, true, true)
+	if err != nil {
+		t.Fatalf("exportWorkflowJSON() error = %v", err)
+	}
+
+	for _, key := range []string{`"id"`, `"versionId"`, `"credentials"`} {
+		if strings.Contains(export, key) {
+			t.Errorf("Expected %s to be stripped from the export:\n%s", key, export)
+		}
+	}
+
+	// The workflow itself is left untouched
+	node := workflow.Nodes[0].(map[string]interface{})
+	if node["id"] != "node-1" || node["credentials"] == nil {
+		t.Error("Expected the exported workflow not to be modified")
+	}
+}
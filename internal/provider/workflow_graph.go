@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+)
+
+// workflowNodeMainInputs and workflowNodeMainOutputs list the number of main inputs and outputs of
+// node types with a fixed layout. Connections of node types that are not listed are not checked,
+// since their inputs and outputs are unknown without the node definitions.
+var (
+	workflowNodeMainInputs = map[string]int{
+		"n8n-nodes-base.merge":           2,
+		"n8n-nodes-base.compareDatasets": 2,
+	}
+	workflowNodeMainOutputs = map[string]int{
+		"n8n-nodes-base.if":              2,
+		"n8n-nodes-base.compareDatasets": 4,
+	}
+)
+
+// workflowGraphResult holds the problems found by validateWorkflowGraph
+type workflowGraphResult struct {
+	Errors   []string
+	Warnings []string
+}
+
+// workflowNodeMainInputCount returns the number of main inputs of a node, or 0 if it is not known
+func workflowNodeMainInputCount(node map[string]interface{}) int {
+	nodeType, _ := node["type"].(string)
+	count := workflowNodeMainInputs[nodeType]
+
+	// The merge node can be configured with more inputs
+	if parameters, ok := node["parameters"].(map[string]interface{}); ok && count > 0 {
+		if n, ok := parameters["numberInputs"].(float64); ok && n > 0 {
+			count = int(n)
+		}
+	}
+
+	return count
+}
+
+// validateWorkflowGraph checks that connections reference defined nodes and existing inputs and outputs,
+// and warns about trigger nodes that are not connected to anything. Nodes are keyed by node name, like
+// connections reference them; a name set in the node itself is accepted as well.
+func validateWorkflowGraph(nodes, connections map[string]interface{}) workflowGraphResult {
+	var result workflowGraphResult
+
+	// Map node IDs and names to node IDs
+	nodeKeys := make(map[string]string, len(nodes))
+	for key, value := range nodes {
+		nodeKeys[key] = key
+		if node, ok := value.(map[string]interface{}); ok {
+			if name, ok := node["name"].(string); ok && name != "" {
+				nodeKeys[name] = key
+			}
+		}
+	}
+
+	connected := make(map[string]bool)
+	for _, source := range sortedKeys(connections) {
+		sourceKey, ok := nodeKeys[source]
+		if !ok {
+			result.Errors = append(result.Errors, fmt.Sprintf("connections.%s: node %q is not defined in nodes", source, source))
+		} else {
+			connected[sourceKey] = true
+		}
+
+		sourceNode, _ := nodes[sourceKey].(map[string]interface{})
+		sourceType, _ := sourceNode["type"].(string)
+
+		outputs, ok := connections[source].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for _, outputType := range sortedKeys(outputs) {
+			outputList, ok := outputs[outputType].([]interface{})
+			if !ok {
+				continue
+			}
+
+			for outputIndex, targets := range outputList {
+				targetList, ok := targets.([]interface{})
+				if !ok || len(targetList) == 0 {
+					continue
+				}
+
+				outputPath := fmt.Sprintf("connections.%s.%s[%d]", source, outputType, outputIndex)
+				if count, ok := workflowNodeMainOutputs[sourceType]; ok && outputType == "main" && outputIndex >= count {
+					result.Errors = append(result.Errors, fmt.Sprintf("%s: output index %d is out of range, node %q has %d outputs",
+						outputPath, outputIndex, source, count))
+				}
+
+				for i, target := range targetList {
+					conn, ok := target.(map[string]interface{})
+					if !ok {
+						continue
+					}
+
+					connPath := fmt.Sprintf("%s[%d]", outputPath, i)
+					if problem := validateWorkflowConnection(connPath, conn, nodes, nodeKeys); problem != "" {
+						result.Errors = append(result.Errors, problem)
+					}
+				}
+			}
+		}
+	}
+
+	// A trigger that is not connected to anything never runs the other nodes
+	if len(nodes) > 1 {
+		for _, key := range sortedKeys(nodes) {
+			node, ok := nodes[key].(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			nodeType, _ := node["type"].(string)
+			if client.IsTriggerNodeType(nodeType) && !connected[key] {
+				result.Warnings = append(result.Warnings,
+					fmt.Sprintf("nodes.%s: trigger node of type %s is not connected to any other node", key, nodeType))
+			}
+		}
+	}
+
+	return result
+}
+
+// validateWorkflowConnection checks that a single connection targets a defined node and an existing
+// input, and returns a description of the problem if it does not
+func validateWorkflowConnection(connPath string, conn map[string]interface{}, nodes map[string]interface{},
+	nodeKeys map[string]string) string {
+	targetName, _ := conn["node"].(string)
+	targetKey, ok := nodeKeys[targetName]
+	if !ok {
+		return fmt.Sprintf("%s: node %q is not defined in nodes", connPath, targetName)
+	}
+
+	// Only main connections have a fixed number of inputs
+	if connType, _ := conn["type"].(string); connType != "main" {
+		return ""
+	}
+
+	targetNode, _ := nodes[targetKey].(map[string]interface{})
+	index, ok := conn["index"].(float64)
+	if inputs := workflowNodeMainInputCount(targetNode); ok && inputs > 0 && int(index) >= inputs {
+		return fmt.Sprintf("%s: input index %d is out of range, node %q has %d inputs",
+			connPath, int(index), targetName, inputs)
+	}
+
+	return ""
+}
+
+// sortedKeys returns the keys of a map in sorted order
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestValidateWorkflowGraph(t *testing.T) {
+	tests := []struct {
+		name         string
+		nodes        string
+		connections  string
+		wantErrors   []string
+		wantWarnings []string
+	}{
+		{
+			name: "valid graph",
+			nodes: `{"start": {"type": "n8n-nodes-base.manualTrigger"}, ` +
+				`"http": {"name": "HTTP Request", "type": "n8n-nodes-base.httpRequest"}}`,
+			connections: `{"start": {"main": [[{"node": "HTTP Request", "type": "main", "index": 0}]]}}`,
+		},
+		{
+			name:        "single unconnected trigger",
+			nodes:       `{"start": {"type": "n8n-nodes-base.start"}}`,
+			connections: `{}`,
+		},
+		{
+			name:        "dangling target",
+			nodes:       `{"start": {"type": "n8n-nodes-base.start"}, "set": {"type": "n8n-nodes-base.set"}}`,
+			connections: `{"start": {"main": [[{"node": "sett", "type": "main", "index": 0}]]}}`,
+			wantErrors:  []string{`connections.start.main[0][0]: node "sett" is not defined in nodes`},
+		},
+		{
+			name:        "dangling source",
+			nodes:       `{"start": {"type": "n8n-nodes-base.start"}, "set": {"type": "n8n-nodes-base.set"}}`,
+			connections: `{"start": {"main": [[{"node": "set", "type": "main", "index": 0}]]}, "old": {"main": [[]]}}`,
+			wantErrors:  []string{`connections.old: node "old" is not defined in nodes`},
+		},
+		{
+			name: "input index out of range",
+			nodes: `{"start": {"type": "n8n-nodes-base.start"}, "a": {"type": "n8n-nodes-base.set"}, ` +
+				`"merge": {"type": "n8n-nodes-base.merge"}}`,
+			connections: `{"start": {"main": [[{"node": "a", "type": "main", "index": 0}]]}, ` +
+				`"a": {"main": [[{"node": "merge", "type": "main", "index": 2}]]}}`,
+			wantErrors: []string{`connections.a.main[0][0]: input index 2 is out of range, node "merge" has 2 inputs`},
+		},
+		{
+			name: "merge with more inputs",
+			nodes: `{"start": {"type": "n8n-nodes-base.start"}, ` +
+				`"merge": {"type": "n8n-nodes-base.merge", "parameters": {"numberInputs": 3}}}`,
+			connections: `{"start": {"main": [[{"node": "merge", "type": "main", "index": 2}]]}}`,
+		},
+		{
+			name: "output index out of range",
+			nodes: `{"start": {"type": "n8n-nodes-base.start"}, "if": {"type": "n8n-nodes-base.if"}, ` +
+				`"set": {"type": "n8n-nodes-base.set"}}`,
+			connections: `{"start": {"main": [[{"node": "if", "type": "main", "index": 0}]]}, ` +
+				`"if": {"main": [[], [], [{"node": "set", "type": "main", "index": 0}]]}}`,
+			wantErrors: []string{`connections.if.main[2]: output index 2 is out of range, node "if" has 2 outputs`},
+		},
+		{
+			name: "orphan trigger",
+			nodes: `{"start": {"type": "n8n-nodes-base.start"}, "cron": {"type": "n8n-nodes-base.scheduleTrigger"}, ` +
+				`"set": {"type": "n8n-nodes-base.set"}}`,
+			connections:  `{"start": {"main": [[{"node": "set", "type": "main", "index": 0}]]}}`,
+			wantWarnings: []string{"nodes.cron: trigger node of type n8n-nodes-base.scheduleTrigger is not connected to any other node"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var nodes, connections map[string]interface{}
+			if err := json.Unmarshal([]byte(tt.nodes), &nodes); err != nil {
+				t.Fatalf("Invalid test nodes JSON: %v", err)
+			}
+			if err := json.Unmarshal([]byte(tt.connections), &connections); err != nil {
+				t.Fatalf("Invalid test connections JSON: %v", err)
+			}
+
+			result := validateWorkflowGraph(nodes, connections)
+
+			if strings.Join(result.Errors, "\n") != strings.Join(tt.wantErrors, "\n") {
+				t.Errorf("Expected errors %q, got %q", tt.wantErrors, result.Errors)
+			}
+			if strings.Join(result.Warnings, "\n") != strings.Join(tt.wantWarnings, "\n") {
+				t.Errorf("Expected warnings %q, got %q", tt.wantWarnings, result.Warnings)
+			}
+		})
+	}
+}
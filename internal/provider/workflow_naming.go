@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+)
+
+// checkWorkflowName adds an error on attrPath if the planned name of a workflow does not match the
+// workflow_name_pattern of the provider. Only new names are checked, so that workflows named before the
+// convention was introduced can still be changed; prior is null for workflows that are created.
+func checkWorkflowName(c client.N8nAPI, attrPath path.Path, name, prior types.String, diags *diag.Diagnostics) {
+	if name.IsNull() || name.IsUnknown() || name.Equal(prior) {
+		return
+	}
+
+	// The pattern was validated when the provider was configured
+	pattern, err := regexp.Compile(c.WorkflowNamePattern())
+	if err != nil || pattern.String() == "" {
+		return
+	}
+
+	if !pattern.MatchString(name.ValueString()) {
+		diags.AddAttributeError(
+			attrPath,
+			"Workflow Name Does Not Match Convention",
+			fmt.Sprintf("Workflow name %q does not match the workflow_name_pattern %q of the provider. Rename the "+
+				"workflow to follow the naming convention.", name.ValueString(), pattern.String()),
+		)
+	}
+}
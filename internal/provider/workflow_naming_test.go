@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client/clientmock"
+)
+
+func TestCheckWorkflowName(t *testing.T) {
+	mock := &clientmock.N8nAPI{
+		WorkflowNamePatternFunc: func() string { return `^(billing|sales) - .+$` },
+	}
+
+	tests := []struct {
+		name    string
+		planned types.String
+		prior   types.String
+		wantErr bool
+	}{
+		{name: "matching name", planned: types.StringValue("billing - Invoices"), prior: types.StringNull()},
+		{name: "new name", planned: types.StringValue("Invoices"), prior: types.StringNull(), wantErr: true},
+		{name: "renamed", planned: types.StringValue("Invoices"), prior: types.StringValue("billing - Invoices"), wantErr: true},
+		{name: "unchanged name", planned: types.StringValue("Invoices"), prior: types.StringValue("Invoices")},
+		{name: "unknown name", planned: types.StringUnknown(), prior: types.StringNull()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			checkWorkflowName(mock, path.Root("name"), tt.planned, tt.prior, &diags)
+			if diags.HasError() != tt.wantErr {
+				t.Errorf("checkWorkflowName() errors = %v, want error %v", diags, tt.wantErr)
+			}
+		})
+	}
+
+	// Without a pattern, any name is accepted
+	var diags diag.Diagnostics
+	checkWorkflowName(&clientmock.N8nAPI{}, path.Root("name"), types.StringValue("Invoices"), types.StringNull(), &diags)
+	if diags.HasError() {
+		t.Errorf("Unexpected diagnostics: %v", diags)
+	}
+}
+
+func TestWorkflowBundleResource_CheckWorkflowNames(t *testing.T) {
+	r := &WorkflowBundleResource{client: &clientmock.N8nAPI{
+		WorkflowNamePatternFunc: func() string { return `^billing - ` },
+	}}
+
+	workflows := map[string]string{
+		"invoices": `{"name": "billing - Invoices"}`,
+		"legacy":   `{"name": "Legacy Sync", "nodes": []}`,
+		"orders":   `{"name": "Orders"}`,
+		"refunds":  `{"nodes": []}`,
+	}
+	prior := map[string]string{
+		"legacy": `{"name": "Legacy Sync"}`,
+	}
+
+	var diags diag.Diagnostics
+	r.checkWorkflowNames(workflows, prior, &diags)
+
+	// The key is the name of workflows without one, and unchanged names are not checked
+	if len(diags.Errors()) != 2 {
+		t.Fatalf("Expected errors for orders and refunds, got %v", diags)
+	}
+	for i, key := range []string{"orders", "refunds"} {
+		if want := path.Root("workflows").AtMapKey(key); !diags.Errors()[i].(diag.DiagnosticWithPath).Path().Equal(want) {
+			t.Errorf("Expected error %d on %s, got %v", i, want, diags.Errors()[i])
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// A change to nodes shows up in the plan as a diff of the whole JSON string, which is hard to review for
+// workflows with many nodes. The plan therefore also summarizes the changes per node.
+
+// maxNodeChangeLines limits the node change summary, so that rewriting a large workflow does not flood
+// the plan output
+const maxNodeChangeLines = 50
+
+// workflowNodeChanges summarizes the changes from the nodes in state to the planned nodes, one line per
+// added, removed or changed node, sorted by node name. Changed nodes list the changed fields, and the
+// changed parameters as parameters.<name>. Node IDs left out of the configuration are kept, so they are
+// not a change; neither are positions when ignorePositions is set.
+func workflowNodeChanges(state, plan map[string]interface{}, ignorePositions bool) []string {
+	names := make(map[string]interface{}, len(state)+len(plan))
+	for name := range state {
+		names[name] = nil
+	}
+	for name := range plan {
+		names[name] = nil
+	}
+
+	var lines []string
+	for _, name := range sortedKeys(names) {
+		before, inState := state[name]
+		after, inPlan := plan[name]
+		switch {
+		case !inState:
+			lines = append(lines, fmt.Sprintf("+ %s%s", name, nodeTypeSuffix(after)))
+		case !inPlan:
+			lines = append(lines, fmt.Sprintf("- %s%s", name, nodeTypeSuffix(before)))
+		default:
+			if fields := changedNodeFields(before, after, ignorePositions); len(fields) > 0 {
+				lines = append(lines, fmt.Sprintf("~ %s: %s", name, strings.Join(fields, ", ")))
+			}
+		}
+	}
+	return lines
+}
+
+// nodeTypeSuffix returns the type of a node in parentheses, or an empty string if it has none
+func nodeTypeSuffix(nodeData interface{}) string {
+	node, _ := nodeData.(map[string]interface{})
+	if nodeType, _ := node["type"].(string); nodeType != "" {
+		return fmt.Sprintf(" (%s)", nodeType)
+	}
+	return ""
+}
+
+// changedNodeFields returns the fields that differ between two versions of a node, sorted by name
+func changedNodeFields(beforeData, afterData interface{}, ignorePositions bool) []string {
+	before, _ := beforeData.(map[string]interface{})
+	after, _ := afterData.(map[string]interface{})
+
+	var changed []string
+	for _, field := range changedKeys(before, after) {
+		if _, ok := after[field]; !ok && field == "id" {
+			continue
+		}
+		if field == "position" && ignorePositions {
+			continue
+		}
+
+		beforeParams, okBefore := before[field].(map[string]interface{})
+		afterParams, okAfter := after[field].(map[string]interface{})
+		if field != "parameters" || !okBefore || !okAfter {
+			changed = append(changed, field)
+			continue
+		}
+		for _, param := range changedKeys(beforeParams, afterParams) {
+			changed = append(changed, "parameters."+param)
+		}
+	}
+	return changed
+}
+
+// changedKeys returns the keys whose values differ between two objects, sorted by name
+func changedKeys(before, after map[string]interface{}) []string {
+	var changed []string
+	for _, key := range sortedKeys(before) {
+		if value, ok := after[key]; !ok || !reflect.DeepEqual(before[key], value) {
+			changed = append(changed, key)
+		}
+	}
+	for _, key := range sortedKeys(after) {
+		if _, ok := before[key]; !ok {
+			changed = append(changed, key)
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}
+
+// reportNodeChanges adds a warning that summarizes the planned changes to the nodes of a workflow
+func reportNodeChanges(plan, state *WorkflowResourceModel, diags *diag.Diagnostics) {
+	if plan.Nodes.IsUnknown() || plan.Nodes.IsNull() || plan.Nodes.Equal(state.Nodes) {
+		return
+	}
+
+	planned, current := decodeNodes(plan.Nodes), decodeNodes(state.Nodes)
+	if planned == nil || current == nil {
+		return
+	}
+
+	lines := workflowNodeChanges(current, planned, plan.IgnoreNodePositions.ValueBool())
+	if len(lines) == 0 {
+		return
+	}
+	if len(lines) > maxNodeChangeLines {
+		lines = append(lines[:maxNodeChangeLines], fmt.Sprintf("... and %d more", len(lines)-maxNodeChangeLines))
+	}
+
+	diags.AddAttributeWarning(
+		path.Root("nodes"),
+		"Workflow Nodes Changed",
+		fmt.Sprintf("The nodes of workflow %q (%s) will change:\n\n%s", state.Name.ValueString(),
+			state.ID.ValueString(), strings.Join(lines, "\n")),
+	)
+}
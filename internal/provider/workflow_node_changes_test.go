@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestWorkflowNodeChanges(t *testing.T) {
+	state := map[string]interface{}{
+		"Webhook": map[string]interface{}{
+			"id": "n1", "type": "n8n-nodes-base.webhook", "position": []interface{}{0, 0},
+			"parameters": map[string]interface{}{"path": "orders"},
+		},
+		"HTTP Request": map[string]interface{}{
+			"type": "n8n-nodes-base.httpRequest", "typeVersion": 4,
+			"parameters": map[string]interface{}{"url": "https://old.example.com", "method": "GET", "id": "x"},
+		},
+		"Slack": map[string]interface{}{"type": "n8n-nodes-base.slack"},
+	}
+	plan := map[string]interface{}{
+		"Webhook": map[string]interface{}{
+			"type": "n8n-nodes-base.webhook", "position": []interface{}{200, 0},
+			"parameters": map[string]interface{}{"path": "orders"},
+		},
+		"HTTP Request": map[string]interface{}{
+			"type": "n8n-nodes-base.httpRequest", "typeVersion": 4.2,
+			"parameters": map[string]interface{}{"url": "https://new.example.com", "method": "GET", "timeout": 10},
+		},
+		"Email": map[string]interface{}{"type": "n8n-nodes-base.emailSend"},
+	}
+
+	expected := []string{
+		"+ Email (n8n-nodes-base.emailSend)",
+		"~ HTTP Request: parameters.id, parameters.timeout, parameters.url, typeVersion",
+		"- Slack (n8n-nodes-base.slack)",
+		"~ Webhook: position",
+	}
+	if got := workflowNodeChanges(state, plan, false); !reflect.DeepEqual(got, expected) {
+		t.Errorf("workflowNodeChanges() = %q, want %q", got, expected)
+	}
+
+	// Moved nodes are no change when positions are ignored
+	if got := workflowNodeChanges(state, plan, true); len(got) != 3 || strings.HasPrefix(got[2], "~ Webhook") {
+		t.Errorf("Expected the moved node to be left out, got %q", got)
+	}
+}
+
+func TestReportNodeChanges(t *testing.T) {
+	state := &WorkflowResourceModel{
+		ID:    types.StringValue("wf-1"),
+		Name:  types.StringValue("Orders"),
+		Nodes: types.StringValue(`{"Webhook": {"type": "n8n-nodes-base.webhook"}}`),
+	}
+
+	// Reformatted JSON is not reported
+	plan := *state
+	plan.Nodes = types.StringValue(`{"Webhook":{"type":"n8n-nodes-base.webhook"}}`)
+	var diags diag.Diagnostics
+	reportNodeChanges(&plan, state, &diags)
+	if len(diags) != 0 {
+		t.Errorf("Expected no diagnostics, got %v", diags)
+	}
+
+	plan.Nodes = types.StringValue(`{"Webhook": {"type": "n8n-nodes-base.webhook"}, "Set": {"type": "n8n-nodes-base.set"}}`)
+	reportNodeChanges(&plan, state, &diags)
+	if diags.WarningsCount() != 1 || !strings.Contains(diags[0].Detail(), "+ Set (n8n-nodes-base.set)") {
+		t.Errorf("Expected a warning listing the added node, got %v", diags)
+	}
+}
@@ -0,0 +1,308 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+)
+
+// The nodes attribute is a JSON object keyed by node name, since n8n connections refer to nodes by name.
+// The node ID is optional in the configuration: nodes without one keep the ID n8n last returned for a
+// node of that name, and new nodes get a random one.
+
+// nodeRef identifies a node of a workflow as returned by n8n
+type nodeRef struct {
+	Name string `json:"name"`
+	ID   string `json:"id"`
+}
+
+// nodeOrderKey is the private state key holding the names and IDs of the workflow nodes in the order n8n
+// returned them
+const nodeOrderKey = "node_order"
+
+// privateStateGetter is satisfied by the private state of resource requests
+type privateStateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+// convertNodesToArray converts nodes from Terraform's object format to n8n API's array format. Nodes listed
+// in known, the nodes in the order n8n last returned them, keep that order and their IDs; the others
+// follow sorted by name. A stable order keeps n8n from recording a new version when nothing changed.
+func (r *WorkflowResource) convertNodesToArray(nodes map[string]interface{}, known []nodeRef) []interface{} {
+	position := make(map[string]int, len(known))
+	for i, node := range known {
+		position[node.Name] = i
+	}
+
+	names := make([]string, 0, len(nodes))
+	for name, nodeData := range nodes {
+		if _, ok := nodeData.(map[string]interface{}); ok {
+			names = append(names, name)
+		}
+	}
+
+	sort.Slice(names, func(i, j int) bool {
+		pi, knownI := position[names[i]]
+		pj, knownJ := position[names[j]]
+		if knownI && knownJ {
+			return pi < pj
+		}
+		if knownI || knownJ {
+			return knownI
+		}
+		return names[i] < names[j]
+	})
+
+	var nodesArray []interface{}
+	for _, name := range names {
+		// Copy the node so that the configured value is not modified
+		nodeMap := nodes[name].(map[string]interface{})
+		node := make(map[string]interface{}, len(nodeMap)+2)
+		for key, value := range nodeMap {
+			node[key] = value
+		}
+
+		node["name"] = name
+		if id, _ := node["id"].(string); id == "" {
+			if i, ok := position[name]; ok && known[i].ID != "" {
+				node["id"] = known[i].ID
+			} else {
+				node["id"] = uuid.NewString()
+			}
+		}
+
+		nodesArray = append(nodesArray, node)
+	}
+
+	return nodesArray
+}
+
+// convertNodesFromArray converts nodes from n8n API's array format to Terraform's object format. The ID and
+// name fields are only kept in a node if the node in prior, the configured or stored nodes, has them, so
+// that leaving them out of the configuration does not show up as a change. Without a prior node, e.g. on
+// import, the ID is kept.
+func (r *WorkflowResource) convertNodesFromArray(nodesArray []interface{}, prior map[string]interface{}) map[string]interface{} {
+	nodesObject := make(map[string]interface{})
+
+	for _, nodeData := range nodesArray {
+		nodeMap, ok := nodeData.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := nodeMap["name"].(string)
+		if name == "" {
+			continue
+		}
+
+		node := make(map[string]interface{}, len(nodeMap))
+		for key, value := range nodeMap {
+			node[key] = value
+		}
+
+		priorNode, hasPrior := prior[name].(map[string]interface{})
+		if _, ok := priorNode["name"]; !ok {
+			delete(node, "name")
+		}
+		if _, ok := priorNode["id"]; hasPrior && !ok {
+			delete(node, "id")
+		}
+
+		nodesObject[name] = node
+	}
+
+	return nodesObject
+}
+
+// restoreNodePositions replaces the position of each node read from n8n with its position in prior, the
+// configured or stored nodes, so that nodes moved in the editor do not show up as a change. New nodes keep
+// the position n8n returned.
+func restoreNodePositions(nodes, prior map[string]interface{}) {
+	for name, nodeData := range nodes {
+		node, ok := nodeData.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		priorNode, ok := prior[name].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if position, ok := priorNode["position"]; ok {
+			node["position"] = position
+		} else {
+			delete(node, "position")
+		}
+	}
+}
+
+// keepNodePositions sets the position of each node to the position of the node of the same name in remote,
+// so that an update does not move nodes back. Both are in the array format of the API.
+func keepNodePositions(nodes, remote []interface{}) {
+	positions := make(map[string]interface{}, len(remote))
+	for _, nodeData := range remote {
+		if node, ok := nodeData.(map[string]interface{}); ok {
+			if name, _ := node["name"].(string); name != "" && node["position"] != nil {
+				positions[name] = node["position"]
+			}
+		}
+	}
+
+	for _, nodeData := range nodes {
+		node, ok := nodeData.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := node["name"].(string)
+		if position, ok := positions[name]; ok {
+			node["position"] = position
+		}
+	}
+}
+
+// setNodeOrder records the names and IDs of the nodes of a workflow, in the order n8n returned them, in
+// private state. The nodes attribute is an object and cannot hold the order, nor the IDs of nodes that
+// are configured without one.
+func setNodeOrder(ctx context.Context, private privateStateSetter, workflow *client.Workflow) diag.Diagnostics {
+	order := []nodeRef{}
+	for _, nodeData := range workflow.Nodes {
+		if nodeMap, ok := nodeData.(map[string]interface{}); ok {
+			name, _ := nodeMap["name"].(string)
+			id, _ := nodeMap["id"].(string)
+			if name != "" {
+				order = append(order, nodeRef{Name: name, ID: id})
+			}
+		}
+	}
+
+	value, err := json.Marshal(order)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Internal Error", fmt.Sprintf("Unable to encode node order, got error: %s", err))
+		return diags
+	}
+	return private.SetKey(ctx, nodeOrderKey, value)
+}
+
+// getNodeOrder returns the nodes recorded by setNodeOrder, or nil if none were recorded
+func getNodeOrder(ctx context.Context, private privateStateGetter) ([]nodeRef, diag.Diagnostics) {
+	value, diags := private.GetKey(ctx, nodeOrderKey)
+	if diags.HasError() || len(value) == 0 {
+		return nil, diags
+	}
+
+	var order []nodeRef
+	if err := json.Unmarshal(value, &order); err != nil {
+		// An unreadable order only affects the order and IDs of nodes configured without one
+		return nil, diags
+	}
+	return order, diags
+}
+
+// decodeNodes decodes a known nodes attribute value, or returns nil if it is unset or invalid
+func decodeNodes(value types.String) map[string]interface{} {
+	if value.IsNull() || value.IsUnknown() || value.ValueString() == "" {
+		return nil
+	}
+
+	var nodes map[string]interface{}
+	if err := json.Unmarshal([]byte(value.ValueString()), &nodes); err != nil {
+		return nil
+	}
+	return nodes
+}
+
+// workflowJSONValue encodes the nodes or connections for state as JSON indented by two spaces, with object keys
+// sorted, so that changes read from n8n are reviewable line by line in plan output. The prior value is kept if
+// it holds the same JSON, so that configurations may format it freely, e.g. with file() or jsonencode().
+func workflowJSONValue(prior types.String, value interface{}) (types.String, error) {
+	encoded, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return prior, err
+	}
+
+	if !prior.IsNull() && !prior.IsUnknown() {
+		var priorValue, current interface{}
+		if json.Unmarshal([]byte(prior.ValueString()), &priorValue) == nil &&
+			json.Unmarshal(encoded, &current) == nil && reflect.DeepEqual(priorValue, current) {
+			return prior, nil
+		}
+	}
+
+	return types.StringValue(string(encoded)), nil
+}
+
+// validateWorkflowNodes checks that the name of each node matches its key and that node IDs are unique
+func validateWorkflowNodes(nodes map[string]interface{}) []string {
+	var problems []string
+
+	ids := make(map[string]string)
+	for _, key := range sortedKeys(nodes) {
+		node, ok := nodes[key].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if name, ok := node["name"].(string); ok && name != key {
+			problems = append(problems, fmt.Sprintf("nodes.%s: name %q does not match the key of the node; nodes are "+
+				"keyed by their name, so use %q as the key or remove the name", key, name, name))
+		}
+
+		if id, _ := node["id"].(string); id != "" {
+			if other, ok := ids[id]; ok {
+				problems = append(problems, fmt.Sprintf("nodes.%s: ID %q is already used by node %q", key, id, other))
+			}
+			ids[id] = key
+		}
+	}
+
+	return problems
+}
+
+// upgradeNodesKeyedByID converts a nodes attribute value of schema version 0, which keyed nodes by ID and
+// dropped the ID from the node, to nodes keyed by name with their ID
+func upgradeNodesKeyedByID(value types.String) (types.String, error) {
+	if value.IsNull() || value.IsUnknown() || value.ValueString() == "" {
+		return value, nil
+	}
+
+	var nodes map[string]interface{}
+	if err := json.Unmarshal([]byte(value.ValueString()), &nodes); err != nil {
+		return value, fmt.Errorf("unable to parse nodes JSON: %w", err)
+	}
+
+	upgraded := make(map[string]interface{}, len(nodes))
+	for _, id := range sortedKeys(nodes) {
+		nodeMap, ok := nodes[id].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		node := make(map[string]interface{}, len(nodeMap))
+		for key, value := range nodeMap {
+			node[key] = value
+		}
+		delete(node, "name")
+		node["id"] = id
+
+		// Nodes without a name, or with a name that is taken, keep their ID as the key
+		name, _ := nodeMap["name"].(string)
+		if _, taken := upgraded[name]; name == "" || taken {
+			name = id
+		}
+		upgraded[name] = node
+	}
+
+	result, err := json.Marshal(upgraded)
+	if err != nil {
+		return value, fmt.Errorf("unable to encode nodes JSON: %w", err)
+	}
+	return types.StringValue(string(result)), nil
+}
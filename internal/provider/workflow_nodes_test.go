@@ -0,0 +1,348 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+)
+
+// nodeFields returns the value of a field of each node in an n8n nodes array
+func nodeFields(nodesArray []interface{}, field string) []string {
+	var values []string
+	for _, node := range nodesArray {
+		value, _ := node.(map[string]interface{})[field].(string)
+		values = append(values, value)
+	}
+	return values
+}
+
+func TestWorkflowResource_ConvertNodesToArray(t *testing.T) {
+	nodes := map[string]interface{}{
+		"Webhook": map[string]interface{}{"type": "n8n-nodes-base.webhook"},
+		"Slack":   map[string]interface{}{"id": "node-slack", "type": "n8n-nodes-base.slack"},
+		"Alpha":   map[string]interface{}{"type": "n8n-nodes-base.noOp"},
+		"Set":     map[string]interface{}{"type": "n8n-nodes-base.set"},
+	}
+
+	r := &WorkflowResource{}
+
+	// Without known nodes, nodes are sorted by name on every call
+	for i := 0; i < 5; i++ {
+		nodesArray := r.convertNodesToArray(nodes, nil)
+		if got := nodeFields(nodesArray, "name"); !reflect.DeepEqual(got, []string{"Alpha", "Set", "Slack", "Webhook"}) {
+			t.Fatalf("Expected nodes sorted by name, got %v", got)
+		}
+	}
+
+	// Known nodes keep their order and ID, new nodes follow
+	known := []nodeRef{{Name: "Webhook", ID: "node-webhook"}, {Name: "Removed", ID: "node-removed"}, {Name: "Slack", ID: "old"}}
+	nodesArray := r.convertNodesToArray(nodes, known)
+	if got := nodeFields(nodesArray, "name"); !reflect.DeepEqual(got, []string{"Webhook", "Slack", "Alpha", "Set"}) {
+		t.Errorf("Expected the known order followed by new nodes, got %v", got)
+	}
+
+	ids := nodeFields(nodesArray, "id")
+	if ids[0] != "node-webhook" || ids[1] != "node-slack" {
+		t.Errorf("Expected the known ID and the configured ID, got %v", ids)
+	}
+	if ids[2] == "" || ids[3] == "" || ids[2] == ids[3] {
+		t.Errorf("Expected new nodes to get unique IDs, got %v", ids)
+	}
+
+	if _, ok := nodes["Webhook"].(map[string]interface{})["id"]; ok {
+		t.Error("Expected the configured nodes not to be modified")
+	}
+}
+
+func TestWorkflowResource_ConvertNodesFromArray(t *testing.T) {
+	nodesArray := []interface{}{
+		map[string]interface{}{"id": "node-1", "name": "Webhook", "type": "n8n-nodes-base.webhook"},
+		map[string]interface{}{"id": "node-2", "name": "Slack", "type": "n8n-nodes-base.slack"},
+		map[string]interface{}{"id": "node-3", "name": "Added in n8n", "type": "n8n-nodes-base.set"},
+		map[string]interface{}{"id": "node-4", "type": "n8n-nodes-base.noOp"},
+	}
+
+	r := &WorkflowResource{}
+
+	// Without prior nodes, e.g. on import, nodes keep their ID
+	imported := r.convertNodesFromArray(nodesArray, nil)
+	expected := map[string]interface{}{
+		"Webhook":      map[string]interface{}{"id": "node-1", "type": "n8n-nodes-base.webhook"},
+		"Slack":        map[string]interface{}{"id": "node-2", "type": "n8n-nodes-base.slack"},
+		"Added in n8n": map[string]interface{}{"id": "node-3", "type": "n8n-nodes-base.set"},
+	}
+	if !reflect.DeepEqual(imported, expected) {
+		t.Errorf("Expected nodes keyed by name, got %v", imported)
+	}
+
+	// Nodes follow the shape of the prior nodes
+	prior := map[string]interface{}{
+		"Webhook": map[string]interface{}{"type": "n8n-nodes-base.webhook"},
+		"Slack":   map[string]interface{}{"id": "node-2", "name": "Slack", "type": "n8n-nodes-base.slack"},
+	}
+	refreshed := r.convertNodesFromArray(nodesArray, prior)
+	expected = map[string]interface{}{
+		"Webhook":      map[string]interface{}{"type": "n8n-nodes-base.webhook"},
+		"Slack":        map[string]interface{}{"id": "node-2", "name": "Slack", "type": "n8n-nodes-base.slack"},
+		"Added in n8n": map[string]interface{}{"id": "node-3", "type": "n8n-nodes-base.set"},
+	}
+	if !reflect.DeepEqual(refreshed, expected) {
+		t.Errorf("Expected ID and name only where the prior nodes have them, got %v", refreshed)
+	}
+}
+
+// testPrivateState is an in-memory private state for tests
+type testPrivateState map[string][]byte
+
+func (p testPrivateState) GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics) {
+	return p[key], nil
+}
+
+func (p testPrivateState) SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics {
+	p[key] = value
+	return nil
+}
+
+func TestWorkflowResource_NodeOrder(t *testing.T) {
+	ctx := context.Background()
+	private := testPrivateState{}
+
+	order, diags := getNodeOrder(ctx, private)
+	if diags.HasError() || order != nil {
+		t.Fatalf("Expected no order before one was recorded, got %v, %v", order, diags)
+	}
+
+	workflow := &client.Workflow{Nodes: []interface{}{
+		map[string]interface{}{"id": "z", "name": "Webhook"},
+		map[string]interface{}{"id": "a", "name": "Slack"},
+	}}
+	if diags := setNodeOrder(ctx, private, workflow); diags.HasError() {
+		t.Fatalf("setNodeOrder() diagnostics: %v", diags)
+	}
+
+	order, diags = getNodeOrder(ctx, private)
+	expected := []nodeRef{{Name: "Webhook", ID: "z"}, {Name: "Slack", ID: "a"}}
+	if diags.HasError() || !reflect.DeepEqual(order, expected) {
+		t.Errorf("Expected the nodes in the order returned by n8n, got %v, %v", order, diags)
+	}
+
+	// An order in an unknown format is ignored
+	private[nodeOrderKey] = []byte(`["z", "a"]`)
+	if order, _ := getNodeOrder(ctx, private); order != nil {
+		t.Errorf("Expected an unreadable order to be ignored, got %v", order)
+	}
+}
+
+func TestRestoreNodePositions(t *testing.T) {
+	prior := map[string]interface{}{
+		"Webhook": map[string]interface{}{"type": "n8n-nodes-base.webhook", "position": []interface{}{float64(0), float64(0)}},
+		"Set":     map[string]interface{}{"type": "n8n-nodes-base.set"},
+	}
+	nodes := map[string]interface{}{
+		"Webhook": map[string]interface{}{"type": "n8n-nodes-base.webhook", "position": []interface{}{float64(240), float64(80)}},
+		"Set":     map[string]interface{}{"type": "n8n-nodes-base.set", "position": []interface{}{float64(460), float64(80)}},
+		"Slack":   map[string]interface{}{"type": "n8n-nodes-base.slack", "position": []interface{}{float64(680), float64(80)}},
+	}
+
+	restoreNodePositions(nodes, prior)
+
+	if !reflect.DeepEqual(nodes["Webhook"], prior["Webhook"]) || !reflect.DeepEqual(nodes["Set"], prior["Set"]) {
+		t.Errorf("Expected the prior positions, got %v", nodes)
+	}
+	if _, ok := nodes["Slack"].(map[string]interface{})["position"]; !ok {
+		t.Error("Expected a new node to keep its position")
+	}
+}
+
+func TestKeepNodePositions(t *testing.T) {
+	nodes := []interface{}{
+		map[string]interface{}{"name": "Webhook", "position": []interface{}{0, 0}},
+		map[string]interface{}{"name": "Slack", "position": []interface{}{680, 80}},
+	}
+	remote := []interface{}{
+		map[string]interface{}{"name": "Webhook", "position": []interface{}{240, 80}},
+	}
+
+	keepNodePositions(nodes, remote)
+
+	if position := nodes[0].(map[string]interface{})["position"]; !reflect.DeepEqual(position, []interface{}{240, 80}) {
+		t.Errorf("Expected the position in n8n, got %v", position)
+	}
+	if position := nodes[1].(map[string]interface{})["position"]; !reflect.DeepEqual(position, []interface{}{680, 80}) {
+		t.Errorf("Expected a new node to keep the configured position, got %v", position)
+	}
+}
+
+func TestWorkflowResource_OnlyNodesMoved(t *testing.T) {
+	r := &WorkflowResource{}
+	state := WorkflowResourceModel{
+		Name:                types.StringValue("Test"),
+		Active:              types.BoolValue(false),
+		Nodes:               types.StringValue(`{"Webhook":{"type":"n8n-nodes-base.webhook","position":[0,0]}}`),
+		Connections:         types.StringValue(`{}`),
+		CredentialOverrides: types.MapNull(types.StringType),
+		ParameterOverrides:  types.MapNull(types.StringType),
+	}
+	remote := func(position []interface{}, nodeType string) *client.Workflow {
+		return &client.Workflow{
+			Name:        "Test",
+			Nodes:       []interface{}{map[string]interface{}{"name": "Webhook", "type": nodeType, "position": position}},
+			Connections: map[string]interface{}{},
+		}
+	}
+
+	if !r.onlyNodesMoved(state, remote([]interface{}{float64(240), float64(80)}, "n8n-nodes-base.webhook")) {
+		t.Error("Expected a moved node not to count as a change")
+	}
+	if r.onlyNodesMoved(state, remote([]interface{}{float64(0), float64(0)}, "n8n-nodes-base.formTrigger")) {
+		t.Error("Expected a changed node to count as a change")
+	}
+}
+
+func TestValidateWorkflowNodes(t *testing.T) {
+	problems := validateWorkflowNodes(map[string]interface{}{
+		"Webhook": map[string]interface{}{"name": "Webhook", "id": "node-1"},
+		"node-2":  map[string]interface{}{"name": "Slack", "id": "node-2"},
+		"Set":     map[string]interface{}{"id": "node-1"},
+	})
+
+	if len(problems) != 2 {
+		t.Fatalf("Expected 2 problems, got %v", problems)
+	}
+	if !strings.Contains(problems[0], `nodes.Webhook: ID "node-1" is already used by node "Set"`) {
+		t.Errorf("Expected a duplicate ID problem, got %s", problems[0])
+	}
+	if !strings.Contains(problems[1], `nodes.node-2: name "Slack" does not match the key of the node`) {
+		t.Errorf("Expected a name mismatch problem, got %s", problems[1])
+	}
+}
+
+func TestWorkflowJSONValue(t *testing.T) {
+	connections := map[string]interface{}{
+		"Webhook": map[string]interface{}{"main": []interface{}{[]interface{}{
+			map[string]interface{}{"node": "Set", "type": "main", "index": float64(0)},
+		}}},
+	}
+
+	value, err := workflowJSONValue(types.StringNull(), connections)
+	if err != nil {
+		t.Fatalf("workflowJSONValue() error = %v", err)
+	}
+	expected := `{
+  "Webhook": {
+    "main": [
+      [
+        {
+          "index": 0,
+          "node": "Set",
+          "type": "main"
+        }
+      ]
+    ]
+  }
+}`
+	if value.ValueString() != expected {
+		t.Errorf("Expected indented JSON with sorted keys, got %s", value.ValueString())
+	}
+
+	// The configured formatting is kept while the JSON is the same
+	configured := types.StringValue(`{"Webhook":{"main":[[{"type":"main","node":"Set","index":0}]]}}`)
+	if value, _ := workflowJSONValue(configured, connections); !value.Equal(configured) {
+		t.Errorf("Expected the prior value to be kept, got %s", value.ValueString())
+	}
+
+	changed := types.StringValue(`{"Webhook":{"main":[[{"type":"main","node":"Set","index":1}]]}}`)
+	if value, _ := workflowJSONValue(changed, connections); value.ValueString() != expected {
+		t.Errorf("Expected the value read from n8n, got %s", value.ValueString())
+	}
+}
+
+func TestUpgradeNodesKeyedByID(t *testing.T) {
+	upgraded, err := upgradeNodesKeyedByID(types.StringValue(`{
+		"node-1": {"name": "Webhook", "type": "n8n-nodes-base.webhook"},
+		"node-2": {"name": "Webhook", "type": "n8n-nodes-base.set"},
+		"Start": {"type": "n8n-nodes-base.start"}
+	}`))
+	if err != nil {
+		t.Fatalf("upgradeNodesKeyedByID() error = %v", err)
+	}
+
+	expected := `{"Start":{"id":"Start","type":"n8n-nodes-base.start"},` +
+		`"Webhook":{"id":"node-1","type":"n8n-nodes-base.webhook"},` +
+		`"node-2":{"id":"node-2","type":"n8n-nodes-base.set"}}`
+	if upgraded.ValueString() != expected {
+		t.Errorf("Expected %s, got %s", expected, upgraded.ValueString())
+	}
+
+	if upgraded, err := upgradeNodesKeyedByID(types.StringNull()); err != nil || !upgraded.IsNull() {
+		t.Errorf("Expected null nodes to stay null, got %v, %v", upgraded, err)
+	}
+	if _, err := upgradeNodesKeyedByID(types.StringValue(`{`)); err == nil {
+		t.Error("Expected error for invalid nodes JSON")
+	}
+}
+
+func TestWorkflowResource_UpgradeStateV0(t *testing.T) {
+	ctx := context.Background()
+	r := &WorkflowResource{}
+
+	upgrader, ok := r.UpgradeState(ctx)[0]
+	if !ok {
+		t.Fatal("Expected a state upgrader for version 0")
+	}
+
+	// A version 0 state with an attribute that no longer exists and without newer attributes
+	rawState := `{
+		"id": "wf-1",
+		"name": "Orders",
+		"active": false,
+		"is_archived": false,
+		"nodes": "{\"node-1\":{\"name\":\"Webhook\",\"type\":\"n8n-nodes-base.webhook\"}}",
+		"connections": "{}"
+	}`
+
+	req := resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: []byte(rawState)}}
+	var resp resource.UpgradeStateResponse
+	upgrader.StateUpgrader(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	value, err := resp.DynamicValue.Unmarshal(schemaResp.Schema.Type().TerraformType(ctx))
+	if err != nil {
+		t.Fatalf("Expected the upgraded state to match the current schema, got error: %v", err)
+	}
+
+	var data WorkflowResourceModel
+	state := tfsdk.State{Raw: value, Schema: schemaResp.Schema}
+	if diags := state.Get(ctx, &data); diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	if data.ID.ValueString() != "wf-1" || data.Name.ValueString() != "Orders" || !data.FolderID.IsNull() {
+		t.Errorf("Expected the other attributes to be kept, got %+v", data)
+	}
+	expectedNodes := `{"Webhook":{"id":"node-1","type":"n8n-nodes-base.webhook"}}`
+	if data.Nodes.ValueString() != expectedNodes {
+		t.Errorf("Expected nodes %s, got %s", expectedNodes, data.Nodes.ValueString())
+	}
+
+	// Invalid prior state is reported
+	req = resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: []byte(`{"nodes": "{"}`)}}
+	resp = resource.UpgradeStateResponse{}
+	upgrader.StateUpgrader(ctx, req, &resp)
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected an error for invalid nodes JSON")
+	}
+}
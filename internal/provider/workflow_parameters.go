@@ -0,0 +1,222 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The parameter_overrides attribute sets values in the nodes before the workflow is sent, so that one
+// exported workflow can be promoted across environments. Each key addresses a value by node name and the
+// path within the node, e.g. "HTTP Request.parameters.url" or "Set.parameters.values.string[0].value".
+// Like credential_overrides, the nodes attribute keeps the configured values.
+
+// overrideStep is one step of the path of a parameter override: an object key or an array index
+type overrideStep struct {
+	key   string
+	index int
+}
+
+// isIndex reports whether the step is an array index
+func (s overrideStep) isIndex() bool {
+	return s.key == ""
+}
+
+// parseOverrideAddress splits the address of a parameter override into the node it refers to and the path
+// within the node. Node names may contain dots, so the longest node name the address starts with is used.
+func parseOverrideAddress(nodes map[string]interface{}, address string) (string, []overrideStep, error) {
+	node := ""
+	for name := range nodes {
+		if strings.HasPrefix(address, name+".") && len(name) > len(node) {
+			node = name
+		}
+	}
+	if node == "" {
+		return "", nil, fmt.Errorf("%s does not start with the name of a node of the workflow followed by a dot", address)
+	}
+
+	var steps []overrideStep
+	for _, part := range strings.Split(strings.TrimPrefix(address, node+"."), ".") {
+		key, indexes, _ := strings.Cut(part, "[")
+		if key == "" {
+			return "", nil, fmt.Errorf("%s has an empty key", address)
+		}
+		steps = append(steps, overrideStep{key: key})
+
+		if indexes == "" {
+			continue
+		}
+		for _, index := range strings.Split(strings.TrimSuffix(indexes, "]"), "][") {
+			i, err := strconv.Atoi(index)
+			if err != nil || i < 0 || !strings.HasSuffix(indexes, "]") {
+				return "", nil, fmt.Errorf("%s has an invalid array index in %q", address, part)
+			}
+			steps = append(steps, overrideStep{index: i})
+		}
+	}
+
+	return node, steps, nil
+}
+
+// lookupOverridePath returns the value at steps within value, and whether it exists
+func lookupOverridePath(value interface{}, steps []overrideStep) (interface{}, bool) {
+	for _, step := range steps {
+		if step.isIndex() {
+			array, ok := value.([]interface{})
+			if !ok || step.index >= len(array) {
+				return nil, false
+			}
+			value = array[step.index]
+			continue
+		}
+
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if value, ok = object[step.key]; !ok {
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+// updateOverridePath returns a copy of value with the value at steps set to set, or removed if remove is
+// set. Missing objects along the path are created; the objects and arrays it passes are copied, so that
+// value itself is not modified.
+func updateOverridePath(value interface{}, steps []overrideStep, set interface{}, remove bool) (interface{}, error) {
+	if len(steps) == 0 {
+		return set, nil
+	}
+	step := steps[0]
+
+	if step.isIndex() {
+		array, ok := value.([]interface{})
+		if !ok || step.index >= len(array) {
+			return nil, fmt.Errorf("index %d does not exist", step.index)
+		}
+		updated := append([]interface{}{}, array...)
+		if len(steps) == 1 && remove {
+			return append(updated[:step.index], updated[step.index+1:]...), nil
+		}
+		element, err := updateOverridePath(array[step.index], steps[1:], set, remove)
+		if err != nil {
+			return nil, err
+		}
+		updated[step.index] = element
+		return updated, nil
+	}
+
+	object, ok := value.(map[string]interface{})
+	if !ok && value != nil {
+		return nil, fmt.Errorf("%s is not within an object", step.key)
+	}
+	updated := make(map[string]interface{}, len(object)+1)
+	for key, element := range object {
+		updated[key] = element
+	}
+	if len(steps) == 1 && remove {
+		delete(updated, step.key)
+		return updated, nil
+	}
+	element, err := updateOverridePath(object[step.key], steps[1:], set, remove)
+	if err != nil {
+		return nil, err
+	}
+	updated[step.key] = element
+	return updated, nil
+}
+
+// parameterOverrideValue converts the value of a parameter override for the value it replaces. Strings
+// and new values are set as is; other values are replaced with the override decoded as JSON, so that
+// numbers, booleans and objects can be set with jsonencode.
+func parameterOverrideValue(override string, current interface{}, exists bool) (interface{}, error) {
+	if _, isString := current.(string); !exists || current == nil || isString {
+		return override, nil
+	}
+
+	var decoded interface{}
+	if err := json.Unmarshal([]byte(override), &decoded); err != nil {
+		return nil, fmt.Errorf("replaces a value that is not a string, so it must be JSON: %w", err)
+	}
+	return decoded, nil
+}
+
+// applyParameterOverrides sets the values of parameter_overrides in nodes, which is keyed by node name, and
+// returns the overrides that cannot be applied
+func applyParameterOverrides(nodes map[string]interface{}, overrides types.Map) []string {
+	var problems []string
+	elements := overrides.Elements()
+	for _, address := range sortedKeys(elements) {
+		override, ok := elements[address].(types.String)
+		if !ok || override.IsNull() || override.IsUnknown() {
+			continue
+		}
+
+		name, steps, err := parseOverrideAddress(nodes, address)
+		if err != nil {
+			problems = append(problems, err.Error())
+			continue
+		}
+
+		current, exists := lookupOverridePath(nodes[name], steps)
+		value, err := parameterOverrideValue(override.ValueString(), current, exists)
+		if err == nil {
+			nodes[name], err = updateOverridePath(nodes[name], steps, value, false)
+		}
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %s", address, err))
+		}
+	}
+	return problems
+}
+
+// restoreOverriddenParameters replaces the values that match a parameter override in the nodes read from
+// n8n with the values of prior, the configured or stored nodes, so that overrides do not show up as a
+// change. Values that no longer match the override, e.g. because they were changed in n8n, are kept.
+func restoreOverriddenParameters(nodes, prior map[string]interface{}, overrides types.Map) {
+	elements := overrides.Elements()
+	for _, address := range sortedKeys(elements) {
+		override, ok := elements[address].(types.String)
+		if !ok || override.IsNull() || override.IsUnknown() {
+			continue
+		}
+
+		name, steps, err := parseOverrideAddress(prior, address)
+		if err != nil {
+			continue
+		}
+		if _, ok := nodes[name]; !ok {
+			continue
+		}
+
+		priorValue, hasPrior := lookupOverridePath(prior[name], steps)
+		applied, err := parameterOverrideValue(override.ValueString(), priorValue, hasPrior)
+		if err != nil {
+			continue
+		}
+		if remote, ok := lookupOverridePath(nodes[name], steps); !ok || !reflect.DeepEqual(remote, applied) {
+			continue
+		}
+
+		if hasPrior {
+			nodes[name], _ = updateOverridePath(nodes[name], steps, priorValue, false)
+			continue
+		}
+
+		// Remove the value the override added, along with the objects created for it
+		nodes[name], _ = updateOverridePath(nodes[name], steps, nil, true)
+		for i := len(steps) - 1; i > 0; i-- {
+			value, _ := lookupOverridePath(nodes[name], steps[:i])
+			object, isObject := value.(map[string]interface{})
+			if _, inPrior := lookupOverridePath(prior[name], steps[:i]); !isObject || len(object) > 0 || inPrior {
+				break
+			}
+			nodes[name], _ = updateOverridePath(nodes[name], steps[:i], nil, true)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseOverrideAddress(t *testing.T) {
+	nodes := map[string]interface{}{"HTTP Request": nil, "HTTP Request.v2": nil, "Set": nil}
+
+	tests := []struct {
+		address   string
+		wantNode  string
+		wantSteps []overrideStep
+		wantError string
+	}{
+		{
+			address:   "HTTP Request.parameters.url",
+			wantNode:  "HTTP Request",
+			wantSteps: []overrideStep{{key: "parameters"}, {key: "url"}},
+		},
+		{
+			address:   "HTTP Request.v2.parameters.url",
+			wantNode:  "HTTP Request.v2",
+			wantSteps: []overrideStep{{key: "parameters"}, {key: "url"}},
+		},
+		{
+			address:   "Set.parameters.values[1][0].value",
+			wantNode:  "Set",
+			wantSteps: []overrideStep{{key: "parameters"}, {key: "values"}, {index: 1}, {index: 0}, {key: "value"}},
+		},
+		{address: "Slack.parameters.channel", wantError: "does not start with the name of a node"},
+		{address: "Set.parameters..value", wantError: "has an empty key"},
+		{address: "Set.parameters.values[x]", wantError: "invalid array index"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.address, func(t *testing.T) {
+			node, steps, err := parseOverrideAddress(nodes, tt.address)
+			if tt.wantError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantError) {
+					t.Errorf("Expected an error containing %q, got %v", tt.wantError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseOverrideAddress() error = %v", err)
+			}
+			if node != tt.wantNode || !reflect.DeepEqual(steps, tt.wantSteps) {
+				t.Errorf("Expected %s %v, got %s %v", tt.wantNode, tt.wantSteps, node, steps)
+			}
+		})
+	}
+}
+
+func TestApplyParameterOverrides(t *testing.T) {
+	var nodes map[string]interface{}
+	if err := json.Unmarshal([]byte(`{
+		"HTTP Request": {"parameters": {"url": "https://dev.example.com", "options": {"timeout": 1000}}},
+		"Set": {"parameters": {"values": {"string": [{"name": "env", "value": "dev"}]}}}
+	}`), &nodes); err != nil {
+		t.Fatal(err)
+	}
+
+	overrides := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"HTTP Request.parameters.url":             types.StringValue("https://api.example.com"),
+		"HTTP Request.parameters.options.timeout": types.StringValue("5000"),
+		"HTTP Request.parameters.sendHeaders":     types.StringValue("true"),
+		"Set.parameters.values.string[0].value":   types.StringValue("prod"),
+	})
+	if problems := applyParameterOverrides(nodes, overrides); len(problems) > 0 {
+		t.Fatalf("Unexpected problems: %v", problems)
+	}
+
+	parameters := nodes["HTTP Request"].(map[string]interface{})["parameters"].(map[string]interface{})
+	if parameters["url"] != "https://api.example.com" {
+		t.Errorf("Expected the URL to be replaced, got %v", parameters["url"])
+	}
+	// Non-string values are decoded as JSON, new values are strings
+	if timeout := parameters["options"].(map[string]interface{})["timeout"]; timeout != float64(5000) {
+		t.Errorf("Expected the timeout to stay a number, got %#v", timeout)
+	}
+	if parameters["sendHeaders"] != "true" {
+		t.Errorf("Expected a new value to be set as a string, got %#v", parameters["sendHeaders"])
+	}
+	if value, _ := lookupOverridePath(nodes["Set"], []overrideStep{{key: "parameters"}, {key: "values"},
+		{key: "string"}, {index: 0}, {key: "value"}}); value != "prod" {
+		t.Errorf("Expected the array element to be replaced, got %v", value)
+	}
+
+	problems := applyParameterOverrides(nodes, types.MapValueMust(types.StringType, map[string]attr.Value{
+		"HTTP Request.parameters.options.timeout": types.StringValue("soon"),
+		"HTTP Request.parameters.url.host":        types.StringValue("api.example.com"),
+		"Set.parameters.values.string[3].value":   types.StringValue("prod"),
+	}))
+	if len(problems) != 3 || !strings.Contains(problems[0], "must be JSON") ||
+		!strings.Contains(problems[1], "host is not within an object") || !strings.Contains(problems[2], "index 3 does not exist") {
+		t.Errorf("Expected every problem to be reported, got %v", problems)
+	}
+}
+
+func TestRestoreOverriddenParameters(t *testing.T) {
+	overrides := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"HTTP Request.parameters.url":             types.StringValue("https://api.example.com"),
+		"HTTP Request.parameters.options.timeout": types.StringValue("5000"),
+		"Set.parameters.mode":                     types.StringValue("raw"),
+	})
+
+	var prior, nodes map[string]interface{}
+	if err := json.Unmarshal([]byte(`{
+		"HTTP Request": {"parameters": {"url": "https://dev.example.com"}},
+		"Set": {"parameters": {"mode": "manual"}}
+	}`), &prior); err != nil {
+		t.Fatal(err)
+	}
+	// The mode of Set was changed in n8n since it was overridden
+	if err := json.Unmarshal([]byte(`{
+		"HTTP Request": {"parameters": {"url": "https://api.example.com", "options": {"timeout": "5000"}}},
+		"Set": {"parameters": {"mode": "expression"}}
+	}`), &nodes); err != nil {
+		t.Fatal(err)
+	}
+	remote := nodes["HTTP Request"]
+
+	restoreOverriddenParameters(nodes, prior, overrides)
+
+	if !reflect.DeepEqual(nodes["HTTP Request"], prior["HTTP Request"]) {
+		t.Errorf("Expected the configured URL and the added timeout to be removed, got %v", nodes["HTTP Request"])
+	}
+	if mode, _ := lookupOverridePath(nodes["Set"], []overrideStep{{key: "parameters"}, {key: "mode"}}); mode != "expression" {
+		t.Errorf("Expected a value that does not match the override to be kept, got %v", mode)
+	}
+	if url, _ := lookupOverridePath(remote, []overrideStep{{key: "parameters"}, {key: "url"}}); url != "https://api.example.com" {
+		t.Error("Expected the nodes read from n8n not to be modified")
+	}
+}
@@ -2,25 +2,44 @@ package provider
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 
 	"github.com/devops247-online/terraform-provider-n8n/internal/client"
 )
 
+// workflowSchemaVersion is the version of the workflow resource schema. Version 1 keys nodes by name
+// instead of ID; UpgradeState migrates states of earlier versions.
+const workflowSchemaVersion = 1
+
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &WorkflowResource{}
 var _ resource.ResourceWithImportState = &WorkflowResource{}
+var _ resource.ResourceWithIdentity = &WorkflowResource{}
+var _ resource.ResourceWithModifyPlan = &WorkflowResource{}
+var _ resource.ResourceWithValidateConfig = &WorkflowResource{}
+var _ resource.ResourceWithUpgradeState = &WorkflowResource{}
 
 func NewWorkflowResource() resource.Resource {
 	return &WorkflowResource{}
@@ -28,34 +47,89 @@ func NewWorkflowResource() resource.Resource {
 
 // WorkflowResource defines the resource implementation.
 type WorkflowResource struct {
-	client *client.Client
+	client client.N8nAPI
 }
 
 // WorkflowResourceModel describes the resource data model.
 type WorkflowResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Active      types.Bool   `tfsdk:"active"`
-	Nodes       types.String `tfsdk:"nodes"`
-	Connections types.String `tfsdk:"connections"`
-	Settings    types.String `tfsdk:"settings"`
-	StaticData  types.String `tfsdk:"static_data"`
-	PinnedData  types.String `tfsdk:"pinned_data"`
-	Tags        types.List   `tfsdk:"tags"`
-	VersionID   types.String `tfsdk:"version_id"`
-	CreatedAt   types.String `tfsdk:"created_at"`
-	UpdatedAt   types.String `tfsdk:"updated_at"`
+	ID                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Active              types.Bool   `tfsdk:"active"`
+	Nodes               types.String `tfsdk:"nodes"`
+	CredentialOverrides types.Map    `tfsdk:"credential_overrides"`
+	CredentialIDs       types.Set    `tfsdk:"credential_ids"`
+	ParameterOverrides  types.Map    `tfsdk:"parameter_overrides"`
+	Connections         types.String `tfsdk:"connections"`
+	Settings            types.String `tfsdk:"settings"`
+	StaticData          types.String `tfsdk:"static_data"`
+	ManageStaticData    types.Bool   `tfsdk:"manage_static_data"`
+	PinnedData          types.String `tfsdk:"pinned_data"`
+	ManagePinnedData    types.Bool   `tfsdk:"manage_pinned_data"`
+	Tags                types.List   `tfsdk:"tags"`
+	CreateMissingTags   types.Bool   `tfsdk:"create_missing_tags"`
+	ProjectID           types.String `tfsdk:"project_id"`
+	FolderID            types.String `tfsdk:"folder_id"`
+	VersionID           types.String `tfsdk:"version_id"`
+	PinVersion          types.String `tfsdk:"pin_version_id"`
+	Overwrite           types.Bool   `tfsdk:"overwrite_remote_changes"`
+	IgnoreNodePositions types.Bool   `tfsdk:"ignore_node_positions"`
+	Archived            types.Bool   `tfsdk:"archived"`
+	ArchiveOnDestroy    types.Bool   `tfsdk:"archive_on_destroy"`
+	DeletionProtection  types.Bool   `tfsdk:"deletion_protection"`
+	ForceDestroy        types.Bool   `tfsdk:"force_destroy"`
+	AllowDeactivation   types.Bool   `tfsdk:"allow_deactivation"`
+	DeploymentStrategy  types.String `tfsdk:"deployment_strategy"`
+	VerificationPayload types.String `tfsdk:"verification_payload"`
+	VerificationTimeout types.Int64  `tfsdk:"verification_timeout"`
+	PostUpdateCheck     types.Object `tfsdk:"post_update_check"`
+	CreatedAt           types.String `tfsdk:"created_at"`
+	UpdatedAt           types.String `tfsdk:"updated_at"`
+
+	// Workflow fields maintained by n8n
+	TriggerCount types.Int64  `tfsdk:"trigger_count"`
+	Meta         types.String `tfsdk:"meta"`
+
+	// Typed workflow settings, merged into settings before sending to n8n
+	ErrorWorkflowID       types.String `tfsdk:"error_workflow_id"`
+	Timezone              types.String `tfsdk:"timezone"`
+	ExecutionTimeout      types.Int64  `tfsdk:"execution_timeout"`
+	SaveExecutionProgress types.Bool   `tfsdk:"save_execution_progress"`
+	SaveManualExecutions  types.Bool   `tfsdk:"save_manual_executions"`
+	CallerPolicy          types.String `tfsdk:"caller_policy"`
+
+	WebhookURLs types.Map `tfsdk:"webhook_urls"`
+}
+
+// workflowWebhookURLAttrTypes describes the object type of each webhook_urls entry
+func workflowWebhookURLAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"production_url": types.StringType,
+		"test_url":       types.StringType,
+	}
+}
+
+// Supported values for the workflow callerPolicy setting
+var workflowCallerPolicies = []string{
+	"any",
+	"none",
+	"workflowsFromAList",
+	"workflowsFromSameOwner",
 }
 
 func (r *WorkflowResource) Metadata(ctx context.Context, req resource.MetadataRequest,
 	resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_workflow"
+	// Blue/green deployments replace the workflow, and with it its ID
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (r *WorkflowResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: workflowSchemaVersion,
 		MarkdownDescription: "Manages an n8n workflow. Workflows are the core automation units in " +
-			"n8n that define a series of nodes and their connections.",
+			"n8n that define a series of nodes and their connections. The `nodes`, `connections` and `settings` " +
+			"JSON is validated against the n8n workflow schema at plan time, and connections must reference " +
+			"nodes defined in `nodes`.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -70,59 +144,326 @@ func (r *WorkflowResource) Schema(ctx context.Context, req resource.SchemaReques
 				Required:            true,
 			},
 			"active": schema.BoolAttribute{
-				MarkdownDescription: "Whether the workflow is active and can be triggered",
-				Optional:            true,
-				Computed:            true,
-				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether the workflow is active and can be triggered. When activation is managed " +
+					"with `n8n_workflow_activation`, leave it unset and add it to `lifecycle.ignore_changes`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
 			},
 			"nodes": schema.StringAttribute{
-				MarkdownDescription: "JSON string containing the workflow nodes configuration",
-				Optional:            true,
-				Computed:            true,
+				MarkdownDescription: "JSON object of the workflow nodes keyed by node name, the name connections refer " +
+					"to nodes by. The n8n node ID may be set with `id`; nodes without one keep the ID n8n assigned. " +
+					"Any formatting of the JSON is accepted; values read from n8n are stored indented with sorted keys. " +
+					"Plans that change nodes summarize the added, removed and changed nodes in a warning.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"credential_overrides": schema.MapAttribute{
+				MarkdownDescription: "Credentials to use in nodes, keyed by node name. Each value is the ID or the " +
+					"unique name of a credential, which replaces the credential of its type in the node before the " +
+					"workflow is sent. This lets the same `nodes` JSON be applied to instances with different credentials.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"credential_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the credentials the nodes use in n8n, including those set by " +
+					"`credential_overrides`. Credentials that nodes or overrides refer to are checked at plan time, " +
+					"so that a workflow does not fail to activate because a credential does not exist (yet). Refer " +
+					"to credentials managed in the same configuration by their `id` attribute, so that they are " +
+					"created before the workflow.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"parameter_overrides": schema.MapAttribute{
+				MarkdownDescription: "Values to set in nodes before the workflow is sent, keyed by the node name and " +
+					"the path within the node, e.g. `HTTP Request.parameters.url` or `Set.parameters.values.string[0].value`. " +
+					"Values replacing strings, or values that do not exist yet, are set as strings; other values are " +
+					"replaced with the value decoded as JSON, e.g. from `jsonencode`. This lets the same `nodes` JSON be " +
+					"promoted across environments.",
+				ElementType: types.StringType,
+				Optional:    true,
 			},
 			"connections": schema.StringAttribute{
-				MarkdownDescription: "JSON string containing the workflow connections between nodes",
-				Optional:            true,
-				Computed:            true,
+				MarkdownDescription: "JSON string containing the workflow connections between nodes. Like `nodes`, it is kept as " +
+					"configured while n8n returns the same JSON, and stored indented with sorted keys otherwise.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"settings": schema.StringAttribute{
-				MarkdownDescription: "JSON string containing workflow settings",
+				MarkdownDescription: "JSON string containing workflow settings. Settings that have a dedicated " +
+					"attribute (e.g. `timezone`) should be set through that attribute instead; setting both is an error.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					settingsUseStateForUnknown(),
+				},
+			},
+			"error_workflow_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the workflow to run when this workflow fails (`settings.errorWorkflow`). " +
+					"Reference an `n8n_workflow` resource (e.g. `n8n_workflow.on_error.id`) so it is created first. " +
+					"The referenced workflow must exist.",
+				Optional: true,
+			},
+			"timezone": schema.StringAttribute{
+				MarkdownDescription: "IANA time zone used by the workflow, e.g. 'Europe/Berlin' (`settings.timezone`)",
 				Optional:            true,
-				Computed:            true,
+				Validators: []validator.String{
+					validTimezone(),
+				},
 			},
-			"static_data": schema.StringAttribute{
-				MarkdownDescription: "JSON string containing static data for the workflow",
+			"execution_timeout": schema.Int64Attribute{
+				MarkdownDescription: "Maximum execution time in seconds, or -1 to disable the timeout " +
+					"(`settings.executionTimeout`)",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64AtLeast(-1),
+				},
+			},
+			"save_execution_progress": schema.BoolAttribute{
+				MarkdownDescription: "Whether to save execution data after each node (`settings.saveExecutionProgress`)",
 				Optional:            true,
-				Computed:            true,
 			},
-			"pinned_data": schema.StringAttribute{
-				MarkdownDescription: "JSON string containing pinned data for testing purposes",
+			"save_manual_executions": schema.BoolAttribute{
+				MarkdownDescription: "Whether to save data of manually started executions (`settings.saveManualExecutions`)",
 				Optional:            true,
-				Computed:            true,
+			},
+			"caller_policy": schema.StringAttribute{
+				MarkdownDescription: "Which workflows may call this workflow: 'any', 'none', 'workflowsFromAList' or " +
+					"'workflowsFromSameOwner' (`settings.callerPolicy`)",
+				Optional: true,
+				Validators: []validator.String{
+					stringOneOf(workflowCallerPolicies...),
+				},
+			},
+			"static_data": schema.StringAttribute{
+				MarkdownDescription: "JSON string containing static data for the workflow. n8n updates static data " +
+					"at runtime, e.g. the last poll time of triggers, so it is only read unless `manage_static_data` is set.",
+				Optional: true,
+				Computed: true,
+			},
+			"manage_static_data": schema.BoolAttribute{
+				MarkdownDescription: "Whether the provider manages the static data of the workflow with `static_data`. " +
+					"When false, the static data n8n maintains is never sent, so that it does not drift from the " +
+					"configuration. Defaults to false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"pinned_data": schema.StringAttribute{
+				MarkdownDescription: "JSON object of the output pinned to nodes for testing, keyed by node name, like " +
+					"`pinData` in workflow exports. Requires `manage_pinned_data`; otherwise the pinned data of the workflow " +
+					"is neither sent nor read. Changing it requires the internal API, see `api_mode`.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"manage_pinned_data": schema.BoolAttribute{
+				MarkdownDescription: "Whether the provider manages the pinned data of the workflow with `pinned_data`. " +
+					"Pinned data holds sample payloads for testing, so it is left alone by default to keep it from " +
+					"being promoted to production. Defaults to false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
 			},
 			"tags": schema.ListAttribute{
-				MarkdownDescription: "List of tags associated with the workflow",
-				ElementType:         types.StringType,
-				Optional:            true,
-				Computed:            true,
-				Default:             listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+				MarkdownDescription: "Names of the tags of the workflow. Tags that do not exist fail the apply unless " +
+					"`create_missing_tags` is set.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+			},
+			"create_missing_tags": schema.BoolAttribute{
+				MarkdownDescription: "Whether to create the tags in `tags` that do not exist yet, so that no separate " +
+					"resource is needed per tag. Defaults to false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the project the workflow belongs to (Enterprise feature). Defaults to the " +
+					"provider's `default_project_id`; without either, the workflow stays in the personal project of " +
+					"the authenticated user. Changing it moves the workflow to the new project.",
+				Optional: true,
+				Computed: true,
+			},
+			"folder_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the `n8n_folder` the workflow is placed in, which must belong to the " +
+					"workflow's project. Without it, the workflow is at the top level of its project. Requires " +
+					"session authentication.",
+				Optional: true,
 			},
 			"version_id": schema.StringAttribute{
 				MarkdownDescription: "Version identifier of the workflow",
 				Computed:            true,
 			},
+			"trigger_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of trigger nodes that start the workflow when it is active",
+				Computed:            true,
+			},
+			"meta": schema.StringAttribute{
+				MarkdownDescription: "JSON string containing the workflow metadata maintained by n8n, e.g. the template " +
+					"the workflow was created from",
+				Computed: true,
+			},
+			"pin_version_id": schema.StringAttribute{
+				MarkdownDescription: "Expected version identifier of the workflow. Planning fails if the workflow in n8n " +
+					"is at a different version than both this one and the version last applied by Terraform, which " +
+					"indicates it was edited outside of Terraform (e.g. in the editor UI).",
+				Optional: true,
+			},
+			"overwrite_remote_changes": schema.BoolAttribute{
+				MarkdownDescription: "Whether to apply changes even if the workflow was modified in n8n since Terraform " +
+					"last wrote it (e.g. edited in the editor UI). When false, updates fail instead of discarding " +
+					"those edits. Defaults to false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"ignore_node_positions": schema.BoolAttribute{
+				MarkdownDescription: "Whether to ignore the positions of nodes in n8n, so that moving nodes in the editor " +
+					"does not show up as a change. Positions are written on create; updates keep the positions the nodes " +
+					"have in n8n, and moving nodes does not count as a change for `overwrite_remote_changes`. " +
+					"Defaults to false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"archived": schema.BoolAttribute{
+				MarkdownDescription: "Whether the workflow is archived. Archived workflows are inactive and hidden from " +
+					"the workflow list in n8n, but can be restored. Defaults to the current state of the workflow. " +
+					"Requires session authentication.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"archive_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Whether to archive the workflow instead of deleting it when it is destroyed, so " +
+					"that it can still be restored in n8n. Requires session authentication. Defaults to false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"deletion_protection": schema.BoolAttribute{
+				MarkdownDescription: "Whether the provider refuses to delete the workflow, e.g. to protect production " +
+					"workflows from an accidental `terraform destroy`. Unlike the `prevent_destroy` lifecycle argument, " +
+					"this also applies when the resource is removed from the configuration. Defaults to false.",
+				Optional: true,
+			},
+			"force_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Whether to delete the workflow despite `deletion_protection`. Must be applied " +
+					"before the workflow is destroyed. Defaults to false.",
+				Optional: true,
+			},
+			"allow_deactivation": schema.BoolAttribute{
+				MarkdownDescription: "Whether plans may deactivate the workflow or remove trigger nodes from it while " +
+					"it is active without a warning. Without it, such plans warn that the workflow will stop running, " +
+					"to prevent an accidental outage of production automations. Defaults to false.",
+				Optional: true,
+			},
+			"deployment_strategy": schema.StringAttribute{
+				MarkdownDescription: "How changes to the nodes, connections or settings of an active workflow are " +
+					"deployed: 'in_place' edits the workflow, and 'blue_green' creates a new workflow with the changes, " +
+					"activates it in place of the current one and archives the current one, so that hot production " +
+					"workflows are never edited in place. The new workflow gets a new ID. Since only one active workflow " +
+					"can listen on a webhook path, the current workflow is deactivated right before the new one is " +
+					"activated. If the new workflow cannot be activated or verified, it is deleted and the current " +
+					"workflow is reactivated. Archiving requires session authentication. Defaults to 'in_place'.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(workflowDeploymentInPlace),
+				Validators: []validator.String{
+					stringOneOf(workflowDeploymentInPlace, workflowDeploymentBlueGreen),
+				},
+			},
+			"verification_payload": schema.StringAttribute{
+				MarkdownDescription: "JSON object to run the new workflow of a blue/green deployment with once it is " +
+					"active. The deployment is rolled back unless the execution succeeds. Use `jsonencode({})` to verify " +
+					"without a payload. Manual runs require session authentication and n8n to save manual executions.",
+				Optional: true,
+			},
+			"verification_timeout": schema.Int64Attribute{
+				MarkdownDescription: "Maximum time in seconds to wait for the verification execution to finish. " +
+					"Defaults to 300.",
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(workflowCheckTimeout),
+				Validators: []validator.Int64{
+					int64AtLeast(1),
+				},
+			},
 			"created_at": schema.StringAttribute{
 				MarkdownDescription: "Timestamp when the workflow was created",
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"updated_at": schema.StringAttribute{
 				MarkdownDescription: "Timestamp when the workflow was last updated",
 				Computed:            true,
 			},
+			"webhook_urls": schema.MapNestedAttribute{
+				MarkdownDescription: "Webhook URLs exposed by the workflow's webhook and form trigger nodes, keyed by node name",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"production_url": schema.StringAttribute{
+							MarkdownDescription: "URL that triggers the active workflow",
+							Computed:            true,
+						},
+						"test_url": schema.StringAttribute{
+							MarkdownDescription: "URL that triggers the workflow while listening for a test event in the editor",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"post_update_check": schema.SingleNestedBlock{
+				MarkdownDescription: "Manual execution that must succeed after the workflow is updated in place, e.g. a " +
+					"canary run with sample trigger data. If the execution does not succeed, the changes to the workflow " +
+					"are rolled back to the version in n8n before the update and the apply fails. It does not run when " +
+					"the workflow is created or only Terraform-side attributes change; blue/green deployments are " +
+					"verified with `verification_payload` instead. Manual runs require session authentication and n8n " +
+					"to save manual executions.",
+				Attributes: map[string]schema.Attribute{
+					"pin_data": schema.StringAttribute{
+						MarkdownDescription: "JSON object of the output of nodes to pin for the execution, keyed by node " +
+							"name like `pinned_data`, e.g. `{\"Webhook\": [{\"json\": {\"order_id\": 1}}]}`. Without it, " +
+							"the execution uses the pinned data of the workflow.",
+						Optional: true,
+					},
+					"timeout": schema.Int64Attribute{
+						MarkdownDescription: "Maximum time in seconds to wait for the execution to finish. Defaults to 300.",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64AtLeast(1),
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+func (r *WorkflowResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("Workflow identifier")
+}
+
 func (r *WorkflowResource) Configure(ctx context.Context, req resource.ConfigureRequest,
 	resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
@@ -130,12 +471,12 @@ func (r *WorkflowResource) Configure(ctx context.Context, req resource.Configure
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(client.N8nAPI)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.",
+			fmt.Sprintf("Expected client.N8nAPI, got: %T. Please report this issue to the provider developers.",
 				req.ProviderData),
 		)
 
@@ -155,120 +496,90 @@ func (r *WorkflowResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	// Create workflow object
-	workflow := &client.Workflow{
-		Name:   data.Name.ValueString(),
-		Active: data.Active.ValueBool(),
+	// Verify the error workflow exists now that references are resolved
+	r.validateErrorWorkflow(&data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	// Parse and validate JSON fields if provided
-	if !data.Nodes.IsNull() && data.Nodes.ValueString() != "" {
-		if err := r.validateWorkflowJSON(data.Nodes.ValueString(), "nodes"); err != nil {
-			resp.Diagnostics.AddAttributeError(
-				path.Root("nodes"),
-				"Invalid Nodes JSON",
-				err.Error(),
-			)
-			return
-		}
-		var nodes map[string]interface{}
-		if err := json.Unmarshal([]byte(data.Nodes.ValueString()), &nodes); err != nil {
-			resp.Diagnostics.AddAttributeError(
-				path.Root("nodes"),
-				"Invalid JSON",
-				fmt.Sprintf("Unable to parse nodes JSON: %s", err),
-			)
-			return
-		}
-		// Convert nodes from object format to array format for API
-		nodesArray := r.convertNodesToArray(nodes)
-		workflow.Nodes = nodesArray
+	projectID := createProjectID(r.client, data.ProjectID)
+	if projectID != "" && !checkInstanceRequirement(r.client, "n8n_workflow", requiresProjects, &resp.Diagnostics) {
+		return
 	}
 
-	// Connections field is required by n8n API, default to empty object if not provided
-	if !data.Connections.IsNull() && data.Connections.ValueString() != "" {
-		if err := r.validateWorkflowJSON(data.Connections.ValueString(), "connections"); err != nil {
-			resp.Diagnostics.AddAttributeError(
-				path.Root("connections"),
-				"Invalid Connections JSON",
-				err.Error(),
-			)
-			return
-		}
-		var connections map[string]interface{}
-		if err := json.Unmarshal([]byte(data.Connections.ValueString()), &connections); err != nil {
-			resp.Diagnostics.AddAttributeError(
-				path.Root("connections"),
-				"Invalid JSON",
-				fmt.Sprintf("Unable to parse connections JSON: %s", err),
-			)
-			return
-		}
-		workflow.Connections = connections
-	} else {
-		// Set empty connections object if not provided (required by n8n API)
-		workflow.Connections = make(map[string]interface{})
+	workflow, ok := r.workflowFromModel(ctx, &data, nil, &resp.Diagnostics)
+	if !ok {
+		return
 	}
 
-	// Settings field is required by n8n API, default to basic settings if not provided
-	if !data.Settings.IsNull() && data.Settings.ValueString() != "" {
-		var settings map[string]interface{}
-		if err := json.Unmarshal([]byte(data.Settings.ValueString()), &settings); err != nil {
-			resp.Diagnostics.AddAttributeError(
-				path.Root("settings"),
-				"Invalid JSON",
-				fmt.Sprintf("Unable to parse settings JSON: %s", err),
-			)
+	credentials, ok := r.resolveOverrideCredentials(ctx, data.CredentialOverrides, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	overrideNodeCredentials(workflow.Nodes, credentials)
+
+	archive := data.Archived.ValueBool()
+
+	// Create workflow via API
+	createdWorkflow, err := r.client.CreateWorkflow(workflow)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create workflow, got error: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, createdWorkflow.ID)...)
+
+	// Update model with response data
+	tags := data.Tags
+	r.updateModelFromWorkflow(&data, createdWorkflow)
+	resp.Diagnostics.Append(setAppliedWorkflowVersion(ctx, resp.Private, data.VersionID)...)
+	resp.Diagnostics.Append(setAppliedWorkflowContent(ctx, resp.Private, createdWorkflow)...)
+	resp.Diagnostics.Append(setNodeOrder(ctx, resp.Private, createdWorkflow)...)
+
+	// Tags are set once the workflow exists
+	if len(tags.Elements()) > 0 {
+		appliedTags, ok := r.applyTags(ctx, createdWorkflow.ID, tags, data.CreateMissingTags.ValueBool(), &resp.Diagnostics)
+		if !ok {
+			// Keep the created workflow in state so that it is not orphaned
+			data.ProjectID, data.FolderID = types.StringNull(), types.StringNull()
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 			return
 		}
-		workflow.Settings = settings
-	} else {
-		// Set basic settings if not provided (required by n8n API)
-		workflow.Settings = map[string]interface{}{
-			"executionOrder": "v1",
-		}
+		data.Tags = appliedTags
 	}
 
-	if !data.StaticData.IsNull() && data.StaticData.ValueString() != "" {
-		var staticData map[string]interface{}
-		if err := json.Unmarshal([]byte(data.StaticData.ValueString()), &staticData); err != nil {
-			resp.Diagnostics.AddAttributeError(
-				path.Root("static_data"),
-				"Invalid JSON",
-				fmt.Sprintf("Unable to parse static_data JSON: %s", err),
-			)
+	// New workflows are created in the personal project and moved afterwards
+	data.ProjectID = types.StringNull()
+	if projectID != "" {
+		if !moveToProject("n8n_workflow", createdWorkflow.ID, projectID, r.client.TransferWorkflow, &resp.Diagnostics) {
+			// Keep the created workflow in state so that it is not orphaned
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 			return
 		}
-		workflow.StaticData = staticData
+		data.ProjectID = projectIDValue(projectID)
 	}
 
-	if !data.PinnedData.IsNull() && data.PinnedData.ValueString() != "" {
-		var pinnedData map[string]interface{}
-		if err := json.Unmarshal([]byte(data.PinnedData.ValueString()), &pinnedData); err != nil {
-			resp.Diagnostics.AddAttributeError(
-				path.Root("pinned_data"),
-				"Invalid JSON",
-				fmt.Sprintf("Unable to parse pinned_data JSON: %s", err),
-			)
+	// Folders belong to a project, so the workflow is placed in its folder once it is in the project
+	if !data.FolderID.IsNull() {
+		if !r.moveToFolder(createdWorkflow.ID, data.FolderID.ValueString(), &resp.Diagnostics) {
+			data.FolderID = types.StringNull()
+			// Keep the created workflow in state so that it is not orphaned
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 			return
 		}
-		workflow.PinnedData = pinnedData
 	}
 
-	// Tags are read-only during creation, will be set via update if needed
-
-	// Create workflow via API
-	createdWorkflow, err := r.client.CreateWorkflow(workflow)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create workflow, got error: %s", err))
-		return
+	// Workflows can only be archived once they exist
+	if archive {
+		archivedWorkflow, err := r.client.ArchiveWorkflow(createdWorkflow.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to archive workflow, got error: %s", err))
+			// Keep the created workflow in state so that it is not orphaned
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+		r.updateModelFromWorkflow(&data, archivedWorkflow)
 	}
 
-	// TODO: Tags are read-only in n8n API, need to investigate proper tag management approach
-
-	// Update model with response data
-	r.updateModelFromWorkflow(&data, createdWorkflow)
-
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -286,12 +597,33 @@ func (r *WorkflowResource) Read(ctx context.Context, req resource.ReadRequest, r
 	// Get workflow from API
 	workflow, err := r.client.GetWorkflow(data.ID.ValueString())
 	if err != nil {
+		if client.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workflow, got error: %s", err))
 		return
 	}
 
+	// Imported workflows have no value yet; use the schema default
+	if data.Overwrite.IsNull() {
+		data.Overwrite = types.BoolValue(false)
+	}
+	if data.ArchiveOnDestroy.IsNull() {
+		data.ArchiveOnDestroy = types.BoolValue(false)
+	}
+	if data.DeploymentStrategy.IsNull() {
+		data.DeploymentStrategy = types.StringValue(workflowDeploymentInPlace)
+	}
+	if data.VerificationTimeout.IsNull() {
+		data.VerificationTimeout = types.Int64Value(workflowCheckTimeout)
+	}
+
 	// Update model with response data
 	r.updateModelFromWorkflow(&data, workflow)
+	resp.Diagnostics.Append(setNodeOrder(ctx, resp.Private, workflow)...)
+
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.ID.ValueString())...)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -307,132 +639,240 @@ func (r *WorkflowResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	// Create workflow object for update
-	workflow := &client.Workflow{
-		Name:   data.Name.ValueString(),
-		Active: data.Active.ValueBool(),
+	// Verify the error workflow exists now that references are resolved
+	r.validateErrorWorkflow(&data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	// Parse and validate JSON fields if provided (similar to Create method)
-	if !data.Nodes.IsNull() && data.Nodes.ValueString() != "" {
-		if err := r.validateWorkflowJSON(data.Nodes.ValueString(), "nodes"); err != nil {
-			resp.Diagnostics.AddAttributeError(
-				path.Root("nodes"),
-				"Invalid Nodes JSON",
-				err.Error(),
-			)
-			return
-		}
-		var nodes map[string]interface{}
-		if err := json.Unmarshal([]byte(data.Nodes.ValueString()), &nodes); err != nil {
-			resp.Diagnostics.AddAttributeError(
-				path.Root("nodes"),
-				"Invalid JSON",
-				fmt.Sprintf("Unable to parse nodes JSON: %s", err),
-			)
-			return
-		}
-		// Convert nodes from object format to array format for API
-		nodesArray := r.convertNodesToArray(nodes)
-		workflow.Nodes = nodesArray
+	var priorProjectID types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("project_id"), &priorProjectID)...)
+	moveProject := !data.ProjectID.IsNull() && data.ProjectID.ValueString() != priorProjectID.ValueString()
+	if moveProject && !checkInstanceRequirement(r.client, "n8n_workflow", requiresProjects, &resp.Diagnostics) {
+		return
 	}
 
-	// Connections field is required by n8n API, default to empty object if not provided
-	if !data.Connections.IsNull() && data.Connections.ValueString() != "" {
-		if err := r.validateWorkflowJSON(data.Connections.ValueString(), "connections"); err != nil {
-			resp.Diagnostics.AddAttributeError(
-				path.Root("connections"),
-				"Invalid Connections JSON",
-				err.Error(),
-			)
-			return
-		}
-		var connections map[string]interface{}
-		if err := json.Unmarshal([]byte(data.Connections.ValueString()), &connections); err != nil {
-			resp.Diagnostics.AddAttributeError(
-				path.Root("connections"),
-				"Invalid JSON",
-				fmt.Sprintf("Unable to parse connections JSON: %s", err),
-			)
-			return
-		}
-		workflow.Connections = connections
-	} else {
-		// Set empty connections object if not provided (required by n8n API)
-		workflow.Connections = make(map[string]interface{})
+	var state WorkflowResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	// Settings field is required by n8n API, default to basic settings if not provided
-	if !data.Settings.IsNull() && data.Settings.ValueString() != "" {
-		var settings map[string]interface{}
-		if err := json.Unmarshal([]byte(data.Settings.ValueString()), &settings); err != nil {
-			resp.Diagnostics.AddAttributeError(
-				path.Root("settings"),
-				"Invalid JSON",
-				fmt.Sprintf("Unable to parse settings JSON: %s", err),
-			)
+	// Blue/green deployments plan a new ID, while the workflow to update is the one in state
+	data.ID = state.ID
+
+	var check *WorkflowPostUpdateCheckModel
+	if !data.PostUpdateCheck.IsNull() && !data.PostUpdateCheck.IsUnknown() {
+		check = &WorkflowPostUpdateCheckModel{}
+		resp.Diagnostics.Append(data.PostUpdateCheck.As(ctx, check, basetypes.ObjectAsOptions{})...)
+
+		if resp.Diagnostics.HasError() {
 			return
 		}
-		workflow.Settings = settings
-	} else {
-		// Set basic settings if not provided (required by n8n API)
-		workflow.Settings = map[string]interface{}{
-			"executionOrder": "v1",
-		}
 	}
 
-	if !data.StaticData.IsNull() && data.StaticData.ValueString() != "" {
-		var staticData map[string]interface{}
-		if err := json.Unmarshal([]byte(data.StaticData.ValueString()), &staticData); err != nil {
-			resp.Diagnostics.AddAttributeError(
-				path.Root("static_data"),
-				"Invalid JSON",
-				fmt.Sprintf("Unable to parse static_data JSON: %s", err),
-			)
+	// The workflow in n8n is also what a failed post update check rolls back to
+	ignorePositions := data.IgnoreNodePositions.ValueBool()
+	var remote *client.Workflow
+	if !data.Overwrite.ValueBool() || ignorePositions || check != nil {
+		var err error
+		remote, err = r.client.GetWorkflow(data.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workflow, got error: %s", err))
 			return
 		}
-		workflow.StaticData = staticData
 	}
 
-	if !data.PinnedData.IsNull() && data.PinnedData.ValueString() != "" {
-		var pinnedData map[string]interface{}
-		if err := json.Unmarshal([]byte(data.PinnedData.ValueString()), &pinnedData); err != nil {
-			resp.Diagnostics.AddAttributeError(
-				path.Root("pinned_data"),
-				"Invalid JSON",
-				fmt.Sprintf("Unable to parse pinned_data JSON: %s", err),
-			)
+	// Refuse to discard edits made outside of Terraform unless asked to
+	if !data.Overwrite.ValueBool() && (!ignorePositions || !r.onlyNodesMoved(state, remote)) {
+		appliedVersion, diags := req.Private.GetKey(ctx, appliedVersionKey)
+		resp.Diagnostics.Append(diags...)
+		appliedContent, diags := req.Private.GetKey(ctx, appliedContentKey)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
 			return
 		}
-		workflow.PinnedData = pinnedData
-	}
 
-	// Handle tags
-	if !data.Tags.IsNull() {
-		var tags []string
-		resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
+		checkRemoteChanges(&state, appliedVersion, appliedContent, remote, &resp.Diagnostics)
 		if resp.Diagnostics.HasError() {
 			return
 		}
-		workflow.Tags = tags
 	}
 
-	// Update workflow via API
-	updatedWorkflow, err := r.client.UpdateWorkflow(data.ID.ValueString(), workflow)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update workflow, got error: %s", err))
+	// Nodes are sent in the order and with the IDs n8n last returned, so that neither shows up as a change
+	knownNodes, diags := getNodeOrder(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+
+	workflow, ok := r.workflowFromModel(ctx, &data, knownNodes, &resp.Diagnostics)
+	if !ok {
 		return
 	}
 
-	// Update model with response data
-	r.updateModelFromWorkflow(&data, updatedWorkflow)
+	credentials, ok := r.resolveOverrideCredentials(ctx, data.CredentialOverrides, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	overrideNodeCredentials(workflow.Nodes, credentials)
 
-	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-}
+	// Only send the fields that changed, so that fields the provider does not manage are kept. Without a
+	// usable prior state (e.g. invalid JSON written by an older version), every field is sent.
+	prior, ok := r.workflowFromModel(ctx, &state, knownNodes, &diag.Diagnostics{})
+	if !ok {
+		prior = &client.Workflow{}
+	} else if data.CredentialOverrides.Equal(state.CredentialOverrides) {
+		overrideNodeCredentials(prior.Nodes, credentials)
+	} else {
+		// The credentials the prior overrides resolved to are not known, so the nodes are always sent
+		prior.Nodes = nil
+	}
 
-func (r *WorkflowResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data WorkflowResourceModel
+	// Nodes moved in the editor stay where they are
+	if ignorePositions {
+		keepNodePositions(workflow.Nodes, remote.Nodes)
+		keepNodePositions(prior.Nodes, remote.Nodes)
+	}
+
+	// Blue/green deployments put the changes live in a new workflow instead of editing the active one
+	if blueGreenDeployment(&data, &state) {
+		deployed, ok := r.deployBlueGreen(ctx, &data, state.ID.ValueString(), workflow, &resp.Diagnostics)
+		if !ok {
+			return
+		}
+		resp.Diagnostics.Append(setAppliedWorkflowVersion(ctx, resp.Private, data.VersionID)...)
+		resp.Diagnostics.Append(setAppliedWorkflowContent(ctx, resp.Private, deployed)...)
+		resp.Diagnostics.Append(setNodeOrder(ctx, resp.Private, deployed)...)
+		resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.ID.ValueString())...)
+
+		// Save updated data into Terraform state
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	changes, err := client.WorkflowChanges(prior, workflow)
+	if err != nil {
+		resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Unable to compare workflow changes, got error: %s", err))
+		return
+	}
+
+	// The folder is applied after the update, which may report the current one
+	folderID := data.FolderID
+
+	// Archived workflows cannot be edited, so they are restored before and archived after the update
+	archive := !data.Archived.IsUnknown() && data.Archived.ValueBool() && !state.Archived.ValueBool()
+	if !data.Archived.IsUnknown() && !data.Archived.ValueBool() && state.Archived.ValueBool() {
+		if _, err := r.client.UnarchiveWorkflow(data.ID.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to unarchive workflow, got error: %s", err))
+			return
+		}
+	}
+
+	// Update workflow via API, or refresh it if only Terraform-side attributes changed
+	var updatedWorkflow *client.Workflow
+	if len(changes) > 0 {
+		updatedWorkflow, err = r.client.PatchWorkflow(data.ID.ValueString(), changes)
+	} else {
+		updatedWorkflow, err = r.client.GetWorkflow(data.ID.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update workflow, got error: %s", err))
+		return
+	}
+
+	// A failed post update check rolls the changes back, so that the workflow keeps running as before. The
+	// prior state is kept, with the workflow as it is in n8n afterwards.
+	if len(changes) > 0 && check != nil && !archive && !r.checkWorkflowUpdate(data.ID.ValueString(), check, &resp.Diagnostics) {
+		current := updatedWorkflow
+		reverted, err := r.revertWorkflowUpdate(data.ID.ValueString(), remote, updatedWorkflow, changes)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to roll back workflow to its prior version, "+
+				"got error: %s", err))
+		} else {
+			current = reverted
+		}
+
+		r.updateModelFromWorkflow(&state, current)
+		resp.Diagnostics.Append(setAppliedWorkflowVersion(ctx, resp.Private, state.VersionID)...)
+		resp.Diagnostics.Append(setAppliedWorkflowContent(ctx, resp.Private, current)...)
+		resp.Diagnostics.Append(setNodeOrder(ctx, resp.Private, current)...)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
+
+	// Tags are set with their own endpoint, before the workflow is archived
+	tags := data.Tags
+	tagsChanged := !data.Tags.Equal(state.Tags)
+	if tagsChanged {
+		appliedTags, ok := r.applyTags(ctx, data.ID.ValueString(), data.Tags, data.CreateMissingTags.ValueBool(),
+			&resp.Diagnostics)
+		if !ok {
+			// Keep the applied changes in state
+			r.updateModelFromWorkflow(&data, updatedWorkflow)
+			data.Tags = state.Tags
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+		tags = appliedTags
+	}
+
+	if archive {
+		archivedWorkflow, err := r.client.ArchiveWorkflow(data.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to archive workflow, got error: %s", err))
+			// Keep the applied changes in state
+			r.updateModelFromWorkflow(&data, updatedWorkflow)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+		updatedWorkflow = archivedWorkflow
+	}
+
+	// Update model with response data
+	r.updateModelFromWorkflow(&data, updatedWorkflow)
+	if tagsChanged {
+		data.Tags = tags
+	}
+	resp.Diagnostics.Append(setAppliedWorkflowVersion(ctx, resp.Private, data.VersionID)...)
+	resp.Diagnostics.Append(setAppliedWorkflowContent(ctx, resp.Private, updatedWorkflow)...)
+	resp.Diagnostics.Append(setNodeOrder(ctx, resp.Private, updatedWorkflow)...)
+
+	if moveProject {
+		if !moveToProject("n8n_workflow", data.ID.ValueString(), data.ProjectID.ValueString(), r.client.TransferWorkflow,
+			&resp.Diagnostics) {
+			data.ProjectID = priorProjectID
+			data.FolderID = state.FolderID
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+
+	// Moving the workflow to another project takes it out of its folder
+	data.FolderID = folderID
+	if !folderID.Equal(state.FolderID) || (moveProject && !folderID.IsNull()) {
+		destination := folderID.ValueString()
+		if folderID.IsNull() {
+			destination = client.ProjectRootFolderID
+		}
+
+		if !r.moveToFolder(data.ID.ValueString(), destination, &resp.Diagnostics) {
+			data.FolderID = state.FolderID
+			if moveProject {
+				data.FolderID = types.StringNull()
+			}
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, data.ID.ValueString())...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WorkflowResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data WorkflowResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -441,6 +881,23 @@ func (r *WorkflowResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	if !checkDeletionProtection("n8n_workflow", data.ID.ValueString(), data.DeletionProtection, data.ForceDestroy,
+		&resp.Diagnostics) {
+		return
+	}
+
+	// Archive instead of deleting if asked to; an archived workflow is left as it is
+	if data.ArchiveOnDestroy.ValueBool() {
+		if data.Archived.ValueBool() {
+			return
+		}
+
+		if _, err := r.client.ArchiveWorkflow(data.ID.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to archive workflow, got error: %s", err))
+		}
+		return
+	}
+
 	// Delete workflow via API
 	err := r.client.DeleteWorkflow(data.ID.ValueString())
 	if err != nil {
@@ -449,9 +906,483 @@ func (r *WorkflowResource) Delete(ctx context.Context, req resource.DeleteReques
 	}
 }
 
+// ValidateConfig validates the nodes, connections and settings JSON against the embedded n8n
+// workflow schema, so that mistakes surface at plan time instead of as API errors during apply
+func (r *WorkflowResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse) {
+	var data WorkflowResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Archiving a workflow deactivates it, so both cannot be requested together
+	if data.Archived.ValueBool() && data.Active.ValueBool() {
+		resp.Diagnostics.AddAttributeError(path.Root("archived"), "Conflicting Attributes",
+			"An archived workflow cannot be active. Set active to false to archive the workflow.")
+	}
+
+	if !data.StaticData.IsNull() && !data.ManageStaticData.IsUnknown() && !data.ManageStaticData.ValueBool() {
+		resp.Diagnostics.AddAttributeError(path.Root("static_data"), "Unmanaged Static Data",
+			"static_data is only sent to n8n when manage_static_data is true. Set manage_static_data = true to "+
+				"manage the static data of this workflow, or remove static_data to leave it to n8n.")
+	}
+	if !data.VerificationPayload.IsNull() && !data.DeploymentStrategy.IsUnknown() &&
+		data.DeploymentStrategy.ValueString() != workflowDeploymentBlueGreen {
+		resp.Diagnostics.AddAttributeError(path.Root("verification_payload"), "Conflicting Attributes",
+			"verification_payload is only used by blue/green deployments. Set deployment_strategy = \"blue_green\" "+
+				"to verify new versions of the workflow before the current one is archived.")
+	}
+	if !data.VerificationPayload.IsNull() && !data.VerificationPayload.IsUnknown() {
+		var payload map[string]interface{}
+		if err := json.Unmarshal([]byte(data.VerificationPayload.ValueString()), &payload); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("verification_payload"), "Invalid Verification Payload JSON",
+				fmt.Sprintf("Unable to parse verification_payload as a JSON object: %s", err))
+		}
+	}
+	var check WorkflowPostUpdateCheckModel
+	if !data.PostUpdateCheck.IsNull() && !data.PostUpdateCheck.IsUnknown() {
+		resp.Diagnostics.Append(data.PostUpdateCheck.As(ctx, &check, basetypes.ObjectAsOptions{})...)
+	}
+	if !check.PinData.IsNull() && !check.PinData.IsUnknown() {
+		var pinData map[string]interface{}
+		if err := json.Unmarshal([]byte(check.PinData.ValueString()), &pinData); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("post_update_check").AtName("pin_data"), "Invalid Pin Data JSON",
+				fmt.Sprintf("Unable to parse pin_data as a JSON object: %s", err))
+		}
+	}
+	if !data.PinnedData.IsNull() && !data.ManagePinnedData.IsUnknown() && !data.ManagePinnedData.ValueBool() {
+		resp.Diagnostics.AddAttributeError(path.Root("pinned_data"), "Unmanaged Pinned Data",
+			"pinned_data is only sent to n8n when manage_pinned_data is true. Set manage_pinned_data = true to "+
+				"manage the pinned data of this workflow, e.g. only in test environments.")
+	}
+
+	validateWorkflowJSONSchema("nodes", "Invalid Nodes JSON", data.Nodes, &resp.Diagnostics)
+	validateWorkflowJSONSchema("connections", "Invalid Connections JSON", data.Connections, &resp.Diagnostics)
+	validateWorkflowJSONSchema("settings", "Invalid Settings JSON", data.Settings, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateSettingsConflicts(&data, &resp.Diagnostics)
+
+	// Run the structural checks of apply at plan time, so that invalid nodes do not fail halfway through an apply
+	for _, field := range []struct {
+		name, summary string
+		value         types.String
+	}{
+		{"nodes", "Invalid Nodes JSON", data.Nodes},
+		{"connections", "Invalid Connections JSON", data.Connections},
+	} {
+		if field.value.IsNull() || field.value.IsUnknown() {
+			continue
+		}
+		if err := r.validateWorkflowJSON(field.value.ValueString(), field.name); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(field.name), field.summary, err.Error())
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if problems := validateWorkflowNodes(decodeNodes(data.Nodes)); len(problems) > 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("nodes"), "Invalid Nodes JSON",
+			fmt.Sprintf("The workflow nodes are not valid:\n  - %s", strings.Join(problems, "\n  - ")))
+		return
+	}
+
+	if !data.Nodes.IsNull() && !data.Nodes.IsUnknown() && !data.CredentialOverrides.IsUnknown() {
+		if problems := validateCredentialOverrides(decodeNodes(data.Nodes), data.CredentialOverrides); len(problems) > 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("credential_overrides"), "Invalid Credential Overrides",
+				fmt.Sprintf("The credential overrides do not match the nodes:\n  - %s", strings.Join(problems, "\n  - ")))
+			return
+		}
+	}
+
+	// Apply the parameter overrides at plan time, so that addresses that do not match the nodes fail early
+	if !data.Nodes.IsNull() && !data.Nodes.IsUnknown() && !data.ParameterOverrides.IsUnknown() {
+		if problems := applyParameterOverrides(decodeNodes(data.Nodes), data.ParameterOverrides); len(problems) > 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("parameter_overrides"), "Invalid Parameter Overrides",
+				fmt.Sprintf("The parameter overrides cannot be applied to the nodes:\n  - %s", strings.Join(problems, "\n  - ")))
+			return
+		}
+	}
+
+	r.validateWorkflowConnections(&data, &resp.Diagnostics)
+}
+
+// validateWorkflowConnections checks the connection graph once both nodes and connections are known
+func (r *WorkflowResource) validateWorkflowConnections(model *WorkflowResourceModel, diags *diag.Diagnostics) {
+	if model.Nodes.IsNull() || model.Nodes.IsUnknown() || model.Connections.IsNull() || model.Connections.IsUnknown() {
+		return
+	}
+
+	var nodes, connections map[string]interface{}
+	if json.Unmarshal([]byte(model.Nodes.ValueString()), &nodes) != nil ||
+		json.Unmarshal([]byte(model.Connections.ValueString()), &connections) != nil {
+		return
+	}
+
+	result := validateWorkflowGraph(nodes, connections)
+
+	if len(result.Errors) > 0 {
+		diags.AddAttributeError(
+			path.Root("connections"),
+			"Invalid Workflow Connections",
+			fmt.Sprintf("The workflow connections do not match the nodes:\n  - %s", strings.Join(result.Errors, "\n  - ")),
+		)
+	}
+
+	for _, warning := range result.Warnings {
+		diags.AddAttributeWarning(path.Root("nodes"), "Unconnected Trigger Node", warning)
+	}
+}
+
+// validateWorkflowJSONSchema reports an attribute error if a known JSON attribute value cannot be parsed
+// or does not match the workflow schema
+func validateWorkflowJSONSchema(fieldName, summary string, value types.String, diags *diag.Diagnostics) {
+	if value.IsNull() || value.IsUnknown() || value.ValueString() == "" {
+		return
+	}
+
+	var decoded interface{}
+	if err := json.Unmarshal([]byte(value.ValueString()), &decoded); err != nil {
+		diags.AddAttributeError(path.Root(fieldName), summary, fmt.Sprintf("Unable to parse %s JSON: %s", fieldName, err))
+		return
+	}
+
+	problems, err := validateWorkflowSchema(fieldName, decoded)
+	if err != nil {
+		diags.AddError("Internal Error", fmt.Sprintf("Unable to validate %s JSON, got error: %s", fieldName, err))
+		return
+	}
+
+	if len(problems) > 0 {
+		diags.AddAttributeError(
+			path.Root(fieldName),
+			summary,
+			fmt.Sprintf("The %s JSON does not match the n8n workflow schema:\n  - %s", fieldName,
+				strings.Join(problems, "\n  - ")),
+		)
+	}
+}
+
+// ModifyPlan warns when an active workflow is about to stop running, and checks at plan time that the
+// referenced error workflow exists and that a pinned workflow has not been edited outside of Terraform
+func (r *WorkflowResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var plan, state WorkflowResourceModel
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		checkWorkflowDeactivation(&plan, &state, &resp.Diagnostics)
+		reportNodeChanges(&plan, &state, &resp.Diagnostics)
+
+		// A blue/green deployment replaces the workflow with a new one
+		if blueGreenDeployment(&plan, &state) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), types.StringUnknown())...)
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("created_at"), types.StringUnknown())...)
+		}
+	}
+
+	planProjectID(ctx, r.client, req, &resp.Plan, &resp.Diagnostics)
+
+	// The remaining checks need the n8n API
+	if r.client == nil || resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan WorkflowResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	// The state is left null on create
+	var state WorkflowResourceModel
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checkWorkflowName(r.client, path.Root("name"), plan.Name, state.Name, &resp.Diagnostics)
+
+	// Credentials are only looked up when the nodes or overrides that refer to them change
+	if !plan.Nodes.Equal(state.Nodes) || !plan.CredentialOverrides.Equal(state.CredentialOverrides) {
+		r.validateCredentialReferences(ctx, &plan, &resp.Diagnostics)
+	}
+
+	// Skip the lookup when the reference has not changed since the last apply
+	if !req.State.Raw.IsNull() {
+		appliedVersion, diags := req.Private.GetKey(ctx, appliedVersionKey)
+		resp.Diagnostics.Append(diags...)
+		checkPinnedVersion(&plan, &state, appliedVersion, &resp.Diagnostics)
+
+		planID, _ := r.errorWorkflowReference(&plan)
+		stateID, _ := r.errorWorkflowReference(&state)
+		if planID == stateID {
+			return
+		}
+	}
+
+	r.validateErrorWorkflow(&plan, &resp.Diagnostics)
+}
+
+// checkWorkflowDeactivation warns, unless allow_deactivation is set, when a plan deactivates or archives an
+// active workflow or removes trigger nodes from it
+func checkWorkflowDeactivation(plan, state *WorkflowResourceModel, diags *diag.Diagnostics) {
+	if plan.AllowDeactivation.ValueBool() || !state.Active.ValueBool() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	if plan.Active.Equal(types.BoolValue(false)) || (plan.Archived.ValueBool() && !state.Archived.ValueBool()) {
+		diags.AddAttributeWarning(
+			path.Root("active"),
+			"Active Workflow Deactivated",
+			fmt.Sprintf("Workflow %q (%s) is active and will be deactivated, so its triggers stop starting executions. "+
+				"Set allow_deactivation to true to acknowledge this.", name, state.ID.ValueString()),
+		)
+		return
+	}
+
+	if plan.Nodes.IsUnknown() {
+		return
+	}
+	planTriggers := workflowTriggerNodes(decodeNodes(plan.Nodes))
+	var removed []string
+	for _, trigger := range workflowTriggerNodes(decodeNodes(state.Nodes)) {
+		if !slices.Contains(planTriggers, trigger) {
+			removed = append(removed, trigger)
+		}
+	}
+
+	if len(removed) > 0 {
+		diags.AddAttributeWarning(
+			path.Root("nodes"),
+			"Trigger Node Removed",
+			fmt.Sprintf("Trigger nodes %s will be removed from active workflow %q (%s), so they stop starting "+
+				"executions. Set allow_deactivation to true to acknowledge this.", strings.Join(removed, ", "), name,
+				state.ID.ValueString()),
+		)
+	}
+}
+
+// workflowTriggerNodes returns the names of the trigger nodes, sorted by name
+func workflowTriggerNodes(nodes map[string]interface{}) []string {
+	var triggers []string
+	for _, name := range sortedKeys(nodes) {
+		node, _ := nodes[name].(map[string]interface{})
+		if nodeType, _ := node["type"].(string); client.IsTriggerNodeType(nodeType) {
+			triggers = append(triggers, name)
+		}
+	}
+	return triggers
+}
+
+// appliedVersionKey is the private state key holding the workflow version last written by Terraform
+const appliedVersionKey = "applied_version_id"
+
+// privateStateSetter is satisfied by the private state of resource responses
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// setAppliedWorkflowVersion records the workflow version written by Terraform in private state
+func setAppliedWorkflowVersion(ctx context.Context, private privateStateSetter, versionID types.String) diag.Diagnostics {
+	value, err := json.Marshal(versionID.ValueString())
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Internal Error", fmt.Sprintf("Unable to encode workflow version, got error: %s", err))
+		return diags
+	}
+	return private.SetKey(ctx, appliedVersionKey, value)
+}
+
+// appliedContentKey is the private state key holding a hash of the workflow content last written by
+// Terraform, for n8n versions that do not report workflow versions
+const appliedContentKey = "applied_content_hash"
+
+// workflowContentHash returns a hash of the name, nodes, connections and settings of workflow as returned
+// by n8n. Objects are encoded as JSON with sorted keys, so equal content has the same hash.
+func workflowContentHash(workflow *client.Workflow) (string, error) {
+	encoded, err := json.Marshal([]interface{}{workflow.Name, workflow.Nodes, workflow.Connections, workflow.Settings})
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(encoded)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// setAppliedWorkflowContent records the hash of the workflow content written by Terraform in private state
+func setAppliedWorkflowContent(ctx context.Context, private privateStateSetter, workflow *client.Workflow) diag.Diagnostics {
+	hash, err := workflowContentHash(workflow)
+	if err == nil {
+		var value []byte
+		if value, err = json.Marshal(hash); err == nil {
+			return private.SetKey(ctx, appliedContentKey, value)
+		}
+	}
+
+	var diags diag.Diagnostics
+	diags.AddError("Internal Error", fmt.Sprintf("Unable to encode workflow content hash, got error: %s", err))
+	return diags
+}
+
+// checkPinnedVersion reports drift when the remote workflow version, as refreshed into state, matches
+// neither the pinned version nor the version Terraform last applied (appliedVersion, JSON-encoded).
+func checkPinnedVersion(plan, state *WorkflowResourceModel, appliedVersion []byte, diags *diag.Diagnostics) {
+	if plan.PinVersion.IsNull() || plan.PinVersion.IsUnknown() || state.VersionID.IsNull() {
+		return
+	}
+
+	remoteVersion := state.VersionID.ValueString()
+	if remoteVersion == plan.PinVersion.ValueString() {
+		return
+	}
+
+	var applied string
+	if len(appliedVersion) > 0 && json.Unmarshal(appliedVersion, &applied) == nil && applied == remoteVersion {
+		return
+	}
+
+	diags.AddAttributeError(
+		path.Root("pin_version_id"),
+		"Workflow Version Drift",
+		fmt.Sprintf("Workflow %s is at version %s in n8n, but version %s is pinned. The workflow was probably "+
+			"edited outside of Terraform. Review the changes, then update pin_version_id to accept them.",
+			state.ID.ValueString(), remoteVersion, plan.PinVersion.ValueString()),
+	)
+}
+
+// checkRemoteChanges reports a conflict when the remote workflow was modified after Terraform last wrote it.
+// Versions are compared against appliedVersion (JSON-encoded), falling back to the version in state for
+// imported workflows. When n8n does not report versions, the content is compared against appliedContent
+// (the JSON-encoded hash), falling back to the update timestamp.
+func checkRemoteChanges(state *WorkflowResourceModel, appliedVersion, appliedContent []byte, remote *client.Workflow,
+	diags *diag.Diagnostics) {
+	expectedVersion := state.VersionID.ValueString()
+	var applied string
+	if len(appliedVersion) > 0 && json.Unmarshal(appliedVersion, &applied) == nil && applied != "" {
+		expectedVersion = applied
+	}
+
+	var appliedHash string
+	if len(appliedContent) > 0 && json.Unmarshal(appliedContent, &appliedHash) != nil {
+		appliedHash = ""
+	}
+
+	var detail string
+	switch {
+	case remote.VersionID != "" && expectedVersion != "":
+		if remote.VersionID == expectedVersion {
+			return
+		}
+		detail = fmt.Sprintf("it is at version %s, but Terraform last wrote version %s", remote.VersionID, expectedVersion)
+	case appliedHash != "":
+		if hash, err := workflowContentHash(remote); err != nil || hash == appliedHash {
+			return
+		}
+		detail = "its name, nodes, connections or settings differ from what Terraform last wrote"
+	case remote.UpdatedAt != nil && !state.UpdatedAt.IsNull() && !state.UpdatedAt.IsUnknown():
+		remoteUpdatedAt := remote.UpdatedAt.Format("2006-01-02T15:04:05Z")
+		if remoteUpdatedAt == state.UpdatedAt.ValueString() {
+			return
+		}
+		detail = fmt.Sprintf("it was updated at %s, but Terraform last saw an update at %s",
+			remoteUpdatedAt, state.UpdatedAt.ValueString())
+	default:
+		return
+	}
+
+	diags.AddError(
+		"Workflow Changed Outside Terraform",
+		fmt.Sprintf("Workflow %s was modified in n8n since Terraform last applied it: %s. Applying would "+
+			"overwrite those changes. Copy the changes into the configuration, or set "+
+			"overwrite_remote_changes = true to discard them.", state.ID.ValueString(), detail),
+	)
+}
+
+// onlyNodesMoved reports whether the workflow in n8n differs from the workflow in state at most in the
+// positions of its nodes
+func (r *WorkflowResource) onlyNodesMoved(state WorkflowResourceModel, remote *client.Workflow) bool {
+	current := state
+	current.IgnoreNodePositions = types.BoolValue(true)
+	r.updateModelFromWorkflow(&current, remote)
+
+	return current.Name.Equal(state.Name) && current.Active.Equal(state.Active) && current.Nodes.Equal(state.Nodes) &&
+		current.Connections.Equal(state.Connections) && current.Settings.Equal(state.Settings)
+}
+
+// UpgradeState migrates workflow state from earlier schema versions
+func (r *WorkflowResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// Version 0 keyed nodes by ID and dropped the ID from the node
+		0: {StateUpgrader: r.upgradeStateV0},
+	}
+}
+
+// upgradeStateV0 keys the nodes of a version 0 state by name. It rewrites the JSON state instead of
+// decoding it with a prior schema, so that states written by any release with version 0 are upgraded,
+// whichever attributes they have. Attributes that no longer exist are dropped and new ones are null.
+func (r *WorkflowResource) upgradeStateV0(ctx context.Context, req resource.UpgradeStateRequest,
+	resp *resource.UpgradeStateResponse) {
+	if req.RawState == nil || len(req.RawState.JSON) == 0 {
+		resp.Diagnostics.AddError("Unable to Upgrade Workflow State", "The prior workflow state is missing or not in JSON format.")
+		return
+	}
+
+	var rawState map[string]interface{}
+	if err := json.Unmarshal(req.RawState.JSON, &rawState); err != nil {
+		resp.Diagnostics.AddError("Unable to Upgrade Workflow State",
+			fmt.Sprintf("Unable to parse the prior workflow state, got error: %s", err))
+		return
+	}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	for name := range rawState {
+		if _, ok := schemaResp.Schema.Attributes[name]; !ok {
+			delete(rawState, name)
+		}
+	}
+
+	if nodes, ok := rawState["nodes"].(string); ok {
+		upgraded, err := upgradeNodesKeyedByID(types.StringValue(nodes))
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("nodes"), "Unable to Upgrade Workflow State",
+				fmt.Sprintf("Unable to key the nodes of workflow %v by name, got error: %s", rawState["id"], err))
+			return
+		}
+		rawState["nodes"] = upgraded.ValueString()
+	}
+
+	upgradedJSON, err := json.Marshal(rawState)
+	if err != nil {
+		resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Unable to encode the upgraded workflow state, got error: %s", err))
+		return
+	}
+	resp.DynamicValue = &tfprotov6.DynamicValue{JSON: upgradedJSON}
+}
+
 func (r *WorkflowResource) ImportState(ctx context.Context, req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
 
 // validateWorkflowJSON validates the JSON structure of workflow fields
@@ -472,9 +1403,14 @@ func (r *WorkflowResource) validateWorkflowJSON(jsonStr string, fieldName string
 		for nodeKey, nodeValue := range result {
 			if nodeMap, ok := nodeValue.(map[string]interface{}); ok {
 				// Check for required node properties
-				if _, hasType := nodeMap["type"]; !hasType {
+				nodeType, hasType := nodeMap["type"]
+				if !hasType {
 					return fmt.Errorf("node %s is missing required 'type' field", nodeKey)
 				}
+				if typeName, _ := nodeType.(string); !validNodeType(typeName) {
+					return fmt.Errorf("node %s has invalid type %v, expected the node package and name, e.g. "+
+						"'n8n-nodes-base.webhook'", nodeKey, nodeType)
+				}
 			} else {
 				return fmt.Errorf("node %s must be an object", nodeKey)
 			}
@@ -507,6 +1443,142 @@ func (r *WorkflowResource) validateWorkflowJSON(jsonStr string, fieldName string
 	return nil
 }
 
+// validNodeType reports whether a node type has the form "<package>.<node>", e.g. "n8n-nodes-base.webhook"
+// or "@n8n/n8n-nodes-langchain.agent"
+func validNodeType(nodeType string) bool {
+	i := strings.LastIndex(nodeType, ".")
+	return i > 0 && i < len(nodeType)-1 && !strings.ContainsAny(nodeType, " \t\n")
+}
+
+// workflowFromModel builds the workflow sent to the API from the model
+func (r *WorkflowResource) workflowFromModel(ctx context.Context, model *WorkflowResourceModel, knownNodes []nodeRef,
+	diags *diag.Diagnostics) (*client.Workflow, bool) {
+	// Create workflow object
+	workflow := &client.Workflow{
+		Name:   model.Name.ValueString(),
+		Active: model.Active.ValueBool(),
+	}
+
+	// Parse and validate JSON fields if provided
+	if !model.Nodes.IsNull() && model.Nodes.ValueString() != "" {
+		if err := r.validateWorkflowJSON(model.Nodes.ValueString(), "nodes"); err != nil {
+			diags.AddAttributeError(
+				path.Root("nodes"),
+				"Invalid Nodes JSON",
+				err.Error(),
+			)
+			return nil, false
+		}
+		var nodes map[string]interface{}
+		if err := json.Unmarshal([]byte(model.Nodes.ValueString()), &nodes); err != nil {
+			diags.AddAttributeError(
+				path.Root("nodes"),
+				"Invalid JSON",
+				fmt.Sprintf("Unable to parse nodes JSON: %s", err),
+			)
+			return nil, false
+		}
+		if problems := applyParameterOverrides(nodes, model.ParameterOverrides); len(problems) > 0 {
+			diags.AddAttributeError(
+				path.Root("parameter_overrides"),
+				"Invalid Parameter Overrides",
+				fmt.Sprintf("The parameter overrides cannot be applied to the nodes:\n  - %s", strings.Join(problems, "\n  - ")),
+			)
+			return nil, false
+		}
+		// Convert nodes from object format to array format for API
+		nodesArray := r.convertNodesToArray(nodes, knownNodes)
+		workflow.Nodes = nodesArray
+	}
+
+	// Connections field is required by n8n API, default to empty object if not provided
+	if !model.Connections.IsNull() && model.Connections.ValueString() != "" {
+		if err := r.validateWorkflowJSON(model.Connections.ValueString(), "connections"); err != nil {
+			diags.AddAttributeError(
+				path.Root("connections"),
+				"Invalid Connections JSON",
+				err.Error(),
+			)
+			return nil, false
+		}
+		var connections map[string]interface{}
+		if err := json.Unmarshal([]byte(model.Connections.ValueString()), &connections); err != nil {
+			diags.AddAttributeError(
+				path.Root("connections"),
+				"Invalid JSON",
+				fmt.Sprintf("Unable to parse connections JSON: %s", err),
+			)
+			return nil, false
+		}
+		workflow.Connections = connections
+	} else {
+		// Set empty connections object if not provided (required by n8n API)
+		workflow.Connections = make(map[string]interface{})
+	}
+
+	// Settings field is required by n8n API, default to basic settings if not provided
+	var settings map[string]interface{}
+	if !model.Settings.IsNull() && model.Settings.ValueString() != "" {
+		if err := json.Unmarshal([]byte(model.Settings.ValueString()), &settings); err != nil {
+			diags.AddAttributeError(
+				path.Root("settings"),
+				"Invalid JSON",
+				fmt.Sprintf("Unable to parse settings JSON: %s", err),
+			)
+			return nil, false
+		}
+	}
+	if settings == nil {
+		// Set basic settings if not provided or JSON null (required by n8n API)
+		settings = map[string]interface{}{
+			"executionOrder": "v1",
+		}
+	}
+	workflow.Settings = settings
+
+	// Typed settings attributes take precedence over the raw settings JSON
+	r.applySettingsAttributes(model, workflow.Settings)
+
+	if model.ManageStaticData.ValueBool() && !model.StaticData.IsNull() && model.StaticData.ValueString() != "" {
+		var staticData map[string]interface{}
+		if err := json.Unmarshal([]byte(model.StaticData.ValueString()), &staticData); err != nil {
+			diags.AddAttributeError(
+				path.Root("static_data"),
+				"Invalid JSON",
+				fmt.Sprintf("Unable to parse static_data JSON: %s", err),
+			)
+			return nil, false
+		}
+		workflow.StaticData = staticData
+	}
+
+	if model.ManagePinnedData.ValueBool() && !model.PinnedData.IsNull() && model.PinnedData.ValueString() != "" {
+		var pinnedData map[string]interface{}
+		if err := json.Unmarshal([]byte(model.PinnedData.ValueString()), &pinnedData); err != nil {
+			diags.AddAttributeError(
+				path.Root("pinned_data"),
+				"Invalid JSON",
+				fmt.Sprintf("Unable to parse pinned_data JSON: %s", err),
+			)
+			return nil, false
+		}
+		workflow.PinnedData = pinnedData
+	}
+
+	// Tags are not part of the workflow that is sent; applyTags sets them with the tags endpoint
+	return workflow, true
+}
+
+// moveToFolder moves a workflow to a folder and reports whether this succeeded
+func (r *WorkflowResource) moveToFolder(id, folderID string, diags *diag.Diagnostics) bool {
+	if err := r.client.MoveWorkflowToFolder(id, folderID); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to move workflow to folder %s, got error: %s", folderID, err))
+		return false
+	}
+
+	return true
+}
+
 // Helper function to update model from API response
 func (r *WorkflowResource) updateModelFromWorkflow(model *WorkflowResourceModel, workflow *client.Workflow) {
 	model.ID = types.StringValue(workflow.ID)
@@ -516,20 +1588,27 @@ func (r *WorkflowResource) updateModelFromWorkflow(model *WorkflowResourceModel,
 	// Convert JSON fields to strings
 	if workflow.Nodes != nil {
 		// Convert nodes from API array format to Terraform object format
-		nodesObject := r.convertNodesFromArray(workflow.Nodes)
-		if nodesJSON, err := json.Marshal(nodesObject); err == nil {
-			model.Nodes = types.StringValue(string(nodesJSON))
+		priorNodes := decodeNodes(model.Nodes)
+		nodesObject := r.convertNodesFromArray(workflow.Nodes, priorNodes)
+		restoreOverriddenCredentials(nodesObject, priorNodes, model.CredentialOverrides)
+		restoreOverriddenParameters(nodesObject, priorNodes, model.ParameterOverrides)
+		if model.IgnoreNodePositions.ValueBool() {
+			restoreNodePositions(nodesObject, priorNodes)
+		}
+		if nodes, err := workflowJSONValue(model.Nodes, nodesObject); err == nil {
+			model.Nodes = nodes
 		}
 	}
 
 	if workflow.Connections != nil {
-		if connectionsJSON, err := json.Marshal(workflow.Connections); err == nil {
-			model.Connections = types.StringValue(string(connectionsJSON))
+		if connections, err := workflowJSONValue(model.Connections, workflow.Connections); err == nil {
+			model.Connections = connections
 		}
 	}
 
 	if workflow.Settings != nil {
-		if settingsJSON, err := json.Marshal(workflow.Settings); err == nil {
+		settings := r.extractSettingsAttributes(model, workflow.Settings)
+		if settingsJSON, err := json.Marshal(settings); err == nil {
 			model.Settings = types.StringValue(string(settingsJSON))
 		}
 	}
@@ -542,7 +1621,8 @@ func (r *WorkflowResource) updateModelFromWorkflow(model *WorkflowResourceModel,
 		model.StaticData = types.StringNull()
 	}
 
-	if workflow.PinnedData != nil {
+	// Pinned data is only read when it is managed, so that test payloads pinned in the editor are no change
+	if workflow.PinnedData != nil && model.ManagePinnedData.ValueBool() {
 		if pinnedDataJSON, err := json.Marshal(workflow.PinnedData); err == nil {
 			model.PinnedData = types.StringValue(string(pinnedDataJSON))
 		}
@@ -550,19 +1630,29 @@ func (r *WorkflowResource) updateModelFromWorkflow(model *WorkflowResourceModel,
 		model.PinnedData = types.StringNull()
 	}
 
-	// Handle tags
 	if workflow.Tags != nil {
-		tagValues := make([]attr.Value, len(workflow.Tags))
-		for i, tag := range workflow.Tags {
-			tagValues[i] = types.StringValue(tag)
-		}
-		model.Tags = types.ListValueMust(types.StringType, tagValues)
+		model.Tags = tagNamesValue(model.Tags, workflow.Tags)
 	}
 
 	if workflow.VersionID != "" {
 		model.VersionID = types.StringValue(workflow.VersionID)
 	}
 
+	model.TriggerCount = types.Int64Value(int64(workflow.TriggerCount))
+	model.Archived = types.BoolValue(workflow.IsArchived)
+
+	// Only the internal API reports the folder of a workflow
+	if workflow.ParentFolder != nil {
+		model.FolderID = types.StringValue(workflow.ParentFolder.ID)
+	}
+
+	model.Meta = types.StringNull()
+	if workflow.Meta != nil {
+		if metaJSON, err := json.Marshal(workflow.Meta); err == nil {
+			model.Meta = types.StringValue(string(metaJSON))
+		}
+	}
+
 	if workflow.CreatedAt != nil {
 		model.CreatedAt = types.StringValue(workflow.CreatedAt.Format("2006-01-02T15:04:05Z"))
 	}
@@ -570,43 +1660,245 @@ func (r *WorkflowResource) updateModelFromWorkflow(model *WorkflowResourceModel,
 	if workflow.UpdatedAt != nil {
 		model.UpdatedAt = types.StringValue(workflow.UpdatedAt.Format("2006-01-02T15:04:05Z"))
 	}
+
+	model.WebhookURLs = r.webhookURLs(workflow)
+	model.CredentialIDs = workflowCredentialIDs(workflow.Nodes)
 }
 
-// convertNodesToArray converts nodes from Terraform's object format to n8n API's array format
-func (r *WorkflowResource) convertNodesToArray(nodes map[string]interface{}) []interface{} {
-	var nodesArray []interface{}
+// webhookURLs builds the webhook_urls map from the workflow's trigger nodes
+func (r *WorkflowResource) webhookURLs(workflow *client.Workflow) types.Map {
+	objectType := types.ObjectType{AttrTypes: workflowWebhookURLAttrTypes()}
+	urls := map[string]attr.Value{}
+
+	if r.client != nil {
+		for _, webhook := range r.client.WorkflowWebhooks(workflow) {
+			urls[webhook.NodeName] = types.ObjectValueMust(workflowWebhookURLAttrTypes(), map[string]attr.Value{
+				"production_url": types.StringValue(webhook.ProductionURL),
+				"test_url":       types.StringValue(webhook.TestURL),
+			})
+		}
+	}
+
+	return types.MapValueMust(objectType, urls)
+}
 
-	for nodeId, nodeData := range nodes {
-		if nodeMap, ok := nodeData.(map[string]interface{}); ok {
-			// Add the node ID to the node data
-			nodeMap["id"] = nodeId
-			nodesArray = append(nodesArray, nodeMap)
+// errorWorkflowReference returns the error workflow ID configured through error_workflow_id or the
+// raw settings JSON, along with the attribute it came from. An empty ID means there is nothing to check yet.
+func (r *WorkflowResource) errorWorkflowReference(model *WorkflowResourceModel) (string, path.Path) {
+	if !model.ErrorWorkflowID.IsNull() {
+		if model.ErrorWorkflowID.IsUnknown() {
+			return "", path.Root("error_workflow_id")
 		}
+		return model.ErrorWorkflowID.ValueString(), path.Root("error_workflow_id")
 	}
 
-	return nodesArray
+	if model.Settings.IsNull() || model.Settings.IsUnknown() || model.Settings.ValueString() == "" {
+		return "", path.Root("settings")
+	}
+
+	var settings map[string]interface{}
+	if err := json.Unmarshal([]byte(model.Settings.ValueString()), &settings); err != nil {
+		return "", path.Root("settings")
+	}
+
+	errorWorkflowID, _ := settings["errorWorkflow"].(string)
+	return errorWorkflowID, path.Root("settings")
 }
 
-// convertNodesFromArray converts nodes from n8n API's array format to Terraform's object format
-func (r *WorkflowResource) convertNodesFromArray(nodesArray []interface{}) map[string]interface{} {
-	nodesObject := make(map[string]interface{})
+// validateErrorWorkflow ensures the workflow referenced as the error workflow exists in n8n
+func (r *WorkflowResource) validateErrorWorkflow(model *WorkflowResourceModel, diags *diag.Diagnostics) {
+	errorWorkflowID, attrPath := r.errorWorkflowReference(model)
 
-	for _, nodeData := range nodesArray {
-		if nodeMap, ok := nodeData.(map[string]interface{}); ok {
-			if nodeId, exists := nodeMap["id"]; exists {
-				if nodeIdStr, ok := nodeId.(string); ok {
-					// Remove the id field from the node data since it becomes the key
-					nodeCopy := make(map[string]interface{})
-					for k, v := range nodeMap {
-						if k != "id" {
-							nodeCopy[k] = v
-						}
-					}
-					nodesObject[nodeIdStr] = nodeCopy
-				}
-			}
+	// A workflow may use itself as its error workflow
+	if errorWorkflowID == "" || errorWorkflowID == model.ID.ValueString() {
+		return
+	}
+
+	if _, err := r.client.GetWorkflow(errorWorkflowID); err != nil {
+		if client.IsNotFound(err) {
+			diags.AddAttributeError(
+				attrPath,
+				"Invalid Error Workflow",
+				fmt.Sprintf("The error workflow %q does not exist in n8n.", errorWorkflowID),
+			)
+			return
+		}
+		diags.AddError("Client Error", fmt.Sprintf("Unable to verify error workflow, got error: %s", err))
+	}
+}
+
+// applySettingsAttributes merges the typed settings attributes into the workflow settings map
+func (r *WorkflowResource) applySettingsAttributes(model *WorkflowResourceModel, settings map[string]interface{}) {
+	if !model.ErrorWorkflowID.IsNull() {
+		settings["errorWorkflow"] = model.ErrorWorkflowID.ValueString()
+	}
+	if !model.Timezone.IsNull() {
+		settings["timezone"] = model.Timezone.ValueString()
+	}
+	if !model.ExecutionTimeout.IsNull() {
+		settings["executionTimeout"] = model.ExecutionTimeout.ValueInt64()
+	}
+	if !model.SaveExecutionProgress.IsNull() {
+		settings["saveExecutionProgress"] = model.SaveExecutionProgress.ValueBool()
+	}
+	if !model.SaveManualExecutions.IsNull() {
+		settings["saveManualExecutions"] = model.SaveManualExecutions.ValueBool()
+	}
+	if !model.CallerPolicy.IsNull() {
+		settings["callerPolicy"] = model.CallerPolicy.ValueString()
+	}
+}
+
+// validateSettingsConflicts reports typed settings attributes whose key is set in the settings JSON as well,
+// since the typed attribute would silently override it
+func validateSettingsConflicts(model *WorkflowResourceModel, diags *diag.Diagnostics) {
+	if model.Settings.IsNull() || model.Settings.IsUnknown() {
+		return
+	}
+
+	var settings map[string]interface{}
+	if err := json.Unmarshal([]byte(model.Settings.ValueString()), &settings); err != nil {
+		return
+	}
+
+	for _, typed := range []struct {
+		attribute, key string
+		value          attr.Value
+	}{
+		{"error_workflow_id", "errorWorkflow", model.ErrorWorkflowID},
+		{"timezone", "timezone", model.Timezone},
+		{"execution_timeout", "executionTimeout", model.ExecutionTimeout},
+		{"save_execution_progress", "saveExecutionProgress", model.SaveExecutionProgress},
+		{"save_manual_executions", "saveManualExecutions", model.SaveManualExecutions},
+		{"caller_policy", "callerPolicy", model.CallerPolicy},
+	} {
+		if typed.value.IsNull() {
+			continue
+		}
+		if _, ok := settings[typed.key]; ok {
+			diags.AddAttributeError(path.Root(typed.attribute), "Conflicting Attributes",
+				fmt.Sprintf("%s and the %q key of settings are both set, and %s would override the key. Remove one of them.",
+					typed.attribute, typed.key, typed.attribute))
+		}
+	}
+}
+
+// settingsPlanModifier plans the settings attribute, when it is not configured, with its value in state.
+// The settings JSON leaves out the keys of the typed settings attributes that are set, so it only changes
+// on apply when one of those attributes is added or removed.
+type settingsPlanModifier struct{}
+
+// settingsUseStateForUnknown returns a plan modifier which keeps unconfigured settings known
+func settingsUseStateForUnknown() planmodifier.String {
+	return settingsPlanModifier{}
+}
+
+func (m settingsPlanModifier) Description(ctx context.Context) string {
+	return "Once set, the value of this attribute in state will not change unless a typed settings attribute " +
+		"is added or removed."
+}
+
+func (m settingsPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m settingsPlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest,
+	resp *planmodifier.StringResponse) {
+	// Nothing to keep on create, or when the settings are configured or the plan is already known
+	if req.StateValue.IsNull() || !req.PlanValue.IsUnknown() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var plan, state WorkflowResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() || settingsAttributesChanged(&plan, &state) {
+		return
+	}
+
+	resp.PlanValue = req.StateValue
+}
+
+// settingsAttributesChanged reports whether a typed settings attribute is set in one model but not in the
+// other, which moves its key into or out of the settings JSON
+func settingsAttributesChanged(plan, state *WorkflowResourceModel) bool {
+	pairs := [][2]attr.Value{
+		{plan.ErrorWorkflowID, state.ErrorWorkflowID},
+		{plan.Timezone, state.Timezone},
+		{plan.ExecutionTimeout, state.ExecutionTimeout},
+		{plan.SaveExecutionProgress, state.SaveExecutionProgress},
+		{plan.SaveManualExecutions, state.SaveManualExecutions},
+		{plan.CallerPolicy, state.CallerPolicy},
+	}
+
+	for _, pair := range pairs {
+		if pair[0].IsNull() != pair[1].IsNull() {
+			return true
+		}
+	}
+	return false
+}
+
+// extractSettingsAttributes refreshes the typed settings attributes that are managed in the model
+// and returns a copy of the settings without the keys owned by those attributes
+func (r *WorkflowResource) extractSettingsAttributes(model *WorkflowResourceModel,
+	settings map[string]interface{}) map[string]interface{} {
+	remaining := make(map[string]interface{}, len(settings))
+	for k, v := range settings {
+		remaining[k] = v
+	}
+
+	if !model.ErrorWorkflowID.IsNull() {
+		model.ErrorWorkflowID = types.StringNull()
+		if v, ok := remaining["errorWorkflow"].(string); ok {
+			model.ErrorWorkflowID = types.StringValue(v)
+		}
+		delete(remaining, "errorWorkflow")
+	}
+
+	if !model.Timezone.IsNull() {
+		model.Timezone = types.StringNull()
+		if v, ok := remaining["timezone"].(string); ok {
+			model.Timezone = types.StringValue(v)
+		}
+		delete(remaining, "timezone")
+	}
+
+	if !model.ExecutionTimeout.IsNull() {
+		model.ExecutionTimeout = types.Int64Null()
+		switch v := remaining["executionTimeout"].(type) {
+		case float64:
+			model.ExecutionTimeout = types.Int64Value(int64(v))
+		case int64:
+			model.ExecutionTimeout = types.Int64Value(v)
+		}
+		delete(remaining, "executionTimeout")
+	}
+
+	if !model.SaveExecutionProgress.IsNull() {
+		model.SaveExecutionProgress = types.BoolNull()
+		if v, ok := remaining["saveExecutionProgress"].(bool); ok {
+			model.SaveExecutionProgress = types.BoolValue(v)
+		}
+		delete(remaining, "saveExecutionProgress")
+	}
+
+	if !model.SaveManualExecutions.IsNull() {
+		model.SaveManualExecutions = types.BoolNull()
+		if v, ok := remaining["saveManualExecutions"].(bool); ok {
+			model.SaveManualExecutions = types.BoolValue(v)
+		}
+		delete(remaining, "saveManualExecutions")
+	}
+
+	if !model.CallerPolicy.IsNull() {
+		model.CallerPolicy = types.StringNull()
+		if v, ok := remaining["callerPolicy"].(string); ok {
+			model.CallerPolicy = types.StringValue(v)
 		}
+		delete(remaining, "callerPolicy")
 	}
 
-	return nodesObject
+	return remaining
 }
@@ -1,14 +1,26 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"regexp"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
 )
 
 func TestAccWorkflowResource(t *testing.T) {
@@ -46,6 +58,27 @@ func TestAccWorkflowResource(t *testing.T) {
 	})
 }
 
+func TestAccWorkflowResourceImportByIdentity(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkflowResourceConfig("test-workflow-identity"),
+			},
+			// Import with an import block that addresses the workflow by identity
+			{
+				ResourceName:    "n8n_workflow.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
 func TestAccWorkflowResourceWithNodes(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -113,6 +146,691 @@ func TestAccWorkflowResourceInvalidJSON(t *testing.T) {
 	})
 }
 
+func TestAccWorkflowResourceSettingsAttributes(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with typed settings
+			{
+				Config: testAccWorkflowResourceConfigWithSettingsAttributes("test-workflow-settings", "Europe/Berlin", 300),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("n8n_workflow.test", "timezone", "Europe/Berlin"),
+					resource.TestCheckResourceAttr("n8n_workflow.test", "execution_timeout", "300"),
+					resource.TestCheckResourceAttr("n8n_workflow.test", "save_manual_executions", "true"),
+					resource.TestCheckResourceAttr("n8n_workflow.test", "caller_policy", "workflowsFromSameOwner"),
+				),
+			},
+			// Update typed settings
+			{
+				Config: testAccWorkflowResourceConfigWithSettingsAttributes("test-workflow-settings", "UTC", -1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("n8n_workflow.test", "timezone", "UTC"),
+					resource.TestCheckResourceAttr("n8n_workflow.test", "execution_timeout", "-1"),
+				),
+			},
+			// Test invalid time zone
+			{
+				Config:      testAccWorkflowResourceConfigWithSettingsAttributes("test-workflow-settings", "Mars/Olympus", 300),
+				ExpectError: regexp.MustCompile("Invalid Time Zone"),
+			},
+		},
+	})
+}
+
+func TestWorkflowResource_SettingsAttributes(t *testing.T) {
+	r := &WorkflowResource{}
+
+	model := &WorkflowResourceModel{
+		ErrorWorkflowID:       types.StringValue("error-wf"),
+		Timezone:              types.StringValue("Europe/Berlin"),
+		ExecutionTimeout:      types.Int64Value(300),
+		SaveExecutionProgress: types.BoolNull(),
+		SaveManualExecutions:  types.BoolValue(true),
+		CallerPolicy:          types.StringNull(),
+	}
+
+	settings := map[string]interface{}{
+		"executionOrder": "v1",
+		"timezone":       "UTC",
+	}
+
+	r.applySettingsAttributes(model, settings)
+
+	if settings["timezone"] != "Europe/Berlin" {
+		t.Errorf("Expected typed timezone to take precedence, got %v", settings["timezone"])
+	}
+	if settings["errorWorkflow"] != "error-wf" {
+		t.Errorf("Expected errorWorkflow 'error-wf', got %v", settings["errorWorkflow"])
+	}
+	if settings["executionTimeout"] != int64(300) {
+		t.Errorf("Expected executionTimeout 300, got %v", settings["executionTimeout"])
+	}
+	if _, ok := settings["callerPolicy"]; ok {
+		t.Error("Expected null caller_policy not to be sent")
+	}
+
+	// Simulate the API response, where numbers are decoded as float64
+	apiSettings := map[string]interface{}{
+		"executionOrder":   "v1",
+		"errorWorkflow":    "error-wf",
+		"timezone":         "Europe/Berlin",
+		"executionTimeout": float64(600),
+		"callerPolicy":     "any",
+	}
+
+	remaining := r.extractSettingsAttributes(model, apiSettings)
+
+	if model.ExecutionTimeout.ValueInt64() != 600 {
+		t.Errorf("Expected execution_timeout to be refreshed to 600, got %d", model.ExecutionTimeout.ValueInt64())
+	}
+	if !model.SaveManualExecutions.IsNull() {
+		t.Error("Expected save_manual_executions missing from the API to become null")
+	}
+	if !model.CallerPolicy.IsNull() {
+		t.Error("Expected unmanaged caller_policy to stay null")
+	}
+	if _, ok := remaining["timezone"]; ok {
+		t.Error("Expected managed timezone key to be removed from settings")
+	}
+	if remaining["callerPolicy"] != "any" || remaining["executionOrder"] != "v1" {
+		t.Errorf("Expected unmanaged keys to be preserved, got %v", remaining)
+	}
+	if _, ok := apiSettings["timezone"]; !ok {
+		t.Error("Expected the original settings map to be left untouched")
+	}
+}
+
+func TestWorkflowResource_NullSettings(t *testing.T) {
+	r := &WorkflowResource{}
+
+	model := &WorkflowResourceModel{
+		Name:                types.StringValue("Orders"),
+		Nodes:               types.StringValue(`{"Webhook": {"type": "n8n-nodes-base.webhook"}}`),
+		CredentialOverrides: types.MapNull(types.StringType),
+		ParameterOverrides:  types.MapNull(types.StringType),
+		Tags:                types.ListNull(types.StringType),
+		Settings:            types.StringValue("null"),
+		Timezone:            types.StringValue("Europe/Berlin"),
+	}
+
+	var diags diag.Diagnostics
+	workflow, ok := r.workflowFromModel(context.Background(), model, nil, &diags)
+	if !ok {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+	if workflow.Settings["timezone"] != "Europe/Berlin" || workflow.Settings["executionOrder"] != "v1" {
+		t.Errorf("Expected JSON null settings to be treated as unset, got %v", workflow.Settings)
+	}
+}
+
+func TestWorkflowResource_ValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	r := &WorkflowResource{}
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	tests := []struct {
+		name        string
+		nodes       types.String
+		connections types.String
+		overrides   map[string]string
+		staticData  types.String
+		pinnedData  types.String
+		managePins  bool
+		settings    types.String
+		timezone    types.String
+		wantError   string
+	}{
+		{
+			name:        "valid workflow",
+			nodes:       types.StringValue(`{"Webhook": {"type": "n8n-nodes-base.webhook"}, "Set": {"type": "n8n-nodes-base.set"}}`),
+			connections: types.StringValue(`{"Webhook": {"main": [[{"node": "Set", "type": "main", "index": 0}]]}}`),
+		},
+		{
+			name:      "invalid node type",
+			nodes:     types.StringValue(`{"Webhook": {"type": "webhook"}}`),
+			wantError: "node Webhook has invalid type webhook",
+		},
+		{
+			name:        "connection to a missing node",
+			nodes:       types.StringValue(`{"Webhook": {"type": "n8n-nodes-base.webhook"}}`),
+			connections: types.StringValue(`{"Webhook": {"main": [[{"node": "Slack", "type": "main", "index": 0}]]}}`),
+			wantError:   "Slack",
+		},
+		{
+			name:      "credential override for a missing node",
+			nodes:     types.StringValue(`{"Webhook": {"type": "n8n-nodes-base.webhook"}}`),
+			overrides: map[string]string{"Slack": "prod-slack"},
+			wantError: "Slack is not a node of the workflow",
+		},
+		{
+			name:       "pinned data without manage_pinned_data",
+			nodes:      types.StringValue(`{"Webhook": {"type": "n8n-nodes-base.webhook"}}`),
+			pinnedData: types.StringValue(`{"Webhook": [{"json": {"id": 1}}]}`),
+			wantError:  "manage_pinned_data is true",
+		},
+		{
+			name:       "static data without manage_static_data",
+			nodes:      types.StringValue(`{"Webhook": {"type": "n8n-nodes-base.webhook"}}`),
+			staticData: types.StringValue(`{"node:Schedule": {"recurrenceRules": []}}`),
+			wantError:  "manage_static_data is true",
+		},
+		{
+			name:       "managed pinned data",
+			nodes:      types.StringValue(`{"Webhook": {"type": "n8n-nodes-base.webhook"}}`),
+			pinnedData: types.StringValue(`{"Webhook": [{"json": {"id": 1}}]}`),
+			managePins: true,
+		},
+		{
+			name:      "settings key set by a typed attribute",
+			nodes:     types.StringValue(`{"Webhook": {"type": "n8n-nodes-base.webhook"}}`),
+			settings:  types.StringValue(`{"executionOrder": "v1", "timezone": "UTC"}`),
+			timezone:  types.StringValue("Europe/Berlin"),
+			wantError: `timezone and the "timezone" key of settings are both set`,
+		},
+		{
+			name:     "settings and other typed attributes",
+			nodes:    types.StringValue(`{"Webhook": {"type": "n8n-nodes-base.webhook"}}`),
+			settings: types.StringValue(`{"executionOrder": "v1"}`),
+			timezone: types.StringValue("Europe/Berlin"),
+		},
+		{
+			name:  "unknown nodes",
+			nodes: types.StringUnknown(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			overrides := types.MapNull(types.StringType)
+			if tt.overrides != nil {
+				overrides, _ = types.MapValueFrom(ctx, types.StringType, tt.overrides)
+			}
+
+			model := WorkflowResourceModel{
+				Name:                types.StringValue("Orders"),
+				Nodes:               tt.nodes,
+				CredentialOverrides: overrides,
+				CredentialIDs:       types.SetNull(types.StringType),
+				ParameterOverrides:  types.MapNull(types.StringType),
+				Connections:         tt.connections,
+				StaticData:          tt.staticData,
+				PinnedData:          tt.pinnedData,
+				ManagePinnedData:    types.BoolValue(tt.managePins),
+				Settings:            tt.settings,
+				Timezone:            tt.timezone,
+				Tags:                types.ListNull(types.StringType),
+				WebhookURLs:         types.MapNull(types.ObjectType{AttrTypes: workflowWebhookURLAttrTypes()}),
+				PostUpdateCheck:     types.ObjectNull(workflowPostUpdateCheckAttrTypes()),
+			}
+
+			state := tfsdk.State{Schema: schemaResp.Schema}
+			if diags := state.Set(ctx, &model); diags.HasError() {
+				t.Fatalf("Unexpected diagnostics: %v", diags)
+			}
+
+			req := fwresource.ValidateConfigRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: state.Raw}}
+			resp := &fwresource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, req, resp)
+
+			if tt.wantError == "" {
+				if resp.Diagnostics.HasError() {
+					t.Errorf("Unexpected diagnostics: %v", resp.Diagnostics)
+				}
+				return
+			}
+			if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), tt.wantError) {
+				t.Errorf("Expected an error containing %q, got %v", tt.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestCheckWorkflowDeactivation(t *testing.T) {
+	nodes := `{"Webhook": {"type": "n8n-nodes-base.webhook"}, "Schedule": {"type": "n8n-nodes-base.scheduleTrigger"}, ` +
+		`"Set": {"type": "n8n-nodes-base.set"}}`
+
+	tests := []struct {
+		name        string
+		stateActive bool
+		planActive  types.Bool
+		archived    bool
+		planNodes   types.String
+		allow       bool
+		wantWarning string
+	}{
+		{name: "unchanged", stateActive: true, planActive: types.BoolValue(true), planNodes: types.StringValue(nodes)},
+		{name: "deactivated", stateActive: true, planActive: types.BoolValue(false), planNodes: types.StringValue(nodes),
+			wantWarning: "Active Workflow Deactivated"},
+		{name: "archived", stateActive: true, planActive: types.BoolValue(true), archived: true,
+			planNodes: types.StringValue(nodes), wantWarning: "Active Workflow Deactivated"},
+		{name: "deactivation allowed", stateActive: true, planActive: types.BoolValue(false),
+			planNodes: types.StringValue(nodes), allow: true},
+		{name: "inactive workflow", stateActive: false, planActive: types.BoolValue(false),
+			planNodes: types.StringValue(`{}`)},
+		{name: "trigger removed", stateActive: true, planActive: types.BoolValue(true),
+			planNodes:   types.StringValue(`{"Webhook": {"type": "n8n-nodes-base.webhook"}}`),
+			wantWarning: "Trigger Node Removed"},
+		{name: "regular node removed", stateActive: true, planActive: types.BoolValue(true),
+			planNodes: types.StringValue(`{"Webhook": {"type": "n8n-nodes-base.webhook"}, ` +
+				`"Schedule": {"type": "n8n-nodes-base.scheduleTrigger"}}`)},
+		{name: "unknown nodes", stateActive: true, planActive: types.BoolValue(true), planNodes: types.StringUnknown()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := &WorkflowResourceModel{
+				ID:       types.StringValue("wf-1"),
+				Name:     types.StringValue("Orders"),
+				Active:   types.BoolValue(tt.stateActive),
+				Archived: types.BoolValue(false),
+				Nodes:    types.StringValue(nodes),
+			}
+			plan := &WorkflowResourceModel{
+				Active:            tt.planActive,
+				Archived:          types.BoolValue(tt.archived),
+				Nodes:             tt.planNodes,
+				AllowDeactivation: types.BoolValue(tt.allow),
+			}
+
+			var diags diag.Diagnostics
+			checkWorkflowDeactivation(plan, state, &diags)
+
+			if tt.wantWarning == "" {
+				if len(diags) > 0 {
+					t.Errorf("Expected no diagnostics, got %v", diags)
+				}
+				return
+			}
+			if len(diags) != 1 || diags.WarningsCount() != 1 || diags[0].Summary() != tt.wantWarning {
+				t.Errorf("Expected a %q warning, got %v", tt.wantWarning, diags)
+			}
+		})
+	}
+}
+
+func TestWorkflowResource_SettingsPlanModifier(t *testing.T) {
+	ctx := context.Background()
+
+	var schemaResp fwresource.SchemaResponse
+	(&WorkflowResource{}).Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	stateModel := WorkflowResourceModel{
+		ID:                  types.StringValue("wf-1"),
+		Settings:            types.StringValue(`{"executionOrder":"v1"}`),
+		Timezone:            types.StringValue("Europe/Berlin"),
+		Tags:                types.ListNull(types.StringType),
+		CredentialOverrides: types.MapNull(types.StringType),
+		CredentialIDs:       types.SetNull(types.StringType),
+		ParameterOverrides:  types.MapNull(types.StringType),
+		WebhookURLs: types.MapNull(types.ObjectType{
+			AttrTypes: workflowWebhookURLAttrTypes(),
+		}),
+		PostUpdateCheck: types.ObjectNull(workflowPostUpdateCheckAttrTypes()),
+	}
+
+	tests := []struct {
+		name     string
+		timezone types.String
+		caller   types.String
+		expected types.String
+	}{
+		{"unchanged", types.StringValue("Europe/Berlin"), types.StringNull(), stateModel.Settings},
+		{"typed attribute changed", types.StringValue("UTC"), types.StringNull(), stateModel.Settings},
+		{"typed attribute removed", types.StringNull(), types.StringNull(), types.StringUnknown()},
+		{"typed attribute added", types.StringValue("Europe/Berlin"), types.StringValue("any"), types.StringUnknown()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			planModel := stateModel
+			planModel.Settings = types.StringUnknown()
+			planModel.Timezone = tt.timezone
+			planModel.CallerPolicy = tt.caller
+
+			state := tfsdk.State{Schema: schemaResp.Schema}
+			plan := tfsdk.Plan{Schema: schemaResp.Schema}
+			if diags := state.Set(ctx, &stateModel); diags.HasError() {
+				t.Fatalf("Unexpected diagnostics: %v", diags)
+			}
+			if diags := plan.Set(ctx, &planModel); diags.HasError() {
+				t.Fatalf("Unexpected diagnostics: %v", diags)
+			}
+
+			req := planmodifier.StringRequest{
+				ConfigValue: types.StringNull(),
+				PlanValue:   planModel.Settings,
+				StateValue:  stateModel.Settings,
+				Plan:        plan,
+				State:       state,
+			}
+			resp := &planmodifier.StringResponse{PlanValue: req.PlanValue}
+			settingsUseStateForUnknown().PlanModifyString(ctx, req, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if !resp.PlanValue.Equal(tt.expected) {
+				t.Errorf("Expected planned settings %s, got %s", tt.expected, resp.PlanValue)
+			}
+		})
+	}
+}
+
+func TestAccWorkflowResourceErrorWorkflow(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Reference another workflow as the error workflow
+			{
+				Config: testAccWorkflowResourceConfigWithErrorWorkflow("test-workflow-error-handler"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"n8n_workflow.test", "error_workflow_id",
+						"n8n_workflow.error_handler", "id",
+					),
+				),
+			},
+			// Test missing error workflow
+			{
+				Config:      testAccWorkflowResourceConfigWithMissingErrorWorkflow("test-workflow-error-missing"),
+				ExpectError: regexp.MustCompile("Invalid Error Workflow"),
+			},
+		},
+	})
+}
+
+func TestWorkflowResource_ErrorWorkflowReference(t *testing.T) {
+	r := &WorkflowResource{}
+
+	tests := []struct {
+		name     string
+		model    WorkflowResourceModel
+		expected string
+	}{
+		{
+			name: "from error_workflow_id",
+			model: WorkflowResourceModel{
+				ErrorWorkflowID: types.StringValue("wf-1"),
+				Settings:        types.StringValue(`{"errorWorkflow":"wf-2"}`),
+			},
+			expected: "wf-1",
+		},
+		{
+			name: "from settings JSON",
+			model: WorkflowResourceModel{
+				ErrorWorkflowID: types.StringNull(),
+				Settings:        types.StringValue(`{"errorWorkflow":"wf-2"}`),
+			},
+			expected: "wf-2",
+		},
+		{
+			name: "unknown reference",
+			model: WorkflowResourceModel{
+				ErrorWorkflowID: types.StringUnknown(),
+				Settings:        types.StringUnknown(),
+			},
+			expected: "",
+		},
+		{
+			name: "no reference",
+			model: WorkflowResourceModel{
+				ErrorWorkflowID: types.StringNull(),
+				Settings:        types.StringValue(`{"executionOrder":"v1"}`),
+			},
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _ := r.errorWorkflowReference(&tt.model)
+			if got != tt.expected {
+				t.Errorf("Expected error workflow %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestWorkflowResource_ValidateErrorWorkflow(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/api/v1/workflows/existing" {
+			_, _ = w.Write([]byte(`{"id": "existing", "name": "Error handler"}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"code": 404, "message": "Not Found"}`))
+	}))
+	defer server.Close()
+
+	n8nClient, err := client.NewClient(&client.Config{
+		BaseURL: server.URL,
+		Auth:    &client.APIKeyAuth{APIKey: "test-key"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	r := &WorkflowResource{client: n8nClient}
+
+	tests := []struct {
+		name      string
+		model     WorkflowResourceModel
+		wantError bool
+	}{
+		{
+			name:      "existing workflow",
+			model:     WorkflowResourceModel{ErrorWorkflowID: types.StringValue("existing")},
+			wantError: false,
+		},
+		{
+			name:      "missing workflow",
+			model:     WorkflowResourceModel{ErrorWorkflowID: types.StringValue("missing")},
+			wantError: true,
+		},
+		{
+			name: "self reference",
+			model: WorkflowResourceModel{
+				ID:              types.StringValue("self"),
+				ErrorWorkflowID: types.StringValue("self"),
+			},
+			wantError: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			r.validateErrorWorkflow(&tt.model, &diags)
+
+			if diags.HasError() != tt.wantError {
+				t.Errorf("Expected error = %v, got diagnostics: %v", tt.wantError, diags)
+			}
+		})
+	}
+}
+
+func TestAccWorkflowResourceWebhookURLs(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkflowResourceConfigWithWebhook("test-workflow-webhook"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("n8n_workflow.test", "webhook_urls.%", "1"),
+					resource.TestMatchResourceAttr("n8n_workflow.test", "webhook_urls.Webhook.production_url",
+						regexp.MustCompile(`/webhook/tf-acc-orders$`)),
+					resource.TestMatchResourceAttr("n8n_workflow.test", "webhook_urls.Webhook.test_url",
+						regexp.MustCompile(`/webhook-test/tf-acc-orders$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestWorkflowResource_WebhookURLs(t *testing.T) {
+	n8nClient, err := client.NewClient(&client.Config{
+		BaseURL: "https://n8n.example.com",
+		Auth:    &client.APIKeyAuth{APIKey: "test-key"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	r := &WorkflowResource{client: n8nClient}
+
+	workflow := &client.Workflow{
+		Nodes: []interface{}{
+			map[string]interface{}{
+				"name": "Webhook",
+				"type": "n8n-nodes-base.webhook",
+				"parameters": map[string]interface{}{
+					"path": "orders",
+				},
+			},
+		},
+	}
+
+	urls := r.webhookURLs(workflow)
+	if len(urls.Elements()) != 1 {
+		t.Fatalf("Expected 1 webhook URL entry, got %d", len(urls.Elements()))
+	}
+
+	entry, ok := urls.Elements()["Webhook"].(types.Object)
+	if !ok {
+		t.Fatalf("Expected an object entry for node 'Webhook', got %v", urls.Elements())
+	}
+
+	if got := entry.Attributes()["production_url"].(types.String).ValueString(); got != "https://n8n.example.com/webhook/orders" {
+		t.Errorf("Expected production URL 'https://n8n.example.com/webhook/orders', got %q", got)
+	}
+	if got := entry.Attributes()["test_url"].(types.String).ValueString(); got != "https://n8n.example.com/webhook-test/orders" {
+		t.Errorf("Expected test URL 'https://n8n.example.com/webhook-test/orders', got %q", got)
+	}
+
+	// Without a configured client the map is empty rather than unknown
+	empty := (&WorkflowResource{}).webhookURLs(workflow)
+	if empty.IsNull() || empty.IsUnknown() || len(empty.Elements()) != 0 {
+		t.Errorf("Expected an empty known map, got %v", empty)
+	}
+}
+
+func TestAccWorkflowResourcePinVersion(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The version written by Terraform is accepted even though it differs from the pin
+			{
+				Config: testAccWorkflowResourceConfigWithPinVersion("test-workflow-pin", "pinned-version"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("n8n_workflow.test", "pin_version_id", "pinned-version"),
+					resource.TestCheckResourceAttrSet("n8n_workflow.test", "version_id"),
+					resource.TestCheckResourceAttrPair("data.n8n_workflow_versions.test", "current_version_id",
+						"n8n_workflow.test", "version_id"),
+				),
+			},
+			{
+				Config:   testAccWorkflowResourceConfigWithPinVersion("test-workflow-pin", "pinned-version"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestWorkflowResource_CheckPinnedVersion(t *testing.T) {
+	tests := []struct {
+		name           string
+		pin            types.String
+		remoteVersion  string
+		appliedVersion []byte
+		wantError      bool
+	}{
+		{name: "no pin", pin: types.StringNull(), remoteVersion: "v2", wantError: false},
+		{name: "remote matches pin", pin: types.StringValue("v1"), remoteVersion: "v1", wantError: false},
+		{name: "remote written by terraform", pin: types.StringValue("v1"), remoteVersion: "v2",
+			appliedVersion: []byte(`"v2"`), wantError: false},
+		{name: "edited outside terraform", pin: types.StringValue("v1"), remoteVersion: "v3",
+			appliedVersion: []byte(`"v2"`), wantError: true},
+		{name: "imported without applied version", pin: types.StringValue("v1"), remoteVersion: "v3", wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := &WorkflowResourceModel{PinVersion: tt.pin}
+			state := &WorkflowResourceModel{ID: types.StringValue("wf-1"), VersionID: types.StringValue(tt.remoteVersion)}
+
+			var diags diag.Diagnostics
+			checkPinnedVersion(plan, state, tt.appliedVersion, &diags)
+
+			if diags.HasError() != tt.wantError {
+				t.Errorf("Expected error = %v, got diagnostics: %v", tt.wantError, diags)
+			}
+		})
+	}
+}
+
+func TestWorkflowResource_CheckRemoteChanges(t *testing.T) {
+	applied := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	edited := applied.Add(time.Hour)
+
+	written := &client.Workflow{Name: "Orders", Nodes: []interface{}{map[string]interface{}{"name": "Webhook"}}}
+	hash, err := workflowContentHash(written)
+	if err != nil {
+		t.Fatalf("workflowContentHash() error = %v", err)
+	}
+	appliedContent := []byte(`"` + hash + `"`)
+
+	tests := []struct {
+		name           string
+		stateVersion   types.String
+		appliedVersion []byte
+		appliedContent []byte
+		remote         *client.Workflow
+		wantError      bool
+	}{
+		{name: "unchanged", stateVersion: types.StringValue("v1"), appliedVersion: []byte(`"v1"`),
+			remote: &client.Workflow{VersionID: "v1"}, wantError: false},
+		{name: "edited outside terraform", stateVersion: types.StringValue("v2"), appliedVersion: []byte(`"v1"`),
+			remote: &client.Workflow{VersionID: "v2"}, wantError: true},
+		{name: "imported without applied version", stateVersion: types.StringValue("v1"),
+			remote: &client.Workflow{VersionID: "v1"}, wantError: false},
+		{name: "changed after refresh", stateVersion: types.StringValue("v1"),
+			remote: &client.Workflow{VersionID: "v2"}, wantError: true},
+		{name: "unversioned and unchanged", stateVersion: types.StringNull(),
+			remote: &client.Workflow{UpdatedAt: &applied}, wantError: false},
+		{name: "unversioned and updated", stateVersion: types.StringNull(),
+			remote: &client.Workflow{UpdatedAt: &edited}, wantError: true},
+		// Activating the workflow moves the update timestamp, but not the content
+		{name: "unversioned with unchanged content", stateVersion: types.StringNull(), appliedContent: appliedContent,
+			remote: &client.Workflow{Name: "Orders", Active: true, Nodes: written.Nodes, UpdatedAt: &edited}, wantError: false},
+		{name: "unversioned with changed content", stateVersion: types.StringNull(), appliedContent: appliedContent,
+			remote: &client.Workflow{Name: "Orders", UpdatedAt: &applied}, wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := &WorkflowResourceModel{
+				ID:        types.StringValue("wf-1"),
+				VersionID: tt.stateVersion,
+				UpdatedAt: types.StringValue(applied.Format("2006-01-02T15:04:05Z")),
+			}
+
+			var diags diag.Diagnostics
+			checkRemoteChanges(state, tt.appliedVersion, tt.appliedContent, tt.remote, &diags)
+
+			if diags.HasError() != tt.wantError {
+				t.Errorf("Expected error = %v, got diagnostics: %v", tt.wantError, diags)
+			}
+		})
+	}
+}
+
 func TestAccWorkflowResourceLargeWorkflow(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -132,6 +850,36 @@ func TestAccWorkflowResourceLargeWorkflow(t *testing.T) {
 	})
 }
 
+func TestWorkflowResource_UpdateModelPinnedData(t *testing.T) {
+	r := &WorkflowResource{}
+
+	var workflow client.Workflow
+	err := json.Unmarshal([]byte(`{
+		"id": "wf-1",
+		"name": "Orders",
+		"connections": {},
+		"pinData": {"Webhook": [{"json": {"id": 1}}]}
+	}`), &workflow)
+	if err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+
+	for _, manage := range []bool{true, false} {
+		model := &WorkflowResourceModel{ManagePinnedData: types.BoolValue(manage)}
+		r.updateModelFromWorkflow(model, &workflow)
+
+		if !manage {
+			if !model.PinnedData.IsNull() {
+				t.Errorf("Expected unmanaged pinned data not to be read, got %s", model.PinnedData.ValueString())
+			}
+			continue
+		}
+		if model.PinnedData.ValueString() != `{"Webhook":[{"json":{"id":1}}]}` {
+			t.Errorf("Expected the pinData of the workflow, got %s", model.PinnedData.ValueString())
+		}
+	}
+}
+
 // testAccPreCheck validates the necessary test API credentials exist
 func testAccPreCheck(t *testing.T) {
 	// Skip acceptance tests if TF_ACC_SKIP is set (useful for CI environments without n8n setup)
@@ -334,6 +1082,8 @@ resource "n8n_workflow" "test" {
   name   = "%s"
   active = false
   tags   = ["automation", "test"]
+
+  create_missing_tags = true
   
   nodes = jsonencode({
     "start": {
@@ -473,3 +1223,132 @@ resource "n8n_workflow" "test" {
 }
 `, name)
 }
+
+func testAccWorkflowResourceConfigWithSettingsAttributes(name, timezone string, timeout int) string {
+	return fmt.Sprintf(`
+resource "n8n_workflow" "test" {
+  name   = "%s"
+  active = false
+
+  nodes = jsonencode({
+    "start": {
+      "type": "n8n-nodes-base.start",
+      "position": [240, 300],
+      "parameters": {}
+    }
+  })
+
+  connections = jsonencode({})
+
+  timezone               = "%s"
+  execution_timeout      = %d
+  save_manual_executions = true
+  caller_policy          = "workflowsFromSameOwner"
+}
+`, name, timezone, timeout)
+}
+
+func testAccWorkflowResourceConfigWithErrorWorkflow(name string) string {
+	return fmt.Sprintf(`
+resource "n8n_workflow" "error_handler" {
+  name   = "%[1]s-handler"
+  active = false
+
+  nodes = jsonencode({
+    "Error Trigger": {
+      "type": "n8n-nodes-base.errorTrigger",
+      "position": [240, 300],
+      "parameters": {}
+    }
+  })
+
+  connections = jsonencode({})
+}
+
+resource "n8n_workflow" "test" {
+  name   = "%[1]s"
+  active = false
+
+  nodes = jsonencode({
+    "start": {
+      "type": "n8n-nodes-base.start",
+      "position": [240, 300],
+      "parameters": {}
+    }
+  })
+
+  connections = jsonencode({})
+
+  error_workflow_id = n8n_workflow.error_handler.id
+}
+`, name)
+}
+
+func testAccWorkflowResourceConfigWithMissingErrorWorkflow(name string) string {
+	return fmt.Sprintf(`
+resource "n8n_workflow" "test" {
+  name   = "%s"
+  active = false
+
+  nodes = jsonencode({
+    "start": {
+      "type": "n8n-nodes-base.start",
+      "position": [240, 300],
+      "parameters": {}
+    }
+  })
+
+  connections = jsonencode({})
+
+  error_workflow_id = "does-not-exist"
+}
+`, name)
+}
+
+func testAccWorkflowResourceConfigWithWebhook(name string) string {
+	return fmt.Sprintf(`
+resource "n8n_workflow" "test" {
+  name   = "%s"
+  active = false
+
+  nodes = jsonencode({
+    "Webhook": {
+      "type": "n8n-nodes-base.webhook",
+      "typeVersion": 2,
+      "position": [240, 300],
+      "parameters": {
+        "path": "tf-acc-orders",
+        "httpMethod": "POST"
+      }
+    }
+  })
+
+  connections = jsonencode({})
+}
+`, name)
+}
+
+func testAccWorkflowResourceConfigWithPinVersion(name, pinVersion string) string {
+	return fmt.Sprintf(`
+resource "n8n_workflow" "test" {
+  name           = %[1]q
+  active         = false
+  pin_version_id = %[2]q
+
+  nodes = jsonencode({
+    "Start": {
+      "type": "n8n-nodes-base.manualTrigger",
+      "typeVersion": 1,
+      "position": [240, 300],
+      "parameters": {}
+    }
+  })
+
+  connections = jsonencode({})
+}
+
+data "n8n_workflow_versions" "test" {
+  workflow_id = n8n_workflow.test.id
+}
+`, name, pinVersion)
+}
@@ -0,0 +1,283 @@
+package provider
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// workflowSchemaJSON is the JSON schema that nodes, connections and settings are validated against
+//
+//go:embed workflow_schema.json
+var workflowSchemaJSON []byte
+
+// jsonSchema is the subset of JSON Schema used by workflow_schema.json
+type jsonSchema struct {
+	Ref                  string                 `json:"$ref"`
+	Type                 jsonSchemaTypes        `json:"type"`
+	Properties           map[string]*jsonSchema `json:"properties"`
+	Required             []string               `json:"required"`
+	AdditionalProperties *jsonSchemaAdditional  `json:"additionalProperties"`
+	Items                *jsonSchema            `json:"items"`
+	Enum                 []interface{}          `json:"enum"`
+	MinItems             *int                   `json:"minItems"`
+	MaxItems             *int                   `json:"maxItems"`
+	Minimum              *float64               `json:"minimum"`
+	Definitions          map[string]*jsonSchema `json:"definitions"`
+}
+
+// jsonSchemaTypes holds the allowed types of a schema, given either as a string or a list
+type jsonSchemaTypes []string
+
+func (t *jsonSchemaTypes) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*t = jsonSchemaTypes{single}
+		return nil
+	}
+
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return fmt.Errorf("type must be a string or a list of strings: %w", err)
+	}
+	*t = list
+	return nil
+}
+
+// jsonSchemaAdditional is the additionalProperties keyword, either a boolean or a schema
+type jsonSchemaAdditional struct {
+	Allowed bool
+	Schema  *jsonSchema
+}
+
+func (a *jsonSchemaAdditional) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &a.Allowed); err == nil {
+		return nil
+	}
+
+	a.Allowed = true
+	return json.Unmarshal(data, &a.Schema)
+}
+
+// loadWorkflowSchema parses the embedded workflow schema once
+var loadWorkflowSchema = sync.OnceValues(func() (*jsonSchema, error) {
+	var schema jsonSchema
+	if err := json.Unmarshal(workflowSchemaJSON, &schema); err != nil {
+		return nil, fmt.Errorf("failed to parse workflow schema: %w", err)
+	}
+	return &schema, nil
+})
+
+// validateWorkflowSchema validates a decoded workflow field (nodes, connections or settings) against
+// the embedded schema and returns one message per violation
+func validateWorkflowSchema(fieldName string, value interface{}) ([]string, error) {
+	root, err := loadWorkflowSchema()
+	if err != nil {
+		return nil, err
+	}
+
+	schema, ok := root.Properties[fieldName]
+	if !ok {
+		return nil, fmt.Errorf("no schema for workflow field %s", fieldName)
+	}
+
+	v := &jsonSchemaValidator{definitions: root.Definitions}
+	v.validate(fieldName, value, schema)
+
+	return v.problems, nil
+}
+
+// jsonSchemaValidator collects schema violations of a value
+type jsonSchemaValidator struct {
+	definitions map[string]*jsonSchema
+	problems    []string
+}
+
+func (v *jsonSchemaValidator) addProblem(path, format string, args ...interface{}) {
+	v.problems = append(v.problems, fmt.Sprintf("%s: %s", path, fmt.Sprintf(format, args...)))
+}
+
+func (v *jsonSchemaValidator) resolve(schema *jsonSchema) *jsonSchema {
+	if schema.Ref == "" {
+		return schema
+	}
+
+	name := strings.TrimPrefix(schema.Ref, "#/definitions/")
+	if def, ok := v.definitions[name]; ok {
+		return def
+	}
+
+	// An unresolvable reference accepts any value
+	return &jsonSchema{}
+}
+
+func (v *jsonSchemaValidator) validate(path string, value interface{}, schema *jsonSchema) {
+	schema = v.resolve(schema)
+
+	if len(schema.Type) > 0 && !matchesJSONType(value, schema.Type) {
+		v.addProblem(path, "expected %s, got %s", strings.Join(schema.Type, " or "), jsonTypeName(value))
+		return
+	}
+
+	if len(schema.Enum) > 0 && !containsJSONValue(schema.Enum, value) {
+		allowed := make([]string, 0, len(schema.Enum))
+		for _, e := range schema.Enum {
+			allowed = append(allowed, fmt.Sprintf("%v", e))
+		}
+		v.addProblem(path, "value %v is not one of: %s", value, strings.Join(allowed, ", "))
+	}
+
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		v.validateObject(path, typed, schema)
+	case []interface{}:
+		v.validateArray(path, typed, schema)
+	case float64:
+		if schema.Minimum != nil && typed < *schema.Minimum {
+			v.addProblem(path, "value %v is less than the minimum of %v", typed, *schema.Minimum)
+		}
+	}
+}
+
+func (v *jsonSchemaValidator) validateObject(path string, object map[string]interface{}, schema *jsonSchema) {
+	for _, name := range schema.Required {
+		if _, ok := object[name]; !ok {
+			v.addProblem(path, "missing required property %q", name)
+		}
+	}
+
+	// Sort keys so that problems are reported in a stable order
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		childPath := path + "." + key
+
+		if property, ok := schema.Properties[key]; ok {
+			v.validate(childPath, object[key], property)
+			continue
+		}
+
+		switch {
+		case schema.AdditionalProperties == nil:
+			// Additional properties are allowed and unchecked
+		case schema.AdditionalProperties.Schema != nil:
+			v.validate(childPath, object[key], schema.AdditionalProperties.Schema)
+		case !schema.AdditionalProperties.Allowed:
+			if suggestion := closestPropertyName(key, schema.Properties); suggestion != "" {
+				v.addProblem(path, "unknown property %q (did you mean %q?)", key, suggestion)
+			} else {
+				v.addProblem(path, "unknown property %q", key)
+			}
+		}
+	}
+}
+
+func (v *jsonSchemaValidator) validateArray(path string, array []interface{}, schema *jsonSchema) {
+	if schema.MinItems != nil && len(array) < *schema.MinItems {
+		v.addProblem(path, "expected at least %d items, got %d", *schema.MinItems, len(array))
+	}
+	if schema.MaxItems != nil && len(array) > *schema.MaxItems {
+		v.addProblem(path, "expected at most %d items, got %d", *schema.MaxItems, len(array))
+	}
+
+	if schema.Items == nil {
+		return
+	}
+
+	for i, item := range array {
+		v.validate(fmt.Sprintf("%s[%d]", path, i), item, schema.Items)
+	}
+}
+
+// jsonTypeName returns the JSON schema type name of a value decoded by encoding/json
+func jsonTypeName(value interface{}) string {
+	switch typed := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		if typed == math.Trunc(typed) {
+			return "integer"
+		}
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
+
+// matchesJSONType reports whether value has one of the given JSON schema types
+func matchesJSONType(value interface{}, types []string) bool {
+	actual := jsonTypeName(value)
+	for _, t := range types {
+		if t == actual || (t == "number" && actual == "integer") {
+			return true
+		}
+	}
+	return false
+}
+
+func containsJSONValue(values []interface{}, value interface{}) bool {
+	for _, v := range values {
+		if reflect.DeepEqual(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// closestPropertyName returns the known property closest to name, if it is close enough to be a typo
+func closestPropertyName(name string, properties map[string]*jsonSchema) string {
+	best := ""
+	bestDistance := 3
+
+	for property := range properties {
+		distance := levenshteinDistance(strings.ToLower(name), strings.ToLower(property))
+		if distance < bestDistance || (distance == bestDistance && best != "" && property < best) {
+			best = property
+			bestDistance = distance
+		}
+	}
+
+	return best
+}
+
+// levenshteinDistance returns the number of single character edits needed to turn a into b
+func levenshteinDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	previous := make([]int, len(rb)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		current := make([]int, len(rb)+1)
+		current[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous = current
+	}
+
+	return previous[len(rb)]
+}
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidateWorkflowSchema(t *testing.T) {
+	tests := []struct {
+		name         string
+		fieldName    string
+		jsonValue    string
+		wantProblems []string
+	}{
+		{
+			name:      "valid nodes",
+			fieldName: "nodes",
+			jsonValue: `{"start": {"type": "n8n-nodes-base.start", "typeVersion": 1, "position": [240, 300], ` +
+				`"parameters": {}, "credentials": {"httpHeaderAuth": {"id": "1", "name": "auth"}}}}`,
+		},
+		{
+			name:         "misspelled node property",
+			fieldName:    "nodes",
+			jsonValue:    `{"start": {"type": "n8n-nodes-base.start", "postion": [240, 300]}}`,
+			wantProblems: []string{`nodes.start: unknown property "postion" (did you mean "position"?)`},
+		},
+		{
+			name:         "node without type",
+			fieldName:    "nodes",
+			jsonValue:    `{"start": {"position": [240, 300]}}`,
+			wantProblems: []string{`nodes.start: missing required property "type"`},
+		},
+		{
+			name:         "invalid position",
+			fieldName:    "nodes",
+			jsonValue:    `{"start": {"type": "n8n-nodes-base.start", "position": [240]}}`,
+			wantProblems: []string{"nodes.start.position: expected at least 2 items, got 1"},
+		},
+		{
+			name:      "valid connections",
+			fieldName: "connections",
+			jsonValue: `{"start": {"main": [[{"node": "webhook", "type": "main", "index": 0}], null]}}`,
+		},
+		{
+			name:         "connections not nested per output",
+			fieldName:    "connections",
+			jsonValue:    `{"start": {"main": [{"node": "webhook", "type": "main", "index": 0}]}}`,
+			wantProblems: []string{"connections.start.main[0]: expected array or null, got object"},
+		},
+		{
+			name:      "invalid connection",
+			fieldName: "connections",
+			jsonValue: `{"start": {"main": [[{"node": "webhook", "index": -1}]]}}`,
+			wantProblems: []string{
+				`connections.start.main[0][0]: missing required property "type"`,
+				"connections.start.main[0][0].index: value -1 is less than the minimum of 0",
+			},
+		},
+		{
+			name:      "valid settings",
+			fieldName: "settings",
+			jsonValue: `{"executionOrder": "v1", "saveDataErrorExecution": "all", "executionTimeout": 3600}`,
+		},
+		{
+			name:      "invalid settings",
+			fieldName: "settings",
+			jsonValue: `{"executionOrder": "v2", "saveManualExecution": true}`,
+			wantProblems: []string{
+				"settings.executionOrder: value v2 is not one of: v0, v1",
+				`settings: unknown property "saveManualExecution" (did you mean "saveManualExecutions"?)`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var value interface{}
+			if err := json.Unmarshal([]byte(tt.jsonValue), &value); err != nil {
+				t.Fatalf("Invalid test JSON: %v", err)
+			}
+
+			problems, err := validateWorkflowSchema(tt.fieldName, value)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if strings.Join(problems, "\n") != strings.Join(tt.wantProblems, "\n") {
+				t.Errorf("Expected problems %q, got %q", tt.wantProblems, problems)
+			}
+		})
+	}
+}
+
+func TestValidateWorkflowJSONSchema(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     types.String
+		wantError bool
+	}{
+		{name: "null", value: types.StringNull(), wantError: false},
+		{name: "unknown", value: types.StringUnknown(), wantError: false},
+		{name: "valid", value: types.StringValue(`{"start": {"type": "n8n-nodes-base.start"}}`), wantError: false},
+		{name: "invalid JSON", value: types.StringValue("invalid json"), wantError: true},
+		{name: "schema violation", value: types.StringValue(`{"start": {"typ": "n8n-nodes-base.start"}}`), wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			validateWorkflowJSONSchema("nodes", "Invalid Nodes JSON", tt.value, &diags)
+
+			if diags.HasError() != tt.wantError {
+				t.Errorf("Expected error = %v, got diagnostics: %v", tt.wantError, diags)
+			}
+		})
+	}
+}
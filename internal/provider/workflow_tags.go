@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+)
+
+// applyTags sets the tags of a workflow to the tags named in tags and returns the names of the tags the
+// workflow has afterwards. Tags that do not exist are created if createMissing is set.
+func (r *WorkflowResource) applyTags(ctx context.Context, id string, tags types.List, createMissing bool,
+	diags *diag.Diagnostics) (types.List, bool) {
+	var names []string
+	diags.Append(tags.ElementsAs(ctx, &names, false)...)
+	if diags.HasError() {
+		return tags, false
+	}
+
+	tagIDs, err := resolveTagIDs(r.client, names, createMissing)
+	if err != nil {
+		diags.AddAttributeError(path.Root("tags"), "Unable to Resolve Tags", err.Error())
+		return tags, false
+	}
+
+	applied, err := r.client.UpdateWorkflowTags(id, tagIDs)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update workflow tags, got error: %s", err))
+		return tags, false
+	}
+
+	return tagNamesValue(tags, applied), true
+}
+
+// resolveTagIDs returns the IDs of the tags with the given names. Tags that do not exist are created if
+// createMissing is set, and are an error otherwise.
+func resolveTagIDs(c client.N8nAPI, names []string, createMissing bool) ([]string, error) {
+	if len(names) == 0 {
+		return []string{}, nil
+	}
+
+	existing, err := c.GetAllTags()
+	if err != nil {
+		return nil, fmt.Errorf("unable to list tags: %w", err)
+	}
+
+	idsByName := make(map[string]string, len(existing))
+	for _, tag := range existing {
+		idsByName[tag.Name] = tag.ID
+	}
+
+	var missing []string
+	for _, name := range names {
+		if _, ok := idsByName[name]; !ok && !slices.Contains(missing, name) {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 && !createMissing {
+		return nil, fmt.Errorf("the following tags do not exist: %s. Create them in n8n, or set "+
+			"create_missing_tags = true to create them with the workflow", strings.Join(missing, ", "))
+	}
+
+	for _, name := range missing {
+		tag, err := c.CreateTag(name)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create tag %s: %w", name, err)
+		}
+		idsByName[name] = tag.ID
+	}
+
+	ids := make([]string, 0, len(names))
+	for _, name := range names {
+		if id := idsByName[name]; !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// tagNamesValue returns the names of tags as a list. n8n does not keep the order in which tags were set,
+// so the prior list is returned as is if it names the same tags.
+func tagNamesValue(prior types.List, tags []client.Tag) types.List {
+	names := client.TagNames(tags)
+
+	if !prior.IsNull() && !prior.IsUnknown() {
+		var priorNames []string
+		for _, element := range prior.Elements() {
+			if name, ok := element.(types.String); ok {
+				priorNames = append(priorNames, name.ValueString())
+			}
+		}
+		if sameTagNames(priorNames, names) {
+			return prior
+		}
+	}
+
+	values := make([]attr.Value, len(names))
+	for i, name := range names {
+		values[i] = types.StringValue(name)
+	}
+	return types.ListValueMust(types.StringType, values)
+}
+
+// sameTagNames reports whether two lists name the same tags, in any order and ignoring duplicates
+func sameTagNames(a, b []string) bool {
+	a = slices.Compact(slices.Sorted(slices.Values(a)))
+	b = slices.Compact(slices.Sorted(slices.Values(b)))
+	return slices.Equal(a, b)
+}
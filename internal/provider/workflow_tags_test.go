@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+	"github.com/devops247-online/terraform-provider-n8n/internal/client/clientmock"
+)
+
+func TestResolveTagIDs(t *testing.T) {
+	var created []string
+	mock := &clientmock.N8nAPI{
+		GetAllTagsFunc: func() ([]client.Tag, error) {
+			return []client.Tag{{ID: "t1", Name: "prod"}, {ID: "t2", Name: "Prod"}}, nil
+		},
+		CreateTagFunc: func(name string) (*client.Tag, error) {
+			created = append(created, name)
+			return &client.Tag{ID: "new-" + name, Name: name}, nil
+		},
+	}
+
+	ids, err := resolveTagIDs(mock, []string{"prod", "billing", "billing"}, true)
+	if err != nil {
+		t.Fatalf("resolveTagIDs() error = %v", err)
+	}
+	if !reflect.DeepEqual(ids, []string{"t1", "new-billing"}) {
+		t.Errorf("Expected the existing and the created tag, got %v", ids)
+	}
+	if !reflect.DeepEqual(created, []string{"billing"}) {
+		t.Errorf("Expected the missing tag to be created once, got %v", created)
+	}
+
+	// Without create_missing_tags nothing is created
+	created = nil
+	_, err = resolveTagIDs(mock, []string{"billing", "prod", "finance"}, false)
+	if err == nil || !strings.Contains(err.Error(), "do not exist: billing, finance.") {
+		t.Errorf("Expected the missing tags to be listed, got %v", err)
+	}
+	if len(created) != 0 {
+		t.Errorf("Expected no tags to be created, got %v", created)
+	}
+
+	// No tags need no lookup
+	ids, err = resolveTagIDs(&clientmock.N8nAPI{}, nil, false)
+	if err != nil || ids == nil || len(ids) != 0 {
+		t.Errorf("Expected an empty list of IDs, got %v, %v", ids, err)
+	}
+}
+
+func TestTagNamesValue(t *testing.T) {
+	prior := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("prod"), types.StringValue("billing")})
+
+	// The configured order is kept
+	value := tagNamesValue(prior, []client.Tag{{ID: "t2", Name: "billing"}, {ID: "t1", Name: "prod"}})
+	if !value.Equal(prior) {
+		t.Errorf("Expected the prior tags, got %v", value)
+	}
+
+	// Tags changed outside of Terraform are reported in the order of n8n
+	value = tagNamesValue(prior, []client.Tag{{ID: "t1", Name: "prod"}, {ID: "t3", Name: "finance"}})
+	expected := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("prod"), types.StringValue("finance")})
+	if !value.Equal(expected) {
+		t.Errorf("Expected the tags of the workflow, got %v", value)
+	}
+
+	value = tagNamesValue(types.ListNull(types.StringType), nil)
+	if value.IsNull() || len(value.Elements()) != 0 {
+		t.Errorf("Expected an empty list, got %v", value)
+	}
+}
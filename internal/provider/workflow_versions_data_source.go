@@ -0,0 +1,200 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &WorkflowVersionsDataSource{}
+
+func NewWorkflowVersionsDataSource() datasource.DataSource {
+	return &WorkflowVersionsDataSource{}
+}
+
+// WorkflowVersionsDataSource defines the data source implementation.
+type WorkflowVersionsDataSource struct {
+	client client.N8nAPI
+}
+
+// WorkflowVersionsDataSourceModel describes the data source data model.
+type WorkflowVersionsDataSourceModel struct {
+	WorkflowID       types.String `tfsdk:"workflow_id"`
+	CurrentVersionID types.String `tfsdk:"current_version_id"`
+	Versions         types.List   `tfsdk:"versions"`
+}
+
+// workflowVersionAttrTypes describes the object type of each versions entry
+func workflowVersionAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"version_id": types.StringType,
+		"authors":    types.StringType,
+		"created_at": types.StringType,
+		"updated_at": types.StringType,
+	}
+}
+
+func (d *WorkflowVersionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow_versions"
+}
+
+func (d *WorkflowVersionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches the current version and the version history of an n8n workflow. Workflow history " +
+			"is only available with session authentication on n8n versions that support it; otherwise `versions` is " +
+			"empty and a warning is shown.",
+
+		Attributes: map[string]schema.Attribute{
+			"workflow_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the workflow",
+				Required:            true,
+			},
+			"current_version_id": schema.StringAttribute{
+				MarkdownDescription: "Version identifier of the current workflow",
+				Computed:            true,
+			},
+			"versions": schema.ListNestedAttribute{
+				MarkdownDescription: "Saved versions of the workflow, most recent first",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"version_id": schema.StringAttribute{
+							MarkdownDescription: "Version identifier",
+							Computed:            true,
+						},
+						"authors": schema.StringAttribute{
+							MarkdownDescription: "Users who authored the version",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "Timestamp when the version was created",
+							Computed:            true,
+						},
+						"updated_at": schema.StringAttribute{
+							MarkdownDescription: "Timestamp when the version was last updated",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *WorkflowVersionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.N8nAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.N8nAPI, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *WorkflowVersionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data WorkflowVersionsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workflow, err := d.client.GetWorkflow(data.WorkflowID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workflow, got error: %s", err))
+		return
+	}
+
+	data.CurrentVersionID = types.StringValue(workflow.VersionID)
+
+	// Without workflow history only the current version is known
+	if summary, detail := instanceRequirementProblem(d.client, "n8n_workflow_versions", requiresWorkflowHistory); summary != "" {
+		resp.Diagnostics.AddWarning("Workflow History Unavailable", detail)
+		data.Versions = workflowVersionsList(nil)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	versions, err := d.client.GetWorkflowVersions(workflow.ID)
+	if err != nil {
+		if !isHistoryUnavailable(err) {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workflow versions, got error: %s", err))
+			return
+		}
+
+		resp.Diagnostics.AddWarning(
+			"Workflow History Unavailable",
+			fmt.Sprintf("The version history of workflow %s could not be read. Workflow history requires session "+
+				"authentication and an n8n version that supports it. Error: %s", workflow.ID, err),
+		)
+		versions = nil
+	}
+
+	data.Versions = workflowVersionsList(versions)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// isHistoryUnavailable reports whether err indicates that the workflow history endpoint cannot be used
+func isHistoryUnavailable(err error) bool {
+	var apiErr *client.APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+
+	return apiErr.Code == http.StatusUnauthorized ||
+		apiErr.Code == http.StatusForbidden ||
+		apiErr.Code == http.StatusNotFound
+}
+
+// workflowVersionsList converts workflow versions into the versions attribute value
+func workflowVersionsList(versions []client.WorkflowVersion) types.List {
+	objectType := types.ObjectType{AttrTypes: workflowVersionAttrTypes()}
+
+	values := make([]attr.Value, 0, len(versions))
+	for _, version := range versions {
+		createdAt := types.StringNull()
+		if version.CreatedAt != nil {
+			createdAt = types.StringValue(version.CreatedAt.Format("2006-01-02T15:04:05Z"))
+		}
+
+		updatedAt := types.StringNull()
+		if version.UpdatedAt != nil {
+			updatedAt = types.StringValue(version.UpdatedAt.Format("2006-01-02T15:04:05Z"))
+		}
+
+		values = append(values, types.ObjectValueMust(workflowVersionAttrTypes(), map[string]attr.Value{
+			"version_id": types.StringValue(version.VersionID),
+			"authors":    types.StringValue(version.Authors),
+			"created_at": createdAt,
+			"updated_at": updatedAt,
+		}))
+	}
+
+	return types.ListValueMust(objectType, values)
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+)
+
+func TestWorkflowVersionsList(t *testing.T) {
+	createdAt := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	list := workflowVersionsList([]client.WorkflowVersion{
+		{VersionID: "v2", Authors: "Jane Doe", CreatedAt: &createdAt},
+		{VersionID: "v1", Authors: "Jane Doe"},
+	})
+
+	if len(list.Elements()) != 2 {
+		t.Fatalf("Expected 2 versions, got %d", len(list.Elements()))
+	}
+
+	first := list.Elements()[0].(types.Object).Attributes()
+	if got := first["version_id"].(types.String).ValueString(); got != "v2" {
+		t.Errorf("Expected first version 'v2', got %q", got)
+	}
+	if got := first["created_at"].(types.String).ValueString(); got != "2024-03-01T12:00:00Z" {
+		t.Errorf("Expected created_at '2024-03-01T12:00:00Z', got %q", got)
+	}
+
+	second := list.Elements()[1].(types.Object).Attributes()
+	if !second["created_at"].IsNull() {
+		t.Error("Expected missing created_at to be null")
+	}
+
+	// No history yields an empty known list
+	empty := workflowVersionsList(nil)
+	if empty.IsNull() || len(empty.Elements()) != 0 {
+		t.Errorf("Expected an empty list, got %v", empty)
+	}
+}
+
+func TestIsHistoryUnavailable(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "unauthorized", err: fmt.Errorf("wrapped: %w", &client.APIError{Code: 401}), expected: true},
+		{name: "not found", err: &client.APIError{Code: 404}, expected: true},
+		{name: "server error", err: &client.APIError{Code: 500}, expected: false},
+		{name: "network error", err: fmt.Errorf("connection refused"), expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isHistoryUnavailable(tt.err); got != tt.expected {
+				t.Errorf("isHistoryUnavailable() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
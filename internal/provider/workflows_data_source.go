@@ -0,0 +1,235 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &WorkflowsDataSource{}
+
+func NewWorkflowsDataSource() datasource.DataSource {
+	return &WorkflowsDataSource{}
+}
+
+// WorkflowsDataSource defines the data source implementation.
+type WorkflowsDataSource struct {
+	client client.N8nAPI
+}
+
+// WorkflowsDataSourceModel describes the data source data model.
+type WorkflowsDataSourceModel struct {
+	Active          types.Bool   `tfsdk:"active"`
+	Tags            types.List   `tfsdk:"tags"`
+	ProjectID       types.String `tfsdk:"project_id"`
+	IncludeArchived types.Bool   `tfsdk:"include_archived"`
+	PageSize        types.Int64  `tfsdk:"page_size"`
+	MaxItems        types.Int64  `tfsdk:"max_items"`
+	Workflows       types.List   `tfsdk:"workflows"`
+	Total           types.Int64  `tfsdk:"total"`
+}
+
+// workflowSummaryAttrTypes describes the object type of each workflows entry
+func workflowSummaryAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":          types.StringType,
+		"name":        types.StringType,
+		"active":      types.BoolType,
+		"is_archived": types.BoolType,
+		"tags":        types.ListType{ElemType: types.StringType},
+		"created_at":  types.StringType,
+		"updated_at":  types.StringType,
+	}
+}
+
+func (d *WorkflowsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflows"
+}
+
+func (d *WorkflowsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the workflows of the n8n instance, following pagination. Set `max_items` to " +
+			"limit the number of workflows returned; `total` still counts every matching workflow, so " +
+			"`total > length(workflows)` detects a truncated list.",
+
+		Attributes: map[string]schema.Attribute{
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Only list workflows that are active (`true`) or inactive (`false`)",
+				Optional:            true,
+			},
+			"tags": schema.ListAttribute{
+				MarkdownDescription: "Only list workflows that have all of these tags, by name",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Only list workflows of this project",
+				Optional:            true,
+			},
+			"include_archived": schema.BoolAttribute{
+				MarkdownDescription: "Whether to also list archived workflows, which are hidden in n8n and cannot run. " +
+					"Defaults to false.",
+				Optional: true,
+			},
+			"page_size": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Number of workflows requested per page, between 1 and %d. "+
+					"Defaults to %d.", client.MaxPageSize, client.DefaultPageSize),
+				Optional:   true,
+				Validators: []validator.Int64{int64Between(1, client.MaxPageSize)},
+			},
+			"max_items": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of workflows to return. Every page is still read to count " +
+					"`total`. Defaults to returning all matching workflows.",
+				Optional:   true,
+				Validators: []validator.Int64{int64AtLeast(1)},
+			},
+			"workflows": schema.ListNestedAttribute{
+				MarkdownDescription: "Matching workflows, in the order n8n returns them",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Workflow identifier",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the workflow",
+							Computed:            true,
+						},
+						"active": schema.BoolAttribute{
+							MarkdownDescription: "Whether the workflow is active",
+							Computed:            true,
+						},
+						"is_archived": schema.BoolAttribute{
+							MarkdownDescription: "Whether the workflow is archived. Archived workflows are only " +
+								"listed with `include_archived`.",
+							Computed: true,
+						},
+						"tags": schema.ListAttribute{
+							MarkdownDescription: "Names of the tags of the workflow",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "Timestamp when the workflow was created",
+							Computed:            true,
+						},
+						"updated_at": schema.StringAttribute{
+							MarkdownDescription: "Timestamp when the workflow was last updated",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"total": schema.Int64Attribute{
+				MarkdownDescription: "Number of matching workflows, including those beyond `max_items`",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *WorkflowsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.N8nAPI)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected client.N8nAPI, got: %T. Please report this issue to the provider developers.",
+				req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *WorkflowsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data WorkflowsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	options := &client.WorkflowListOptions{
+		ProjectID:       data.ProjectID.ValueString(),
+		Limit:           int(data.PageSize.ValueInt64()),
+		IncludeArchived: data.IncludeArchived.ValueBool(),
+	}
+	if !data.Active.IsNull() {
+		active := data.Active.ValueBool()
+		options.Active = &active
+	}
+	if !data.Tags.IsNull() {
+		resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &options.Tags, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	list, err := d.client.ListWorkflows(options, int(data.MaxItems.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list workflows, got error: %s", err))
+		return
+	}
+
+	data.Workflows = workflowSummariesList(list.Workflows)
+	data.Total = types.Int64Value(int64(list.Total))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// workflowSummariesList converts workflows into the workflows attribute value
+func workflowSummariesList(workflows []client.Workflow) types.List {
+	objectType := types.ObjectType{AttrTypes: workflowSummaryAttrTypes()}
+
+	values := make([]attr.Value, 0, len(workflows))
+	for _, workflow := range workflows {
+		createdAt := types.StringNull()
+		if workflow.CreatedAt != nil {
+			createdAt = types.StringValue(workflow.CreatedAt.Format("2006-01-02T15:04:05Z"))
+		}
+
+		updatedAt := types.StringNull()
+		if workflow.UpdatedAt != nil {
+			updatedAt = types.StringValue(workflow.UpdatedAt.Format("2006-01-02T15:04:05Z"))
+		}
+
+		tags := make([]attr.Value, len(workflow.Tags))
+		for i, name := range client.TagNames(workflow.Tags) {
+			tags[i] = types.StringValue(name)
+		}
+
+		values = append(values, types.ObjectValueMust(workflowSummaryAttrTypes(), map[string]attr.Value{
+			"id":          types.StringValue(workflow.ID),
+			"name":        types.StringValue(workflow.Name),
+			"active":      types.BoolValue(workflow.Active),
+			"is_archived": types.BoolValue(workflow.IsArchived),
+			"tags":        types.ListValueMust(types.StringType, tags),
+			"created_at":  createdAt,
+			"updated_at":  updatedAt,
+		}))
+	}
+
+	return types.ListValueMust(objectType, values)
+}
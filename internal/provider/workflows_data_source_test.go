@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/devops247-online/terraform-provider-n8n/internal/client"
+	"github.com/devops247-online/terraform-provider-n8n/internal/client/clientmock"
+)
+
+func TestWorkflowsDataSource_Read(t *testing.T) {
+	ctx := context.Background()
+	createdAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	var gotOptions *client.WorkflowListOptions
+	var gotMaxItems int
+	mock := &clientmock.N8nAPI{
+		ListWorkflowsFunc: func(options *client.WorkflowListOptions, maxItems int) (*client.WorkflowList, error) {
+			gotOptions, gotMaxItems = options, maxItems
+			return &client.WorkflowList{
+				Workflows: []client.Workflow{
+					{ID: "wf-1", Name: "Orders", Active: true, Tags: []client.Tag{{ID: "t1", Name: "prod"}}, CreatedAt: &createdAt},
+				},
+				Total: 300,
+			}, nil
+		},
+	}
+
+	d := &WorkflowsDataSource{}
+	d.Configure(ctx, datasource.ConfigureRequest{ProviderData: mock}, &datasource.ConfigureResponse{})
+
+	var schemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+
+	config := WorkflowsDataSourceModel{
+		Active:          types.BoolValue(true),
+		Tags:            types.ListValueMust(types.StringType, []attr.Value{types.StringValue("prod")}),
+		ProjectID:       types.StringNull(),
+		IncludeArchived: types.BoolValue(true),
+		PageSize:        types.Int64Value(250),
+		MaxItems:        types.Int64Value(1),
+		Workflows:       types.ListNull(types.ObjectType{AttrTypes: workflowSummaryAttrTypes()}),
+		Total:           types.Int64Null(),
+	}
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	if diags := state.Set(ctx, &config); diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: state.Raw}}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if gotOptions.Active == nil || !*gotOptions.Active || gotOptions.Limit != 250 || !gotOptions.IncludeArchived ||
+		!reflect.DeepEqual(gotOptions.Tags, []string{"prod"}) || gotMaxItems != 1 {
+		t.Errorf("Expected the configured filters and limits, got %+v and max %d", gotOptions, gotMaxItems)
+	}
+
+	var result WorkflowsDataSourceModel
+	resp.State.Get(ctx, &result)
+	if result.Total.ValueInt64() != 300 || len(result.Workflows.Elements()) != 1 {
+		t.Errorf("Expected 1 of 300 workflows, got %d of %d", len(result.Workflows.Elements()), result.Total.ValueInt64())
+	}
+
+	workflow := result.Workflows.Elements()[0].(types.Object).Attributes()
+	if workflow["id"].(types.String).ValueString() != "wf-1" ||
+		workflow["created_at"].(types.String).ValueString() != "2025-01-02T03:04:05Z" ||
+		len(workflow["tags"].(types.List).Elements()) != 1 {
+		t.Errorf("Expected the workflow summary, got %v", workflow)
+	}
+}
@@ -0,0 +1,302 @@
+// Command mockgen generates a mock of an interface of the client package, for unit tests that should not
+// depend on an n8n instance or an httptest server.
+//
+// The mock is a struct with a <Method>Func field per interface method. A method calls its function field,
+// or returns zero values and, if the method returns an error, an error saying that the method is not
+// mocked. Calls records the names of the methods called, in order.
+//
+// Usage:
+//
+//	go run ./tools/mockgen -source internal/client/api.go -interface N8nAPI -out internal/client/clientmock/mock_generated.go
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// clientImportPath is the import path of the package the mocked interfaces are declared in
+const clientImportPath = "github.com/devops247-online/terraform-provider-n8n/internal/client"
+
+func main() {
+	source := flag.String("source", "api.go", "Go file declaring the interface")
+	iface := flag.String("interface", "N8nAPI", "name of the interface to mock")
+	out := flag.String("out", "clientmock/mock_generated.go", "path of the generated Go file")
+	pkg := flag.String("package", "clientmock", "package of the generated Go file")
+	flag.Parse()
+
+	src, err := os.ReadFile(*source)
+	if err != nil {
+		log.Fatalf("unable to read source: %s", err)
+	}
+
+	mock, err := generate(src, filepath.Base(*source), *iface, *pkg)
+	if err != nil {
+		log.Fatalf("unable to generate mock: %s", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(*out), 0o755); err != nil {
+		log.Fatalf("unable to create output directory: %s", err)
+	}
+	if err := os.WriteFile(*out, mock, 0o644); err != nil {
+		log.Fatalf("unable to write mock: %s", err)
+	}
+}
+
+// generate returns the formatted Go source of a mock of the interface named iface declared in src
+func generate(src []byte, sourceName, iface, pkg string) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, sourceName, src, 0)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse source: %w", err)
+	}
+
+	spec := findInterface(file, iface)
+	if spec == nil {
+		return nil, fmt.Errorf("interface %s not found in %s", iface, sourceName)
+	}
+
+	// Imports of the source file, keyed by package name, so the packages its types refer to can be imported
+	imports := make(map[string]string)
+	for _, imp := range file.Imports {
+		path, _ := strconv.Unquote(imp.Path.Value)
+		name := filepath.Base(path)
+		if imp.Name != nil {
+			name = imp.Name.Name
+		}
+		imports[name] = path
+	}
+
+	g := &generator{clientPkg: file.Name.Name, imports: imports, used: map[string]string{"sync": "sync"}}
+
+	var fields, methods bytes.Buffer
+	for _, method := range spec.Methods.List {
+		funcType, ok := method.Type.(*ast.FuncType)
+		if !ok || len(method.Names) == 0 {
+			return nil, fmt.Errorf("interface %s: embedded interfaces are not supported", iface)
+		}
+		name := method.Names[0].Name
+
+		signature, err := g.qualify(funcType)
+		if err != nil {
+			return nil, fmt.Errorf("method %s: %w", name, err)
+		}
+		fmt.Fprintf(&fields, "%sFunc %s\n", name, signature)
+
+		if err := g.writeMethod(&methods, iface, name, funcType); err != nil {
+			return nil, fmt.Errorf("method %s: %w", name, err)
+		}
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by mockgen from %s; DO NOT EDIT.\n\n", sourceName)
+	fmt.Fprintf(&out, "// Package %s provides mocks of the client interfaces for unit tests.\npackage %s\n\nimport (\n", pkg, pkg)
+	for _, name := range sortedKeys(g.used) {
+		fmt.Fprintf(&out, "%q\n", g.used[name])
+	}
+	fmt.Fprintf(&out, "\n%q\n)\n\n", clientImportPath)
+	fmt.Fprintf(&out, "// %s is a mock of %s.%s. Each method calls the function field of the same name, or returns\n", iface,
+		g.clientPkg, iface)
+	fmt.Fprintf(&out, "// zero values and an error if it is not set.\n")
+	fmt.Fprintf(&out, "type %s struct {\n%s\n// Calls lists the methods called, in order\nCalls []string\n\nmu sync.Mutex\n}\n\n", iface,
+		fields.String())
+	fmt.Fprintf(&out, "// Ensure the mock satisfies the interface.\nvar _ %s.%s = &%s{}\n\n", g.clientPkg, iface, iface)
+	fmt.Fprintf(&out, "// record adds a method to Calls\nfunc (m *%s) record(method string) {\n", iface)
+	fmt.Fprintf(&out, "m.mu.Lock()\ndefer m.mu.Unlock()\nm.Calls = append(m.Calls, method)\n}\n")
+	out.Write(methods.Bytes())
+
+	formatted, err := format.Source(out.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("unable to format generated code: %w", err)
+	}
+	return formatted, nil
+}
+
+// findInterface returns the declaration of the named interface type in a file
+func findInterface(file *ast.File, name string) *ast.InterfaceType {
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			if typeSpec := spec.(*ast.TypeSpec); typeSpec.Name.Name == name {
+				iface, _ := typeSpec.Type.(*ast.InterfaceType)
+				return iface
+			}
+		}
+	}
+	return nil
+}
+
+// generator qualifies the types of the source package and records the standard library packages the mock
+// has to import
+type generator struct {
+	clientPkg string
+	imports   map[string]string
+	used      map[string]string
+}
+
+// writeMethod writes the mock implementation of an interface method
+func (g *generator) writeMethod(out *bytes.Buffer, iface, name string, funcType *ast.FuncType) error {
+	var params, args []string
+	for i, field := range fieldList(funcType.Params) {
+		paramType, err := g.qualify(field.Type)
+		if err != nil {
+			return err
+		}
+		names := field.Names
+		if len(names) == 0 {
+			names = []*ast.Ident{ast.NewIdent(fmt.Sprintf("arg%d", i))}
+		}
+		for _, n := range names {
+			params = append(params, n.Name+" "+paramType)
+			if _, variadic := field.Type.(*ast.Ellipsis); variadic {
+				args = append(args, n.Name+"...")
+			} else {
+				args = append(args, n.Name)
+			}
+		}
+	}
+
+	var results, zeros []string
+	for i, field := range fieldList(funcType.Results) {
+		resultType, err := g.qualify(field.Type)
+		if err != nil {
+			return err
+		}
+		results = append(results, resultType)
+		if resultType == "error" {
+			zeros = append(zeros, fmt.Sprintf("fmt.Errorf(%q)", iface+"."+name+" is not mocked"))
+			g.used["fmt"] = "fmt"
+		} else {
+			zeros = append(zeros, fmt.Sprintf("r%d", i))
+		}
+	}
+
+	returns := strings.Join(results, ", ")
+	if len(results) > 1 {
+		returns = "(" + returns + ")"
+	}
+
+	fmt.Fprintf(out, "\n// %s calls %sFunc\n", name, name)
+	fmt.Fprintf(out, "func (m *%s) %s(%s) %s {\n", iface, name, strings.Join(params, ", "), returns)
+	fmt.Fprintf(out, "m.record(%q)\n", name)
+	fmt.Fprintf(out, "if m.%sFunc == nil {\n", name)
+	for i, result := range results {
+		if result != "error" {
+			fmt.Fprintf(out, "var r%d %s\n", i, result)
+		}
+	}
+	if len(results) > 0 {
+		fmt.Fprintf(out, "return %s\n", strings.Join(zeros, ", "))
+	} else {
+		fmt.Fprintf(out, "return\n")
+	}
+	fmt.Fprintf(out, "}\n")
+
+	call := fmt.Sprintf("m.%sFunc(%s)", name, strings.Join(args, ", "))
+	if len(results) > 0 {
+		call = "return " + call
+	}
+	fmt.Fprintf(out, "%s\n}\n", call)
+	return nil
+}
+
+// qualify prints a type expression of the source package for use in the mock package, prefixing the
+// types declared in the source package with its name
+func (g *generator) qualify(expr ast.Expr) (string, error) {
+	var err error
+	qualified := qualifyExpr(expr, func(ident *ast.Ident) ast.Expr {
+		if !ident.IsExported() {
+			return ident
+		}
+		return &ast.SelectorExpr{X: ast.NewIdent(g.clientPkg), Sel: ident}
+	}, func(pkg string) {
+		path, ok := g.imports[pkg]
+		if !ok && err == nil {
+			err = fmt.Errorf("package %s is not imported by the source", pkg)
+		}
+		g.used[pkg] = path
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return types.ExprString(qualified), nil
+}
+
+// qualifyExpr returns a copy of a type expression with its identifiers replaced by qualify and calls
+// imported for each package it refers to
+func qualifyExpr(expr ast.Expr, qualify func(*ast.Ident) ast.Expr, imported func(string)) ast.Expr {
+	recurse := func(e ast.Expr) ast.Expr { return qualifyExpr(e, qualify, imported) }
+
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return qualify(e)
+	case *ast.SelectorExpr:
+		if pkg, ok := e.X.(*ast.Ident); ok {
+			imported(pkg.Name)
+		}
+		return e
+	case *ast.StarExpr:
+		return &ast.StarExpr{X: recurse(e.X)}
+	case *ast.ArrayType:
+		return &ast.ArrayType{Len: e.Len, Elt: recurse(e.Elt)}
+	case *ast.MapType:
+		return &ast.MapType{Key: recurse(e.Key), Value: recurse(e.Value)}
+	case *ast.Ellipsis:
+		return &ast.Ellipsis{Elt: recurse(e.Elt)}
+	case *ast.ChanType:
+		return &ast.ChanType{Dir: e.Dir, Value: recurse(e.Value)}
+	case *ast.FuncType:
+		return &ast.FuncType{
+			Params:  qualifyFields(e.Params, recurse),
+			Results: qualifyFields(e.Results, recurse),
+		}
+	default:
+		// Interface and struct literals are printed as they are
+		return e
+	}
+}
+
+// qualifyFields returns a copy of a parameter or result list with its types qualified
+func qualifyFields(fields *ast.FieldList, qualify func(ast.Expr) ast.Expr) *ast.FieldList {
+	if fields == nil {
+		return nil
+	}
+	qualified := &ast.FieldList{}
+	for _, field := range fields.List {
+		qualified.List = append(qualified.List, &ast.Field{Names: field.Names, Type: qualify(field.Type)})
+	}
+	return qualified
+}
+
+// fieldList returns the fields of a possibly nil field list
+func fieldList(fields *ast.FieldList) []*ast.Field {
+	if fields == nil {
+		return nil
+	}
+	return fields.List
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
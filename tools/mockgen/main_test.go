@@ -0,0 +1,63 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestGenerate_UpToDate(t *testing.T) {
+	src, err := os.ReadFile("../../internal/client/api.go")
+	if err != nil {
+		t.Fatalf("Failed to read source: %v", err)
+	}
+
+	mock, err := generate(src, "api.go", "N8nAPI", "clientmock")
+	if err != nil {
+		t.Fatalf("generate() error = %v", err)
+	}
+
+	checkedIn, err := os.ReadFile("../../internal/client/clientmock/mock_generated.go")
+	if err != nil {
+		t.Fatalf("Failed to read generated mock: %v", err)
+	}
+	if !bytes.Equal(mock, checkedIn) {
+		t.Error("internal/client/clientmock/mock_generated.go is out of date, run make generate-client")
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	src := `package client
+
+import "context"
+
+type API interface {
+	Ping(ctx context.Context, hosts ...string) error
+	Version() string
+	Close()
+}
+`
+
+	mock, err := generate([]byte(src), "api.go", "API", "clientmock")
+	if err != nil {
+		t.Fatalf("generate() error = %v", err)
+	}
+
+	for _, expected := range []string{
+		`"context"`,
+		"PingFunc    func(ctx context.Context, hosts ...string) error",
+		`return fmt.Errorf("API.Ping is not mocked")`,
+		"return m.PingFunc(ctx, hosts...)",
+		"var r0 string",
+		"m.CloseFunc()",
+	} {
+		if !strings.Contains(string(mock), expected) {
+			t.Errorf("Expected the mock to contain %q, got:\n%s", expected, mock)
+		}
+	}
+
+	if _, err := generate([]byte(src), "api.go", "Client", "clientmock"); err == nil {
+		t.Error("Expected error for an interface that is not declared")
+	}
+}
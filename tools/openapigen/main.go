@@ -0,0 +1,493 @@
+// Command openapigen generates n8n API client bindings from an OpenAPI document.
+//
+// Each schema under components.schemas becomes a struct and each operation with an operationId becomes a
+// method of client.Client named after it. Path parameters become string arguments, a JSON request body
+// becomes a pointer to its schema type, and query parameters are collected in a <Method>Params struct.
+// Requests go through Client.request, so they are routed to the API surface that serves the endpoint like
+// hand-written ones.
+//
+// Usage:
+//
+//	go run ./tools/openapigen -spec tools/openapigen/n8n-openapi.yml -out internal/client/api_generated.go
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+
+	"gopkg.in/yaml.v3"
+)
+
+// document is the part of an OpenAPI 3 document the generator reads
+type document struct {
+	Paths      map[string]map[string]*operation `yaml:"paths"`
+	Components struct {
+		Parameters map[string]*parameter `yaml:"parameters"`
+		Schemas    map[string]*schema    `yaml:"schemas"`
+	} `yaml:"components"`
+}
+
+// operation is an operation of a path
+type operation struct {
+	OperationID string       `yaml:"operationId"`
+	Summary     string       `yaml:"summary"`
+	Parameters  []*parameter `yaml:"parameters"`
+	RequestBody *body        `yaml:"requestBody"`
+	Responses   map[string]*body
+}
+
+// parameter is a parameter of an operation, or a reference to one under components.parameters
+type parameter struct {
+	Ref         string  `yaml:"$ref"`
+	Name        string  `yaml:"name"`
+	In          string  `yaml:"in"`
+	Description string  `yaml:"description"`
+	Required    bool    `yaml:"required"`
+	Schema      *schema `yaml:"schema"`
+}
+
+// body is a request body or a response
+type body struct {
+	Required bool `yaml:"required"`
+	Content  map[string]struct {
+		Schema *schema `yaml:"schema"`
+	} `yaml:"content"`
+}
+
+// schema is a schema, or a reference to one under components.schemas
+type schema struct {
+	Ref         string     `yaml:"$ref"`
+	Type        string     `yaml:"type"`
+	Format      string     `yaml:"format"`
+	Description string     `yaml:"description"`
+	Required    []string   `yaml:"required"`
+	Properties  properties `yaml:"properties"`
+	Items       *schema    `yaml:"items"`
+	GoType      string     `yaml:"x-go-type"`
+}
+
+// property is a property of an object schema
+type property struct {
+	Name   string
+	Schema *schema
+}
+
+// properties are the properties of an object schema in the order of the document, which the fields of the
+// generated struct follow
+type properties []property
+
+func (p *properties) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind != yaml.MappingNode {
+		return fmt.Errorf("line %d: properties must be a mapping", node.Line)
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		var s schema
+		if err := node.Content[i+1].Decode(&s); err != nil {
+			return err
+		}
+		*p = append(*p, property{Name: node.Content[i].Value, Schema: &s})
+	}
+	return nil
+}
+
+// methods lists the HTTP methods in the order their operations are generated for a path
+var methods = []string{"get", "post", "put", "patch", "delete"}
+
+// initialisms are spelled in upper case in Go names
+var initialisms = map[string]bool{"API": true, "HTTP": true, "ID": true, "JSON": true, "URL": true}
+
+func main() {
+	specPath := flag.String("spec", "n8n-openapi.yml", "path of the OpenAPI document")
+	outPath := flag.String("out", "api_generated.go", "path of the generated Go file")
+	pkg := flag.String("package", "client", "package of the generated Go file")
+	flag.Parse()
+
+	spec, err := os.ReadFile(*specPath)
+	if err != nil {
+		log.Fatalf("unable to read OpenAPI document: %s", err)
+	}
+
+	source, err := generate(spec, *pkg, filepath.Base(*specPath))
+	if err != nil {
+		log.Fatalf("unable to generate client bindings: %s", err)
+	}
+
+	if err := os.WriteFile(*outPath, source, 0o644); err != nil {
+		log.Fatalf("unable to write client bindings: %s", err)
+	}
+}
+
+// generate returns the formatted Go source of the bindings of an OpenAPI document
+func generate(spec []byte, pkg, specName string) ([]byte, error) {
+	var doc document
+	if err := yaml.Unmarshal(spec, &doc); err != nil {
+		return nil, fmt.Errorf("unable to parse OpenAPI document: %w", err)
+	}
+
+	g := &generator{doc: &doc}
+	for _, name := range sortedKeys(doc.Components.Schemas) {
+		if err := g.writeSchema(name, doc.Components.Schemas[name]); err != nil {
+			return nil, err
+		}
+	}
+	for _, path := range sortedKeys(doc.Paths) {
+		for _, method := range methods {
+			if op := doc.Paths[path][method]; op != nil && op.OperationID != "" {
+				if err := g.writeOperation(path, method, op); err != nil {
+					return nil, fmt.Errorf("%s %s: %w", strings.ToUpper(method), path, err)
+				}
+			}
+		}
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by openapigen from %s; DO NOT EDIT.\n\npackage %s\n\nimport (\n", specName, pkg)
+	for _, imp := range []string{"encoding/json", "fmt", "net/url", "strconv", "time"} {
+		if strings.Contains(g.buf.String(), filepath.Base(imp)+".") {
+			fmt.Fprintf(&out, "%q\n", imp)
+		}
+	}
+	out.WriteString(")\n")
+	out.Write(g.buf.Bytes())
+
+	source, err := format.Source(out.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("unable to format generated code: %w", err)
+	}
+	return source, nil
+}
+
+// generator accumulates the generated declarations
+type generator struct {
+	doc *document
+	buf bytes.Buffer
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+// writeSchema writes the struct of an object schema
+func (g *generator) writeSchema(name string, s *schema) error {
+	if s.Type != "object" || len(s.Properties) == 0 {
+		return fmt.Errorf("schema %s: only object schemas with properties are supported", name)
+	}
+
+	typeName := goName(name)
+	g.printf("\n%s\ntype %s struct {\n", docComment(typeName, s.Description, "is the "+name+" schema"), typeName)
+	for _, prop := range s.Properties {
+		fieldType, err := g.goType(prop.Schema)
+		if err != nil {
+			return fmt.Errorf("schema %s, property %s: %w", name, prop.Name, err)
+		}
+
+		tag := prop.Name
+		if !contains(s.Required, prop.Name) || strings.HasPrefix(fieldType, "*") {
+			tag += ",omitempty"
+		}
+		if prop.Schema.Description != "" {
+			g.printf("// %s\n", prop.Schema.Description)
+		}
+		g.printf("%s %s `json:\"%s\"`\n", goName(prop.Name), fieldType, tag)
+	}
+	g.printf("}\n")
+	return nil
+}
+
+// writeOperation writes the method of an operation, and the struct of its query parameters if it has any
+func (g *generator) writeOperation(path, method string, op *operation) error {
+	name := goName(op.OperationID)
+
+	var args, pathArgs, queryParams []*parameter
+	for _, param := range op.Parameters {
+		param, err := g.resolveParameter(param)
+		if err != nil {
+			return err
+		}
+		switch param.In {
+		case "path":
+			args = append(args, param)
+			pathArgs = append(pathArgs, param)
+		case "query":
+			queryParams = append(queryParams, param)
+		default:
+			return fmt.Errorf("parameter %s: %s parameters are not supported", param.Name, param.In)
+		}
+	}
+
+	var signature []string
+	for _, param := range args {
+		signature = append(signature, lowerFirst(goName(param.Name))+" string")
+	}
+
+	bodyType, err := g.contentType(op.RequestBody)
+	if err != nil {
+		return fmt.Errorf("request body: %w", err)
+	}
+	bodyArg := "nil"
+	if bodyType != "" {
+		bodyArg = lowerFirst(strings.TrimPrefix(bodyType, "*"))
+		signature = append(signature, bodyArg+" "+bodyType)
+	}
+
+	paramsType := name + "Params"
+	if len(queryParams) > 0 {
+		if err := g.writeParams(paramsType, name, queryParams); err != nil {
+			return err
+		}
+		signature = append(signature, "params *"+paramsType)
+	}
+
+	var resultType string
+	for _, status := range []string{"200", "201"} {
+		if response := op.Responses[status]; response != nil {
+			if resultType, err = g.contentType(response); err != nil {
+				return fmt.Errorf("response: %w", err)
+			}
+			break
+		}
+	}
+
+	returns, fail, success := "error", "return ", "return nil"
+	if resultType != "" {
+		returns, fail, success = "("+resultType+", error)", "return nil, ", "return &result, nil"
+	}
+
+	summary := strings.ToLower(op.Summary[:1]) + op.Summary[1:]
+	g.printf("\n// %s calls %s %s: %s\n", name, strings.ToUpper(method), path, summary)
+	g.printf("func (c *Client) %s(%s) %s {\n", name, strings.Join(signature, ", "), returns)
+
+	// Required arguments are checked before the request is sent
+	errContext := ""
+	var errArgs []string
+	for _, param := range pathArgs {
+		arg := lowerFirst(goName(param.Name))
+		g.printf("if %s == \"\" {\n%sfmt.Errorf(\"%s is required\")\n}\n\n", arg, fail, param.Name)
+		errContext += fmt.Sprintf(" %s=%%s", param.Name)
+		errArgs = append(errArgs, arg)
+	}
+	if bodyType != "" && op.RequestBody.Required {
+		g.printf("if %s == nil {\n%sfmt.Errorf(\"%s is required\")\n}\n\n", bodyArg, fail, bodyArg)
+	}
+
+	requestPath, pathFormatArgs := strings.TrimPrefix(path, "/"), []string{}
+	for _, param := range pathArgs {
+		requestPath = strings.Replace(requestPath, "{"+param.Name+"}", "%s", 1)
+		pathFormatArgs = append(pathFormatArgs, "url.PathEscape("+lowerFirst(goName(param.Name))+")")
+	}
+	if len(pathFormatArgs) > 0 {
+		g.printf("path := fmt.Sprintf(%q, %s)\n", requestPath, strings.Join(pathFormatArgs, ", "))
+	} else {
+		g.printf("path := %q\n", requestPath)
+	}
+	if len(queryParams) > 0 {
+		g.printf("if query := params.encode(); query != \"\" {\npath += \"?\" + query\n}\n")
+	}
+	g.printf("\n")
+
+	resultArg := "nil"
+	if resultType != "" {
+		resultArg = "&result"
+		g.printf("var result %s\n", strings.TrimPrefix(resultType, "*"))
+	}
+
+	errFormat := "failed to " + summary
+	if errContext != "" {
+		errFormat += " (" + strings.TrimPrefix(errContext, " ") + ")"
+	}
+	g.printf("if err := c.request(%q, path, %s, %s); err != nil {\n", strings.ToUpper(method), bodyArg, resultArg)
+	g.printf("%sfmt.Errorf(%q, %s)\n}\n\n", fail, errFormat+": %w", strings.Join(append(errArgs, "err"), ", "))
+	g.printf("%s\n}\n", success)
+	return nil
+}
+
+// writeParams writes the struct holding the query parameters of an operation and its encode method
+func (g *generator) writeParams(typeName, method string, params []*parameter) error {
+	g.printf("\n// %s holds the query parameters of %s. Zero values are left out of the request.\n", typeName, method)
+	g.printf("type %s struct {\n", typeName)
+	for _, param := range params {
+		fieldType, err := g.goType(param.Schema)
+		if err != nil {
+			return fmt.Errorf("parameter %s: %w", param.Name, err)
+		}
+		switch fieldType {
+		case "string", "int", "bool":
+		default:
+			return fmt.Errorf("parameter %s: query parameters of type %s are not supported", param.Name, fieldType)
+		}
+		if param.Description != "" {
+			g.printf("// %s\n", param.Description)
+		}
+		g.printf("%s %s\n", goName(param.Name), fieldType)
+	}
+	g.printf("}\n")
+
+	g.printf("\n// encode returns the query string of the parameters\n")
+	g.printf("func (p *%s) encode() string {\nif p == nil {\nreturn \"\"\n}\n\nparams := url.Values{}\n", typeName)
+	for _, param := range params {
+		field := "p." + goName(param.Name)
+		fieldType, _ := g.goType(param.Schema)
+		switch fieldType {
+		case "string":
+			g.printf("if %s != \"\" {\nparams.Set(%q, %s)\n}\n", field, param.Name, field)
+		case "int":
+			g.printf("if %s != 0 {\nparams.Set(%q, strconv.Itoa(%s))\n}\n", field, param.Name, field)
+		case "bool":
+			g.printf("if %s {\nparams.Set(%q, \"true\")\n}\n", field, param.Name)
+		}
+	}
+	g.printf("return params.Encode()\n}\n")
+	return nil
+}
+
+// resolveParameter returns the parameter a reference under components.parameters points to
+func (g *generator) resolveParameter(param *parameter) (*parameter, error) {
+	if param.Ref == "" {
+		return param, nil
+	}
+	name := strings.TrimPrefix(param.Ref, "#/components/parameters/")
+	resolved, ok := g.doc.Components.Parameters[name]
+	if !ok || name == param.Ref {
+		return nil, fmt.Errorf("unresolved parameter reference %s", param.Ref)
+	}
+	return resolved, nil
+}
+
+// contentType returns the Go type of the JSON content of a request body or response, or "" if it has none
+func (g *generator) contentType(b *body) (string, error) {
+	if b == nil {
+		return "", nil
+	}
+	content, ok := b.Content["application/json"]
+	if !ok || content.Schema == nil {
+		return "", nil
+	}
+	if content.Schema.Ref == "" {
+		return "", fmt.Errorf("only references to components.schemas are supported as JSON content")
+	}
+
+	goType, err := g.goType(content.Schema)
+	if err != nil {
+		return "", err
+	}
+	return "*" + goType, nil
+}
+
+// goType returns the Go type of a schema
+func (g *generator) goType(s *schema) (string, error) {
+	if s == nil {
+		return "", fmt.Errorf("missing schema")
+	}
+	if s.GoType != "" {
+		return s.GoType, nil
+	}
+
+	if s.Ref != "" {
+		name := strings.TrimPrefix(s.Ref, "#/components/schemas/")
+		if _, ok := g.doc.Components.Schemas[name]; !ok || name == s.Ref {
+			return "", fmt.Errorf("unresolved schema reference %s", s.Ref)
+		}
+		return goName(name), nil
+	}
+
+	switch s.Type {
+	case "string":
+		if s.Format == "date-time" {
+			return "*time.Time", nil
+		}
+		return "string", nil
+	case "integer":
+		return "int", nil
+	case "number":
+		return "float64", nil
+	case "boolean":
+		return "bool", nil
+	case "array":
+		items, err := g.goType(s.Items)
+		if err != nil {
+			return "", fmt.Errorf("items: %w", err)
+		}
+		return "[]" + items, nil
+	case "object":
+		if len(s.Properties) > 0 {
+			return "", fmt.Errorf("inline object schemas are not supported, move the schema to components.schemas")
+		}
+		return "map[string]interface{}", nil
+	default:
+		return "", fmt.Errorf("unsupported schema type %q", s.Type)
+	}
+}
+
+// goName converts a camelCase or snake_case OpenAPI name to an exported Go name, e.g. workflowId to
+// WorkflowID
+func goName(name string) string {
+	var words []string
+	var word []rune
+	for _, r := range name {
+		switch {
+		case !unicode.IsLetter(r) && !unicode.IsDigit(r):
+			if len(word) > 0 {
+				words, word = append(words, string(word)), nil
+			}
+			continue
+		case unicode.IsUpper(r) && len(word) > 0:
+			words, word = append(words, string(word)), nil
+		}
+		word = append(word, r)
+	}
+	if len(word) > 0 {
+		words = append(words, string(word))
+	}
+
+	var b strings.Builder
+	for _, w := range words {
+		if upper := strings.ToUpper(w); initialisms[upper] {
+			b.WriteString(upper)
+		} else {
+			b.WriteString(strings.ToUpper(w[:1]) + w[1:])
+		}
+	}
+	return b.String()
+}
+
+// lowerFirst returns a Go name with its first word in lower case, for use as a variable name
+func lowerFirst(name string) string {
+	if initialisms[name] {
+		return strings.ToLower(name)
+	}
+	return strings.ToLower(name[:1]) + name[1:]
+}
+
+// docComment returns the doc comment of a declaration, falling back to a generic description
+func docComment(name, description, fallback string) string {
+	if description == "" {
+		return "// " + name + " " + fallback
+	}
+	return "// " + name + " is " + strings.ToLower(description[:1]) + description[1:]
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestGenerate_UpToDate(t *testing.T) {
+	spec, err := os.ReadFile("n8n-openapi.yml")
+	if err != nil {
+		t.Fatalf("Failed to read OpenAPI document: %v", err)
+	}
+
+	source, err := generate(spec, "client", "n8n-openapi.yml")
+	if err != nil {
+		t.Fatalf("generate() error = %v", err)
+	}
+
+	checkedIn, err := os.ReadFile("../../internal/client/api_generated.go")
+	if err != nil {
+		t.Fatalf("Failed to read generated bindings: %v", err)
+	}
+	if !bytes.Equal(source, checkedIn) {
+		t.Error("internal/client/api_generated.go is out of date, run make generate-client")
+	}
+}
+
+func TestGenerate_Unsupported(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     string
+		expected string
+	}{
+		{
+			name: "inline object",
+			spec: `
+components:
+  schemas:
+    tag:
+      type: object
+      properties:
+        owner:
+          type: object
+          properties:
+            id:
+              type: string`,
+			expected: "inline object schemas are not supported",
+		},
+		{
+			name: "unresolved reference",
+			spec: `
+paths:
+  /tags:
+    get:
+      operationId: getTags
+      summary: Retrieve all tags
+      responses:
+        '200':
+          content:
+            application/json:
+              schema:
+                $ref: '#/components/schemas/tagList'`,
+			expected: "unresolved schema reference #/components/schemas/tagList",
+		},
+		{
+			name: "header parameter",
+			spec: `
+paths:
+  /tags:
+    get:
+      operationId: getTags
+      summary: Retrieve all tags
+      parameters:
+        - name: X-Request-Id
+          in: header
+          schema:
+            type: string`,
+			expected: "header parameters are not supported",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := generate([]byte(tt.spec), "client", "spec.yml")
+			if err == nil || !strings.Contains(err.Error(), tt.expected) {
+				t.Errorf("Expected error containing %q, got %v", tt.expected, err)
+			}
+		})
+	}
+}
+
+func TestGoName(t *testing.T) {
+	tests := map[string]string{
+		"execution":      "Execution",
+		"workflowId":     "WorkflowID",
+		"retrySuccessId": "RetrySuccessID",
+		"id":             "ID",
+		"include_data":   "IncludeData",
+		"X-Api-Key":      "XAPIKey",
+	}
+
+	for name, expected := range tests {
+		if got := goName(name); got != expected {
+			t.Errorf("goName(%q) = %q, expected %q", name, got, expected)
+		}
+	}
+}